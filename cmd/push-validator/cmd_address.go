@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// addressPrefixes are the bech32 prefixes accepted by --prefix.
+var addressPrefixes = []string{validator.AccountPrefix, validator.ValoperPrefix, validator.ValconsPrefix}
+
+// addressConversion is one address converted to its other representation.
+type addressConversion struct {
+	Input        string `json:"input"`
+	InputFormat  string `json:"input_format"` // hex or bech32
+	Output       string `json:"output"`
+	OutputFormat string `json:"output_format"`
+	Prefix       string `json:"prefix"` // bech32 prefix of whichever side is bech32
+}
+
+// convertAddress detects whether in is a 0x hex or a bech32 address and
+// converts it to the other form. Hex input is encoded with prefix.
+func convertAddress(in, prefix string) (addressConversion, error) {
+	in = strings.TrimSpace(in)
+	switch prefix {
+	case validator.AccountPrefix, validator.ValoperPrefix, validator.ValconsPrefix:
+	default:
+		return addressConversion{}, exitcodes.InvalidArgsErrorf("invalid --prefix %q: must be one of %s", prefix, strings.Join(addressPrefixes, ", "))
+	}
+	if strings.HasPrefix(strings.ToLower(in), "0x") {
+		out, err := validator.EncodeBech32(in, prefix)
+		if err != nil {
+			return addressConversion{}, exitcodes.InvalidArgsError(err.Error())
+		}
+		return addressConversion{Input: in, InputFormat: "hex", Output: out, OutputFormat: "bech32", Prefix: prefix}, nil
+	}
+	hrp, out, err := validator.DecodeBech32(in)
+	if err != nil {
+		return addressConversion{}, exitcodes.InvalidArgsErrorf("%v (expected 0x hex or push1/pushvaloper1/pushvalcons1 bech32)", err)
+	}
+	return addressConversion{Input: in, InputFormat: "bech32", Output: out, OutputFormat: "hex", Prefix: hrp}, nil
+}
+
+// handleAddressConvert prints the other representation of addr. Offline.
+func handleAddressConvert(d *Deps, addr, prefix string) error {
+	conv, err := convertAddress(addr, prefix)
+	if err != nil {
+		if flagOutput == "json" {
+			d.Printer.JSON(map[string]any{"ok": false, "error": err.Error(), "input": addr})
+			return silentErr{err}
+		}
+		return err
+	}
+	if flagOutput == "json" {
+		d.Printer.JSON(map[string]any{
+			"ok":            true,
+			"input":         conv.Input,
+			"input_format":  conv.InputFormat,
+			"output":        conv.Output,
+			"output_format": conv.OutputFormat,
+			"prefix":        conv.Prefix,
+		})
+		return nil
+	}
+	fmt.Println(conv.Output)
+	return nil
+}
+
+func init() {
+	var prefix string
+	addressCmd := &cobra.Command{
+		Use:   "address",
+		Short: "Address utilities",
+	}
+	convertCmd := &cobra.Command{
+		Use:   "convert <address>",
+		Short: "Convert between bech32 (push1/pushvaloper1) and EVM (0x) addresses (offline)",
+		Long: `Convert an address to its other representation. A 0x hex address is
+encoded as bech32 with --prefix; a bech32 address (any prefix) is decoded to
+0x hex. Only the converted address is printed, for use in scripts.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleAddressConvert(newDeps(), args[0], prefix)
+		},
+	}
+	convertCmd.Flags().StringVar(&prefix, "prefix", validator.AccountPrefix, "Bech32 prefix for hex input: push, pushvaloper or pushvalcons")
+	addressCmd.AddCommand(convertCmd)
+	rootCmd.AddCommand(addressCmd)
+}
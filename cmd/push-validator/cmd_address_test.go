@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+func TestConvertAddress(t *testing.T) {
+	const hexAddr = "0x00112233445566778899AABBCCDDEEFF00112233"
+	account := validator.HexToBech32(hexAddr, validator.AccountPrefix)
+	valoper := validator.HexToBech32(hexAddr, validator.ValoperPrefix)
+	valcons := validator.HexToBech32(hexAddr, validator.ValconsPrefix)
+
+	tests := []struct {
+		in, prefix string
+		want       addressConversion
+	}{
+		{hexAddr, "push", addressConversion{Input: hexAddr, InputFormat: "hex", Output: account, OutputFormat: "bech32", Prefix: "push"}},
+		{"0x00112233445566778899aabbccddeeff00112233", "pushvaloper", addressConversion{Input: "0x00112233445566778899aabbccddeeff00112233", InputFormat: "hex", Output: valoper, OutputFormat: "bech32", Prefix: "pushvaloper"}},
+		{hexAddr, "pushvalcons", addressConversion{Input: hexAddr, InputFormat: "hex", Output: valcons, OutputFormat: "bech32", Prefix: "pushvalcons"}},
+		{" " + account + " ", "push", addressConversion{Input: account, InputFormat: "bech32", Output: hexAddr, OutputFormat: "hex", Prefix: "push"}},
+		{valoper, "push", addressConversion{Input: valoper, InputFormat: "bech32", Output: hexAddr, OutputFormat: "hex", Prefix: "pushvaloper"}},
+	}
+	for _, tt := range tests {
+		got, err := convertAddress(tt.in, tt.prefix)
+		if err != nil {
+			t.Fatalf("convertAddress(%q, %q) error = %v", tt.in, tt.prefix, err)
+		}
+		if got != tt.want {
+			t.Errorf("convertAddress(%q, %q) = %+v, want %+v", tt.in, tt.prefix, got, tt.want)
+		}
+	}
+
+	for _, bad := range []struct{ in, prefix string }{
+		{"", "push"},
+		{"0x1234", "push"},
+		{"0xZZ112233445566778899aabbccddeeff00112233", "push"},
+		{"push1notbech32", "push"},
+		{"not-an-address", "push"},
+		{hexAddr, "cosmos"},
+	} {
+		_, err := convertAddress(bad.in, bad.prefix)
+		var ec *exitcodes.ErrorWithCode
+		if !errors.As(err, &ec) || ec.Code != exitcodes.InvalidArgs {
+			t.Errorf("convertAddress(%q, %q) error = %v, want invalid args", bad.in, bad.prefix, err)
+		}
+	}
+}
+
+func TestHandleAddressConvert(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter()}
+	for _, out := range []string{"text", "json"} {
+		flagOutput = out
+		if err := handleAddressConvert(d, "0x00112233445566778899aabbccddeeff00112233", "push"); err != nil {
+			t.Fatalf("%s: unexpected error: %v", out, err)
+		}
+	}
+
+	flagOutput = "json"
+	err := handleAddressConvert(d, "bogus", "push")
+	if _, ok := err.(silentErr); !ok {
+		t.Errorf("json error = %v, want silentErr", err)
+	}
+}
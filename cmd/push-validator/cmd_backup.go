@@ -6,6 +6,9 @@ import (
 	"github.com/pushchain/push-validator-cli/internal/admin"
 )
 
+// flagBackupForce skips the free disk space check (backup --force).
+var flagBackupForce bool
+
 // handleBackup creates a backup archive of the node configuration and
 // prints the resulting path, or a JSON object when --output=json.
 func handleBackup(d *Deps) error {
@@ -16,7 +19,7 @@ func handleBackup(d *Deps) error {
 
 // handleBackupWith is the testable core of handleBackup with an injectable backup function.
 func handleBackupWith(d *Deps, backupFn func(admin.BackupOptions) (string, error)) error {
-	path, err := backupFn(admin.BackupOptions{HomeDir: d.Cfg.HomeDir})
+	path, err := backupFn(admin.BackupOptions{HomeDir: d.Cfg.HomeDir, ChainID: d.Cfg.ChainID, SkipDiskCheck: flagBackupForce})
 	if err != nil {
 		if flagOutput == "json" {
 			d.Printer.JSON(map[string]any{"ok": false, "error": err.Error()})
@@ -3,16 +3,22 @@ package main
 import (
     "context"
     "fmt"
+    "math/big"
     "os"
     "strings"
     "time"
 
-    "github.com/pushchain/push-validator-cli/internal/dashboard"
+    "github.com/pushchain/push-validator-cli/internal/validator"
 )
 
+// balanceDenom restricts balance output to a single unit (base or display denom).
+var balanceDenom string
+
 // handleBalance prints an account balance. It resolves the address from
 // either a positional argument or KEY_NAME when --address/arg is omitted.
-// When --output=json is set, it emits a structured object.
+// Amounts are shown in both the human-readable unit and raw base units;
+// --denom restricts output to one unit. When --output=json is set, it emits
+// a structured object with raw and display amounts for every coin held.
 func handleBalance(d *Deps, args []string) error {
     var addr string
     if len(args) > 0 { addr = args[0] }
@@ -46,11 +52,137 @@ func handleBalance(d *Deps, args []string) error {
 
     ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
     defer cancel()
-    bal, err := d.Validator.Balance(ctx, addr)
+    coins, err := d.Validator.Balances(ctx, addr)
     if err != nil {
         if flagOutput == "json" { d.Printer.JSON(map[string]any{"ok": false, "error": err.Error(), "address": addr}) } else { d.Printer.Error(fmt.Sprintf("balance error: %v", err)) }
         return err
     }
-    if flagOutput == "json" { d.Printer.JSON(map[string]any{"ok": true, "address": addr, "balance": bal, "denom": d.Cfg.Denom}) } else { d.Printer.Info(fmt.Sprintf("%s %s", dashboard.FormatSmartNumber(bal), d.Cfg.Denom)) }
+
+    // Always report the staking denom, even when the account holds none of it
+    hasStaking := false
+    for _, c := range coins {
+        if c.Denom == d.Cfg.Denom { hasStaking = true }
+    }
+    if !hasStaking { coins = append([]validator.Coin{{Denom: d.Cfg.Denom, Amount: "0"}}, coins...) }
+
+    // --denom matches either the base denom or its display unit
+    var match *validator.Coin
+    matchDisplay := false
+    if balanceDenom != "" {
+        for i, c := range coins {
+            if strings.EqualFold(balanceDenom, c.Denom) || strings.EqualFold(balanceDenom, lookupDenom(c.Denom).Display) {
+                match, matchDisplay = &coins[i], !strings.EqualFold(balanceDenom, c.Denom)
+                break
+            }
+        }
+        if match == nil {
+            err := fmt.Errorf("unknown denom %q (account holds: %s)", balanceDenom, heldDenoms(coins))
+            if flagOutput == "json" { d.Printer.JSON(map[string]any{"ok": false, "error": err.Error(), "address": addr}) } else { d.Printer.Error(err.Error()) }
+            return silentErr{err}
+        }
+    }
+
+    if flagOutput == "json" {
+        out := map[string]any{"ok": true, "address": addr, "denom": d.Cfg.Denom}
+        entries := make([]map[string]any, 0, len(coins))
+        for _, c := range coins {
+            e := balanceEntry(c)
+            if c.Denom == d.Cfg.Denom {
+                out["balance"] = e["amount_raw"]
+                out["amount_raw"] = e["amount_raw"]
+                out["amount_display"] = e["amount_display"]
+                out["display_denom"] = e["display_denom"]
+                out["exponent"] = e["exponent"]
+            }
+            if match == nil || c.Denom == match.Denom { entries = append(entries, e) }
+        }
+        out["balances"] = entries
+        d.Printer.JSON(out)
+        return nil
+    }
+
+    if match != nil {
+        if matchDisplay {
+            unit := lookupDenom(match.Denom)
+            d.Printer.Info(fmt.Sprintf("%s %s", formatDenomAmount(match.Amount, unit.Exponent), unit.Display))
+        } else {
+            d.Printer.Info(fmt.Sprintf("%s %s", rawAmount(match.Amount), match.Denom))
+        }
+        return nil
+    }
+
+    for _, c := range coins {
+        unit := lookupDenom(c.Denom)
+        if unit.Exponent == 0 {
+            d.Printer.Info(fmt.Sprintf("%s %s", rawAmount(c.Amount), c.Denom))
+            continue
+        }
+        d.Printer.Info(fmt.Sprintf("%s %s (%s %s)", formatDenomAmount(c.Amount, unit.Exponent), unit.Display, rawAmount(c.Amount), c.Denom))
+    }
     return nil
 }
+
+// denomUnit describes how a base denom is shown to humans.
+type denomUnit struct {
+    Display  string // human-readable unit (e.g., PC)
+    Exponent int    // decimals between the base and display unit
+}
+
+// knownDenoms maps base denoms to their display unit. upc uses the
+// EVM-style 18 decimals (1 PC = 1e18 upc).
+var knownDenoms = map[string]denomUnit{
+    "upc": {Display: "PC", Exponent: 18},
+}
+
+// lookupDenom returns the display unit for a base denom. Unknown denoms are
+// displayed as-is with no decimal shift.
+func lookupDenom(denom string) denomUnit {
+    if u, ok := knownDenoms[denom]; ok { return u }
+    return denomUnit{Display: denom, Exponent: 0}
+}
+
+// rawAmount normalizes an empty amount to "0".
+func rawAmount(amount string) string {
+    if amount == "" { return "0" }
+    return amount
+}
+
+// formatDenomAmount converts a base-unit integer string into an exact decimal
+// string shifted by exponent, trimming trailing zeros (e.g., "1500...0", 18 -> "1.5").
+func formatDenomAmount(amount string, exponent int) string {
+    n, ok := new(big.Int).SetString(rawAmount(amount), 10)
+    if !ok { return amount }
+    if exponent <= 0 { return n.String() }
+    neg := n.Sign() < 0
+    n.Abs(n)
+    q, r := new(big.Int).QuoRem(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil), new(big.Int))
+    out := q.String()
+    if r.Sign() != 0 {
+        frac := strings.TrimRight(fmt.Sprintf("%0*s", exponent, r.String()), "0")
+        out += "." + frac
+    }
+    if neg { out = "-" + out }
+    return out
+}
+
+// balanceEntry builds the JSON view of a coin with both raw and display amounts.
+func balanceEntry(c validator.Coin) map[string]any {
+    unit := lookupDenom(c.Denom)
+    return map[string]any{
+        "denom":          c.Denom,
+        "amount_raw":     rawAmount(c.Amount),
+        "amount_display": formatDenomAmount(c.Amount, unit.Exponent),
+        "display_denom":  unit.Display,
+        "exponent":       unit.Exponent,
+    }
+}
+
+// heldDenoms lists the denoms in coins for error messages.
+func heldDenoms(coins []validator.Coin) string {
+    names := make([]string, 0, len(coins))
+    for _, c := range coins {
+        names = append(names, c.Denom)
+        if u := lookupDenom(c.Denom); u.Display != c.Denom { names = append(names, u.Display) }
+    }
+    return strings.Join(names, ", ")
+}
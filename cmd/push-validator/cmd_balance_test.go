@@ -1,9 +1,13 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
 func TestHandleBalance_NoAddress_NoKeyName(t *testing.T) {
@@ -277,3 +281,125 @@ func TestHandleBalance_KeyNameResolution_RunnerError_JSON(t *testing.T) {
 	}
 }
 
+
+func TestFormatDenomAmount(t *testing.T) {
+	tests := []struct {
+		amount   string
+		exponent int
+		want     string
+	}{
+		{"1500000000000000000", 18, "1.5"},
+		{"1000000000000000000", 18, "1"},
+		{"1", 18, "0.000000000000000001"},
+		{"0", 18, "0"},
+		{"", 18, "0"},
+		{"123456789000000000000000", 18, "123456.789"},
+		{"42", 0, "42"},
+		{"not-a-number", 18, "not-a-number"},
+	}
+	for _, tt := range tests {
+		if got := formatDenomAmount(tt.amount, tt.exponent); got != tt.want {
+			t.Errorf("formatDenomAmount(%q, %d) = %q, want %q", tt.amount, tt.exponent, got, tt.want)
+		}
+	}
+}
+
+func TestBalanceEntry(t *testing.T) {
+	e := balanceEntry(validator.Coin{Denom: "upc", Amount: "2500000000000000000"})
+	if e["amount_raw"] != "2500000000000000000" || e["amount_display"] != "2.5" || e["display_denom"] != "PC" || e["exponent"] != 18 {
+		t.Errorf("unexpected upc entry: %v", e)
+	}
+	e = balanceEntry(validator.Coin{Denom: "ibc/ABC", Amount: "7"})
+	if e["amount_display"] != "7" || e["display_denom"] != "ibc/ABC" || e["exponent"] != 0 {
+		t.Errorf("unexpected unknown-denom entry: %v", e)
+	}
+}
+
+func TestHandleBalance_MultipleDenoms(t *testing.T) {
+	origOutput, origDenom := flagOutput, balanceDenom
+	defer func() { flagOutput, balanceDenom = origOutput, origDenom }()
+
+	coins := []validator.Coin{{Denom: "ibc/ABC", Amount: "7"}}
+	for _, output := range []string{"text", "json"} {
+		flagOutput = output
+		d := &Deps{
+			Cfg:       testCfg(),
+			Printer:   getPrinter(),
+			Validator: &mockValidator{balancesResult: coins},
+			Runner:    newMockRunner(),
+		}
+		if err := handleBalance(d, []string{"push1abc123"}); err != nil {
+			t.Fatalf("%s: unexpected error: %v", output, err)
+		}
+	}
+}
+
+func TestHandleBalance_DenomFlag(t *testing.T) {
+	origOutput, origDenom := flagOutput, balanceDenom
+	defer func() { flagOutput, balanceDenom = origOutput, origDenom }()
+	flagOutput = "text"
+
+	d := &Deps{
+		Cfg:       testCfg(),
+		Printer:   getPrinter(),
+		Validator: &mockValidator{balanceResult: "1500000000000000000"},
+		Runner:    newMockRunner(),
+	}
+	for _, denom := range []string{"upc", "PC", "pc"} {
+		balanceDenom = denom
+		if err := handleBalance(d, []string{"push1abc123"}); err != nil {
+			t.Errorf("--denom %s: unexpected error: %v", denom, err)
+		}
+	}
+
+	balanceDenom = "atom"
+	err := handleBalance(d, []string{"push1abc123"})
+	if err == nil || !strings.Contains(err.Error(), "unknown denom") {
+		t.Errorf("expected unknown denom error, got %v", err)
+	}
+}
+
+func TestHandleBalance_DenomFlag_JSON(t *testing.T) {
+	origOutput, origDenom, origStdout := flagOutput, balanceDenom, os.Stdout
+	defer func() { flagOutput, balanceDenom, os.Stdout = origOutput, origDenom, origStdout }()
+	flagOutput = "json"
+
+	coins := []validator.Coin{{Denom: "upc", Amount: "1500000000000000000"}, {Denom: "ibc/ABC", Amount: "7"}}
+	d := &Deps{
+		Cfg:       testCfg(),
+		Printer:   getPrinter(),
+		Validator: &mockValidator{balancesResult: coins},
+		Runner:    newMockRunner(),
+	}
+	run := func() (map[string]any, error) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.Stdout = w
+		herr := handleBalance(d, []string{"push1abc123"})
+		w.Close()
+		os.Stdout = origStdout
+		var out map[string]any
+		if err := json.NewDecoder(r).Decode(&out); err != nil {
+			t.Fatalf("decode JSON output: %v", err)
+		}
+		return out, herr
+	}
+
+	balanceDenom = "ibc/abc"
+	out, err := run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	entries, _ := out["balances"].([]any)
+	if len(entries) != 1 || entries[0].(map[string]any)["denom"] != "ibc/ABC" {
+		t.Errorf("balances = %v, want only ibc/ABC", out["balances"])
+	}
+
+	balanceDenom = "atom"
+	out, err = run()
+	if err == nil || out["ok"] != false {
+		t.Errorf("expected ok:false and an error for unknown denom, got %v, %v", out, err)
+	}
+}
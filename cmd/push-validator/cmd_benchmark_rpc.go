@@ -0,0 +1,222 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	syncmon "github.com/pushchain/push-validator-cli/internal/sync"
+	ui "github.com/pushchain/push-validator-cli/internal/ui"
+)
+
+// rpcBenchHeightSlack is how many blocks an endpoint may trail the highest
+// reported height and still be recommended; probes of different endpoints
+// happen a moment apart, so heights rarely match exactly.
+const rpcBenchHeightSlack = 10
+
+// rpcProber probes one endpoint; syncmon.ProbeEndpoint in production.
+type rpcProber func(base string, timeout time.Duration) syncmon.EndpointSample
+
+// rpcBenchResult summarises the probes of one endpoint. Latencies cover
+// successful probes only: /status returned a height and /health answered.
+type rpcBenchResult struct {
+	URL         string  `json:"url" yaml:"url"`
+	Probes      int     `json:"probes" yaml:"probes"`
+	Successes   int     `json:"successes" yaml:"successes"`
+	SuccessRate float64 `json:"success_rate" yaml:"success_rate"`
+	MinMS       int64   `json:"min_ms" yaml:"min_ms"`
+	AvgMS       int64   `json:"avg_ms" yaml:"avg_ms"`
+	MaxMS       int64   `json:"max_ms" yaml:"max_ms"`
+	Height      int64   `json:"height" yaml:"height"`
+	Recommended bool    `json:"recommended" yaml:"recommended"`
+	Error       string  `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// normalizeRPCURL accepts a bare domain like --genesis-domain does and
+// defaults it to HTTPS.
+func normalizeRPCURL(s string) string {
+	s = strings.TrimRight(strings.TrimSpace(s), "/")
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	return s
+}
+
+// benchmarkRPCs probes every URL count times, one round across all URLs at
+// a time so a slow moment on the network doesn't land on a single endpoint,
+// and marks the recommended endpoint.
+func benchmarkRPCs(urls []string, count int, timeout time.Duration, probe rpcProber) []rpcBenchResult {
+	results := make([]rpcBenchResult, len(urls))
+	total := make([]time.Duration, len(urls))
+	for i, u := range urls {
+		results[i].URL = u
+	}
+	for round := 0; round < count; round++ {
+		for i := range results {
+			r := &results[i]
+			s := probe(r.URL, timeout)
+			r.Probes++
+			switch {
+			case s.Err != nil:
+				r.Error = s.Err.Error()
+				continue
+			case !s.Healthy:
+				r.Error = "/health did not answer 200"
+				continue
+			}
+			ms := s.Latency.Milliseconds()
+			if r.Successes == 0 || ms < r.MinMS {
+				r.MinMS = ms
+			}
+			if ms > r.MaxMS {
+				r.MaxMS = ms
+			}
+			if s.Height > r.Height {
+				r.Height = s.Height
+			}
+			r.Successes++
+			total[i] += s.Latency
+		}
+	}
+	for i := range results {
+		r := &results[i]
+		if r.Probes > 0 {
+			r.SuccessRate = float64(r.Successes) / float64(r.Probes)
+		}
+		if r.Successes > 0 {
+			r.AvgMS = (total[i] / time.Duration(r.Successes)).Milliseconds()
+			r.Error = ""
+		}
+	}
+	if best := recommendRPC(results); best >= 0 {
+		results[best].Recommended = true
+	}
+	return results
+}
+
+// recommendRPC returns the index of the endpoint to use, or -1 if none
+// answered. Endpoints more than rpcBenchHeightSlack behind the highest height
+// are skipped; of the rest the most reliable wins, then the fastest, so an
+// endpoint that failed some probes never beats one that answered them all.
+func recommendRPC(results []rpcBenchResult) int {
+	var maxHeight int64
+	for _, r := range results {
+		if r.Successes > 0 && r.Height > maxHeight {
+			maxHeight = r.Height
+		}
+	}
+	best := -1
+	for i, r := range results {
+		if r.Successes == 0 || r.Height < maxHeight-rpcBenchHeightSlack {
+			continue
+		}
+		if best < 0 || r.SuccessRate > results[best].SuccessRate ||
+			r.SuccessRate == results[best].SuccessRate && r.AvgMS < results[best].AvgMS {
+			best = i
+		}
+	}
+	return best
+}
+
+// handleBenchmarkRPC benchmarks urls and writes the results to w: a table,
+// or JSON/YAML per --output. It fails with a network error when no endpoint
+// answered.
+func handleBenchmarkRPC(w io.Writer, urls []string, count int, timeout time.Duration, probe rpcProber) error {
+	if count < 1 {
+		return exitcodes.InvalidArgsErrorf("--count must be at least 1")
+	}
+	for i, u := range urls {
+		urls[i] = normalizeRPCURL(u)
+	}
+	results := benchmarkRPCs(urls, count, timeout, probe)
+	best := -1
+	for i, r := range results {
+		if r.Recommended {
+			best = i
+		}
+	}
+
+	switch flagOutput {
+	case "json", "yaml":
+		out := map[string]any{"ok": best >= 0, "probes": count, "endpoints": results}
+		if best >= 0 {
+			out["recommended"] = results[best].URL
+		}
+		if flagOutput == "yaml" {
+			data, err := yaml.Marshal(out)
+			if err != nil {
+				return err
+			}
+			_, _ = w.Write(data)
+		} else {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			_ = enc.Encode(out)
+		}
+	default:
+		c := ui.NewColorConfigFromGlobal()
+		headers := []string{"URL", "OK", "MIN", "AVG", "MAX", "HEIGHT"}
+		rows := make([][]string, 0, len(results))
+		for _, r := range results {
+			url := "  " + r.URL
+			if r.Recommended {
+				url = "* " + r.URL
+			}
+			row := []string{url, fmt.Sprintf("%d/%d", r.Successes, r.Probes), "-", "-", "-", "-"}
+			if r.Successes > 0 {
+				row[2], row[3], row[4] = fmt.Sprintf("%dms", r.MinMS), fmt.Sprintf("%dms", r.AvgMS), fmt.Sprintf("%dms", r.MaxMS)
+				row[5] = fmt.Sprintf("%d", r.Height)
+			}
+			rows = append(rows, row)
+		}
+		fmt.Fprintln(w, c.Header(" RPC Benchmark "))
+		fmt.Fprint(w, ui.Table(c, headers, rows, nil))
+		for _, r := range results {
+			if r.Successes == 0 {
+				fmt.Fprintf(w, "%s: %s\n", r.URL, r.Error)
+			}
+		}
+		if best >= 0 {
+			fmt.Fprintf(w, "Recommended: %s (avg %dms, height %d)\n", results[best].URL, results[best].AvgMS, results[best].Height)
+		}
+	}
+
+	if best < 0 {
+		return exitcodes.NetworkErrf("none of the %d endpoints answered", len(urls))
+	}
+	return nil
+}
+
+func init() {
+	var (
+		count   int
+		timeout time.Duration
+	)
+	cmd := &cobra.Command{
+		Use:   "benchmark-rpc <url> [url...]",
+		Short: "Measure RPC endpoint latency and pick the fastest",
+		Long: `Probe each RPC endpoint's /status and /health several times and report
+the min/avg/max /status latency, the share of probes that succeeded and the
+block height each endpoint reports.
+
+The recommended endpoint (marked *) is the fastest of those that answered
+the most probes and are no more than 10 blocks behind the highest reported
+height. Use it for --genesis-domain or --rpc. A bare domain is treated as
+https://<domain>.`,
+		Example: `  push-validator benchmark-rpc donut.rpc.push.org https://rpc.example.com:443
+  push-validator benchmark-rpc --count 10 --output json http://10.0.0.5:26657 http://10.0.0.6:26657`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleBenchmarkRPC(cmd.OutOrStdout(), args, count, timeout, syncmon.ProbeEndpoint)
+		},
+	}
+	cmd.Flags().IntVar(&count, "count", 5, "Probes per endpoint")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Second, "Timeout for each /status request")
+	rootCmd.AddCommand(cmd)
+}
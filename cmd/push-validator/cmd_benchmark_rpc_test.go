@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	syncmon "github.com/pushchain/push-validator-cli/internal/sync"
+)
+
+// fakeProber answers from a fixed sample per URL, with latencies cycling
+// through the listed values.
+func fakeProber(samples map[string][]syncmon.EndpointSample) rpcProber {
+	calls := map[string]int{}
+	return func(base string, timeout time.Duration) syncmon.EndpointSample {
+		list := samples[base]
+		s := list[calls[base]%len(list)]
+		calls[base]++
+		return s
+	}
+}
+
+func TestBenchmarkRPCs(t *testing.T) {
+	ok := func(ms int, height int64) syncmon.EndpointSample {
+		return syncmon.EndpointSample{Healthy: true, Height: height, Latency: time.Duration(ms) * time.Millisecond}
+	}
+	probe := fakeProber(map[string][]syncmon.EndpointSample{
+		"https://fast":    {ok(10, 1000), ok(30, 1001)},
+		"https://slow":    {ok(80, 1002), ok(120, 1003)},
+		"https://stale":   {ok(5, 900)},
+		"https://flaky":   {ok(15, 1002), {Err: errors.New("timeout")}},
+		"https://down":    {{Err: errors.New("connection refused")}},
+		"https://unready": {{Height: 1000, Latency: time.Millisecond}},
+	})
+	urls := []string{"https://fast", "https://slow", "https://stale", "https://flaky", "https://down", "https://unready"}
+	results := benchmarkRPCs(urls, 4, time.Second, probe)
+
+	byURL := map[string]rpcBenchResult{}
+	for _, r := range results {
+		byURL[r.URL] = r
+	}
+	fast := byURL["https://fast"]
+	if fast.Probes != 4 || fast.Successes != 4 || fast.MinMS != 10 || fast.AvgMS != 20 || fast.MaxMS != 30 || fast.Height != 1001 {
+		t.Errorf("fast = %+v", fast)
+	}
+	if !fast.Recommended {
+		t.Errorf("fastest current endpoint should be recommended: %+v", results)
+	}
+	if byURL["https://stale"].Recommended {
+		t.Error("an endpoint far behind the highest height must not be recommended")
+	}
+	if flaky := byURL["https://flaky"]; flaky.SuccessRate != 0.5 || flaky.Error != "" {
+		t.Errorf("flaky = %+v", flaky)
+	}
+	if down := byURL["https://down"]; down.Successes != 0 || down.Error != "connection refused" {
+		t.Errorf("down = %+v", down)
+	}
+	if unready := byURL["https://unready"]; unready.Successes != 0 || !strings.Contains(unready.Error, "/health") {
+		t.Errorf("unready = %+v", unready)
+	}
+}
+
+func TestHandleBenchmarkRPC_JSON(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "json"
+
+	probe := fakeProber(map[string][]syncmon.EndpointSample{
+		"https://rpc.example.com": {{Healthy: true, Height: 42, Latency: 25 * time.Millisecond}},
+		"http://10.0.0.5:26657":   {{Err: errors.New("connection refused")}},
+	})
+	var buf bytes.Buffer
+	if err := handleBenchmarkRPC(&buf, []string{"rpc.example.com/", "http://10.0.0.5:26657"}, 3, time.Second, probe); err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		OK          bool             `json:"ok"`
+		Recommended string           `json:"recommended"`
+		Endpoints   []rpcBenchResult `json:"endpoints"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not JSON: %q (%v)", buf.String(), err)
+	}
+	if !got.OK || got.Recommended != "https://rpc.example.com" || len(got.Endpoints) != 2 || got.Endpoints[0].AvgMS != 25 {
+		t.Errorf("unexpected result: %+v", got)
+	}
+}
+
+func TestHandleBenchmarkRPC_NoneAnswered(t *testing.T) {
+	origOutput, origNoColor := flagOutput, flagNoColor
+	defer func() { flagOutput, flagNoColor = origOutput, origNoColor }()
+	flagOutput, flagNoColor = "text", true
+
+	probe := fakeProber(map[string][]syncmon.EndpointSample{
+		"https://a": {{Err: errors.New("no such host")}},
+	})
+	var buf bytes.Buffer
+	err := handleBenchmarkRPC(&buf, []string{"a"}, 2, time.Second, probe)
+	if code := exitcodes.CodeForError(err); code != exitcodes.NetworkError {
+		t.Errorf("exit code = %d (%v), want %d", code, err, exitcodes.NetworkError)
+	}
+	if !strings.Contains(buf.String(), "https://a: no such host") || strings.Contains(buf.String(), "Recommended") {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+
+	if err := handleBenchmarkRPC(&buf, []string{"a"}, 0, time.Second, probe); exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("--count 0 error = %v, want invalid args", err)
+	}
+}
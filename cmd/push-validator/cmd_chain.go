@@ -2,14 +2,16 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
 
+	"github.com/pushchain/push-validator-cli/internal/archive"
 	"github.com/pushchain/push-validator-cli/internal/chain"
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -31,6 +33,31 @@ type chainInstallOpts struct {
 	version    string
 	force      bool
 	skipVerify bool
+	fromFile   string // install from a local archive instead of GitHub
+	targetOS   string // GOOS of the release asset; empty means this host
+	targetArch string // GOARCH of the release asset; empty means this host
+}
+
+// target returns the OS and arch to download for, defaulting to this host.
+func (o chainInstallOpts) target() (string, string) {
+	goos, goarch := o.targetOS, o.targetArch
+	if goos == "" {
+		goos = runtime.GOOS
+	}
+	if goarch == "" {
+		goarch = runtime.GOARCH
+	}
+	return goos, goarch
+}
+
+// chainInstallResult is the --output json result of a chain install.
+type chainInstallResult struct {
+	OK               bool   `json:"ok"`
+	Release          string `json:"release,omitempty"`           // release tag, empty for --from-file
+	InstalledVersion string `json:"installed_version,omitempty"` // from pchaind version, if it runs here
+	Path             string `json:"path,omitempty"`
+	ChecksumVerified bool   `json:"checksum_verified"`
+	AlreadyInstalled bool   `json:"already_installed,omitempty"`
 }
 
 // prodChainFetcher implements ChainReleaseFetcher using the real chain package.
@@ -43,6 +70,10 @@ func (f *prodChainFetcher) FetchByTag(tag string) (*chain.Release, error) { retu
 func runChainInstallCore(cfg config.Config, fetcher ChainReleaseFetcher, installer ChainInstaller, opts chainInstallOpts, verifyBinary func(string) (string, error)) error {
 	p := getPrinter()
 
+	if opts.fromFile != "" {
+		return runChainInstallFromFile(installer, opts, verifyBinary)
+	}
+
 	// Fetch release (latest or specific version)
 	var release *chain.Release
 	var err error
@@ -61,11 +92,16 @@ func runChainInstallCore(cfg config.Config, fetcher ChainReleaseFetcher, install
 		return fmt.Errorf("failed to fetch release: %w", err)
 	}
 
-	// Find binary for current platform
-	asset, err := chain.GetAssetForPlatform(release)
+	// Find binary for the target platform (this host unless overridden)
+	goos, goarch := opts.target()
+	asset, err := chain.GetAssetForPlatformTarget(release, goos, goarch)
 	if err != nil {
 		return err
 	}
+	if goos != runtime.GOOS || goarch != runtime.GOARCH {
+		// A foreign binary can't be run here to check its version
+		verifyBinary = nil
+	}
 
 	// Check if already installed (unless force)
 	cosmovisorBin := filepath.Join(cfg.HomeDir, "cosmovisor", "genesis", "bin", "pchaind")
@@ -75,7 +111,11 @@ func runChainInstallCore(cfg config.Config, fetcher ChainReleaseFetcher, install
 				if installedVer, verErr := verifyBinary(cosmovisorBin); verErr == nil {
 					releaseVer := strings.TrimPrefix(release.TagName, "v")
 					if installedVer == releaseVer {
-						p.Success(fmt.Sprintf("pchaind %s already installed", release.TagName))
+						if flagOutput == "json" {
+							p.JSON(chainInstallResult{OK: true, Release: release.TagName, InstalledVersion: installedVer, Path: cosmovisorBin, AlreadyInstalled: true})
+						} else {
+							p.Success(fmt.Sprintf("pchaind %s already installed", release.TagName))
+						}
 						return nil
 					}
 				}
@@ -85,9 +125,13 @@ func runChainInstallCore(cfg config.Config, fetcher ChainReleaseFetcher, install
 
 	// Download with progress bar
 	if flagOutput != "json" {
-		fmt.Printf("  → Downloading pchaind %s for %s\n", release.TagName, getOSArch())
+		fmt.Printf("  → Downloading pchaind %s for %s/%s\n", release.TagName, goos, goarch)
+	}
+	var barOut io.Writer = os.Stdout
+	if flagOutput == "json" {
+		barOut = io.Discard
 	}
-	bar := ui.NewProgressBar(os.Stdout, asset.Size)
+	bar := ui.NewProgressBar(barOut, asset.Size)
 	archiveData, err := installer.Download(asset, func(downloaded, total int64) {
 		bar.Update(downloaded)
 	})
@@ -97,6 +141,7 @@ func runChainInstallCore(cfg config.Config, fetcher ChainReleaseFetcher, install
 	}
 
 	// Verify checksum
+	res := chainInstallResult{OK: true, Release: release.TagName}
 	if !opts.skipVerify {
 		if flagOutput != "json" {
 			fmt.Println("  → Verifying checksum")
@@ -105,9 +150,12 @@ func runChainInstallCore(cfg config.Config, fetcher ChainReleaseFetcher, install
 		if err != nil {
 			return fmt.Errorf("checksum verification failed: %w", err)
 		}
-		if verified {
+		res.ChecksumVerified = verified
+		switch {
+		case flagOutput == "json":
+		case verified:
 			fmt.Printf("  %s Checksum verified\n", p.Colors.Success(p.Colors.Emoji("✓")))
-		} else {
+		default:
 			fmt.Printf("  %s Checksum file not available, skipping verification\n", p.Colors.Warning(p.Colors.Emoji("⚠")))
 		}
 	}
@@ -127,68 +175,140 @@ func runChainInstallCore(cfg config.Config, fetcher ChainReleaseFetcher, install
 		installedVer, _ = verifyBinary(pchaindPath)
 	}
 
-	if installedVer != "" {
+	res.InstalledVersion, res.Path = installedVer, pchaindPath
+	switch {
+	case flagOutput == "json":
+		p.JSON(res)
+	case installedVer != "":
 		fmt.Printf("  %s Installed pchaind (%s)\n", p.Colors.Success(p.Colors.Emoji("✓")), installedVer)
-	} else {
+	default:
 		fmt.Printf("  %s Installed pchaind %s\n", p.Colors.Success(p.Colors.Emoji("✓")), release.TagName)
 	}
 
 	return nil
 }
 
-func init() {
+// runChainInstallFromFile installs pchaind from a local archive (e.g. copied
+// onto an air-gapped host). The archive is validated, and checked against a
+// sibling .sha256 or .sha512 file when present, before anything is extracted.
+func runChainInstallFromFile(installer ChainInstaller, opts chainInstallOpts, verifyBinary func(string) (string, error)) error {
+	p := getPrinter()
+
+	if flagOutput != "json" {
+		fmt.Printf("  → Reading %s\n", opts.fromFile)
+	}
+	local, err := archive.ReadLocal(opts.fromFile, !opts.skipVerify)
+	if err != nil {
+		return err
+	}
+	if !opts.skipVerify && flagOutput != "json" {
+		if local.ChecksumVerified {
+			fmt.Printf("  %s Checksum verified\n", p.Colors.Success(p.Colors.Emoji("✓")))
+		} else {
+			fmt.Printf("  %s No %s.sha256 or .sha512 found, skipping verification\n", p.Colors.Warning(p.Colors.Emoji("⚠")), filepath.Base(opts.fromFile))
+		}
+	}
+
+	if flagOutput != "json" {
+		fmt.Println("  → Extracting binary")
+	}
+	pchaindPath, err := installer.ExtractAndInstall(local.Data)
+	if err != nil {
+		return fmt.Errorf("installation failed: %w", err)
+	}
+
+	var installedVer string
+	if verifyBinary != nil {
+		installedVer, _ = verifyBinary(pchaindPath)
+	}
+	if flagOutput == "json" {
+		p.JSON(chainInstallResult{OK: true, InstalledVersion: installedVer, Path: pchaindPath, ChecksumVerified: local.ChecksumVerified})
+	} else if installedVer != "" {
+		fmt.Printf("  %s Installed pchaind (%s) from %s\n", p.Colors.Success(p.Colors.Emoji("✓")), installedVer, filepath.Base(opts.fromFile))
+	} else {
+		fmt.Printf("  %s Installed pchaind from %s\n", p.Colors.Success(p.Colors.Emoji("✓")), filepath.Base(opts.fromFile))
+	}
+	return nil
+}
+
+// newChainInstallCmd builds the pchaind install command. It is registered
+// both as "chain install" and as the top-level "install-chain".
+func newChainInstallCmd(use, example string) *cobra.Command {
 	var (
 		version    string
 		force      bool
 		skipVerify bool
+		fromFile   string
+		targetOS   string
+		targetArch string
 	)
 
-	chainCmd := &cobra.Command{
-		Use:   "chain",
-		Short: "Chain binary management commands",
-		Long:  `Commands for managing the pchaind chain binary, including downloading and installing.`,
-	}
-
-	installCmd := &cobra.Command{
-		Use:   "install",
+	cmd := &cobra.Command{
+		Use:   use + " [version]",
 		Short: "Download and install pchaind binary",
 		Long: `Download and install the pchaind chain binary from GitHub releases.
 
-The binary is installed to the cosmovisor genesis/bin directory for automatic upgrades.
+Installs the latest release unless a version tag is given. The archive's
+checksum is verified and the binary is installed to the cosmovisor
+genesis/bin directory for automatic upgrades.
 
 Examples:
-  push-validator chain install              # Install latest version
-  push-validator chain install --version v0.0.2  # Install specific version
-  push-validator chain install --force      # Force reinstall`,
+` + example,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				if version != "" && version != args[0] {
+					return exitcodes.InvalidArgsError("give the version either as an argument or with --version, not both")
+				}
+				version = args[0]
+			}
+			if fromFile != "" && version != "" {
+				return fmt.Errorf("--from-file cannot be combined with --version")
+			}
+			if fromFile != "" && (targetOS != "" || targetArch != "") {
+				return fmt.Errorf("--from-file cannot be combined with --target-os or --target-arch")
+			}
 			cfg := loadCfg()
 			installer := chain.NewInstaller(cfg.HomeDir)
 			fetcher := &prodChainFetcher{}
 
-			verifyBinary := func(path string) (string, error) {
-				verCmd := exec.Command(path, "version")
-				verCmd.Stdin = nil
-				out, err := verCmd.Output()
-				if err != nil {
-					return "", err
-				}
-				return strings.TrimSpace(string(out)), nil
-			}
-
 			return runChainInstallCore(cfg, fetcher, installer, chainInstallOpts{
 				version:    version,
 				force:      force,
 				skipVerify: skipVerify,
-			}, verifyBinary)
+				fromFile:   fromFile,
+				targetOS:   targetOS,
+				targetArch: targetArch,
+			}, chain.BinaryVersion)
 		},
 	}
 
-	installCmd.Flags().StringVar(&version, "version", "", "Install specific version (e.g., v0.0.2)")
-	installCmd.Flags().BoolVar(&force, "force", false, "Force reinstall even if already installed")
-	installCmd.Flags().BoolVar(&skipVerify, "no-verify", false, "Skip checksum verification")
+	cmd.Flags().StringVar(&version, "version", "", "Install specific version (e.g., v0.0.2)")
+	cmd.Flags().BoolVar(&force, "force", false, "Force reinstall even if already installed")
+	cmd.Flags().BoolVar(&skipVerify, "no-verify", false, "Skip checksum verification")
+	cmd.Flags().StringVar(&targetOS, "target-os", "", "Download the binary for this OS instead of the host's (e.g. linux)")
+	cmd.Flags().StringVar(&targetArch, "target-arch", "", "Download the binary for this architecture instead of the host's (e.g. arm64)")
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "Install from a local release archive instead of downloading (checked against <archive>.sha256 or .sha512 if present)")
+	return cmd
+}
 
-	chainCmd.AddCommand(installCmd)
+func init() {
+	chainCmd := &cobra.Command{
+		Use:   "chain",
+		Short: "Chain binary management commands",
+		Long:  `Commands for managing the pchaind chain binary, including downloading and installing.`,
+	}
+
+	chainCmd.AddCommand(newChainInstallCmd("install", `  push-validator chain install              # Install latest version
+  push-validator chain install v0.0.2       # Install specific version
+  push-validator chain install --force      # Force reinstall
+  push-validator chain install --from-file /media/usb/push-chain_0.0.2_linux_amd64.tar.gz  # Offline install
+  push-validator chain install --target-arch arm64 --home /srv/pi-stage  # Stage an arm64 binary`))
 	rootCmd.AddCommand(chainCmd)
+
+	rootCmd.AddCommand(newChainInstallCmd("install-chain", `  push-validator install-chain              # Install latest version
+  push-validator install-chain v0.0.2       # Pin a version
+  push-validator install-chain -o json      # Machine-readable result`))
 }
 
 // getOSArch returns a string like "darwin/arm64"
@@ -1,13 +1,18 @@
 package main
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/pushchain/push-validator-cli/internal/chain"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 )
 
 // mockChainFetcher implements ChainReleaseFetcher for tests.
@@ -282,22 +287,135 @@ func TestRunChainInstallCore_VerifyFails_StillSucceeds(t *testing.T) {
 }
 
 func TestRunChainInstallCore_JSON_Output(t *testing.T) {
-	origOutput := flagOutput
-	defer func() { flagOutput = origOutput }()
+	origOutput, origStdout := flagOutput, os.Stdout
+	defer func() { flagOutput, os.Stdout = origOutput, origStdout }()
 	flagOutput = "json"
 
 	cfg := testCfg()
 	fetcher := &mockChainFetcher{latest: testChainRelease("v2.0.0")}
 	installer := &mockChainInstaller{
-		downloadData: []byte("data"),
-		installPath:  "/tmp/pchaind",
+		downloadData:   []byte("data"),
+		checksumResult: true,
+		installPath:    "/tmp/pchaind",
 	}
 
-	err := runChainInstallCore(cfg, fetcher, installer, chainInstallOpts{
-		force:      true,
-		skipVerify: true,
-	}, nil)
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	err = runChainInstallCore(cfg, fetcher, installer, chainInstallOpts{force: true}, func(string) (string, error) {
+		return "2.0.0", nil
+	})
+	w.Close()
+	os.Stdout = origStdout
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+
+	// Only the JSON result is written to stdout
+	var res chainInstallResult
+	dec := json.NewDecoder(r)
+	if err := dec.Decode(&res); err != nil {
+		t.Fatalf("decode JSON output: %v", err)
+	}
+	if dec.More() {
+		t.Error("unexpected output after the JSON result")
+	}
+	want := chainInstallResult{OK: true, Release: "v2.0.0", InstalledVersion: "2.0.0", Path: "/tmp/pchaind", ChecksumVerified: true}
+	if res != want {
+		t.Errorf("result = %+v, want %+v", res, want)
+	}
+}
+
+func TestChainInstallCmd_VersionArg(t *testing.T) {
+	cmd, _, err := rootCmd.Find([]string{"install-chain"})
+	if err != nil || cmd.Name() != "install-chain" {
+		t.Fatalf("install-chain not registered: %v", err)
+	}
+	if err := cmd.Args(cmd, []string{"v1", "v2"}); err == nil {
+		t.Error("expected an error for two versions")
+	}
+
+	cmd = newChainInstallCmd("install-chain", "")
+	if err := cmd.Flags().Set("version", "v1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	err = cmd.RunE(cmd, []string{"v2.0.0"})
+	if exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("conflicting versions: err = %v, want invalid args", err)
+	}
+}
+
+func TestRunChainInstallCore_FromFile(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	path := writeTestArchive(t, t.TempDir(), "push-chain_0.0.2_linux_amd64.tar.gz", "bin/pchaind")
+	sum := sha256.Sum256(mustReadFile(t, path))
+	_ = os.WriteFile(path+".sha256", []byte(hex.EncodeToString(sum[:])), 0o644)
+
+	// Fetch and download fail so any network access shows up as an error
+	fetcher := &mockChainFetcher{latestErr: fmt.Errorf("offline")}
+	installer := &mockChainInstaller{downloadErr: fmt.Errorf("offline"), installPath: "/tmp/pchaind"}
+
+	err := runChainInstallCore(testCfg(), fetcher, installer, chainInstallOpts{fromFile: path}, func(string) (string, error) { return "0.0.2", nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A checksum mismatch stops before extraction
+	_ = os.WriteFile(path+".sha256", []byte(strings.Repeat("0", 64)), 0o644)
+	installer.installErr = fmt.Errorf("extracted anyway")
+	err = runChainInstallCore(testCfg(), fetcher, installer, chainInstallOpts{fromFile: path}, nil)
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("expected checksum mismatch, got %v", err)
+	}
+}
+
+func mustReadFile(t *testing.T, path string) []byte {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestRunChainInstallCore_CrossTargetSkipsVerify(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	goarch := "arm64"
+	if runtime.GOARCH == "arm64" {
+		goarch = "amd64"
+	}
+	release := &chain.Release{
+		TagName: "v2.0.0",
+		Assets: []chain.Asset{
+			{Name: fmt.Sprintf("push-chain_2.0.0_%s_%s.tar.gz", runtime.GOOS, runtime.GOARCH)},
+			{Name: fmt.Sprintf("push-chain_2.0.0_%s_%s.tar.gz", runtime.GOOS, goarch)},
+		},
+	}
+	installer := &mockChainInstaller{downloadData: []byte("data"), checksumResult: true, installPath: "/tmp/pchaind"}
+
+	err := runChainInstallCore(testCfg(), &mockChainFetcher{latest: release}, installer, chainInstallOpts{
+		targetArch: goarch,
+	}, func(path string) (string, error) {
+		t.Fatalf("verifyBinary called for a %s binary on %s", goarch, runtime.GOARCH)
+		return "", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A target with no published asset fails before downloading
+	err = runChainInstallCore(testCfg(), &mockChainFetcher{latest: release}, installer, chainInstallOpts{
+		targetOS: "plan9",
+	}, nil)
+	if err == nil || !strings.Contains(err.Error(), "plan9/") {
+		t.Fatalf("expected no-binary error for plan9, got %v", err)
+	}
 }
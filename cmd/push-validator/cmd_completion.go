@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+)
+
+var completionShells = []string{"bash", "zsh", "fish", "powershell"}
+
+// genCompletion writes the completion script for shell to w.
+func genCompletion(w io.Writer, shell string) error {
+	switch shell {
+	case "bash":
+		return rootCmd.GenBashCompletion(w)
+	case "zsh":
+		return rootCmd.GenZshCompletion(w)
+	case "fish":
+		return rootCmd.GenFishCompletion(w, true)
+	case "powershell":
+		return rootCmd.GenPowerShellCompletionWithDesc(w)
+	default:
+		return fmt.Errorf("unknown shell: %s", shell)
+	}
+}
+
+// detectShell guesses the user's shell from $SHELL, falling back to
+// PowerShell on Windows or when only $PSModulePath is set.
+func detectShell(getenv func(string) string) (string, error) {
+	if sh := getenv("SHELL"); sh != "" {
+		name := strings.TrimSuffix(filepath.Base(sh), ".exe")
+		switch name {
+		case "pwsh":
+			return "powershell", nil
+		case "bash", "zsh", "fish", "powershell":
+			return name, nil
+		}
+		return "", exitcodes.InvalidArgsErrorf("unsupported shell %q from $SHELL; pass one of: %s", name, strings.Join(completionShells, ", "))
+	}
+	if runtime.GOOS == "windows" || getenv("PSModulePath") != "" {
+		return "powershell", nil
+	}
+	return "", exitcodes.InvalidArgsErrorf("cannot detect the shell ($SHELL is not set); pass one of: %s", strings.Join(completionShells, ", "))
+}
+
+// completionPath returns where the completion script for shell is installed,
+// and the line to add to the shell's startup file to load it (empty when the
+// shell loads the directory by itself).
+func completionPath(shell, home string, getenv func(string) string) (path, activate string) {
+	name := rootCmd.Name()
+	switch shell {
+	case "bash":
+		path = filepath.Join(home, ".bash_completion.d", name)
+		return path, fmt.Sprintf("Add to ~/.bashrc:\n  source %s", path)
+	case "zsh":
+		dir := filepath.Join(home, ".zsh", "completions")
+		if zd := getenv("ZDOTDIR"); zd != "" {
+			dir = filepath.Join(zd, "completions")
+		}
+		return filepath.Join(dir, "_"+name), fmt.Sprintf("Add to ~/.zshrc, before compinit runs:\n  fpath=(%s $fpath)\n  autoload -U compinit && compinit", dir)
+	case "fish":
+		dir := filepath.Join(home, ".config")
+		if xdg := getenv("XDG_CONFIG_HOME"); xdg != "" {
+			dir = xdg
+		}
+		return filepath.Join(dir, "fish", "completions", name+".fish"), ""
+	default: // powershell
+		dir := filepath.Join(home, ".config", "powershell")
+		if runtime.GOOS == "windows" {
+			dir = filepath.Join(home, "Documents", "PowerShell")
+		}
+		path = filepath.Join(dir, name+".ps1")
+		return path, fmt.Sprintf("Add to your PowerShell profile ($PROFILE):\n  . %s", path)
+	}
+}
+
+// installCompletion writes the completion script for shell under home and
+// returns its path and activation instructions. An existing file is only
+// replaced with force.
+func installCompletion(shell, home string, force bool, getenv func(string) string) (string, string, error) {
+	var script bytes.Buffer
+	if err := genCompletion(&script, shell); err != nil {
+		return "", "", exitcodes.InvalidArgsError(err.Error())
+	}
+	path, activate := completionPath(shell, home, getenv)
+	if _, err := os.Stat(path); err == nil && !force {
+		return "", "", exitcodes.PreconditionErrorf("%s already exists; pass --force to overwrite it", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", "", fmt.Errorf("create completion directory: %w", err)
+	}
+	if err := os.WriteFile(path, script.Bytes(), 0o644); err != nil {
+		return "", "", fmt.Errorf("write completion script: %w", err)
+	}
+	return path, activate, nil
+}
+
+func init() {
+	var (
+		printOnly bool
+		force     bool
+	)
+	installCmd := &cobra.Command{
+		Use:   "install [bash|zsh|fish|powershell]",
+		Short: "Install shell completion for the current user",
+		Long: `Write the completion script where the shell loads it from and print how
+to activate it. The shell is detected from $SHELL unless given.
+
+  bash        ~/.bash_completion.d/push-validator
+  zsh         ~/.zsh/completions/_push-validator ($ZDOTDIR/completions if set)
+  fish        ~/.config/fish/completions/push-validator.fish
+  powershell  push-validator.ps1 next to the PowerShell profile`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shell := ""
+			if len(args) == 1 {
+				shell = args[0]
+			} else {
+				detected, err := detectShell(os.Getenv)
+				if err != nil {
+					return err
+				}
+				shell = detected
+			}
+			if printOnly {
+				if err := genCompletion(os.Stdout, shell); err != nil {
+					return exitcodes.InvalidArgsError(err.Error())
+				}
+				return nil
+			}
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return fmt.Errorf("find home directory: %w", err)
+			}
+			path, activate, err := installCompletion(shell, home, force, os.Getenv)
+			if err != nil {
+				return err
+			}
+			p := getPrinter()
+			if flagOutput == "json" {
+				p.JSON(map[string]any{"ok": true, "shell": shell, "path": path})
+				return nil
+			}
+			p.Success(fmt.Sprintf("Installed %s completion to %s", shell, path))
+			if activate != "" {
+				fmt.Println(activate)
+			}
+			fmt.Println("Open a new shell to use it.")
+			return nil
+		},
+	}
+	installCmd.Flags().BoolVar(&printOnly, "print", false, "Print the script to stdout instead of installing it")
+	installCmd.Flags().BoolVar(&force, "force", false, "Overwrite an existing completion file")
+	completionCmd.AddCommand(installCmd)
+}
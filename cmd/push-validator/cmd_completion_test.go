@@ -0,0 +1,115 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+)
+
+func envMap(m map[string]string) func(string) string {
+	return func(k string) string { return m[k] }
+}
+
+func TestDetectShell(t *testing.T) {
+	tests := []struct {
+		env     map[string]string
+		want    string
+		wantErr bool
+	}{
+		{map[string]string{"SHELL": "/bin/bash"}, "bash", false},
+		{map[string]string{"SHELL": "/usr/local/bin/zsh"}, "zsh", false},
+		{map[string]string{"SHELL": "/opt/homebrew/bin/fish"}, "fish", false},
+		{map[string]string{"SHELL": "/usr/bin/pwsh"}, "powershell", false},
+		{map[string]string{"SHELL": "/bin/tcsh"}, "", true},
+		{map[string]string{"PSModulePath": `C:\Modules`}, "powershell", false},
+	}
+	for _, tt := range tests {
+		got, err := detectShell(envMap(tt.env))
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("detectShell(%v) = %q, %v; want %q, err %v", tt.env, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestInstallCompletion(t *testing.T) {
+	tests := []struct {
+		shell    string
+		env      map[string]string
+		wantPath string
+		activate string
+	}{
+		{"bash", nil, ".bash_completion.d/push-validator", "source "},
+		{"zsh", nil, ".zsh/completions/_push-validator", "fpath=("},
+		{"fish", nil, ".config/fish/completions/push-validator.fish", ""},
+		{"powershell", nil, ".config/powershell/push-validator.ps1", "$PROFILE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.shell, func(t *testing.T) {
+			home := t.TempDir()
+			path, activate, err := installCompletion(tt.shell, home, false, envMap(tt.env))
+			if err != nil {
+				t.Fatalf("installCompletion() error = %v", err)
+			}
+			if want := filepath.Join(home, tt.wantPath); path != want {
+				t.Errorf("path = %q, want %q", path, want)
+			}
+			if !strings.Contains(activate, tt.activate) {
+				t.Errorf("activation %q should mention %q", activate, tt.activate)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil || !strings.Contains(string(data), "push-validator") {
+				t.Errorf("completion script not written: %v", err)
+			}
+		})
+	}
+}
+
+func TestInstallCompletion_XDGAndZDOTDIR(t *testing.T) {
+	home := t.TempDir()
+	xdg := filepath.Join(home, "xdg")
+	path, _, err := installCompletion("fish", home, false, envMap(map[string]string{"XDG_CONFIG_HOME": xdg}))
+	if err != nil || path != filepath.Join(xdg, "fish", "completions", "push-validator.fish") {
+		t.Errorf("fish path = %q, %v", path, err)
+	}
+	zd := filepath.Join(home, "zdot")
+	path, _, err = installCompletion("zsh", home, false, envMap(map[string]string{"ZDOTDIR": zd}))
+	if err != nil || path != filepath.Join(zd, "completions", "_push-validator") {
+		t.Errorf("zsh path = %q, %v", path, err)
+	}
+}
+
+func TestInstallCompletion_ExistingFile(t *testing.T) {
+	home := t.TempDir()
+	path := filepath.Join(home, ".bash_completion.d", "push-validator")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := installCompletion("bash", home, false, envMap(nil))
+	if err == nil || exitcodes.CodeForError(err) != exitcodes.PreconditionFailed || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("expected precondition error mentioning --force, got %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "old" {
+		t.Error("existing file changed without --force")
+	}
+
+	if _, _, err := installCompletion("bash", home, true, envMap(nil)); err != nil {
+		t.Fatalf("installCompletion(force) error = %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) == "old" {
+		t.Error("--force did not overwrite the existing file")
+	}
+}
+
+func TestInstallCompletion_UnknownShell(t *testing.T) {
+	_, _, err := installCompletion("tcsh", t.TempDir(), false, envMap(nil))
+	if exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("expected invalid args error, got %v", err)
+	}
+}
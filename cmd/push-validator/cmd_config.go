@@ -0,0 +1,83 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
+)
+
+// handleConfigNodeSet sets one known config.toml/app.toml key in place.
+func handleConfigNodeSet(d *Deps, key, value string) error {
+	k, ok := files.NodeKeys[key]
+	if !ok {
+		return configSetFailed(d, exitcodes.InvalidArgsErrorf("unknown key %q (known: %s)", key, strings.Join(files.NodeKeyNames(), ", ")))
+	}
+	if err := k.Validate(value); err != nil {
+		return configSetFailed(d, exitcodes.InvalidArgsErrorf("invalid %s: %v", key, err))
+	}
+
+	old, err := files.GetNodeConfig(d.Cfg.HomeDir, key)
+	if errors.Is(err, fs.ErrNotExist) {
+		return configSetFailed(d, exitcodes.PreconditionErrorf("%s not found in %s/config; initialize the node first", k.File, d.Cfg.HomeDir))
+	}
+	if err == nil {
+		err = files.SetNodeConfig(d.Cfg.HomeDir, key, value)
+	}
+	if err != nil {
+		return configSetFailed(d, fmt.Errorf("failed to update %s: %w", k.File, err))
+	}
+
+	if flagOutput == "json" {
+		d.Printer.JSON(map[string]any{"ok": true, "key": key, "file": k.File, "old_value": old, "value": value})
+		return nil
+	}
+	d.Printer.Success(fmt.Sprintf("Set %s = %q in %s", key, value, k.File))
+	if old != value {
+		fmt.Println(d.Printer.Colors.Description(fmt.Sprintf("  was %q; restart the node to apply: push-validator restart", old)))
+	}
+	return nil
+}
+
+func configSetFailed(d *Deps, err error) error {
+	if flagOutput == "json" {
+		d.Printer.JSON(map[string]any{"ok": false, "error": err.Error()})
+		return silentErr{err}
+	}
+	return err
+}
+
+func init() {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Edit node configuration",
+	}
+	configCmd.AddCommand(&cobra.Command{
+		Use:   "node-set <key> <value>",
+		Short: "Set a known config.toml/app.toml key",
+		Long: `Set one of the node's config.toml or app.toml tunables in place. Only that
+line is rewritten; comments and other settings are kept. The value is
+validated before anything is written.
+
+Known keys:
+  pruning                app.toml  default|nothing|everything|custom
+  pruning-keep-recent    app.toml  non-negative integer
+  pruning-interval       app.toml  non-negative integer
+  minimum-gas-prices     app.toml  amounts with denom, e.g. 1000upc
+  tx_index.indexer       config.toml  kv|null|psql
+  p2p.persistent_peers   config.toml  comma-separated id@host:port
+  p2p.seeds              config.toml  comma-separated id@host:port
+
+Restart the node for changes to take effect.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleConfigNodeSet(newDeps(), args[0], args[1])
+		},
+	})
+	rootCmd.AddCommand(configCmd)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+)
+
+func TestHandleConfigNodeSet(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	cfg := testCfg()
+	cfg.HomeDir = t.TempDir()
+	d := &Deps{Cfg: cfg, Printer: getPrinter()}
+
+	// Not initialized yet
+	err := handleConfigNodeSet(d, "pruning", "nothing")
+	if err == nil || exitcodes.CodeForError(err) != exitcodes.PreconditionFailed {
+		t.Fatalf("expected precondition error without app.toml, got %v", err)
+	}
+
+	cfgDir := filepath.Join(cfg.HomeDir, "config")
+	if err := os.MkdirAll(cfgDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	appPath := filepath.Join(cfgDir, "app.toml")
+	if err := os.WriteFile(appPath, []byte("# keep me\npruning = \"default\"\n\n[api]\nenable = false\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := handleConfigNodeSet(d, "pruning", "nothing"); err != nil {
+		t.Fatalf("handleConfigNodeSet() error: %v", err)
+	}
+	b, _ := os.ReadFile(appPath)
+	if s := string(b); !strings.Contains(s, `pruning = 'nothing'`) || !strings.Contains(s, "# keep me") {
+		t.Fatalf("app.toml not updated in place: %s", s)
+	}
+
+	for _, tc := range []struct{ key, value string }{
+		{"nosuch.key", "x"},
+		{"pruning", "sometimes"},
+		{"p2p.seeds", "not-a-peer"},
+	} {
+		err := handleConfigNodeSet(d, tc.key, tc.value)
+		if err == nil || exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+			t.Errorf("handleConfigNodeSet(%q, %q) = %v, want invalid args", tc.key, tc.value, err)
+		}
+	}
+}
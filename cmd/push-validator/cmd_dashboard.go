@@ -12,6 +12,8 @@ import (
 	"golang.org/x/term"
 
 	"github.com/pushchain/push-validator-cli/internal/dashboard"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/logline"
 	"github.com/pushchain/push-validator-cli/internal/ui"
 )
 
@@ -50,6 +52,9 @@ func createDashboardCmd() *cobra.Command {
 		refreshInterval time.Duration
 		rpcTimeout      time.Duration
 		debugMode       bool
+		panels          []string
+		since           string
+		layout          string
 	)
 
 	cmd := &cobra.Command{
@@ -64,9 +69,40 @@ func createDashboardCmd() *cobra.Command {
 
 The dashboard auto-refreshes every 2 seconds by default. Press '?' for help.
 
+Use --panels to choose which panels are shown. The resources panel (CPU,
+pchaind memory and free disk with rolling averages) is opt-in:
+
+  push-validator dashboard --panels default,resources
+
+In the log viewer, press 'g' and type a time to jump to the first line
+logged at or after it. --since does the same on launch:
+
+  push-validator dashboard --since 30m
+  push-validator dashboard --since "2024-05-01 14:05"
+
+Press 'c' to cycle the layout between auto, single, double and triple
+(panels per row). The layout and the last terminal size are remembered in
+the home directory; --layout overrides the saved layout.
+
 For non-interactive environments (CI/pipes), dashboard automatically falls back
 to a static text snapshot.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			selected, err := dashboard.ParsePanels(panels)
+			if err != nil {
+				return err
+			}
+			var logSince time.Time
+			if since != "" {
+				if logSince, err = logline.ParseTime(since, time.Now()); err != nil {
+					return exitcodes.InvalidArgsErrorf("--since: %v", err)
+				}
+			}
+			var layoutMode string
+			if layout != "" {
+				if layoutMode, err = dashboard.ParseLayoutMode(layout); err != nil {
+					return exitcodes.InvalidArgsErrorf("--layout: %v", err)
+				}
+			}
 			cfg := loadCfg()
 			opts := dashboard.Options{
 				Config:          cfg,
@@ -76,8 +112,11 @@ to a static text snapshot.`,
 				NoEmoji:         flagNoEmoji,
 				Debug:           debugMode,
 				CLIVersion:      Version,
-				Supervisor:      newSupervisor(cfg.HomeDir),
+				Supervisor:      newSupervisor(cfg),
 				BinPath:         findPchaind(),
+				Panels:          selected,
+				LogSince:        logSince,
+				Layout:          layoutMode,
 			}
 			opts = normalizeDashboardOptions(opts)
 
@@ -92,6 +131,9 @@ to a static text snapshot.`,
 	cmd.Flags().DurationVar(&refreshInterval, "refresh-interval", 2*time.Second, "Dashboard refresh interval")
 	cmd.Flags().DurationVar(&rpcTimeout, "rpc-timeout", 15*time.Second, "RPC request timeout")
 	cmd.Flags().BoolVar(&debugMode, "debug", false, "Enable debug mode for troubleshooting")
+	cmd.Flags().StringVar(&since, "since", "", "Open the log viewer at this time: a duration ago (30m), 15:04, 2006-01-02 15:04:05 or RFC 3339")
+	cmd.Flags().StringVar(&layout, "layout", "", "Panel layout: "+strings.Join(dashboard.LayoutModes, ", ")+" (default: the saved layout, else auto)")
+	cmd.Flags().StringSliceVar(&panels, "panels", nil, "Panels to show: default or any of "+strings.Join(dashboard.PanelNames, ", "))
 
 	return cmd
 }
@@ -2,16 +2,25 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/chain"
 	"github.com/pushchain/push-validator-cli/internal/config"
 	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
 	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/fdlimit"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
 	"github.com/pushchain/push-validator-cli/internal/node"
 	"github.com/pushchain/push-validator-cli/internal/process"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
@@ -25,13 +34,42 @@ var doctorCmd = &cobra.Command{
 - Process status and accessibility
 - Configuration file validity
 - Network connectivity (RPC, P2P, remote endpoints)
-- Disk space and permissions
-- Common configuration issues`,
+- Disk space, permissions and the open file limit
+- Installed pchaind version against the network's
+- Common configuration issues
+
+With --check-external it also checks that the P2P port is reachable from the
+internet. That check needs outbound internet access.
+
+With --report it also writes a bug report file: CLI and pchaind versions,
+OS/arch, paths, cosmovisor detection, effective config, node config files,
+the check results and the last log lines, with secrets redacted.
+
+With --output json (or yaml) it prints one summary object instead: "ok" and
+a "checks" list of {name, status, message, remediation}. The exit code is
+non-zero when any check fails; warnings alone exit 0.`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	RunE:          runDoctor,
 }
 
+// Flags for the opt-in external reachability check
+var (
+	doctorCheckExternal bool
+	doctorIPEchoURL     string
+)
+
+// Flags for the bug report file
+var (
+	doctorReport     bool
+	doctorReportFile string
+)
+
+// defaultIPEchoURL returns the caller's public IP as plain text.
+const defaultIPEchoURL = "https://api.ipify.org"
+
+// checkResult is one doctor check. Name is stable and unique per check, so
+// --output json consumers can match on it; don't reword it.
 type checkResult struct {
 	Name     string
 	Status   string // "pass", "warn", "fail"
@@ -40,15 +78,12 @@ type checkResult struct {
 }
 
 func runDoctor(cmd *cobra.Command, args []string) error {
-	cfg := config.Load()
-	if flagHome != "" {
-		cfg.HomeDir = flagHome
-	}
+	cfg := loadCfg()
 
 	c := getPrinter().Colors
 
 	// Create dependencies for checks
-	sup := newSupervisor(cfg.HomeDir)
+	sup := newSupervisor(cfg)
 	rpc := cfg.RPCLocal
 	if rpc == "" {
 		rpc = "http://127.0.0.1:26657"
@@ -58,22 +93,115 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 
 	results := runDoctorChecks(cfg, sup, localCli, remoteCli, c)
 
-	return doctorSummary(results, c)
+	installed, installedErr := chain.BinaryVersion(findPchaind())
+	netCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	network, networkErr := node.ABCIInfo(netCtx, cfg.RemoteRPCURL())
+	cancel()
+	results = append(results, checkPchaindVersion(installed, installedErr, network, networkErr, c))
+
+	if doctorCheckExternal {
+		results = append(results, checkExternalP2P(cfg, localCli, newReachabilityProbe(doctorIPEchoURL), c))
+	}
+
+	var err error
+	if !doctorStructured() {
+		err = doctorSummary(results, c)
+	} else if _, _, failed := tallyChecks(results); failed > 0 {
+		err = exitcodes.ValidationErrf("%d of %d doctor checks failed", failed, len(results))
+	}
+	reportPath := ""
+	if doctorReport || doctorReportFile != "" {
+		in := gatherReport(cfg, sup.LogPath(), results)
+		in.NetworkVersion = network.Version
+		if networkErr != nil {
+			in.NetworkVersion = fmt.Sprintf("unavailable (%v)", networkErr)
+		}
+		path, werr := writeReport(doctorReportFile, in)
+		if werr != nil {
+			return werr
+		}
+		reportPath = path
+		if !doctorStructured() {
+			fmt.Println()
+			fmt.Println(c.Info("Report written to " + path))
+			fmt.Println(c.Description("Secrets are redacted, but review it before attaching it to an issue."))
+		}
+	}
+	if doctorStructured() {
+		if werr := writeStructured(os.Stdout, doctorJSON(results, reportPath)); werr != nil {
+			return werr
+		}
+	}
+	return err
+}
+
+// doctorStructured reports whether doctor prints a JSON or YAML summary
+// instead of its text checks.
+func doctorStructured() bool {
+	return flagOutput == "json" || flagOutput == "yaml"
+}
+
+// doctorJSON is the --output json summary of results. Each check's name is
+// its stable checkResult.Name; its details become the remediation list.
+func doctorJSON(results []checkResult, reportPath string) map[string]any {
+	checks := make([]map[string]any, 0, len(results))
+	for _, r := range results {
+		remediation := r.Details
+		if remediation == nil {
+			remediation = []string{}
+		}
+		checks = append(checks, map[string]any{
+			"name":        r.Name,
+			"status":      r.Status,
+			"message":     r.Message,
+			"remediation": remediation,
+		})
+	}
+	passed, warned, failed := tallyChecks(results)
+	out := map[string]any{
+		"ok":       failed == 0,
+		"passed":   passed,
+		"warnings": warned,
+		"failed":   failed,
+		"checks":   checks,
+	}
+	if reportPath != "" {
+		out["report"] = reportPath
+	}
+	return out
+}
+
+// tallyChecks counts results by status.
+func tallyChecks(results []checkResult) (passed, warned, failed int) {
+	for _, r := range results {
+		switch r.Status {
+		case "pass":
+			passed++
+		case "warn":
+			warned++
+		case "fail":
+			failed++
+		}
+	}
+	return passed, warned, failed
 }
 
 // runDoctorChecks runs all diagnostic checks and returns results.
 func runDoctorChecks(cfg config.Config, sup process.Supervisor, localCli node.Client, remoteCli node.Client, c *ui.ColorConfig) []checkResult {
-	// Header
-	fmt.Println(c.Header(" VALIDATOR HEALTH CHECK "))
-	fmt.Println()
+	if !doctorStructured() {
+		fmt.Println(c.Header(" VALIDATOR HEALTH CHECK "))
+		fmt.Println()
+	}
 
 	results := []checkResult{}
 	results = append(results, checkProcessRunning(sup, c))
 	results = append(results, checkRPCAccessible(cfg, c))
 	results = append(results, checkConfigFiles(cfg, c))
+	results = append(results, checkPrivValState(cfg, c))
 	results = append(results, checkP2PPeers(localCli, c))
 	results = append(results, checkRemoteConnectivity(remoteCli, cfg.GenesisDomain, c))
 	results = append(results, checkDiskSpace(cfg, c))
+	results = append(results, checkFileLimits(nodeFileLimits(sup), c))
 	results = append(results, checkPermissions(cfg, c))
 	results = append(results, checkSyncStatus(localCli, c))
 	results = append(results, checkCosmovisor(cfg, c))
@@ -86,20 +214,7 @@ func doctorSummary(results []checkResult, c *ui.ColorConfig) error {
 	fmt.Println()
 	fmt.Println(c.Separator(60))
 
-	passed := 0
-	warned := 0
-	failed := 0
-
-	for _, r := range results {
-		switch r.Status {
-		case "pass":
-			passed++
-		case "warn":
-			warned++
-		case "fail":
-			failed++
-		}
-	}
+	passed, warned, failed := tallyChecks(results)
 
 	summary := fmt.Sprintf("Checks: %d passed, %d warnings, %d failed", passed, warned, failed)
 	if failed > 0 {
@@ -194,6 +309,27 @@ func checkConfigFiles(cfg config.Config, c *ui.ColorConfig) checkResult {
 	return result
 }
 
+func checkPrivValState(cfg config.Config, c *ui.ColorConfig) checkResult {
+	result := checkResult{Name: "Validator Signing State"}
+
+	if err := files.CheckPrivValState(cfg.HomeDir); err != nil {
+		result.Status = "fail"
+		result.Message = err.Error()
+		if errors.Is(err, files.ErrPrivValStateCorrupt) {
+			result.Details = []string{
+				"The node will not start until this file is repaired",
+				"Stop the node, make sure this validator key is not running elsewhere, then run 'push-validator start' to replace it",
+			}
+		}
+	} else {
+		result.Status = "pass"
+		result.Message = "priv_validator_state.json is valid (or will be created on start)"
+	}
+
+	printCheck(result, c)
+	return result
+}
+
 func checkP2PPeers(cli node.Client, c *ui.ColorConfig) checkResult {
 	result := checkResult{Name: "P2P Network"}
 
@@ -225,6 +361,118 @@ func checkP2PPeers(cli node.Client, c *ui.ColorConfig) checkResult {
 	return result
 }
 
+// reachabilityProbe finds this host's public IP and dials TCP addresses.
+type reachabilityProbe struct {
+	publicIP func(ctx context.Context) (string, error)
+	dial     func(ctx context.Context, addr string) error
+}
+
+// newReachabilityProbe asks echoURL for the public IP and dials directly.
+func newReachabilityProbe(echoURL string) reachabilityProbe {
+	return reachabilityProbe{
+		publicIP: func(ctx context.Context) (string, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, echoURL, nil)
+			if err != nil {
+				return "", err
+			}
+			client, err := httpclient.Default()
+			if err != nil {
+				return "", err
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return "", err
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return "", fmt.Errorf("%s returned HTTP %d", echoURL, resp.StatusCode)
+			}
+			body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+			if err != nil {
+				return "", err
+			}
+			ip := strings.TrimSpace(string(body))
+			if net.ParseIP(ip) == nil {
+				return "", fmt.Errorf("%s returned %q, not an IP address", echoURL, ip)
+			}
+			return ip, nil
+		},
+		dial: func(ctx context.Context, addr string) error {
+			conn, err := (&net.Dialer{Timeout: 3 * time.Second}).DialContext(ctx, "tcp", addr)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
+// checkExternalP2P reports whether the P2P port looks reachable from the
+// internet. Only inbound peers prove it. Failing that it dials the public IP,
+// but from inside the network a router with hairpin NAT can answer that
+// without the port being open to the internet, so success is inconclusive.
+func checkExternalP2P(cfg config.Config, cli node.Client, probe reachabilityProbe, c *ui.ColorConfig) checkResult {
+	result := checkResult{Name: "External P2P Reachability"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ip, err := probe.publicIP(ctx)
+	if err != nil {
+		result.Status = "warn"
+		result.Message = "Could not detect external IP (needs outbound internet)"
+		result.Details = []string{
+			fmt.Sprintf("Error: %v", err),
+			"Run without --check-external on offline hosts",
+		}
+		printCheck(result, c)
+		return result
+	}
+	addr := net.JoinHostPort(ip, fmt.Sprintf("%d", cfg.P2PPort))
+
+	inbound := 0
+	if peers, err := cli.Peers(ctx); err == nil {
+		for _, p := range peers {
+			if !p.Outbound {
+				inbound++
+			}
+		}
+	}
+	extAddr := files.ExternalAddress(cfg.HomeDir)
+
+	switch {
+	case inbound > 0:
+		result.Status = "pass"
+		result.Message = fmt.Sprintf("Reachable at %s (%d inbound peer(s))", addr, inbound)
+	case probe.dial(ctx, addr) == nil:
+		result.Status = "warn"
+		result.Message = fmt.Sprintf("%s accepts connections, but that is inconclusive from this host", addr)
+		result.Details = []string{
+			"No inbound peers yet; a router with hairpin NAT can accept a connection to its own public IP from inside the network even when the port is closed to the internet",
+			fmt.Sprintf("Confirm from another network (e.g. nc -vz %s %d), or check again once the node has inbound peers", ip, cfg.P2PPort),
+		}
+	default:
+		result.Status = "warn"
+		result.Message = fmt.Sprintf("%s does not appear reachable from the internet", addr)
+		result.Details = []string{
+			"No inbound peers, and a connection to the public IP failed",
+			fmt.Sprintf("Forward TCP port %d on your router/NAT to this host", cfg.P2PPort),
+			fmt.Sprintf("Allow inbound TCP %d in the firewall (e.g. sudo ufw allow %d/tcp)", cfg.P2PPort, cfg.P2PPort),
+			"Some routers can't connect back to their own public IP; inbound peers are the definitive signal",
+		}
+	}
+
+	switch {
+	case extAddr == "":
+		result.Details = append(result.Details, fmt.Sprintf("Set external_address = \"%s\" under [p2p] in config.toml so peers can dial back", addr))
+	case extAddr != addr && strings.TrimPrefix(extAddr, "tcp://") != addr:
+		result.Details = append(result.Details, fmt.Sprintf("external_address in config.toml is %s but the detected address is %s", extAddr, addr))
+	}
+
+	printCheck(result, c)
+	return result
+}
+
 func checkRemoteConnectivity(cli node.Client, domain string, c *ui.ColorConfig) checkResult {
 	result := checkResult{Name: "Remote Connectivity"}
 
@@ -286,6 +534,66 @@ func checkDiskSpace(cfg config.Config, c *ui.ColorConfig) checkResult {
 	return result
 }
 
+// fileLimits are the open file limits checkFileLimits judges and whose
+// they are.
+type fileLimits struct {
+	Limits fdlimit.Limits
+	Source string // "node (PID n)" or "this shell"
+	Note   string // why the node's own limits were not read, if they weren't
+	Err    error
+}
+
+// nodeFileLimits reads the running node's open file limits, falling back to
+// the limits a node started from this shell would get.
+func nodeFileLimits(sup process.Supervisor) fileLimits {
+	if pid, ok := sup.PID(); ok {
+		l, err := fdlimit.Process(pid)
+		if err == nil {
+			return fileLimits{Limits: l, Source: fmt.Sprintf("node (PID %d)", pid)}
+		}
+		self, serr := fdlimit.Child()
+		return fileLimits{Limits: self, Source: "this shell", Note: fmt.Sprintf("Could not read the node's limits: %v", err), Err: serr}
+	}
+	self, err := fdlimit.Child()
+	return fileLimits{Limits: self, Source: "this shell", Err: err}
+}
+
+func checkFileLimits(fl fileLimits, c *ui.ColorConfig) checkResult {
+	result := checkResult{Name: "Open File Limit"}
+
+	switch {
+	case errors.Is(fl.Err, fdlimit.ErrUnsupported):
+		result.Status = "pass"
+		result.Message = fmt.Sprintf("Not checked: no open file limit on %s", runtime.GOOS)
+	case fl.Err != nil:
+		result.Status = "warn"
+		result.Message = "Could not read the open file limit"
+		result.Details = []string{fmt.Sprintf("Error: %v", fl.Err)}
+	case fl.Limits.Low():
+		result.Status = "warn"
+		result.Message = fmt.Sprintf("Soft limit %d is below the recommended %d for %s", fl.Limits.Soft, fdlimit.Recommended, fl.Source)
+		result.Details = []string{
+			fl.Limits.String(),
+			"With many peers the node runs out of file descriptors and drops connections",
+			fmt.Sprintf("Shell: run 'ulimit -n %d' before 'push-validator start' (add it to ~/.profile to keep it)", fdlimit.Recommended),
+			fmt.Sprintf("systemd: set LimitNOFILE=%d under [Service], then 'sudo systemctl daemon-reload' and restart the unit", fdlimit.Recommended),
+		}
+		if fl.Limits.Hard < fdlimit.Recommended {
+			result.Details = append(result.Details, fmt.Sprintf("The hard limit is also too low: raise nofile in /etc/security/limits.conf (e.g. '* hard nofile %d') and log in again", fdlimit.Recommended))
+		}
+		result.Details = append(result.Details, "Restart the node for a new limit to take effect")
+	default:
+		result.Status = "pass"
+		result.Message = fmt.Sprintf("%s for %s", fl.Limits, fl.Source)
+	}
+	if fl.Note != "" {
+		result.Details = append(result.Details, fl.Note)
+	}
+
+	printCheck(result, c)
+	return result
+}
+
 func checkPermissions(cfg config.Config, c *ui.ColorConfig) checkResult {
 	result := checkResult{Name: "File Permissions"}
 
@@ -367,7 +675,59 @@ func checkCosmovisor(cfg config.Config, c *ui.ColorConfig) checkResult {
 	return result
 }
 
+// normalizeVersion reduces `pchaind version` output or an /abci_info
+// version to a comparable form: first line, no leading "v".
+func normalizeVersion(v string) string {
+	v = strings.TrimSpace(v)
+	if i := strings.IndexByte(v, '\n'); i >= 0 {
+		v = strings.TrimSpace(v[:i])
+	}
+	return strings.TrimPrefix(v, "v")
+}
+
+// checkPchaindVersion compares the installed pchaind with the version the
+// network's RPC node reports in /abci_info. A mismatch is the usual reason a
+// node stops syncing at an upgrade height.
+func checkPchaindVersion(installed string, installedErr error, network node.AppInfo, networkErr error, c *ui.ColorConfig) checkResult {
+	result := checkResult{Name: "pchaind Version"}
+
+	switch {
+	case installedErr != nil:
+		result.Status = "warn"
+		result.Message = "Could not read the installed pchaind version"
+		result.Details = []string{
+			fmt.Sprintf("pchaind version: %v", installedErr),
+			"Install it with: push-validator install-chain",
+		}
+	case networkErr != nil || network.Version == "":
+		result.Status = "warn"
+		result.Message = fmt.Sprintf("pchaind %s installed; network version not checked", normalizeVersion(installed))
+		if networkErr != nil {
+			result.Details = []string{fmt.Sprintf("Remote /abci_info unavailable: %v", networkErr)}
+		} else {
+			result.Details = []string{"Remote /abci_info reported no version"}
+		}
+	case normalizeVersion(installed) != normalizeVersion(network.Version):
+		result.Status = "warn"
+		result.Message = fmt.Sprintf("Installed pchaind %s, network runs %s", normalizeVersion(installed), normalizeVersion(network.Version))
+		result.Details = []string{
+			"A version mismatch can stop the node at the next upgrade height",
+			"Install the network's version: push-validator install-chain v" + normalizeVersion(network.Version),
+			"For a scheduled upgrade, see: push-validator cosmovisor upgrade-info",
+		}
+	default:
+		result.Status = "pass"
+		result.Message = fmt.Sprintf("pchaind %s matches the network", normalizeVersion(installed))
+	}
+
+	printCheck(result, c)
+	return result
+}
+
 func printCheck(r checkResult, c *ui.ColorConfig) {
+	if doctorStructured() {
+		return
+	}
 	icon := ""
 	msg := ""
 
@@ -391,5 +751,9 @@ func printCheck(r checkResult, c *ui.ColorConfig) {
 }
 
 func init() {
+	doctorCmd.Flags().BoolVar(&doctorCheckExternal, "check-external", false, "Also check that the P2P port is reachable from the internet (needs outbound internet)")
+	doctorCmd.Flags().StringVar(&doctorIPEchoURL, "ip-echo-url", defaultIPEchoURL, "Service that returns this host's public IP, used by --check-external")
+	doctorCmd.Flags().BoolVar(&doctorReport, "report", false, "Also write a redacted environment report for bug reports")
+	doctorCmd.Flags().StringVar(&doctorReportFile, "report-file", "", "Report path (implies --report; default push-validator-report-<time>.txt in the current directory)")
 	rootCmd.AddCommand(doctorCmd)
 }
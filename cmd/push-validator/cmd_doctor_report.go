@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
+)
+
+// reportLogLines is how much of the node log a report includes.
+const reportLogLines = 200
+
+// reportEnvPrefixes selects the environment variables a report lists.
+var reportEnvPrefixes = []string{"PUSH_", "PCHAIN", "HOME_DIR", "MONIKER", "DAEMON_", "HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "GITHUB_TOKEN"}
+
+// reportInput is everything `doctor --report` writes, gathered up front so
+// the report itself is a pure function of it.
+type reportInput struct {
+	Now            time.Time
+	Cfg            config.Config
+	PchaindPath    string
+	PchaindVersion string
+	NetworkVersion string // from the remote node's /abci_info; set by the doctor run
+	Cosmovisor     cosmovisor.DetectionResult
+	LogPath        string
+	LogTail        string
+	Checks         []checkResult
+	Env            []string // KEY=value pairs, e.g. os.Environ()
+}
+
+// gatherReport collects the report input for cfg. Checks are the results of
+// the doctor run that produced the report.
+func gatherReport(cfg config.Config, logPath string, checks []checkResult) reportInput {
+	in := reportInput{
+		Now:         time.Now(),
+		Cfg:         cfg,
+		PchaindPath: findPchaind(),
+		Cosmovisor:  cosmovisor.Detect(cfg.HomeDir),
+		LogPath:     logPath,
+		LogTail:     readLogTail(logPath, reportLogLines),
+		Checks:      checks,
+		Env:         os.Environ(),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, in.PchaindPath, "version").CombinedOutput()
+	if err != nil {
+		in.PchaindVersion = fmt.Sprintf("unavailable (%v)", err)
+	} else {
+		in.PchaindVersion = strings.TrimSpace(string(out))
+	}
+	return in
+}
+
+// buildReport renders the report as plain text with secrets redacted.
+func buildReport(in reportInput) string {
+	var b strings.Builder
+	section := func(title string) {
+		fmt.Fprintf(&b, "\n== %s ==\n", title)
+	}
+	kv := func(k string, v any) {
+		fmt.Fprintf(&b, "%-22s %v\n", k+":", v)
+	}
+
+	fmt.Fprintf(&b, "push-validator report, generated %s\n", in.Now.UTC().Format(time.RFC3339))
+
+	section("CLI")
+	kv("version", Version)
+	kv("commit", Commit)
+	kv("build date", BuildDate)
+	kv("os/arch", runtime.GOOS+"/"+runtime.GOARCH)
+	kv("go", runtime.Version())
+
+	section("Node binaries")
+	kv("pchaind", in.PchaindPath)
+	kv("pchaind version", in.PchaindVersion)
+	kv("network version", in.NetworkVersion)
+	kv("cosmovisor available", in.Cosmovisor.Available)
+	kv("cosmovisor path", in.Cosmovisor.BinaryPath)
+	kv("cosmovisor set up", in.Cosmovisor.SetupComplete)
+	kv("cosmovisor reason", in.Cosmovisor.Reason)
+
+	section("Effective config")
+	c := in.Cfg
+	kv("home", c.HomeDir)
+	kv("chain id", c.ChainID)
+	kv("genesis domain", c.GenesisDomain)
+	kv("genesis hash", c.GenesisHash)
+	kv("rpc local", c.RPCLocal)
+	kv("rpc port", c.RPCPort)
+	kv("p2p port", c.P2PPort)
+	kv("keyring backend", c.KeyringBackend)
+	kv("denom", c.Denom)
+	kv("snapshot url", c.SnapshotURL)
+	kv("http proxy", c.HTTPProxy)
+	kv("ca cert", c.CACertFile)
+	kv("rpc ca cert", c.RPCCACertFile)
+	kv("rpc tls insecure", c.RPCTLSInsecure)
+	kv("rpc retry", c.RPCRetry)
+	kv("sync tolerance", c.SyncTolerance)
+	kv("http timeout", c.HTTPTimeout)
+	kv("download rate", c.DownloadRate)
+	kv("update cache dir", c.UpdateCacheLocation())
+	kv("update interval", c.UpdateCheckInterval)
+	kv("cache ttl", c.CacheTTL)
+
+	section("Environment")
+	var env []string
+	for _, e := range in.Env {
+		for _, p := range reportEnvPrefixes {
+			if strings.HasPrefix(e, p) {
+				env = append(env, e)
+				break
+			}
+		}
+	}
+	sort.Strings(env)
+	if len(env) == 0 {
+		b.WriteString("(none set)\n")
+	}
+	for _, e := range env {
+		b.WriteString(e + "\n")
+	}
+
+	if len(in.Checks) > 0 {
+		section("Doctor checks")
+		for _, r := range in.Checks {
+			fmt.Fprintf(&b, "[%s] %s: %s\n", r.Status, r.Name, r.Message)
+			for _, d := range r.Details {
+				fmt.Fprintf(&b, "    %s\n", d)
+			}
+		}
+	}
+
+	// Node config files, without comments; key files and the keyring are
+	// never read
+	for _, name := range []string{"config.toml", "app.toml", "client.toml"} {
+		section("config/" + name)
+		data, err := os.ReadFile(filepath.Join(in.Cfg.HomeDir, "config", name))
+		if err != nil {
+			fmt.Fprintf(&b, "(unreadable: %v)\n", err)
+			continue
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			t := strings.TrimSpace(line)
+			if t == "" || strings.HasPrefix(t, "#") {
+				continue
+			}
+			b.WriteString(t + "\n")
+		}
+	}
+
+	section(fmt.Sprintf("Log tail (%s, last %d lines)", in.LogPath, reportLogLines))
+	if in.LogTail == "" {
+		b.WriteString("(empty or unreadable)\n")
+	} else {
+		b.WriteString(in.LogTail + "\n")
+	}
+
+	return redactReport(b.String())
+}
+
+// Redaction patterns, applied to the whole report.
+var (
+	// user:password@ in URLs such as proxy settings
+	reURLCreds = regexp.MustCompile(`(?i)([a-z][a-z0-9+.-]*://)[^/\s:@]+:[^/\s@]+@`)
+	// key = value, key: value and "key": "value" where the key names a secret
+	reSecretKV = regexp.MustCompile(`(?i)([a-z0-9_.-]*(?:token|secret|password|passwd|passphrase|mnemonic|priv_?key|private_?key|api_?key)[a-z0-9_.-]*"?\s*[:=]\s*)("[^"]*"|'[^']*'|\S+)`)
+	reBearer   = regexp.MustCompile(`(?i)(bearer\s+)[a-z0-9._~+/-]+=*`)
+	reGHToken  = regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{20,}|github_pat_[A-Za-z0-9_]{20,})\b`)
+	// 12 to 24 short lowercase words in a row, the shape of a BIP-39 mnemonic
+	reMnemonic = regexp.MustCompile(`\b(?:[a-z]{3,8} ){11,23}[a-z]{3,8}\b`)
+)
+
+// redactReport strips credentials, tokens and mnemonics from s.
+func redactReport(s string) string {
+	s = reURLCreds.ReplaceAllString(s, "${1}[REDACTED]@")
+	s = reSecretKV.ReplaceAllString(s, "${1}[REDACTED]")
+	s = reBearer.ReplaceAllString(s, "${1}[REDACTED]")
+	s = reGHToken.ReplaceAllString(s, "[REDACTED]")
+	s = reMnemonic.ReplaceAllString(s, "[REDACTED MNEMONIC]")
+	return s
+}
+
+// writeReport writes the report to path, or to a timestamped file in the
+// current directory when path is empty, and returns the path written.
+func writeReport(path string, in reportInput) (string, error) {
+	if path == "" {
+		path = fmt.Sprintf("push-validator-report-%s.txt", in.Now.Format("20060102-150405"))
+	}
+	if err := os.WriteFile(path, []byte(buildReport(in)), 0o600); err != nil {
+		return "", fmt.Errorf("write report: %w", err)
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return path, nil
+}
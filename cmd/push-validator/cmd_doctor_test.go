@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/fdlimit"
 	"github.com/pushchain/push-validator-cli/internal/node"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 )
@@ -32,6 +35,37 @@ func TestCheckProcessRunning_Running(t *testing.T) {
 	}
 }
 
+func TestCheckPchaindVersion(t *testing.T) {
+	c := testColorConfig()
+	tests := []struct {
+		name         string
+		installed    string
+		installedErr error
+		network      node.AppInfo
+		networkErr   error
+		wantStatus   string
+		wantMsg      string
+	}{
+		{"match", "v0.0.2\n", nil, node.AppInfo{Version: "0.0.2"}, nil, "pass", "pchaind 0.0.2 matches the network"},
+		{"mismatch", "0.0.1", nil, node.AppInfo{Version: "0.0.2"}, nil, "warn", "Installed pchaind 0.0.1, network runs 0.0.2"},
+		{"network offline", "0.0.1", nil, node.AppInfo{}, fmt.Errorf("connection refused"), "warn", "network version not checked"},
+		{"binary missing", "", fmt.Errorf("exec: not found"), node.AppInfo{Version: "0.0.2"}, nil, "warn", "Could not read"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := checkPchaindVersion(tt.installed, tt.installedErr, tt.network, tt.networkErr, c)
+			if r.Status != tt.wantStatus || !strings.Contains(r.Message, tt.wantMsg) {
+				t.Errorf("checkPchaindVersion() = %s %q, want %s containing %q", r.Status, r.Message, tt.wantStatus, tt.wantMsg)
+			}
+		})
+	}
+
+	r := checkPchaindVersion("0.0.1", nil, node.AppInfo{Version: "0.0.2"}, nil, c)
+	if !strings.Contains(strings.Join(r.Details, "\n"), "push-validator install-chain v0.0.2") {
+		t.Errorf("mismatch details should suggest install-chain, got %v", r.Details)
+	}
+}
+
 func TestCheckProcessRunning_RunningNoPID(t *testing.T) {
 	sup := &mockSupervisor{running: true, pid: 0}
 	c := testColorConfig()
@@ -74,6 +108,23 @@ func TestCheckConfigFiles_AllPresent(t *testing.T) {
 	}
 }
 
+func TestCheckPrivValState(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.Config{HomeDir: dir}
+	c := testColorConfig()
+
+	if result := checkPrivValState(cfg, c); result.Status != "pass" {
+		t.Errorf("checkPrivValState(missing) Status = %q, want pass", result.Status)
+	}
+
+	os.MkdirAll(filepath.Join(dir, "data"), 0o755)
+	os.WriteFile(filepath.Join(dir, "data", "priv_validator_state.json"), []byte(`{"height":`), 0o644)
+	result := checkPrivValState(cfg, c)
+	if result.Status != "fail" || len(result.Details) == 0 {
+		t.Errorf("checkPrivValState(corrupt) = %+v, want fail with details", result)
+	}
+}
+
 func TestCheckConfigFiles_MissingGenesis(t *testing.T) {
 	dir := t.TempDir()
 	configDir := filepath.Join(dir, "config")
@@ -242,6 +293,49 @@ func TestCheckDiskSpace_NonexistentDir(t *testing.T) {
 	}
 }
 
+func TestCheckFileLimits(t *testing.T) {
+	c := testColorConfig()
+	tests := []struct {
+		name       string
+		fl         fileLimits
+		wantStatus string
+		wantDetail string
+	}{
+		{"recommended", fileLimits{Limits: fdlimit.Limits{Soft: 65536, Hard: 65536}, Source: "node (PID 7)"}, "pass", ""},
+		{"unlimited", fileLimits{Limits: fdlimit.Limits{Soft: fdlimit.Unlimited, Hard: fdlimit.Unlimited}, Source: "this shell"}, "pass", ""},
+		{"low soft", fileLimits{Limits: fdlimit.Limits{Soft: 1024, Hard: 524288}, Source: "node (PID 7)"}, "warn", "LimitNOFILE=65536"},
+		{"low hard", fileLimits{Limits: fdlimit.Limits{Soft: 1024, Hard: 4096}, Source: "this shell"}, "warn", "/etc/security/limits.conf"},
+		{"unsupported", fileLimits{Err: fdlimit.ErrUnsupported}, "pass", ""},
+		{"unreadable", fileLimits{Err: os.ErrPermission}, "warn", "Error:"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := checkFileLimits(tt.fl, c)
+			if r.Status != tt.wantStatus {
+				t.Errorf("Status = %q (%s), want %q", r.Status, r.Message, tt.wantStatus)
+			}
+			if tt.wantDetail != "" && !strings.Contains(strings.Join(r.Details, "\n"), tt.wantDetail) {
+				t.Errorf("Details = %q, want mention of %q", r.Details, tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestNodeFileLimits_FallsBackToShell(t *testing.T) {
+	fl := nodeFileLimits(&mockSupervisor{running: false})
+	if fl.Source != "this shell" || fl.Note != "" {
+		t.Errorf("stopped node: %+v, want this shell's limits", fl)
+	}
+
+	fl = nodeFileLimits(&mockSupervisor{running: true, pid: os.Getpid()})
+	if fl.Err != nil {
+		t.Fatalf("own PID: %v", fl.Err)
+	}
+	if fl.Source != fmt.Sprintf("node (PID %d)", os.Getpid()) && fl.Note == "" {
+		t.Errorf("own PID: %+v, want node limits or a note why not", fl)
+	}
+}
+
 func TestCheckPermissions_WorldReadable(t *testing.T) {
 	dir := t.TempDir()
 	configDir := filepath.Join(dir, "config")
@@ -324,6 +418,36 @@ func TestDoctorSummary_WithFailures(t *testing.T) {
 	}
 }
 
+func TestDoctorJSON(t *testing.T) {
+	results := []checkResult{
+		{Name: "Process Status", Status: "pass", Message: "running"},
+		{Name: "P2P Network", Status: "warn", Message: "Only 1 peer(s) connected"},
+		{Name: "Configuration Files", Status: "fail", Message: "Missing configuration files: genesis.json", Details: []string{"Run 'push-validator init' to initialize configuration"}},
+	}
+	out := doctorJSON(results, "/tmp/report.txt")
+	if out["ok"] != false || out["passed"] != 1 || out["warnings"] != 1 || out["failed"] != 1 || out["report"] != "/tmp/report.txt" {
+		t.Errorf("summary = %v", out)
+	}
+	checks := out["checks"].([]map[string]any)
+	if len(checks) != 3 || checks[2]["name"] != "Configuration Files" || checks[2]["status"] != "fail" {
+		t.Fatalf("checks = %v", checks)
+	}
+	if rem := checks[2]["remediation"].([]string); len(rem) != 1 {
+		t.Errorf("remediation = %v", rem)
+	}
+	if rem := checks[0]["remediation"].([]string); rem == nil || len(rem) != 0 {
+		t.Errorf("pass remediation = %#v, want empty list", rem)
+	}
+
+	out = doctorJSON(results[:2], "")
+	if out["ok"] != true {
+		t.Errorf("warnings alone should be ok: %v", out)
+	}
+	if _, ok := out["report"]; ok {
+		t.Error("report key without --report")
+	}
+}
+
 func TestRunDoctorChecks_Integration(t *testing.T) {
 	dir := t.TempDir()
 	configDir := filepath.Join(dir, "config")
@@ -352,8 +476,16 @@ func TestRunDoctorChecks_Integration(t *testing.T) {
 
 	results := runDoctorChecks(cfg, sup, localCli, remoteCli, c)
 
-	if len(results) != 9 {
-		t.Errorf("runDoctorChecks() returned %d results, want 9", len(results))
+	if len(results) != 11 {
+		t.Errorf("runDoctorChecks() returned %d results, want 11", len(results))
+	}
+	// --output json consumers match checks by name
+	names := map[string]bool{}
+	for _, r := range results {
+		if r.Name == "" || names[r.Name] {
+			t.Errorf("check name %q is empty or repeated", r.Name)
+		}
+		names[r.Name] = true
 	}
 
 	// Count passes
@@ -517,8 +649,8 @@ func TestRunDoctorChecks_AllFailing(t *testing.T) {
 
 	results := runDoctorChecks(cfg, sup, localCli, remoteCli, c)
 
-	if len(results) != 9 {
-		t.Errorf("runDoctorChecks() returned %d results, want 9", len(results))
+	if len(results) != 11 {
+		t.Errorf("runDoctorChecks() returned %d results, want 11", len(results))
 	}
 
 	// Count failures and warnings
@@ -537,3 +669,64 @@ func TestRunDoctorChecks_AllFailing(t *testing.T) {
 		t.Errorf("runDoctorChecks() only %d checks failed/warned, expected at least 5", failCount+warnCount)
 	}
 }
+
+func TestCheckExternalP2P(t *testing.T) {
+	c := testColorConfig()
+	probe := func(ip string, ipErr, dialErr error) reachabilityProbe {
+		return reachabilityProbe{
+			publicIP: func(context.Context) (string, error) { return ip, ipErr },
+			dial:     func(context.Context, string) error { return dialErr },
+		}
+	}
+	cfg := config.Config{HomeDir: t.TempDir(), P2PPort: 26656}
+	inbound := &mockNodeClient{peers: []node.Peer{{ID: "a", Outbound: true}, {ID: "b"}}}
+	outboundOnly := &mockNodeClient{peers: []node.Peer{{ID: "a", Outbound: true}}}
+	refused := fmt.Errorf("connection refused")
+
+	t.Run("inbound peers", func(t *testing.T) {
+		r := checkExternalP2P(cfg, inbound, probe("203.0.113.7", nil, refused), c)
+		if r.Status != "pass" || !containsSubstr(r.Message, "203.0.113.7:26656") || !containsSubstr(r.Message, "1 inbound") {
+			t.Errorf("got %+v", r)
+		}
+	})
+
+	t.Run("dial succeeds", func(t *testing.T) {
+		// Possibly a hairpin NAT loopback, so it proves nothing on its own
+		r := checkExternalP2P(cfg, outboundOnly, probe("203.0.113.7", nil, nil), c)
+		if r.Status != "warn" || !containsSubstr(r.Message, "inconclusive from this host") {
+			t.Errorf("got %+v", r)
+		}
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		r := checkExternalP2P(cfg, outboundOnly, probe("203.0.113.7", nil, refused), c)
+		if r.Status != "warn" {
+			t.Fatalf("Status = %q, want warn", r.Status)
+		}
+		var forward, extAddr bool
+		for _, d := range r.Details {
+			forward = forward || containsSubstr(d, "Forward TCP port 26656")
+			extAddr = extAddr || containsSubstr(d, `external_address = "203.0.113.7:26656"`)
+		}
+		if !forward || !extAddr {
+			t.Errorf("missing remediation: %v", r.Details)
+		}
+	})
+
+	t.Run("offline", func(t *testing.T) {
+		r := checkExternalP2P(cfg, inbound, probe("", fmt.Errorf("no route to host"), nil), c)
+		if r.Status != "warn" || !containsSubstr(r.Message, "outbound internet") {
+			t.Errorf("got %+v", r)
+		}
+	})
+
+	t.Run("external_address mismatch", func(t *testing.T) {
+		home := t.TempDir()
+		_ = os.MkdirAll(filepath.Join(home, "config"), 0o755)
+		_ = os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte("[p2p]\nexternal_address = \"198.51.100.1:26656\"\n"), 0o644)
+		r := checkExternalP2P(config.Config{HomeDir: home, P2PPort: 26656}, inbound, probe("203.0.113.7", nil, nil), c)
+		if len(r.Details) != 1 || !containsSubstr(r.Details[0], "198.51.100.1:26656") {
+			t.Errorf("Details = %v", r.Details)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	syncmon "github.com/pushchain/push-validator-cli/internal/sync"
+)
+
+// Actions reported by ensure-running.
+const (
+	ensureNone    = "none"    // already running and synced
+	ensureStarted = "started" // was stopped and has been started
+	ensureSynced  = "synced"  // was running but catching up, waited for sync
+)
+
+// ensureResult is the outcome of ensure-running.
+type ensureResult struct {
+	OK            bool   `json:"ok"`
+	Action        string `json:"action"`
+	WasRunning    bool   `json:"was_running"`
+	WaitedForSync bool   `json:"waited_for_sync"`
+	Height        int64  `json:"height,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// ensurePoll is how often ensure-running re-checks RPC after a start.
+var ensurePoll = time.Second
+
+// ensureRunning converges the node to running and synced within ctx's
+// deadline. waitSync blocks until the node has caught up. The error carries
+// the exit code for the failure; a nil error with a result Action other than
+// ensureNone means the node was brought up.
+func ensureRunning(ctx context.Context, d *Deps, w io.Writer, waitSync func(context.Context) error) (ensureResult, error) {
+	res := ensureResult{Action: ensureNone, WasRunning: d.Sup.IsRunning()}
+	hostport := d.Cfg.RPCHostPort()
+
+	if !res.WasRunning {
+		genesis := filepath.Join(d.Cfg.HomeDir, "config", "genesis.json")
+		if _, err := os.Stat(genesis); err != nil {
+			return res, exitcodes.PreconditionErrorf("node is not initialized (%s missing); run 'push-validator start' for first-time setup", genesis)
+		}
+		fmt.Fprintln(w, "→ Node is stopped, starting it...")
+		if _, err := d.Sup.Start(nodeStartOpts(d.Cfg)); err != nil {
+			if errors.Is(err, files.ErrPrivValStateBehind) {
+				return res, doubleSignRefusal(err)
+			}
+			return res, exitcodes.ProcessErrf("failed to start node: %v", err)
+		}
+		res.Action = ensureStarted
+	}
+
+	// Wait for RPC, which can take a while after a start while databases open
+	for !d.RPCCheck(hostport, 800*time.Millisecond) {
+		if !d.Sup.IsRunning() {
+			return res, exitcodes.ProcessErrf("node process exited; check 'push-validator logs'")
+		}
+		select {
+		case <-ctx.Done():
+			return res, exitcodes.NewErrorf(exitcodes.RPCUnreachable, "node RPC at %s did not answer before the deadline", hostport)
+		case <-time.After(ensurePoll):
+		}
+	}
+
+	st, err := d.Node.Status(ctx)
+	if err != nil {
+		return res, exitcodes.NetworkErrf("RPC status error: %v", err)
+	}
+	res.Height = st.Height
+	if !st.CatchingUp {
+		return res, nil
+	}
+
+	fmt.Fprintf(w, "→ Node is catching up at height %d, waiting for sync...\n", st.Height)
+	res.WaitedForSync = true
+	if res.Action == ensureNone {
+		res.Action = ensureSynced
+	}
+	if err := waitSync(ctx); err != nil {
+		switch {
+		case ctx.Err() != nil:
+			return res, exitcodes.NewErrorf(exitcodes.CatchingUp, "node still catching up at the deadline")
+		case errors.Is(err, syncmon.ErrSyncStuck):
+			return res, exitcodes.NewErrorf(exitcodes.SyncStuck, "%v", err)
+		default:
+			return res, fmt.Errorf("sync failed: %w", err)
+		}
+	}
+	if st, err := d.Node.Status(ctx); err == nil {
+		res.Height = st.Height
+	}
+	return res, nil
+}
+
+// handleEnsureRunning runs ensureRunning with a deadline and reports the
+// outcome: exit 0 when nothing had to change, exitcodes.BroughtUp when the
+// node was started or waited on, and the failure's own code otherwise.
+func handleEnsureRunning(d *Deps, timeout time.Duration) error {
+	if timeout <= 0 {
+		return exitcodes.InvalidArgsError("--timeout must be positive")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	w := d.Output
+	if w == nil {
+		w = os.Stdout
+	}
+	jsonOut := flagOutput == "json"
+	if jsonOut {
+		w = io.Discard
+	}
+	waitSync := func(ctx context.Context) error {
+		return syncmon.RunWithRetry(ctx, syncmon.RetryOptions{
+			Options: syncmon.Options{
+				LocalRPC:      d.Cfg.RPCLocal,
+				RemoteRPC:     d.Cfg.RemoteRPCURL(),
+				LogPath:       d.Sup.LogPath(),
+				Window:        30,
+				Out:           w,
+				Interval:      120 * time.Millisecond,
+				Quiet:         flagQuiet || jsonOut,
+				StuckTimeout:  30 * time.Minute,
+				SyncTolerance: d.Cfg.SyncTolerance,
+			},
+			MaxRetries: 3,
+		})
+	}
+
+	res, err := ensureRunning(ctx, d, w, waitSync)
+	res.OK = err == nil
+	if err != nil {
+		res.Error = err.Error()
+	}
+	if jsonOut {
+		d.Printer.JSON(res)
+	} else if err == nil {
+		switch res.Action {
+		case ensureStarted:
+			d.Printer.Success(fmt.Sprintf("Node started and synced at height %d", res.Height))
+		case ensureSynced:
+			d.Printer.Success(fmt.Sprintf("Node synced at height %d", res.Height))
+		default:
+			d.Printer.Success(fmt.Sprintf("Node already running and synced at height %d", res.Height))
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if res.Action != ensureNone {
+		return exitcodes.ErrBroughtUp
+	}
+	return nil
+}
+
+func init() {
+	var timeout time.Duration
+	ensureCmd := &cobra.Command{
+		Use:   "ensure-running",
+		Short: "Start the node and wait for sync unless it is already running and synced",
+		Long: `Make sure the node is running and synced, doing nothing if it already is.
+
+A stopped node is started; a node that is catching up is waited on until it
+syncs. Everything must finish within --timeout.
+
+Exit codes: 0 when the node was already running and synced, 21 when it had to
+be started or waited on and is now synced, and the failure's code otherwise
+(e.g. 3 not initialized, 5 failed to start, 11 RPC never answered, 12 still
+catching up at the deadline, 42 sync stuck).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleEnsureRunning(newDeps(), timeout)
+		},
+	}
+	ensureCmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Deadline for starting and syncing the node")
+	rootCmd.AddCommand(ensureCmd)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/node"
+	syncmon "github.com/pushchain/push-validator-cli/internal/sync"
+)
+
+func ensureDeps(t *testing.T, running bool, rpcUp bool, st node.Status) *Deps {
+	t.Helper()
+	cfg := testCfg()
+	cfg.HomeDir = t.TempDir()
+	if err := os.MkdirAll(filepath.Join(cfg.HomeDir, "config"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(cfg.HomeDir, "config", "genesis.json"), []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return &Deps{
+		Cfg:      cfg,
+		Sup:      &mockSupervisor{running: running},
+		Node:     &mockNodeClient{status: st},
+		Printer:  getPrinter(),
+		RPCCheck: func(string, time.Duration) bool { return rpcUp },
+	}
+}
+
+func noSyncWait(t *testing.T) func(context.Context) error {
+	return func(context.Context) error {
+		t.Error("waitSync should not be called")
+		return nil
+	}
+}
+
+func TestEnsureRunning_AlreadyOK(t *testing.T) {
+	d := ensureDeps(t, true, true, node.Status{Height: 100})
+	res, err := ensureRunning(context.Background(), d, &bytes.Buffer{}, noSyncWait(t))
+	if err != nil {
+		t.Fatalf("ensureRunning() error = %v", err)
+	}
+	if res.Action != ensureNone || !res.WasRunning || res.Height != 100 {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestEnsureRunning_StartsStoppedNode(t *testing.T) {
+	d := ensureDeps(t, false, true, node.Status{Height: 100})
+	res, err := ensureRunning(context.Background(), d, &bytes.Buffer{}, noSyncWait(t))
+	if err != nil {
+		t.Fatalf("ensureRunning() error = %v", err)
+	}
+	if res.Action != ensureStarted || res.WasRunning || !d.Sup.IsRunning() {
+		t.Errorf("result = %+v, running = %v", res, d.Sup.IsRunning())
+	}
+}
+
+func TestEnsureRunning_WaitsForSync(t *testing.T) {
+	d := ensureDeps(t, true, true, node.Status{Height: 50, CatchingUp: true})
+	waited := false
+	res, err := ensureRunning(context.Background(), d, &bytes.Buffer{}, func(context.Context) error {
+		waited = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ensureRunning() error = %v", err)
+	}
+	if !waited || res.Action != ensureSynced || !res.WaitedForSync {
+		t.Errorf("result = %+v, waited = %v", res, waited)
+	}
+}
+
+func TestEnsureRunning_Failures(t *testing.T) {
+	origPoll := ensurePoll
+	ensurePoll = time.Millisecond
+	defer func() { ensurePoll = origPoll }()
+
+	t.Run("not initialized", func(t *testing.T) {
+		d := ensureDeps(t, false, true, node.Status{})
+		d.Cfg.HomeDir = t.TempDir()
+		_, err := ensureRunning(context.Background(), d, &bytes.Buffer{}, noSyncWait(t))
+		if exitcodes.CodeForError(err) != exitcodes.PreconditionFailed {
+			t.Errorf("err = %v, want precondition", err)
+		}
+	})
+	t.Run("start fails", func(t *testing.T) {
+		d := ensureDeps(t, false, true, node.Status{})
+		d.Sup = &mockSupervisor{startErr: fmt.Errorf("port in use")}
+		_, err := ensureRunning(context.Background(), d, &bytes.Buffer{}, noSyncWait(t))
+		if exitcodes.CodeForError(err) != exitcodes.ProcessError {
+			t.Errorf("err = %v, want process error", err)
+		}
+	})
+	t.Run("below signed height", func(t *testing.T) {
+		d := ensureDeps(t, false, true, node.Status{})
+		d.Sup = &mockSupervisor{startErr: fmt.Errorf("%w: state height 0, signed up to 500", files.ErrPrivValStateBehind)}
+		_, err := ensureRunning(context.Background(), d, &bytes.Buffer{}, noSyncWait(t))
+		if exitcodes.CodeForError(err) != exitcodes.PreconditionFailed || !strings.Contains(err.Error(), "--i-understand-double-sign-risk") {
+			t.Errorf("err = %v, want precondition with the override hint", err)
+		}
+	})
+	t.Run("rpc never answers", func(t *testing.T) {
+		d := ensureDeps(t, true, false, node.Status{})
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := ensureRunning(ctx, d, &bytes.Buffer{}, noSyncWait(t))
+		if exitcodes.CodeForError(err) != exitcodes.RPCUnreachable {
+			t.Errorf("err = %v, want rpc unreachable", err)
+		}
+	})
+	t.Run("sync stuck", func(t *testing.T) {
+		d := ensureDeps(t, true, true, node.Status{CatchingUp: true})
+		_, err := ensureRunning(context.Background(), d, &bytes.Buffer{}, func(context.Context) error {
+			return fmt.Errorf("sync failed after 3 retries: %w", syncmon.ErrSyncStuck)
+		})
+		if exitcodes.CodeForError(err) != exitcodes.SyncStuck {
+			t.Errorf("err = %v, want sync stuck", err)
+		}
+	})
+	t.Run("deadline while catching up", func(t *testing.T) {
+		d := ensureDeps(t, true, true, node.Status{CatchingUp: true})
+		ctx, cancel := context.WithCancel(context.Background())
+		_, err := ensureRunning(ctx, d, &bytes.Buffer{}, func(ctx context.Context) error {
+			cancel()
+			return ctx.Err()
+		})
+		if exitcodes.CodeForError(err) != exitcodes.CatchingUp {
+			t.Errorf("err = %v, want catching up", err)
+		}
+	})
+}
+
+func TestHandleEnsureRunning_ExitCodes(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "json"
+
+	if err := handleEnsureRunning(ensureDeps(t, true, true, node.Status{Height: 1}), time.Minute); err != nil {
+		t.Errorf("already running: err = %v, want nil", err)
+	}
+	err := handleEnsureRunning(ensureDeps(t, false, true, node.Status{Height: 1}), time.Minute)
+	if !errors.Is(err, exitcodes.ErrBroughtUp) || exitcodes.CodeForError(err) != exitcodes.BroughtUp {
+		t.Errorf("started: err = %v, want ErrBroughtUp", err)
+	}
+	if err := handleEnsureRunning(ensureDeps(t, true, true, node.Status{}), 0); exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("zero timeout: err = %v, want invalid args", err)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/node"
+)
+
+// eventStreamer subscribes to query and calls fn per event until ctx ends;
+// node.StreamEvents in production.
+type eventStreamer func(ctx context.Context, baseURL, query string, fn func(node.Event)) error
+
+// eventLine is one event as printed with --output json.
+type eventLine struct {
+	Time   string              `json:"time"`
+	Event  string              `json:"event"`
+	Type   string              `json:"type"`
+	Events map[string][]string `json:"events,omitempty"`
+	Data   json.RawMessage     `json:"data,omitempty"`
+}
+
+// handleEvents streams events matching query from the local node until
+// interrupted.
+func handleEvents(d *Deps, query string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	w := d.Output
+	if w == nil {
+		w = os.Stdout
+	}
+	return tailEvents(ctx, d, w, query, node.StreamEvents)
+}
+
+// tailEvents is the testable core of handleEvents. Each event is printed as
+// it arrives, one JSON object per line with --output json.
+func tailEvents(ctx context.Context, d *Deps, w io.Writer, query string, stream eventStreamer) error {
+	if flagOutput == "yaml" {
+		return exitcodes.InvalidArgsError("events supports --output text or json")
+	}
+	if err := node.ValidateEventQuery(query); err != nil {
+		return exitcodes.InvalidArgsErrorf("--query: %v", err)
+	}
+	jsonOut := flagOutput == "json"
+	c := d.Printer.Colors
+	if !jsonOut {
+		fmt.Fprintln(w, c.Description(fmt.Sprintf("Streaming events matching %s from %s (Ctrl+C to exit)", query, d.Cfg.RPCLocal)))
+	}
+
+	err := stream(ctx, d.Cfg.RPCLocal, query, func(ev node.Event) {
+		now := time.Now()
+		if jsonOut {
+			writeNDJSON(w, eventLine{
+				Time:   now.UTC().Format(time.RFC3339Nano),
+				Event:  ev.Name(),
+				Type:   ev.Type,
+				Events: ev.Events,
+				Data:   ev.Data,
+			})
+			return
+		}
+		writeEventText(w, now, ev, c.Info, c.Description)
+	})
+	if err != nil {
+		return exitcodes.NetworkErrf("event stream from %s: %v", d.Cfg.RPCLocal, err)
+	}
+	return nil
+}
+
+// writeEventText prints ev as a timestamped name followed by its indexed
+// attributes, one per line in key order. tm.event repeats the name and is
+// left out.
+func writeEventText(w io.Writer, now time.Time, ev node.Event, name, attr func(string) string) {
+	fmt.Fprintf(w, "%s %s\n", now.Local().Format("15:04:05"), name(ev.Name()))
+	keys := make([]string, 0, len(ev.Events))
+	for k := range ev.Events {
+		if k != "tm.event" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintln(w, attr(fmt.Sprintf("  %s=%s", k, strings.Join(ev.Events[k], ","))))
+	}
+}
+
+func init() {
+	var query string
+	eventsCmd := &cobra.Command{
+		Use:   "events",
+		Short: "Stream CometBFT events from the local node",
+		Long: `Subscribe to the local node's CometBFT events over WebSocket and print each
+one as it arrives, like tail -f.
+
+--query takes a CometBFT event query: conditions such as tm.event='Tx' or
+tx.height>100 joined by AND. Each event prints its indexed attributes; with
+--output json each event is one JSON line that also carries the raw event
+data. A dropped connection is redialed (see --rpc-retry); Ctrl+C stops cleanly.`,
+		Example: `  push-validator events --query "tm.event='NewBlock'"
+  push-validator events --query "tm.event='Tx' AND message.sender='push1...'" --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleEvents(newDeps(), query)
+		},
+	}
+	eventsCmd.Flags().StringVar(&query, "query", "tm.event='NewBlock'", "CometBFT event query to subscribe to")
+	rootCmd.AddCommand(eventsCmd)
+}
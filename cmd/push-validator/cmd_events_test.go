@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/node"
+)
+
+// fakeEventStream delivers events, then returns err.
+func fakeEventStream(err error, events ...node.Event) eventStreamer {
+	return func(ctx context.Context, baseURL, query string, fn func(node.Event)) error {
+		for _, ev := range events {
+			fn(ev)
+		}
+		return err
+	}
+}
+
+var testTxEvent = node.Event{
+	Type:   "tendermint/event/Tx",
+	Events: map[string][]string{"tm.event": {"Tx"}, "tx.height": {"42"}, "message.action": {"/cosmos.bank.v1beta1.MsgSend"}},
+	Data:   json.RawMessage(`{"TxResult":{"height":"42"}}`),
+}
+
+func runTailEvents(t *testing.T, output, query string, stream eventStreamer) (string, error) {
+	t.Helper()
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = output
+	t.Setenv("NO_COLOR", "1")
+
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter()}
+	var buf bytes.Buffer
+	err := tailEvents(context.Background(), d, &buf, query, stream)
+	return buf.String(), err
+}
+
+func TestTailEvents_Text(t *testing.T) {
+	out, err := runTailEvents(t, "text", "tm.event='Tx'", fakeEventStream(nil, testTxEvent))
+	if err != nil {
+		t.Fatalf("tailEvents() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines, want banner, name and 2 attributes:\n%s", len(lines), out)
+	}
+	if !strings.HasSuffix(lines[1], " Tx") {
+		t.Errorf("name line = %q, want a time and Tx", lines[1])
+	}
+	if lines[2] != "  message.action=/cosmos.bank.v1beta1.MsgSend" || lines[3] != "  tx.height=42" {
+		t.Errorf("attributes = %q, want them sorted without tm.event", lines[2:])
+	}
+}
+
+func TestTailEvents_JSON(t *testing.T) {
+	out, err := runTailEvents(t, "json", "tm.event='Tx'", fakeEventStream(nil, testTxEvent, testTxEvent))
+	if err != nil {
+		t.Fatalf("tailEvents() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want one per event:\n%s", len(lines), out)
+	}
+	var got eventLine
+	if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+		t.Fatalf("line is not JSON: %v\n%s", err, lines[0])
+	}
+	if got.Event != "Tx" || got.Type != "tendermint/event/Tx" || got.Events["tx.height"][0] != "42" {
+		t.Errorf("event line = %+v", got)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, got.Time); err != nil {
+		t.Errorf("time = %q: %v", got.Time, err)
+	}
+	if string(got.Data) != `{"TxResult":{"height":"42"}}` {
+		t.Errorf("data = %s", got.Data)
+	}
+}
+
+func TestTailEvents_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		query    string
+		err      error
+		wantCode int
+	}{
+		{"invalid query", "text", "tm.event=NewBlock", nil, exitcodes.InvalidArgs},
+		{"yaml output", "yaml", "tm.event='NewBlock'", nil, exitcodes.InvalidArgs},
+		{"subscription error", "json", "tm.event='NewBlock'", errors.New("subscription error: Internal error"), exitcodes.NetworkError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runTailEvents(t, tt.output, tt.query, fakeEventStream(tt.err))
+			if code := exitcodes.CodeForError(err); code != tt.wantCode {
+				t.Errorf("exit code = %d (%v), want %d", code, err, tt.wantCode)
+			}
+		})
+	}
+}
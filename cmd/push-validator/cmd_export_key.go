@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/admin"
+)
+
+var flagExportKeyOutDir string
+
+func init() {
+	exportKeyCmd := &cobra.Command{
+		Use:   "export-key",
+		Short: "Export validator keys to an encrypted archive",
+		Long: "Write priv_validator_key.json and node_key.json to a password-protected archive for moving " +
+			"a validator to another host. The node must be stopped first.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleExportKey(newDeps(), flagExportKeyOutDir)
+		},
+	}
+	exportKeyCmd.Flags().StringVar(&flagExportKeyOutDir, "out-dir", "", "Directory for the archive (default: <home>/backups)")
+	rootCmd.AddCommand(exportKeyCmd)
+}
+
+// handleExportKey exports the consensus and node keys to an encrypted archive.
+func handleExportKey(d *Deps, outDir string) error {
+	return handleExportKeyWith(d, outDir, admin.ExportKeys)
+}
+
+// handleExportKeyWith is the testable core of handleExportKey with an injectable export function.
+// Key material is never printed; only the archive path is reported.
+func handleExportKeyWith(d *Deps, outDir string, exportFn func(admin.ExportKeysOptions) (string, error)) error {
+	fail := func(err error) error {
+		if flagOutput == "json" {
+			d.Printer.JSON(map[string]any{"ok": false, "error": err.Error()})
+		} else {
+			d.Printer.Error(fmt.Sprintf("export-key error: %v", err))
+		}
+		return silentErr{err}
+	}
+
+	// A running node is signing with these keys; exporting them now is how
+	// operators end up with two live copies.
+	if d.Sup.IsRunning() {
+		if flagOutput != "json" {
+			fmt.Println(d.Printer.Colors.Info("Stop the node first with: push-validator stop"))
+		}
+		return fail(fmt.Errorf("node is running; stop it before exporting keys"))
+	}
+
+	if !d.Prompter.IsInteractive() {
+		return fail(fmt.Errorf("export-key requires an interactive terminal to enter the archive password"))
+	}
+	password, err := readSecret(d.Prompter, "Archive password: ")
+	if err != nil {
+		return fail(fmt.Errorf("read password: %w", err))
+	}
+	if len(password) < admin.MinExportPasswordLen {
+		return fail(fmt.Errorf("password must be at least %d characters", admin.MinExportPasswordLen))
+	}
+	confirm, err := readSecret(d.Prompter, "Confirm password: ")
+	if err != nil {
+		return fail(fmt.Errorf("read password: %w", err))
+	}
+	if confirm != password {
+		return fail(fmt.Errorf("passwords do not match"))
+	}
+
+	path, err := exportFn(admin.ExportKeysOptions{
+		HomeDir:  d.Cfg.HomeDir,
+		OutDir:   outDir,
+		ChainID:  d.Cfg.ChainID,
+		Password: password,
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	if flagOutput == "json" {
+		d.Printer.JSON(map[string]any{"ok": true, "archive_path": path, "chain_id": d.Cfg.ChainID})
+		fmt.Fprintln(os.Stderr, "WARNING: never run this node and a node restored from this archive at the same time. Doing so will double-sign and get the validator slashed and tombstoned.")
+		return nil
+	}
+
+	c := d.Printer.Colors
+	d.Printer.Success(fmt.Sprintf("Validator keys exported: %s", path))
+	d.Printer.KeyValueLine("Chain ID", d.Cfg.ChainID, "")
+	fmt.Println()
+	fmt.Println(c.Error(c.Emoji("⚠️") + " DOUBLE-SIGNING RISK"))
+	fmt.Println(c.Warning("Never start this node again once the keys are restored on another host."))
+	fmt.Println(c.Warning("Two nodes signing with the same key will double-sign; the validator is then"))
+	fmt.Println(c.Warning("slashed and permanently tombstoned. Keep this node stopped, or remove its"))
+	fmt.Println(c.Warning("config/priv_validator_key.json, before starting the restored copy."))
+	fmt.Println()
+	fmt.Println(c.Info("Store the archive and its password separately; anyone with both controls the validator."))
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/admin"
+)
+
+func TestHandleExportKey_RefusesWhileRunning(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	for _, output := range []string{"text", "json"} {
+		flagOutput = output
+		called := false
+		d := &Deps{
+			Cfg:      testCfg(),
+			Printer:  getPrinter(),
+			Sup:      &mockSupervisor{running: true},
+			Prompter: &mockPrompter{interactive: true, responses: []string{"password1", "password1"}},
+		}
+		err := handleExportKeyWith(d, "", func(admin.ExportKeysOptions) (string, error) {
+			called = true
+			return "", nil
+		})
+		if err == nil || !strings.Contains(err.Error(), "running") {
+			t.Fatalf("%s: expected node running error, got %v", output, err)
+		}
+		if called {
+			t.Errorf("%s: export must not run while the node is running", output)
+		}
+	}
+}
+
+func TestHandleExportKey_PasswordChecks(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	noExport := func(admin.ExportKeysOptions) (string, error) {
+		t.Fatal("export should not be called")
+		return "", nil
+	}
+	tests := []struct {
+		name     string
+		prompter *mockPrompter
+		want     string
+	}{
+		{"non-interactive", &mockPrompter{interactive: false}, "interactive"},
+		{"too short", &mockPrompter{interactive: true, responses: []string{"short"}}, "at least"},
+		{"mismatch", &mockPrompter{interactive: true, responses: []string{"password1", "password2"}}, "do not match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Sup: &mockSupervisor{}, Prompter: tt.prompter}
+			err := handleExportKeyWith(d, "", noExport)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestHandleExportKey_Success(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	home := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	_ = os.WriteFile(filepath.Join(home, "config", "priv_validator_key.json"), []byte(`{"priv_key":"secret"}`), 0o600)
+	_ = os.WriteFile(filepath.Join(home, "config", "node_key.json"), []byte(`{"priv_key":"secret"}`), 0o600)
+
+	for _, output := range []string{"text", "json"} {
+		flagOutput = output
+		cfg := testCfg()
+		cfg.HomeDir = home
+		var got admin.ExportKeysOptions
+		d := &Deps{
+			Cfg:      cfg,
+			Printer:  getPrinter(),
+			Sup:      &mockSupervisor{},
+			Prompter: &mockPrompter{interactive: true, responses: []string{"password1", "password1"}},
+		}
+		err := handleExportKeyWith(d, t.TempDir(), func(opts admin.ExportKeysOptions) (string, error) {
+			got = opts
+			return admin.ExportKeys(opts)
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", output, err)
+		}
+		if got.Password != "password1" || got.ChainID != cfg.ChainID {
+			t.Errorf("%s: unexpected options: chain=%q", output, got.ChainID)
+		}
+	}
+}
@@ -124,6 +124,7 @@ func handleIncreaseStake(d *Deps) error {
 	p.KeyValueLine("Available Balance", fmt.Sprintf("%.6f", balPC)+" PC", "blue")
 	p.KeyValueLine("Available to Delegate", fmt.Sprintf("%.6f", maxDelegatePC)+" PC", "blue")
 	p.KeyValueLine("Reserved for Fees", "0.1 PC", "dim")
+	printTxFee(p, d.Cfg.Denom)
 	fmt.Println()
 
 	// Check if user has enough balance
@@ -269,13 +270,18 @@ func handleIncreaseStake(d *Deps) error {
 		return fmt.Errorf("delegation transaction failed: %w", delegErr)
 	}
 
+	txRes, err := confirmTx(d.Validator, txHash, flagTxWait, flagTxWaitTimeout)
+	if err != nil {
+		return err
+	}
+
 	// Success output
 	if flagOutput == "json" {
-		getPrinter().JSON(map[string]any{
+		getPrinter().JSON(withTxResult(map[string]any{
 			"ok":                true,
 			"txhash":            txHash,
 			"delegation_amount": delegationAmount,
-		})
+		}, txRes))
 	} else {
 		fmt.Println()
 		p.Success(p.Colors.Emoji("✅") + " Delegation successful!")
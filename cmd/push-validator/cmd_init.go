@@ -1,11 +1,23 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/pushchain/push-validator-cli/internal/admin"
 	"github.com/pushchain/push-validator-cli/internal/bootstrap"
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/diskspace"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/process"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 )
 
@@ -14,15 +26,28 @@ var (
 	initChainID      string
 	initSnapshotURL  string
 	initSkipSnapshot bool
+	initForce        bool
+	initStateSync    bool
+	initStateSyncRPC []string
+	initGenesisHash  string
+	initGenesisURL   string
 )
 
+// initNodeSettingFlags maps init flags to the config keys they set.
+var initNodeSettingFlags = []struct{ flag, key, usage string }{
+	{"pruning", "pruning", "app.toml pruning strategy (default|nothing|everything|custom)"},
+	{"min-gas-prices", "minimum-gas-prices", "app.toml minimum-gas-prices (e.g. 1000upc)"},
+	{"indexer", "tx_index.indexer", "config.toml tx indexer (kv|null|psql)"},
+	{"persistent-peers", "p2p.persistent_peers", "config.toml persistent peers (comma-separated id@host:port)"},
+	{"seeds", "p2p.seeds", "config.toml seed nodes (comma-separated id@host:port)"},
+}
+
 var initNodeCmd = &cobra.Command{
 	Use:    "init",
 	Short:  "Initialize local node home",
 	Hidden: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg := loadCfg()
-		p := getPrinter()
 		if initMoniker == "" {
 			initMoniker = getenvDefault("MONIKER", "push-validator")
 		}
@@ -32,6 +57,15 @@ var initNodeCmd = &cobra.Command{
 		if initSnapshotURL == "" {
 			initSnapshotURL = cfg.SnapshotURL
 		}
+		if initGenesisHash == "" {
+			initGenesisHash = cfg.GenesisHash
+		}
+		if _, err := bootstrap.ParseGenesisHash(initGenesisHash); err != nil {
+			return exitcodes.InvalidArgsError(err.Error())
+		}
+		if _, err := bootstrap.ParseGenesisURL(initGenesisURL); err != nil {
+			return exitcodes.InvalidArgsError(err.Error())
+		}
 
 		// Create progress callback that shows init steps
 		progressCallback := func(msg string) {
@@ -40,8 +74,19 @@ var initNodeCmd = &cobra.Command{
 			}
 		}
 
-		svc := bootstrap.New()
-		if err := svc.Init(cmd.Context(), bootstrap.Options{
+		settings := map[string]string{}
+		for _, f := range initNodeSettingFlags {
+			if cmd.Flags().Changed(f.flag) {
+				settings[f.key], _ = cmd.Flags().GetString(f.flag)
+			}
+		}
+		for key, value := range settings {
+			if err := files.NodeKeys[key].Validate(value); err != nil {
+				return exitcodes.InvalidArgsErrorf("invalid %s: %v", key, err)
+			}
+		}
+
+		return handleInitWith(cmd.Context(), cfg, bootstrap.New(), newSupervisor(cfg), &ttyPrompter{}, &prodFetcher{}, bootstrap.Options{
 			HomeDir:          cfg.HomeDir,
 			ChainID:          initChainID,
 			Moniker:          initMoniker,
@@ -51,30 +96,134 @@ var initNodeCmd = &cobra.Command{
 			Progress:         progressCallback,
 			SnapshotProgress: createSnapshotProgressCallback(flagOutput),
 			SkipSnapshot:     initSkipSnapshot,
-		}); err != nil {
-			ui.PrintError(ui.ErrorMessage{
-				Problem: "Initialization failed",
-				Causes: []string{
-					"Network issue fetching genesis or status",
-					"Incorrect --genesis-domain or RPC unreachable",
-					"pchaind binary missing or not executable",
-				},
-				Actions: []string{
-					"Verify connectivity: curl https://<genesis-domain>/status",
-					"Set --genesis-domain to a working RPC host",
-					"Ensure pchaind is installed and in PATH or pass --bin",
-				},
-				Hints: []string{"push-validator validators --output json"},
-			})
-			return err
+			SkipDiskCheck:    initForce,
+			StateSync:        initStateSync,
+			StateSyncRPCs:    initStateSyncRPC,
+			NodeSettings:     settings,
+			GenesisHash:      initGenesisHash,
+			GenesisURL:       initGenesisURL,
+		})
+	},
+}
+
+// homeInitialized reports whether home has the config, genesis and keys a
+// completed init leaves behind.
+func homeInitialized(home string) bool {
+	for _, name := range []string{"config.toml", "genesis.json", "priv_validator_key.json", "node_key.json"} {
+		if _, err := os.Stat(filepath.Join(home, "config", name)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// handleInitWith is the testable core of init. An initialized home is left
+// alone unless --force is set, in which case its keys are backed up and
+// config and data cleared before the full bootstrap flow runs again.
+func handleInitWith(ctx context.Context, cfg config.Config, svc bootstrap.Service, sup process.Supervisor, prompter Prompter, fetcher ValidatorFetcher, opts bootstrap.Options) error {
+	p := getPrinter()
+
+	if homeInitialized(cfg.HomeDir) && !initForce {
+		// Setting flags still apply, so they can be changed without a re-init
+		keys := make([]string, 0, len(opts.NodeSettings))
+		for k := range opts.NodeSettings {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := files.SetNodeConfig(cfg.HomeDir, k, opts.NodeSettings[k]); err != nil {
+				return fmt.Errorf("set %s: %w", k, err)
+			}
+			if flagOutput != "json" {
+				p.Success(fmt.Sprintf("Set %s = %s", k, opts.NodeSettings[k]))
+			}
 		}
-		// Only show success message when NOT in scripted mode (--skip-snapshot)
-		// install.sh calls with --skip-snapshot and handles its own "Node initialized" message
-		if flagOutput != "json" && !initSkipSnapshot {
-			p.Success("Initialization complete")
+		if flagOutput != "json" {
+			p.Info(fmt.Sprintf("Node home %s is already initialized, nothing to do (pass --force to re-initialize)", cfg.HomeDir))
 		}
 		return nil
-	},
+	}
+	if initForce && homeHasState(cfg.HomeDir) {
+		proceed, err := confirmReinit(cfg, sup, prompter, fetcher)
+		if err != nil || !proceed {
+			return err
+		}
+		backupDir, err := admin.ClearForReinit(admin.ReinitOptions{HomeDir: cfg.HomeDir})
+		if err != nil {
+			return fmt.Errorf("clear node home: %w", err)
+		}
+		if flagOutput != "json" {
+			if backupDir != "" {
+				p.Success(fmt.Sprintf("Previous keys and signing state backed up to %s", backupDir))
+			}
+			p.Info("Cleared config and data, re-initializing...")
+		}
+	}
+
+	if err := svc.Init(ctx, opts); err != nil {
+		if errors.Is(err, diskspace.ErrInsufficient) {
+			return exitcodes.PreconditionError(err.Error())
+		}
+		if errors.Is(err, bootstrap.ErrGenesisHashMismatch) || errors.Is(err, bootstrap.ErrGenesisChainIDMismatch) {
+			return exitcodes.ValidationErr(err.Error())
+		}
+		ui.PrintError(ui.ErrorMessage{
+			Problem: "Initialization failed",
+			Causes: []string{
+				"Network issue fetching genesis or status",
+				"Incorrect --genesis-domain or RPC unreachable",
+				"pchaind binary missing or not executable",
+			},
+			Actions: []string{
+				"Verify connectivity: curl https://<genesis-domain>/status",
+				"Set --genesis-domain to a working RPC host",
+				"Ensure pchaind is installed and in PATH or pass --bin",
+			},
+			Hints: []string{"push-validator validators --output json"},
+		})
+		return err
+	}
+	// Only show success message when NOT in scripted mode (--skip-snapshot)
+	// install.sh calls with --skip-snapshot and handles its own "Node initialized" message
+	if flagOutput != "json" && !opts.SkipSnapshot {
+		p.Success("Initialization complete")
+	}
+	return nil
+}
+
+// homeHasState reports whether home holds any config or chain data that
+// init --force would clear.
+func homeHasState(home string) bool {
+	for _, dir := range []string{"config", "data"} {
+		if _, err := os.Stat(filepath.Join(home, dir)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// confirmReinit checks that init --force may clear the home: the node must
+// be stopped, the operator must confirm (or pass --yes), and a registered
+// validator's key gets the same guard as full-reset.
+func confirmReinit(cfg config.Config, sup process.Supervisor, prompter Prompter, fetcher ValidatorFetcher) (bool, error) {
+	p := getPrinter()
+	if sup.IsRunning() {
+		return false, exitcodes.PreconditionErrorf("node is running: stop it with 'push-validator stop' before init --force")
+	}
+	if !flagYes {
+		if flagNonInteractive || flagOutput == "json" {
+			return false, exitcodes.PreconditionErrorf("init --force requires confirmation: use --yes to confirm in non-interactive mode")
+		}
+		fmt.Println(p.Colors.Warning(p.Colors.Emoji("⚠️") + "  This will clear the config and chain data in " + cfg.HomeDir))
+		fmt.Println("Validator and node keys are backed up first; keyrings are kept.")
+		fmt.Println()
+		response, err := prompter.ReadLine("Re-initialize node home? (y/N): ")
+		if err != nil || strings.ToLower(strings.TrimSpace(response)) != "y" {
+			fmt.Println(p.Colors.Info("Init cancelled"))
+			return false, nil
+		}
+	}
+	return confirmValidatorKeyLoss(cfg, prompter, fetcher, "init --force")
 }
 
 func init() {
@@ -82,5 +231,14 @@ func init() {
 	initNodeCmd.Flags().StringVar(&initChainID, "chain-id", "", "Chain ID")
 	initNodeCmd.Flags().StringVar(&initSnapshotURL, "snapshot-url", "", "Snapshot download base URL")
 	initNodeCmd.Flags().BoolVar(&initSkipSnapshot, "skip-snapshot", false, "Skip snapshot download (for separate step)")
+	initNodeCmd.Flags().BoolVar(&initForce, "force", false, "Re-initialize an existing home (keys are backed up first) and skip the free disk space check")
+	initNodeCmd.Flags().BoolVar(&flagKeyLossAck, "i-understand-key-loss", false, "With --force, allow re-initializing a registered validator's home without typing its moniker")
+	initNodeCmd.Flags().BoolVar(&initStateSync, "state-sync", false, "Sync via CometBFT state sync instead of downloading a snapshot (falls back to the snapshot if unavailable)")
+	initNodeCmd.Flags().StringSliceVar(&initStateSyncRPC, "state-sync-rpc", nil, "RPC servers for state sync light client verification (at least 2; default: genesis RPC and fullnode peers)")
+	initNodeCmd.Flags().StringVar(&initGenesisHash, "genesis-hash", "", "Expected SHA-256 of the downloaded genesis.json; init aborts on mismatch (env PUSH_GENESIS_HASH)")
+	initNodeCmd.Flags().StringVar(&initGenesisURL, "genesis-url", "", "Fetch genesis.json from this http(s):// or file:// URL instead of the genesis domain; its chain_id must match --chain-id")
+	for _, f := range initNodeSettingFlags {
+		initNodeCmd.Flags().String(f.flag, "", f.usage)
+	}
 	rootCmd.AddCommand(initNodeCmd)
 }
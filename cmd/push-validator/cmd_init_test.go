@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/bootstrap"
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// fakeBootstrap counts Init calls and writes the files a real init leaves.
+type fakeBootstrap struct{ calls int }
+
+func (f *fakeBootstrap) Init(ctx context.Context, opts bootstrap.Options) error {
+	f.calls++
+	return writeInitializedHome(opts.HomeDir, "new")
+}
+
+func writeInitializedHome(home, key string) error {
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0o755); err != nil {
+		return err
+	}
+	for _, name := range []string{"config.toml", "genesis.json", "node_key.json"} {
+		if err := os.WriteFile(filepath.Join(home, "config", name), []byte("{}"), 0o644); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(home, "config", "priv_validator_key.json"), []byte(key), 0o600)
+}
+
+func TestHandleInit(t *testing.T) {
+	origOutput, origYes, origNonInteractive, origForce := flagOutput, flagYes, flagNonInteractive, initForce
+	defer func() {
+		flagOutput, flagYes, flagNonInteractive, initForce = origOutput, origYes, origNonInteractive, origForce
+	}()
+	registered := &mockFetcher{myValidator: validator.MyValidatorInfo{IsValidator: true, Moniker: "my-node"}}
+
+	tests := []struct {
+		name       string
+		existing   bool
+		force      bool
+		yes        bool
+		running    bool
+		fetcher    *mockFetcher
+		responses  []string
+		wantInit   bool
+		wantKey    string
+		wantBackup bool
+		wantErr    bool
+	}{
+		{name: "fresh home", wantInit: true, wantKey: "new"},
+		{name: "already initialized", existing: true, wantKey: "old"},
+		{name: "forced with --yes", existing: true, force: true, yes: true, fetcher: &mockFetcher{}, wantInit: true, wantKey: "new", wantBackup: true},
+		{name: "forced, confirmed", existing: true, force: true, fetcher: &mockFetcher{}, responses: []string{"y"}, wantInit: true, wantKey: "new", wantBackup: true},
+		{name: "forced, declined", existing: true, force: true, fetcher: &mockFetcher{}, responses: []string{"n"}, wantKey: "old"},
+		{name: "forced, node running", existing: true, force: true, yes: true, running: true, wantKey: "old", wantErr: true},
+		{name: "forced, registered validator", existing: true, force: true, yes: true, fetcher: registered, responses: []string{"other"}, wantKey: "old"},
+		{name: "forced, registered validator confirmed", existing: true, force: true, yes: true, fetcher: registered, responses: []string{"my-node"}, wantInit: true, wantKey: "new", wantBackup: true},
+		{name: "forced on fresh home", force: true, wantInit: true, wantKey: "new"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagOutput, flagYes, flagNonInteractive, initForce = "text", tt.yes, false, tt.force
+			home := t.TempDir()
+			if tt.existing {
+				if err := writeInitializedHome(home, "old"); err != nil {
+					t.Fatal(err)
+				}
+			}
+			svc := &fakeBootstrap{}
+			err := handleInitWith(context.Background(), config.Config{HomeDir: home}, svc,
+				&mockSupervisor{running: tt.running}, &mockPrompter{responses: tt.responses}, tt.fetcher,
+				bootstrap.Options{HomeDir: home, SkipSnapshot: true})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (svc.calls > 0) != tt.wantInit {
+				t.Errorf("bootstrap Init calls = %d, want init %v", svc.calls, tt.wantInit)
+			}
+			key, _ := os.ReadFile(filepath.Join(home, "config", "priv_validator_key.json"))
+			if string(key) != tt.wantKey {
+				t.Errorf("priv_validator_key.json = %q, want %q", key, tt.wantKey)
+			}
+			backups, _ := filepath.Glob(filepath.Join(home, "backups", "keys-*", "priv_validator_key.json"))
+			if (len(backups) == 1) != tt.wantBackup {
+				t.Fatalf("key backups = %v, want backup %v", backups, tt.wantBackup)
+			}
+			if tt.wantBackup {
+				if old, _ := os.ReadFile(backups[0]); string(old) != "old" {
+					t.Errorf("backed up key = %q, want the previous key", old)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleInit_ForceNonInteractiveNeedsYes(t *testing.T) {
+	origOutput, origYes, origNonInteractive, origForce := flagOutput, flagYes, flagNonInteractive, initForce
+	defer func() {
+		flagOutput, flagYes, flagNonInteractive, initForce = origOutput, origYes, origNonInteractive, origForce
+	}()
+	flagOutput, flagYes, flagNonInteractive, initForce = "text", false, true, true
+
+	home := t.TempDir()
+	if err := writeInitializedHome(home, "old"); err != nil {
+		t.Fatal(err)
+	}
+	svc := &fakeBootstrap{}
+	err := handleInitWith(context.Background(), config.Config{HomeDir: home}, svc, &mockSupervisor{}, &mockPrompter{}, &mockFetcher{}, bootstrap.Options{HomeDir: home})
+	if err == nil || svc.calls != 0 {
+		t.Fatalf("err = %v, calls = %d; want refusal without init", err, svc.calls)
+	}
+	if !homeInitialized(home) {
+		t.Error("home was modified despite the refusal")
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	ui "github.com/pushchain/push-validator-cli/internal/ui"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+var flagKeysRecover bool
+
+func init() {
+	keysCmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage keys in the node keyring",
+		Long:  "List, show, and add keys using the configured keyring backend and home directory",
+	}
+
+	keysCmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List keys in the keyring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleKeysList(newDeps())
+		},
+	})
+
+	keysCmd.AddCommand(&cobra.Command{
+		Use:   "show <name>",
+		Short: "Show a key's address and public key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleKeysShow(newDeps(), args[0])
+		},
+	})
+
+	addCmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Create a new key (or import one with --recover)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleKeysAdd(newDeps(), args[0], flagKeysRecover)
+		},
+	}
+	addCmd.Flags().BoolVar(&flagKeysRecover, "recover", false, "Import the key from an existing mnemonic phrase")
+	keysCmd.AddCommand(addCmd)
+
+	rootCmd.AddCommand(keysCmd)
+}
+
+// keyJSON is the public view of a key. It deliberately has no mnemonic field.
+func keyJSON(k validator.KeyInfo) map[string]any {
+	return map[string]any{
+		"name":        k.Name,
+		"type":        k.Type,
+		"address":     k.Address,
+		"evm_address": validator.Bech32ToHex(k.Address),
+		"pubkey":      k.Pubkey,
+	}
+}
+
+// handleKeysList prints all keys in the configured keyring.
+func handleKeysList(d *Deps) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	keys, err := d.Validator.ListKeys(ctx)
+	if err != nil {
+		if flagOutput == "json" {
+			d.Printer.JSON(map[string]any{"ok": false, "error": err.Error()})
+		} else {
+			d.Printer.Error(fmt.Sprintf("failed to list keys: %v", err))
+		}
+		return silentErr{err}
+	}
+
+	if flagOutput == "json" {
+		out := make([]map[string]any, 0, len(keys))
+		for _, k := range keys {
+			out = append(out, keyJSON(k))
+		}
+		d.Printer.JSON(map[string]any{"ok": true, "keyring_backend": d.Cfg.KeyringBackend, "keys": out})
+		return nil
+	}
+
+	c := d.Printer.Colors
+	fmt.Println(c.Header(" Keys "))
+	if len(keys) == 0 {
+		fmt.Println(c.Info("No keys found in the " + d.Cfg.KeyringBackend + " keyring"))
+		fmt.Println(c.Apply(c.Theme.Command, "  push-validator keys add <name>"))
+		return nil
+	}
+	headers := []string{"NAME", "TYPE", "ADDRESS", "EVM ADDRESS"}
+	rows := make([][]string, 0, len(keys))
+	for _, k := range keys {
+		rows = append(rows, []string{k.Name, k.Type, k.Address, validator.Bech32ToHex(k.Address)})
+	}
+	fmt.Print(ui.Table(c, headers, rows, nil))
+	fmt.Printf("Total Keys: %d\n", len(keys))
+	return nil
+}
+
+// handleKeysShow prints the public details of a single key.
+func handleKeysShow(d *Deps, name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	k, err := d.Validator.ShowKey(ctx, name)
+	if err != nil {
+		if flagOutput == "json" {
+			d.Printer.JSON(map[string]any{"ok": false, "error": err.Error(), "name": name})
+		} else {
+			d.Printer.Error(err.Error())
+			fmt.Println(d.Printer.Colors.Info("List available keys with: push-validator keys list"))
+		}
+		return silentErr{err}
+	}
+
+	if flagOutput == "json" {
+		out := keyJSON(k)
+		out["ok"] = true
+		d.Printer.JSON(out)
+		return nil
+	}
+
+	d.Printer.KeyValueLine("Name", k.Name, "")
+	d.Printer.KeyValueLine("Type", k.Type, "dim")
+	d.Printer.KeyValueLine("Address", k.Address, "blue")
+	d.Printer.KeyValueLine("EVM Address", validator.Bech32ToHex(k.Address), "blue")
+	if k.Pubkey != "" {
+		d.Printer.KeyValueLine("Public Key", k.Pubkey, "dim")
+	}
+	return nil
+}
+
+// handleKeysAdd creates a new key, or imports one from a mnemonic when
+// recoverKey is set. A newly generated mnemonic is printed exactly once and
+// never included in JSON output (it goes to stderr instead).
+func handleKeysAdd(d *Deps, name string, recoverKey bool) error {
+	fail := func(err error) error {
+		if flagOutput == "json" {
+			d.Printer.JSON(map[string]any{"ok": false, "error": err.Error(), "name": name})
+		} else {
+			d.Printer.Error(err.Error())
+		}
+		return silentErr{err}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if existing, err := d.Validator.ShowKey(ctx, name); err == nil {
+		return fail(fmt.Errorf("key '%s' already exists with address %s", name, existing.Address))
+	}
+
+	var (
+		k   validator.KeyInfo
+		err error
+	)
+	if recoverKey {
+		if !d.Prompter.IsInteractive() {
+			return fail(fmt.Errorf("--recover requires an interactive terminal to enter the mnemonic"))
+		}
+		line, rerr := d.Prompter.ReadLine("Enter your recovery mnemonic phrase (12 or 24 words): ")
+		if rerr != nil {
+			return fail(fmt.Errorf("read mnemonic: %w", rerr))
+		}
+		mnemonic := strings.ToLower(strings.Join(strings.Fields(line), " "))
+		if verr := validator.ValidateMnemonic(mnemonic); verr != nil {
+			return fail(verr)
+		}
+		k, err = d.Validator.ImportKey(ctx, name, mnemonic)
+	} else {
+		k, err = d.Validator.EnsureKey(ctx, name)
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	if flagOutput == "json" {
+		out := keyJSON(k)
+		out["ok"] = true
+		out["recovered"] = recoverKey
+		d.Printer.JSON(out)
+		if k.Mnemonic != "" {
+			// Keep the secret out of machine-readable stdout
+			fmt.Fprintln(os.Stderr, "WARNING: write this mnemonic down and store it offline. It will not be shown again:")
+			fmt.Fprintln(os.Stderr, k.Mnemonic)
+		}
+		return nil
+	}
+
+	if recoverKey {
+		d.Printer.Success(fmt.Sprintf("Key '%s' imported", k.Name))
+	} else {
+		d.Printer.Success(fmt.Sprintf("Key '%s' created", k.Name))
+	}
+	d.Printer.KeyValueLine("Address", k.Address, "blue")
+	d.Printer.KeyValueLine("EVM Address", validator.Bech32ToHex(k.Address), "blue")
+	if k.Mnemonic != "" {
+		d.Printer.MnemonicBox(k.Mnemonic)
+		fmt.Println(d.Printer.Colors.Warning("**Important** Write this mnemonic phrase in a safe place."))
+		fmt.Println(d.Printer.Colors.Warning("It is the only way to recover this key and it will NOT be shown again."))
+		fmt.Println()
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+func TestHandleKeysList_Success(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	keys := []validator.KeyInfo{{Name: "validator-key", Type: "local", Address: "push1abc"}}
+	for _, output := range []string{"text", "json"} {
+		flagOutput = output
+		d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Validator: &mockValidator{listKeysResult: keys}}
+		if err := handleKeysList(d); err != nil {
+			t.Fatalf("%s: unexpected error: %v", output, err)
+		}
+	}
+}
+
+func TestHandleKeysList_Empty(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Validator: &mockValidator{}}
+	if err := handleKeysList(d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestHandleKeysList_Error(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "json"
+
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Validator: &mockValidator{listKeysErr: errMock}}
+	err := handleKeysList(d)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if _, ok := err.(silentErr); !ok {
+		t.Errorf("expected silentErr, got %T", err)
+	}
+}
+
+func TestHandleKeysShow(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Validator: &mockValidator{
+		showKeyResult: validator.KeyInfo{Name: "k", Address: "push1abc", Pubkey: `{"key":"x"}`},
+	}}
+	if err := handleKeysShow(d, "k"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d.Validator = &mockValidator{showKeyErr: errMock}
+	if err := handleKeysShow(d, "missing"); err == nil {
+		t.Fatal("expected error for missing key")
+	}
+}
+
+func TestHandleKeysAdd_AlreadyExists(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Validator: &mockValidator{
+		showKeyResult: validator.KeyInfo{Name: "k", Address: "push1abc"},
+	}}
+	err := handleKeysAdd(d, "k", false)
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected already exists error, got %v", err)
+	}
+}
+
+func TestHandleKeysAdd_New(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	for _, output := range []string{"text", "json"} {
+		flagOutput = output
+		d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Validator: &mockValidator{
+			showKeyErr:      errMock,
+			ensureKeyResult: validator.KeyInfo{Name: "k", Address: "push1abc", Mnemonic: "word word word"},
+		}}
+		if err := handleKeysAdd(d, "k", false); err != nil {
+			t.Fatalf("%s: unexpected error: %v", output, err)
+		}
+	}
+}
+
+func TestHandleKeysAdd_Recover(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	mnemonic := strings.TrimSpace(strings.Repeat("abandon ", 11) + "about")
+	d := &Deps{
+		Cfg:     testCfg(),
+		Printer: getPrinter(),
+		Validator: &mockValidator{
+			showKeyErr:      errMock,
+			importKeyResult: validator.KeyInfo{Name: "k", Address: "push1abc"},
+		},
+		Prompter: &mockPrompter{interactive: true, responses: []string{"  " + strings.ToUpper(mnemonic) + "  "}},
+	}
+	if err := handleKeysAdd(d, "k", true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Invalid mnemonic is rejected before touching the keyring
+	d.Prompter = &mockPrompter{interactive: true, responses: []string{"too short"}}
+	if err := handleKeysAdd(d, "k", true); err == nil {
+		t.Fatal("expected invalid mnemonic error")
+	}
+
+	// Non-interactive sessions cannot enter a mnemonic
+	d.Prompter = &mockPrompter{interactive: false}
+	if err := handleKeysAdd(d, "k", true); err == nil || !strings.Contains(err.Error(), "interactive") {
+		t.Fatalf("expected interactive terminal error, got %v", err)
+	}
+}
+
+func TestKeyJSON_NoMnemonic(t *testing.T) {
+	out := keyJSON(validator.KeyInfo{Name: "k", Address: "push1abc", Mnemonic: "secret words"})
+	for k, v := range out {
+		if s, ok := v.(string); ok && strings.Contains(s, "secret") {
+			t.Errorf("mnemonic leaked via field %q", k)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/pushchain/push-validator-cli/internal/admin"
+	ui "github.com/pushchain/push-validator-cli/internal/ui"
+)
+
+// legacyBinDirs overrides where migrateLegacyLayout looks for a
+// pre-Cosmovisor pchaind; tests set it.
+var legacyBinDirs []string
+
+// migrateLegacyLayout brings a home directory left by releases that ran
+// pchaind directly in line with the Cosmovisor layout before start and
+// status look at it. Each action is logged to stderr. Destructive actions
+// need --yes or a confirmation and are skipped with a warning when neither
+// is possible; they are offered again next time.
+func migrateLegacyLayout(home string, prompter Prompter) {
+	for _, a := range admin.DetectLegacyLayout(admin.LegacyLayoutOptions{HomeDir: home, BinDirs: legacyBinDirs}) {
+		if a.Destructive && !flagYes {
+			if flagNonInteractive || flagOutput == "json" || !prompter.IsInteractive() {
+				ui.Log().Warnf("legacy home layout: not applied without --yes: %s", a.Description)
+				continue
+			}
+			response, err := prompter.ReadLine("Legacy home layout: " + a.Description + "? (y/N): ")
+			if err != nil || strings.ToLower(strings.TrimSpace(response)) != "y" {
+				ui.Log().Infof("legacy home layout: skipped: %s", a.Description)
+				continue
+			}
+		}
+		if err := a.Apply(); err != nil {
+			ui.Log().Warnf("legacy home layout: %s: %v", a.Description, err)
+			continue
+		}
+		ui.Log().Infof("legacy home layout: %s", a.Description)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ui "github.com/pushchain/push-validator-cli/internal/ui"
+)
+
+// legacyHome writes a legacy log and a pchaind in a legacy bin directory.
+func legacyHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	binDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, "logs"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, "logs", "pchaind.log"), []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(binDir, "pchaind"), []byte("bin"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	origBinDirs := legacyBinDirs
+	legacyBinDirs = []string{binDir}
+	t.Cleanup(func() { legacyBinDirs = origBinDirs })
+	return home
+}
+
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	orig := ui.Log()
+	ui.SetLogger(ui.NewLogger(&buf, ui.LevelInfo))
+	t.Cleanup(func() { ui.SetLogger(orig) })
+	return &buf
+}
+
+func TestMigrateLegacyLayout_NonInteractiveSkipsDestructive(t *testing.T) {
+	origYes, origOutput := flagYes, flagOutput
+	defer func() { flagYes, flagOutput = origYes, origOutput }()
+	flagYes, flagOutput = false, "text"
+	home := legacyHome(t)
+	logs := captureLog(t)
+
+	migrateLegacyLayout(home, &mockPrompter{interactive: false})
+
+	if _, err := os.Stat(filepath.Join(home, "cosmovisor", "genesis", "bin", "pchaind")); err != nil {
+		t.Errorf("binary should be copied without confirmation: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(home, "logs", "cosmovisor.log")); !os.IsNotExist(err) {
+		t.Errorf("log must not move without confirmation, stat err = %v", err)
+	}
+	out := logs.String()
+	if !strings.Contains(out, "[INFO] legacy home layout: copy") || !strings.Contains(out, "[WARN] legacy home layout: not applied without --yes") {
+		t.Errorf("unexpected log output:\n%s", out)
+	}
+
+	// A second run only repeats the pending destructive action
+	logs.Reset()
+	migrateLegacyLayout(home, &mockPrompter{interactive: false})
+	if out := logs.String(); strings.Contains(out, "copy") || !strings.Contains(out, "[WARN]") {
+		t.Errorf("second run log output:\n%s", out)
+	}
+}
+
+func TestMigrateLegacyLayout_ConfirmedMove(t *testing.T) {
+	origYes, origOutput := flagYes, flagOutput
+	defer func() { flagYes, flagOutput = origYes, origOutput }()
+	flagYes, flagOutput = false, "text"
+	home := legacyHome(t)
+	captureLog(t)
+
+	migrateLegacyLayout(home, &mockPrompter{interactive: true, responses: []string{"y"}})
+
+	data, err := os.ReadFile(filepath.Join(home, "logs", "pchaind.log"))
+	if err != nil || string(data) != "old" {
+		t.Errorf("old log path should resolve to the moved log: %q, %v", data, err)
+	}
+	if target, err := os.Readlink(filepath.Join(home, "logs", "pchaind.log")); err != nil || target != "cosmovisor.log" {
+		t.Errorf("logs/pchaind.log link = %q, %v", target, err)
+	}
+}
+
+func TestMigrateLegacyLayout_DeclinedMove(t *testing.T) {
+	origYes, origOutput := flagYes, flagOutput
+	defer func() { flagYes, flagOutput = origYes, origOutput }()
+	flagYes, flagOutput = false, "text"
+	home := legacyHome(t)
+	logs := captureLog(t)
+
+	migrateLegacyLayout(home, &mockPrompter{interactive: true, responses: []string{"n"}})
+
+	if _, err := os.Stat(filepath.Join(home, "logs", "cosmovisor.log")); !os.IsNotExist(err) {
+		t.Errorf("declined move was applied, stat err = %v", err)
+	}
+	if !strings.Contains(logs.String(), "skipped") {
+		t.Errorf("declined move not logged:\n%s", logs.String())
+	}
+}
+
+func TestMigrateLegacyLayout_YesFlag(t *testing.T) {
+	origYes, origOutput := flagYes, flagOutput
+	defer func() { flagYes, flagOutput = origYes, origOutput }()
+	flagYes, flagOutput = true, "json"
+	home := legacyHome(t)
+	captureLog(t)
+
+	migrateLegacyLayout(home, &mockPrompter{interactive: false})
+
+	if _, err := os.Stat(filepath.Join(home, "logs", "cosmovisor.log")); err != nil {
+		t.Errorf("--yes should move the log: %v", err)
+	}
+}
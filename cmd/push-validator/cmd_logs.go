@@ -13,6 +13,9 @@ import (
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 )
 
+// logsRaw shows JSON log lines as logged instead of in compact form.
+var logsRaw bool
+
 // logDeps holds injectable dependencies for handleLogsCore.
 type logDeps struct {
 	isTerminal func(fd int) bool
@@ -93,5 +96,6 @@ func handleLogsCore(sup process.Supervisor, deps logDeps) error {
 		LogPath:    lp,
 		ShowFooter: interactive,
 		NoColor:    flagNoColor,
+		Raw:        logsRaw,
 	})
 }
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/logline"
+)
+
+// logExportOptions configures logs --export.
+type logExportOptions struct {
+	Path  string
+	Since time.Time // drop lines logged before this; zero keeps everything
+	Lines int       // keep only the last Lines lines; 0 keeps everything
+}
+
+// logExportResult describes a finished export.
+type logExportResult struct {
+	Path  string
+	Files []string // source files, oldest first
+	Lines int
+	Bytes int64
+}
+
+// snapshotLogFiles opens logPath and its rotations, oldest first, and
+// records each file's size. Reading only that much of each open file gives
+// a consistent snapshot even if the node appends or rotates meanwhile.
+func snapshotLogFiles(logPath string) ([]*os.File, []int64, error) {
+	paths := rotatedLogFiles(logPath)
+	if len(paths) == 0 {
+		return nil, nil, fmt.Errorf("log file not found: %s", logPath)
+	}
+	var (
+		files []*os.File
+		sizes []int64
+	)
+	for i := len(paths) - 1; i >= 0; i-- {
+		f, err := os.Open(paths[i])
+		if err != nil {
+			closeLogFiles(files)
+			return nil, nil, err
+		}
+		st, err := f.Stat()
+		if err != nil {
+			_ = f.Close()
+			closeLogFiles(files)
+			return nil, nil, err
+		}
+		files = append(files, f)
+		sizes = append(sizes, st.Size())
+	}
+	return files, sizes, nil
+}
+
+func closeLogFiles(files []*os.File) {
+	for _, f := range files {
+		_ = f.Close()
+	}
+}
+
+// exportLogLines calls emit with every line of the snapshot, ANSI escapes
+// removed, from the first line logged at or after since onwards. Lines
+// without a timestamp go with the line before them.
+func exportLogLines(files []*os.File, sizes []int64, since time.Time, emit func(string)) error {
+	started := since.IsZero()
+	for i, f := range files {
+		var r io.Reader = io.LimitReader(f, sizes[i])
+		if filepath.Ext(f.Name()) == ".gz" {
+			gz, err := gzip.NewReader(r)
+			if err != nil {
+				return fmt.Errorf("%s: %w", f.Name(), err)
+			}
+			r = gz
+		}
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 64*1024), 1024*1024)
+		for sc.Scan() {
+			line := ansi.Strip(sc.Text())
+			if !started {
+				t, ok := logline.Parse(line).Timestamp(since)
+				if !ok || t.Before(since) {
+					continue
+				}
+				started = true
+			}
+			emit(line)
+		}
+		if err := sc.Err(); err != nil {
+			return fmt.Errorf("%s: %w", f.Name(), err)
+		}
+	}
+	return nil
+}
+
+// exportLogs writes a snapshot of logPath and its rotations, oldest first,
+// to opts.Path. It works whether or not the node is running.
+func exportLogs(logPath string, opts logExportOptions) (logExportResult, error) {
+	res := logExportResult{Path: opts.Path}
+	if opts.Lines < 0 {
+		return res, exitcodes.InvalidArgsError("--lines must not be negative")
+	}
+	files, sizes, err := snapshotLogFiles(logPath)
+	if err != nil {
+		return res, err
+	}
+	defer closeLogFiles(files)
+	for _, f := range files {
+		res.Files = append(res.Files, f.Name())
+		if sameFile(f.Name(), opts.Path) {
+			return res, exitcodes.InvalidArgsErrorf("--export must not overwrite the log file %s", f.Name())
+		}
+	}
+
+	out, err := os.OpenFile(opts.Path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return res, err
+	}
+	w := bufio.NewWriter(out)
+	write := func(line string) {
+		n, _ := w.WriteString(line + "\n")
+		res.Bytes += int64(n)
+		res.Lines++
+	}
+
+	var tail []string
+	emit := write
+	if opts.Lines > 0 {
+		emit = func(line string) {
+			tail = append(tail, line)
+			if len(tail) > opts.Lines {
+				tail = tail[1:]
+			}
+		}
+	}
+	err = exportLogLines(files, sizes, opts.Since, emit)
+	for _, line := range tail {
+		write(line)
+	}
+	if ferr := w.Flush(); err == nil {
+		err = ferr
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	return res, err
+}
+
+// sameFile reports whether a and b name the same existing file.
+func sameFile(a, b string) bool {
+	sa, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	sb, err := os.Stat(b)
+	return err == nil && os.SameFile(sa, sb)
+}
+
+// handleLogsExport runs logs --export and reports where the logs went.
+func handleLogsExport(logPath string, opts logExportOptions) error {
+	if logPath == "" {
+		return fmt.Errorf("no log path configured")
+	}
+	res, err := exportLogs(logPath, opts)
+	p := getPrinter()
+	if err != nil {
+		if flagOutput == "json" {
+			p.JSON(map[string]any{"ok": false, "error": err.Error()})
+			return silentErr{err}
+		}
+		return err
+	}
+	if flagOutput == "json" {
+		p.JSON(map[string]any{"ok": true, "path": res.Path, "files": res.Files, "lines": res.Lines, "bytes": res.Bytes})
+		return nil
+	}
+	p.Success(fmt.Sprintf("Exported %d lines (%d bytes) from %d log file(s) to %s", res.Lines, res.Bytes, len(res.Files), res.Path))
+	return nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+)
+
+// writeRotatedLogs writes pchaind.log with a plain .1 and a gzipped .2
+// rotation and returns the active log's path.
+func writeRotatedLogs(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "pchaind.log")
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write([]byte("10:00:00 INF oldest\n"))
+	_ = zw.Close()
+	for path, data := range map[string][]byte{
+		logPath + ".2.gz": gz.Bytes(),
+		logPath + ".1":    []byte("10:05:00 INF \x1b[32mmiddle\x1b[0m\n  trace line\n"),
+		logPath:           []byte("10:10:00 INF newest\n"),
+	} {
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return logPath
+}
+
+func TestExportLogs_AllFilesOldestFirst(t *testing.T) {
+	logPath := writeRotatedLogs(t)
+	out := filepath.Join(t.TempDir(), "export.log")
+
+	res, err := exportLogs(logPath, logExportOptions{Path: out})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "10:00:00 INF oldest\n10:05:00 INF middle\n  trace line\n10:10:00 INF newest\n"
+	data, _ := os.ReadFile(out)
+	if string(data) != want {
+		t.Errorf("export:\n%q\nwant:\n%q", data, want)
+	}
+	if res.Bytes != int64(len(want)) || res.Lines != 4 || len(res.Files) != 3 || res.Files[2] != logPath {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestExportLogs_SinceAndLines(t *testing.T) {
+	logPath := writeRotatedLogs(t)
+	out := filepath.Join(t.TempDir(), "export.log")
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 10, 5, 0, 0, now.Location())
+
+	if _, err := exportLogs(logPath, logExportOptions{Path: out, Since: since}); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(out)
+	if want := "10:05:00 INF middle\n  trace line\n10:10:00 INF newest\n"; string(data) != want {
+		t.Errorf("--since export = %q, want %q", data, want)
+	}
+
+	if _, err := exportLogs(logPath, logExportOptions{Path: out, Lines: 2}); err != nil {
+		t.Fatal(err)
+	}
+	data, _ = os.ReadFile(out)
+	if want := "  trace line\n10:10:00 INF newest\n"; string(data) != want {
+		t.Errorf("--lines export = %q, want %q", data, want)
+	}
+}
+
+func TestExportLogs_Snapshot(t *testing.T) {
+	logPath := writeRotatedLogs(t)
+	files, sizes, err := snapshotLogFiles(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeLogFiles(files)
+
+	// Lines the node writes after the snapshot are not exported
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString("10:11:00 INF later\n")
+	_ = f.Close()
+
+	var lines []string
+	if err := exportLogLines(files, sizes, time.Time{}, func(l string) { lines = append(lines, l) }); err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 4 || lines[3] != "10:10:00 INF newest" {
+		t.Errorf("lines = %q", lines)
+	}
+}
+
+func TestExportLogs_Errors(t *testing.T) {
+	logPath := writeRotatedLogs(t)
+
+	_, err := exportLogs(logPath, logExportOptions{Path: logPath + ".1"})
+	if exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("exporting onto a log file: error = %v, want invalid args", err)
+	}
+	if data, _ := os.ReadFile(logPath + ".1"); len(data) == 0 {
+		t.Error("rotated log was truncated")
+	}
+
+	if _, err := exportLogs(filepath.Join(t.TempDir(), "missing.log"), logExportOptions{Path: filepath.Join(t.TempDir(), "out")}); err == nil {
+		t.Error("missing log should fail")
+	}
+}
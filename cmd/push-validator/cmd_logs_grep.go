@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+)
+
+// logGrepOptions configures a one-shot search of the node log.
+type logGrepOptions struct {
+	Pattern    string
+	IgnoreCase bool
+	Regex      bool
+	Before     int // context lines before each match (-B)
+	After      int // context lines after each match (-A)
+}
+
+// logMatch is one matching line and its context.
+type logMatch struct {
+	File   string   `json:"file"`
+	Line   int      `json:"line"`
+	Text   string   `json:"text"`
+	Before []string `json:"before,omitempty"`
+	After  []string `json:"after,omitempty"`
+}
+
+// newLogMatcher builds the line predicate for opts.
+func newLogMatcher(opts logGrepOptions) (func(string) bool, error) {
+	if opts.Pattern == "" {
+		return nil, exitcodes.InvalidArgsError("--grep pattern must not be empty")
+	}
+	if opts.Regex {
+		expr := opts.Pattern
+		if opts.IgnoreCase {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, exitcodes.InvalidArgsErrorf("invalid --grep regex: %v", err)
+		}
+		return re.MatchString, nil
+	}
+	if opts.IgnoreCase {
+		needle := strings.ToLower(opts.Pattern)
+		return func(line string) bool { return strings.Contains(strings.ToLower(line), needle) }, nil
+	}
+	return func(line string) bool { return strings.Contains(line, opts.Pattern) }, nil
+}
+
+// rotatedLogFiles returns logPath followed by its rotations (.1, .2, ...,
+// optionally gzipped), stopping at the first missing one.
+func rotatedLogFiles(logPath string) []string {
+	var files []string
+	if _, err := os.Stat(logPath); err == nil {
+		files = append(files, logPath)
+	}
+	for i := 1; ; i++ {
+		base := logPath + "." + strconv.Itoa(i)
+		if _, err := os.Stat(base); err == nil {
+			files = append(files, base)
+		} else if _, err := os.Stat(base + ".gz"); err == nil {
+			files = append(files, base+".gz")
+		} else {
+			return files
+		}
+	}
+}
+
+// grepLogFile scans one log file, transparently decompressing .gz rotations.
+func grepLogFile(path string, match func(string) bool, before, after int) ([]logMatch, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+	matches, err := grepLogReader(r, filepath.Base(path), match, before, after)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return matches, nil
+}
+
+// grepLogReader returns every line of r that satisfies match, with up to
+// before/after lines of context.
+func grepLogReader(r io.Reader, file string, match func(string) bool, before, after int) ([]logMatch, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var (
+		matches []logMatch
+		prev    []string // last `before` lines
+		open    []int    // indexes of matches still collecting after-context
+		lineNo  int
+	)
+	for sc.Scan() {
+		lineNo++
+		text := sc.Text()
+
+		still := open[:0]
+		for _, i := range open {
+			matches[i].After = append(matches[i].After, text)
+			if len(matches[i].After) < after {
+				still = append(still, i)
+			}
+		}
+		open = still
+
+		if match(text) {
+			m := logMatch{File: file, Line: lineNo, Text: text}
+			if len(prev) > 0 {
+				m.Before = append([]string(nil), prev...)
+			}
+			matches = append(matches, m)
+			if after > 0 {
+				open = append(open, len(matches)-1)
+			}
+		}
+
+		if before > 0 {
+			prev = append(prev, text)
+			if len(prev) > before {
+				prev = prev[1:]
+			}
+		}
+	}
+	return matches, sc.Err()
+}
+
+// searchLogs greps logPath and its rotations in order.
+func searchLogs(logPath string, opts logGrepOptions) ([]string, []logMatch, error) {
+	if opts.Before < 0 || opts.After < 0 {
+		return nil, nil, exitcodes.InvalidArgsError("-A and -B must not be negative")
+	}
+	match, err := newLogMatcher(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	files := rotatedLogFiles(logPath)
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("log file not found: %s", logPath)
+	}
+	var all []logMatch
+	for _, f := range files {
+		matches, err := grepLogFile(f, match, opts.Before, opts.After)
+		if err != nil {
+			return files, all, err
+		}
+		all = append(all, matches...)
+	}
+	return files, all, nil
+}
+
+// writeLogMatches prints matches grep-style: "file:line:text" for matches,
+// "file-line-text" for context, and "--" between separate groups.
+func writeLogMatches(w io.Writer, matches []logMatch) {
+	type entry struct {
+		text    string
+		isMatch bool
+	}
+	var order []string
+	byFile := map[string]map[int]entry{}
+	for _, m := range matches {
+		lines, ok := byFile[m.File]
+		if !ok {
+			lines = map[int]entry{}
+			byFile[m.File] = lines
+			order = append(order, m.File)
+		}
+		for i, text := range m.Before {
+			n := m.Line - len(m.Before) + i
+			if _, seen := lines[n]; !seen {
+				lines[n] = entry{text: text}
+			}
+		}
+		lines[m.Line] = entry{text: m.Text, isMatch: true}
+		for i, text := range m.After {
+			n := m.Line + 1 + i
+			if _, seen := lines[n]; !seen {
+				lines[n] = entry{text: text}
+			}
+		}
+	}
+
+	first := true
+	for _, file := range order {
+		lines := byFile[file]
+		nums := make([]int, 0, len(lines))
+		for n := range lines {
+			nums = append(nums, n)
+		}
+		sort.Ints(nums)
+		for i, n := range nums {
+			if !first && (i == 0 || n != nums[i-1]+1) {
+				fmt.Fprintln(w, "--")
+			}
+			first = false
+			sep := "-"
+			if lines[n].isMatch {
+				sep = ":"
+			}
+			fmt.Fprintf(w, "%s%s%d%s%s\n", file, sep, n, sep, lines[n].text)
+		}
+	}
+}
+
+// handleLogsGrep searches the node log and its rotations once and exits.
+// It works whether or not the node is running.
+func handleLogsGrep(w io.Writer, logPath string, opts logGrepOptions) error {
+	if logPath == "" {
+		return fmt.Errorf("no log path configured")
+	}
+	files, matches, err := searchLogs(logPath, opts)
+	if err != nil {
+		if flagOutput == "json" {
+			getPrinter().JSON(map[string]any{"ok": false, "error": err.Error()})
+			return silentErr{err}
+		}
+		return err
+	}
+	if flagOutput == "json" {
+		if matches == nil {
+			matches = []logMatch{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(map[string]any{"ok": true, "files": files, "total": len(matches), "matches": matches})
+	}
+	if len(matches) == 0 {
+		getPrinter().Info(fmt.Sprintf("No lines matching %q in %d log file(s)", opts.Pattern, len(files)))
+		return nil
+	}
+	writeLogMatches(w, matches)
+	return nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGrepLogReader_Context(t *testing.T) {
+	input := "a\nb\nERR one\nc\nd\ne\nf\nERR two\ng\n"
+	match := func(s string) bool { return strings.HasPrefix(s, "ERR") }
+
+	got, err := grepLogReader(strings.NewReader(input), "pchaind.log", match, 2, 1)
+	if err != nil {
+		t.Fatalf("grepLogReader() error = %v", err)
+	}
+	want := []logMatch{
+		{File: "pchaind.log", Line: 3, Text: "ERR one", Before: []string{"a", "b"}, After: []string{"c"}},
+		{File: "pchaind.log", Line: 8, Text: "ERR two", Before: []string{"e", "f"}, After: []string{"g"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v\nwant %+v", got, want)
+	}
+
+	var buf bytes.Buffer
+	writeLogMatches(&buf, got)
+	wantText := "pchaind.log-1-a\npchaind.log-2-b\npchaind.log:3:ERR one\npchaind.log-4-c\n--\npchaind.log-6-e\npchaind.log-7-f\npchaind.log:8:ERR two\npchaind.log-9-g\n"
+	if buf.String() != wantText {
+		t.Errorf("text output:\n%s\nwant:\n%s", buf.String(), wantText)
+	}
+}
+
+func TestGrepLogReader_OverlappingContext(t *testing.T) {
+	input := "x\nERR 1\nERR 2\ny\n"
+	match := func(s string) bool { return strings.HasPrefix(s, "ERR") }
+	got, err := grepLogReader(strings.NewReader(input), "f", match, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	writeLogMatches(&buf, got)
+	// Adjacent groups merge and a match is never printed as context
+	want := "f-1-x\nf:2:ERR 1\nf:3:ERR 2\nf-4-y\n"
+	if buf.String() != want {
+		t.Errorf("text output:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestNewLogMatcher(t *testing.T) {
+	tests := []struct {
+		opts    logGrepOptions
+		line    string
+		want    bool
+		wantErr bool
+	}{
+		{opts: logGrepOptions{Pattern: "err"}, line: "some err here", want: true},
+		{opts: logGrepOptions{Pattern: "err"}, line: "some ERR here", want: false},
+		{opts: logGrepOptions{Pattern: "err", IgnoreCase: true}, line: "some ERR here", want: true},
+		{opts: logGrepOptions{Pattern: "height=\\d+", Regex: true}, line: "height=42", want: true},
+		{opts: logGrepOptions{Pattern: "HEIGHT=\\d+", Regex: true, IgnoreCase: true}, line: "height=42", want: true},
+		{opts: logGrepOptions{Pattern: "a.c"}, line: "abc", want: false},
+		{opts: logGrepOptions{Pattern: "(", Regex: true}, wantErr: true},
+		{opts: logGrepOptions{Pattern: ""}, wantErr: true},
+	}
+	for _, tt := range tests {
+		m, err := newLogMatcher(tt.opts)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("newLogMatcher(%+v) expected error", tt.opts)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("newLogMatcher(%+v) error = %v", tt.opts, err)
+		}
+		if got := m(tt.line); got != tt.want {
+			t.Errorf("match(%+v, %q) = %v, want %v", tt.opts, tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestSearchLogs_Rotated(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "pchaind.log")
+	_ = os.WriteFile(logPath, []byte("new ERR\nok\n"), 0o644)
+	_ = os.WriteFile(logPath+".1", []byte("older ERR\n"), 0o644)
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write([]byte("ok\noldest ERR\n"))
+	_ = zw.Close()
+	_ = os.WriteFile(logPath+".2.gz", gz.Bytes(), 0o644)
+	// Not reached: .3 is missing
+	_ = os.WriteFile(logPath+".4", []byte("stray ERR\n"), 0o644)
+
+	files, matches, err := searchLogs(logPath, logGrepOptions{Pattern: "ERR"})
+	if err != nil {
+		t.Fatalf("searchLogs() error = %v", err)
+	}
+	if want := []string{logPath, logPath + ".1", logPath + ".2.gz"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("files = %v, want %v", files, want)
+	}
+	var texts []string
+	for _, m := range matches {
+		texts = append(texts, m.File+":"+m.Text)
+	}
+	want := []string{"pchaind.log:new ERR", "pchaind.log.1:older ERR", "pchaind.log.2.gz:oldest ERR"}
+	if !reflect.DeepEqual(texts, want) {
+		t.Errorf("matches = %v, want %v", texts, want)
+	}
+
+	if _, _, err := searchLogs(filepath.Join(dir, "missing.log"), logGrepOptions{Pattern: "x"}); err == nil || !strings.Contains(err.Error(), "log file not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if _, _, err := searchLogs(logPath, logGrepOptions{Pattern: "x", After: -1}); err == nil {
+		t.Error("expected error for negative context")
+	}
+}
+
+func TestHandleLogsGrep_JSON(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "json"
+
+	logPath := filepath.Join(t.TempDir(), "pchaind.log")
+	_ = os.WriteFile(logPath, []byte("one\nERR two\nthree\n"), 0o644)
+
+	var buf bytes.Buffer
+	if err := handleLogsGrep(&buf, logPath, logGrepOptions{Pattern: "err", IgnoreCase: true, Before: 1}); err != nil {
+		t.Fatalf("handleLogsGrep() error = %v", err)
+	}
+	var got struct {
+		OK      bool       `json:"ok"`
+		Total   int        `json:"total"`
+		Matches []logMatch `json:"matches"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if !got.OK || got.Total != 1 || got.Matches[0].Line != 2 || !reflect.DeepEqual(got.Matches[0].Before, []string{"one"}) {
+		t.Errorf("unexpected output: %+v", got)
+	}
+
+	if err := handleLogsGrep(&buf, "", logGrepOptions{Pattern: "x"}); err == nil {
+		t.Error("expected error for empty log path")
+	}
+}
@@ -436,3 +436,30 @@ func TestHandleLogsCore_StatError(t *testing.T) {
 		t.Errorf("expected 'log file not found', got: %v", err)
 	}
 }
+
+func TestHandleLogsCore_RawFlag(t *testing.T) {
+	origNonInteractive := flagNonInteractive
+	defer func() {
+		flagNonInteractive = origNonInteractive
+		logsRaw = false
+	}()
+	flagNonInteractive = true
+	logsRaw = true
+
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "node.log")
+	os.WriteFile(logFile, []byte(`{"level":"info","message":"log"}`+"\n"), 0o644)
+
+	var calledOpts ui.LogUIOptions
+	deps := testLogDeps(logFile)
+	deps.runLogUI = func(ctx context.Context, opts ui.LogUIOptions) error {
+		calledOpts = opts
+		return nil
+	}
+	if err := handleLogsCore(&mockSupervisor{logPath: logFile}, deps); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !calledOpts.Raw {
+		t.Error("expected --raw to reach the log viewer")
+	}
+}
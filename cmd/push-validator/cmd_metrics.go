@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/metrics"
+)
+
+// collectMetrics takes one metrics snapshot. When sampleCPU is set it blocks
+// for about a second to measure CPU usage first.
+func collectMetrics(ctx context.Context, cfg config.Config, sampleCPU bool) (metrics.Snapshot, bool) {
+	col := metrics.NewWithoutCPU()
+	cpuSampled := sampleCPU && col.SampleCPU() == nil
+	return col.Collect(ctx, cfg.RPCLocal, cfg.RemoteRPCURL()), cpuSampled
+}
+
+// writeMetrics renders snap as YAML when --output yaml is set and as JSON
+// otherwise. cpuSampled tells consumers whether system.cpu_percent is a real
+// reading or was skipped.
+func writeMetrics(w io.Writer, snap metrics.Snapshot, cpuSampled bool) error {
+	out := map[string]any{"ok": true, "cpu_sampled": cpuSampled, "metrics": snap}
+	if flagOutput == "yaml" {
+		data, err := yaml.Marshal(out)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func init() {
+	var noCPU bool
+	metricsCmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "Print one snapshot of the dashboard's metrics as JSON or YAML",
+		Long: `Collect the same system, network, chain and node metrics the dashboard
+shows, once, and print them as JSON (or YAML with --output yaml).
+
+CPU usage is measured over one second; pass --no-cpu to skip it and return
+immediately with cpu_percent left at 0.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			snap, cpuSampled := collectMetrics(ctx, loadCfg(), !noCPU)
+			return writeMetrics(os.Stdout, snap, cpuSampled)
+		},
+	}
+	metricsCmd.Flags().BoolVar(&noCPU, "no-cpu", false, "Skip the one-second CPU sample")
+	rootCmd.AddCommand(metricsCmd)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/metrics"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	snap := metrics.Snapshot{
+		System:  metrics.System{CPUPercent: 12.5, MemUsed: 1024, MemTotal: 4096},
+		Network: metrics.Network{Peers: 3, LatencyMS: 48},
+		Chain:   metrics.Chain{LocalHeight: 100, RemoteHeight: 105, CatchingUp: true},
+		Node:    metrics.Node{ChainID: "push_42101-1", NodeID: "abc", Moniker: "alpha", RPCListening: true},
+	}
+
+	flagOutput = "json"
+	var buf bytes.Buffer
+	if err := writeMetrics(&buf, snap, true); err != nil {
+		t.Fatalf("writeMetrics() error = %v", err)
+	}
+	var got struct {
+		OK         bool             `json:"ok"`
+		CPUSampled bool             `json:"cpu_sampled"`
+		Metrics    metrics.Snapshot `json:"metrics"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if !got.OK || !got.CPUSampled || got.Metrics != snap {
+		t.Errorf("unexpected output: %+v", got)
+	}
+	for _, key := range []string{`"cpu_percent": 12.5`, `"remote_height": 105`, `"rpc_listening": true`} {
+		if !strings.Contains(buf.String(), key) {
+			t.Errorf("JSON missing %s:\n%s", key, buf.String())
+		}
+	}
+
+	// Text output falls back to JSON
+	flagOutput = "text"
+	buf.Reset()
+	if err := writeMetrics(&buf, snap, false); err != nil {
+		t.Fatalf("writeMetrics(text) error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `"cpu_sampled": false`) {
+		t.Errorf("expected JSON for text output:\n%s", buf.String())
+	}
+
+	flagOutput = "yaml"
+	buf.Reset()
+	if err := writeMetrics(&buf, snap, true); err != nil {
+		t.Fatalf("writeMetrics(yaml) error = %v", err)
+	}
+	for _, key := range []string{"chain_id: push_42101-1", "local_height: 100", "latency_ms: 48"} {
+		if !strings.Contains(buf.String(), key) {
+			t.Errorf("YAML missing %q:\n%s", key, buf.String())
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/node"
+)
+
+// handleNodeID prints this node's P2P ID. It is derived from
+// config/node_key.json, falling back to `pchaind tendermint show-node-id`
+// for key types the CLI can't read. Neither path needs the node running.
+func handleNodeID(d *Deps) error {
+	id, source, err := lookupNodeID(d)
+	if err != nil {
+		if flagOutput == "json" {
+			d.Printer.JSON(map[string]any{"ok": false, "error": err.Error()})
+		} else {
+			d.Printer.Error(err.Error())
+		}
+		return silentErr{err}
+	}
+
+	if flagOutput == "json" {
+		d.Printer.JSON(map[string]any{"ok": true, "node_id": id, "source": source})
+		return nil
+	}
+	d.Printer.KeyValueLine("Node ID", id, "blue")
+	return nil
+}
+
+// lookupNodeID returns the node ID and where it came from ("node_key.json"
+// or "pchaind").
+func lookupNodeID(d *Deps) (string, string, error) {
+	keyPath := filepath.Join(d.Cfg.HomeDir, "config", "node_key.json")
+	id, fileErr := node.IDFromKeyFile(keyPath)
+	if fileErr == nil {
+		return id, "node_key.json", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	out, err := d.Runner.Run(ctx, findPchaind(), "tendermint", "show-node-id", "--home", d.Cfg.HomeDir)
+	if id := strings.TrimSpace(string(out)); err == nil && id != "" {
+		return id, "pchaind", nil
+	}
+	return "", "", fmt.Errorf("failed to read node ID: %w", fileErr)
+}
+
+func init() {
+	rootCmd.AddCommand(&cobra.Command{
+		Use:   "node-id",
+		Short: "Show this node's P2P node ID (offline)",
+		Long: `Show the node ID used in peer addresses (<id>@<host>:26656).
+
+The ID is derived from config/node_key.json without contacting the network,
+so it works while the node is stopped or still syncing.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleNodeID(newDeps())
+		},
+	})
+}
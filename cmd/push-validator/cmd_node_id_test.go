@@ -0,0 +1,51 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLookupNodeID(t *testing.T) {
+	origBin := flagBin
+	defer func() { flagBin = origBin }()
+	flagBin = "pchaind"
+
+	cfg := testCfg()
+	cfg.HomeDir = t.TempDir()
+	runner := newMockRunner()
+	d := &Deps{Cfg: cfg, Printer: getPrinter(), Runner: runner}
+	showNodeID := "pchaind tendermint show-node-id --home " + cfg.HomeDir
+
+	// No node_key.json and pchaind fails
+	if _, _, err := lookupNodeID(d); err == nil {
+		t.Fatal("expected error without node_key.json or pchaind")
+	}
+
+	// Falls back to pchaind when the key file can't be read
+	runner.outputs[showNodeID] = []byte("abcdef0123456789abcdef0123456789abcdef01\n")
+	id, source, err := lookupNodeID(d)
+	if err != nil || id != "abcdef0123456789abcdef0123456789abcdef01" || source != "pchaind" {
+		t.Fatalf("lookupNodeID() = %q, %q, %v; want pchaind fallback", id, source, err)
+	}
+
+	// node_key.json wins and needs no pchaind call
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	sum := sha256.Sum256(pub)
+	keyPath := filepath.Join(cfg.HomeDir, "config", "node_key.json")
+	_ = os.MkdirAll(filepath.Dir(keyPath), 0o755)
+	body := fmt.Sprintf(`{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":%q}}`, base64.StdEncoding.EncodeToString(priv))
+	if err := os.WriteFile(keyPath, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	delete(runner.outputs, showNodeID)
+	id, source, err = lookupNodeID(d)
+	if err != nil || id != hex.EncodeToString(sum[:20]) || source != "node_key.json" {
+		t.Fatalf("lookupNodeID() = %q, %q, %v; want ID from node_key.json", id, source, err)
+	}
+}
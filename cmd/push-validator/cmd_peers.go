@@ -2,11 +2,15 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	"github.com/pushchain/push-validator-cli/internal/config"
 	"github.com/pushchain/push-validator-cli/internal/node"
@@ -17,9 +21,26 @@ import (
 func runPeersCore(ctx context.Context, cli node.Client) error {
 	plist, err := cli.Peers(ctx)
 	if err != nil {
+		if flagOutput == "json" {
+			getPrinter().JSON(map[string]any{"ok": false, "error": err.Error()})
+			return silentErr{err}
+		}
 		getPrinter().Error(fmt.Sprintf("peers error: %v", err))
 		return err
 	}
+	if flagOutput == "json" || flagOutput == "yaml" {
+		return writePeers(os.Stdout, plist)
+	}
+	if flagOutput == "table" {
+		writePeersTable(os.Stdout, plist)
+		return nil
+	}
+	printPeers(plist)
+	return nil
+}
+
+// printPeers prints the text view of plist.
+func printPeers(plist []node.Peer) {
 	c := ui.NewColorConfig()
 	headers := []string{"ID", "ADDR"}
 	rows := make([][]string, 0, len(plist))
@@ -29,7 +50,83 @@ func runPeersCore(ctx context.Context, cli node.Client) error {
 	fmt.Println(c.Header(" Connected Peers "))
 	fmt.Print(ui.Table(c, headers, rows, []int{40, 0}))
 	fmt.Printf("Total Peers: %d\n", len(plist))
-	return nil
+}
+
+// peerInfo is one peer in structured peers output.
+type peerInfo struct {
+	ID               string  `json:"id" yaml:"id"`
+	Addr             string  `json:"addr" yaml:"addr"`
+	ListenAddr       string  `json:"listen_addr" yaml:"listen_addr"`
+	Moniker          string  `json:"moniker,omitempty" yaml:"moniker,omitempty"`
+	Direction        string  `json:"direction" yaml:"direction"`
+	ConnectedFor     string  `json:"connected_for,omitempty" yaml:"connected_for,omitempty"`
+	ConnectedSeconds float64 `json:"connected_seconds" yaml:"connected_seconds"`
+}
+
+// writePeers renders plist as JSON or YAML per --output.
+func writePeers(w io.Writer, plist []node.Peer) error {
+	peers := peerInfos(plist)
+	return writeStructured(w, map[string]any{"ok": true, "total": len(peers), "peers": peers})
+}
+
+// peerInfos converts plist for structured output.
+func peerInfos(plist []node.Peer) []peerInfo {
+	peers := make([]peerInfo, 0, len(plist))
+	for _, p := range plist {
+		pi := peerInfo{
+			ID:               p.ID,
+			Addr:             p.Addr,
+			ListenAddr:       p.ListenAddr,
+			Moniker:          p.Moniker,
+			Direction:        "inbound",
+			ConnectedSeconds: p.Duration.Seconds(),
+		}
+		if p.Outbound {
+			pi.Direction = "outbound"
+		}
+		if p.Duration > 0 {
+			pi.ConnectedFor = p.Duration.Round(time.Second).String()
+		}
+		peers = append(peers, pi)
+	}
+	return peers
+}
+
+// writeStructured writes out as YAML for --output yaml, else as JSON.
+func writeStructured(w io.Writer, out map[string]any) error {
+	if flagOutput == "yaml" {
+		data, err := yaml.Marshal(out)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// writePeersTable renders plist for --output table, with the direction,
+// moniker and connection time the text view leaves out.
+func writePeersTable(w io.Writer, plist []node.Peer) {
+	headers := []string{"ID", "ADDR", "DIRECTION", "MONIKER", "CONNECTED"}
+	rows := make([][]string, 0, len(plist))
+	for _, p := range plist {
+		dir, connected := "inbound", "-"
+		if p.Outbound {
+			dir = "outbound"
+		}
+		if p.Duration > 0 {
+			connected = p.Duration.Round(time.Second).String()
+		}
+		moniker := p.Moniker
+		if moniker == "" {
+			moniker = "-"
+		}
+		rows = append(rows, []string{p.ID, p.Addr, dir, moniker, connected})
+	}
+	writeOutputTable(w, headers, rows)
 }
 
 // resolveRPCBase determines the RPC base URL from config.
@@ -56,5 +153,6 @@ func init() {
 			return runPeersCore(ctx, cli)
 		},
 	}
+	peersCmd.AddCommand(newPeersAddCmd(), newPeersSetSeedsCmd(), newPeersDialCmd(), newPeersDialSeedsCmd())
 	rootCmd.AddCommand(peersCmd)
 }
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/node"
+)
+
+// peersDialSettle is how long peers dial waits for the node to connect
+// before reading back its peer set; tests shorten it.
+var peersDialSettle = 3 * time.Second
+
+// handlePeersDial asks the local node to dial the addresses in args now,
+// through the unsafe dial_peers route (dial_seeds when seeds is set), then
+// reports the node's peers. Nothing is written to config.toml.
+func handlePeersDial(ctx context.Context, w io.Writer, cli node.Client, args []string, seeds bool, opts node.DialOptions) error {
+	addrs, err := files.ParsePeers(strings.Join(args, ","))
+	if err == nil && len(addrs) == 0 {
+		err = errors.New("no node addresses given")
+	}
+	if err != nil {
+		return peersDialFailed(exitcodes.InvalidArgsErrorf("%v", err))
+	}
+	route := "dial_peers"
+	if seeds {
+		route = "dial_seeds"
+	}
+	if !flagYes {
+		return peersDialFailed(exitcodes.PreconditionErrorf("%s is an unsafe RPC route that changes the running node's connections; rerun with --yes to confirm", route))
+	}
+
+	if seeds {
+		err = cli.DialSeeds(ctx, addrs)
+	} else {
+		err = cli.DialPeers(ctx, addrs, opts)
+	}
+	if errors.Is(err, node.ErrUnsafeRPCDisabled) {
+		return peersDialFailed(exitcodes.PreconditionErrorf("%v", err))
+	}
+	if err != nil {
+		return peersDialFailed(exitcodes.NetworkErrf("%v", err))
+	}
+
+	// Dialing is asynchronous; give the node a moment before reading back
+	select {
+	case <-time.After(peersDialSettle):
+	case <-ctx.Done():
+	}
+	plist, err := cli.Peers(context.Background())
+	if err != nil {
+		return peersDialFailed(exitcodes.NetworkErrf("dial queued, but listing peers failed: %v", err))
+	}
+	connected := dialedConnected(addrs, plist)
+
+	if flagOutput == "json" || flagOutput == "yaml" {
+		peers := peerInfos(plist)
+		return writeStructured(w, map[string]any{
+			"ok":        true,
+			"route":     route,
+			"dialed":    addrs,
+			"connected": connected,
+			"total":     len(peers),
+			"peers":     peers,
+		})
+	}
+	p := getPrinter()
+	p.Success(fmt.Sprintf("Asked the node to dial %d address(es) via %s; %d connected so far", len(addrs), route, len(connected)))
+	if flagOutput == "table" {
+		writePeersTable(w, plist)
+		return nil
+	}
+	printPeers(plist)
+	return nil
+}
+
+// dialedConnected returns the addresses in addrs whose node ID is among plist.
+func dialedConnected(addrs []string, plist []node.Peer) []string {
+	ids := make(map[string]bool, len(plist))
+	for _, p := range plist {
+		ids[p.ID] = true
+	}
+	connected := []string{}
+	for _, a := range addrs {
+		if id, _, _ := strings.Cut(a, "@"); ids[id] {
+			connected = append(connected, a)
+		}
+	}
+	return connected
+}
+
+func peersDialFailed(err error) error {
+	if flagOutput == "json" {
+		getPrinter().JSON(map[string]any{"ok": false, "error": err.Error()})
+		return silentErr{err}
+	}
+	return err
+}
+
+func newPeersDialCmd() *cobra.Command {
+	var opts node.DialOptions
+	cmd := &cobra.Command{
+		Use:   "dial <id@host:port>...",
+		Short: "Dial peers now through the node's unsafe RPC (needs --yes)",
+		Long: `Ask the running node to connect to one or more peers (node_id@host:port,
+comma or space separated) right away, without a restart, then list its peers.
+
+This calls CometBFT's dial_peers route, which is unsafe: the node only serves
+it with unsafe = true under [rpc] in config.toml, and anyone who can reach the
+RPC can then change the node's connections. --yes is required. The peers are
+not saved; use 'peers add' for that.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+			defer cancel()
+			return handlePeersDial(ctx, os.Stdout, node.New(loadCfg().RPCLocal), args, false, opts)
+		},
+	}
+	cmd.Flags().BoolVar(&opts.Persistent, "persistent", false, "Redial these peers if they disconnect (until the node restarts)")
+	cmd.Flags().BoolVar(&opts.Unconditional, "unconditional", false, "Connect even if the node is at its peer limit")
+	cmd.Flags().BoolVar(&opts.Private, "private", false, "Never gossip these peers' addresses to other peers")
+	return cmd
+}
+
+func newPeersDialSeedsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dial-seeds <id@host:port>...",
+		Short: "Dial seed nodes now through the node's unsafe RPC (needs --yes)",
+		Long: `Ask the running node to connect to seed nodes (node_id@host:port, comma or
+space separated) right away to discover new peers, then list its peers.
+
+This calls CometBFT's unsafe dial_seeds route; see 'peers dial --help'. The
+seeds are not saved; use 'peers set-seeds' for that.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+			defer cancel()
+			return handlePeersDial(ctx, os.Stdout, node.New(loadCfg().RPCLocal), args, true, node.DialOptions{})
+		},
+	}
+}
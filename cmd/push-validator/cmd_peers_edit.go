@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
+)
+
+// handlePeersMerge validates the node addresses in args and merges them into
+// the config.toml entry key (p2p.persistent_peers or p2p.seeds), keeping the
+// existing entries. A running node is restarted to apply the change once the
+// operator confirms (or passes --yes).
+func handlePeersMerge(d *Deps, key string, args []string) error {
+	add, err := files.ParsePeers(strings.Join(args, ","))
+	if err == nil && len(add) == 0 {
+		err = errors.New("no node addresses given")
+	}
+	if err != nil {
+		return configSetFailed(d, exitcodes.InvalidArgsErrorf("%v", err))
+	}
+
+	current, err := files.GetNodeConfig(d.Cfg.HomeDir, key)
+	if errors.Is(err, fs.ErrNotExist) {
+		return configSetFailed(d, exitcodes.PreconditionErrorf("config.toml not found in %s/config; initialize the node first", d.Cfg.HomeDir))
+	}
+	if err != nil {
+		return configSetFailed(d, fmt.Errorf("failed to read config.toml: %w", err))
+	}
+	// Keep whatever the operator already wrote; only new entries are validated
+	var existing []string
+	for _, p := range strings.Split(current, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			existing = append(existing, p)
+		}
+	}
+	merged, added := files.MergePeers(existing, add)
+	if len(added) > 0 {
+		if err := files.SetNodeConfig(d.Cfg.HomeDir, key, strings.Join(merged, ",")); err != nil {
+			return configSetFailed(d, fmt.Errorf("failed to update config.toml: %w", err))
+		}
+	}
+
+	restarted, err := restartToApply(d, len(added) > 0)
+	if flagOutput == "json" {
+		out := map[string]any{"ok": err == nil, "key": key, "peers": merged, "added": added, "restarted": restarted}
+		if added == nil {
+			out["added"] = []string{}
+		}
+		if err != nil {
+			out["error"] = err.Error()
+			d.Printer.JSON(out)
+			return silentErr{err}
+		}
+		d.Printer.JSON(out)
+		return nil
+	}
+	if len(added) == 0 {
+		d.Printer.Info(fmt.Sprintf("All addresses already in %s; nothing changed", key))
+	} else {
+		d.Printer.Success(fmt.Sprintf("Added %d address(es) to %s (%d total)", len(added), key, len(merged)))
+	}
+	return err
+}
+
+// restartToApply restarts a running node through the supervisor after a
+// config change, prompting first unless --yes is set. It reports whether the
+// node was restarted.
+func restartToApply(d *Deps, changed bool) (bool, error) {
+	if !changed || !d.Sup.IsRunning() {
+		return false, nil
+	}
+	p := d.Printer
+	if !flagYes {
+		if flagNonInteractive || flagOutput == "json" || !d.Prompter.IsInteractive() {
+			if flagOutput != "json" {
+				fmt.Println(p.Colors.Description("  Restart the node to apply: push-validator restart"))
+			}
+			return false, nil
+		}
+		response, err := d.Prompter.ReadLine("Restart the node now to apply? (y/N): ")
+		if err != nil || strings.ToLower(strings.TrimSpace(response)) != "y" {
+			fmt.Println(p.Colors.Description("  Restart later to apply: push-validator restart"))
+			return false, nil
+		}
+	}
+	if _, err := d.Sup.Restart(nodeStartOpts(d.Cfg)); err != nil {
+		if errors.Is(err, files.ErrPrivValStateBehind) {
+			return false, doubleSignRefusal(err)
+		}
+		return false, exitcodes.ProcessErrf("config updated but restart failed: %v", err)
+	}
+	if flagOutput != "json" {
+		p.Success("Node restarted")
+	}
+	return true, nil
+}
+
+func newPeersAddCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <id@host:port>...",
+		Short: "Add persistent peers to config.toml",
+		Long: `Add one or more persistent peers (node_id@host:port, comma or space
+separated) to p2p.persistent_peers in config.toml. Existing peers are kept and
+addresses whose node ID is already listed are skipped.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handlePeersMerge(newDeps(), "p2p.persistent_peers", args)
+		},
+	}
+}
+
+func newPeersSetSeedsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-seeds <id@host:port,...>",
+		Short: "Add seed nodes to config.toml",
+		Long: `Merge seed nodes (node_id@host:port, comma or space separated) into
+p2p.seeds in config.toml. Existing seeds are kept and addresses whose node ID
+is already listed are skipped.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handlePeersMerge(newDeps(), "p2p.seeds", args)
+		},
+	}
+}
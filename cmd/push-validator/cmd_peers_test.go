@@ -1,11 +1,19 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
 	"github.com/pushchain/push-validator-cli/internal/node"
 )
 
@@ -103,3 +111,172 @@ func TestResolveRPCBase_Default(t *testing.T) {
 		t.Errorf("resolveRPCBase() = %q, want %q", result, "http://127.0.0.1:26657")
 	}
 }
+
+func TestWritePeers(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	plist := []node.Peer{
+		{ID: "out-peer", Addr: "34.72.243.200:26656", ListenAddr: "tcp://34.72.243.200:26656", Moniker: "alpha", Outbound: true, Duration: 90 * time.Second},
+		{ID: "in-peer", Addr: "10.0.0.5:26656", ListenAddr: "tcp://0.0.0.0:26656"},
+	}
+
+	flagOutput = "json"
+	var buf bytes.Buffer
+	if err := writePeers(&buf, plist); err != nil {
+		t.Fatalf("writePeers() error = %v", err)
+	}
+	var got struct {
+		OK    bool       `json:"ok"`
+		Total int        `json:"total"`
+		Peers []peerInfo `json:"peers"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if !got.OK || got.Total != 2 || len(got.Peers) != 2 {
+		t.Fatalf("unexpected output: %+v", got)
+	}
+	want := peerInfo{ID: "out-peer", Addr: "34.72.243.200:26656", ListenAddr: "tcp://34.72.243.200:26656", Moniker: "alpha", Direction: "outbound", ConnectedFor: "1m30s", ConnectedSeconds: 90}
+	if got.Peers[0] != want {
+		t.Errorf("peer 0 = %+v, want %+v", got.Peers[0], want)
+	}
+	if got.Peers[1].Direction != "inbound" || got.Peers[1].ConnectedFor != "" {
+		t.Errorf("peer 1 = %+v", got.Peers[1])
+	}
+
+	flagOutput = "yaml"
+	buf.Reset()
+	if err := writePeers(&buf, plist); err != nil {
+		t.Fatalf("writePeers(yaml) error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "direction: outbound") || !strings.Contains(buf.String(), "total: 2") {
+		t.Errorf("unexpected YAML:\n%s", buf.String())
+	}
+}
+
+func TestWritePeersTable(t *testing.T) {
+	t.Setenv("COLUMNS", "200")
+	t.Setenv("NO_COLOR", "1")
+	plist := []node.Peer{
+		{ID: "out-peer", Addr: "34.72.243.200:26656", Moniker: "alpha", Outbound: true, Duration: 90 * time.Second},
+		{ID: "in-peer", Addr: "10.0.0.5:26656"},
+	}
+	var buf bytes.Buffer
+	writePeersTable(&buf, plist)
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want header and 2 rows:\n%s", len(lines), buf.String())
+	}
+	if strings.Join(strings.Fields(lines[0]), " ") != "ID ADDR DIRECTION MONIKER CONNECTED" {
+		t.Errorf("header = %q", lines[0])
+	}
+	if got := strings.Fields(lines[2]); strings.Join(got, " ") != "in-peer 10.0.0.5:26656 inbound - -" {
+		t.Errorf("row 2 = %q", lines[2])
+	}
+	// Columns line up: DIRECTION starts at the same offset on every line
+	col := strings.Index(lines[0], "DIRECTION")
+	if strings.Index(lines[1], "outbound") != col || strings.Index(lines[2], "inbound") != col {
+		t.Errorf("columns not aligned:\n%s", buf.String())
+	}
+}
+
+func TestHandlePeersMerge(t *testing.T) {
+	origOutput, origYes, origNonInteractive := flagOutput, flagYes, flagNonInteractive
+	defer func() { flagOutput, flagYes, flagNonInteractive = origOutput, origYes, origNonInteractive }()
+	flagOutput, flagYes, flagNonInteractive = "text", false, false
+
+	a := "6751a6539368608a65512d1a4b7ede4a9cd5004f@136.112.142.137:26656"
+	b := "374573900e4365bea5d946dd69c7343e56e4f375@34.72.243.200:26656"
+	cfg := testCfg()
+	cfg.HomeDir = t.TempDir()
+	cfgPath := filepath.Join(cfg.HomeDir, "config", "config.toml")
+	_ = os.MkdirAll(filepath.Dir(cfgPath), 0o755)
+	if err := os.WriteFile(cfgPath, []byte("[p2p]\n# peers\npersistent_peers = \""+a+"\"\nseeds = \"\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	sup := &mockSupervisor{running: true}
+	d := &Deps{Cfg: cfg, Sup: sup, Printer: getPrinter(), Prompter: &mockPrompter{responses: []string{"n"}, interactive: true}}
+	if err := handlePeersMerge(d, "p2p.persistent_peers", []string{b + "," + a}); err != nil {
+		t.Fatalf("handlePeersMerge() error: %v", err)
+	}
+	if got, _ := files.GetNodeConfig(cfg.HomeDir, "p2p.persistent_peers"); got != a+","+b {
+		t.Errorf("persistent_peers = %q, want existing peer kept and new one appended", got)
+	}
+	if !sup.running {
+		t.Error("declined restart should leave the node running untouched")
+	}
+
+	// --yes restarts through the supervisor
+	flagYes = true
+	sup.running = true
+	if err := handlePeersMerge(d, "p2p.seeds", []string{a}); err != nil {
+		t.Fatalf("handlePeersMerge(seeds) error: %v", err)
+	}
+	if got, _ := files.GetNodeConfig(cfg.HomeDir, "p2p.seeds"); got != a {
+		t.Errorf("seeds = %q, want %q", got, a)
+	}
+
+	sup.startErr = fmt.Errorf("port in use")
+	err := handlePeersMerge(d, "p2p.seeds", []string{b})
+	if err == nil || exitcodes.CodeForError(err) != exitcodes.ProcessError {
+		t.Errorf("expected process error on failed restart, got %v", err)
+	}
+
+	err = handlePeersMerge(d, "p2p.seeds", []string{"not-a-peer"})
+	if err == nil || exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("expected invalid args for malformed address, got %v", err)
+	}
+}
+
+func TestHandlePeersDial(t *testing.T) {
+	origOutput, origYes, origSettle := flagOutput, flagYes, peersDialSettle
+	defer func() { flagOutput, flagYes, peersDialSettle = origOutput, origYes, origSettle }()
+	flagOutput, flagYes, peersDialSettle = "text", false, 0
+
+	a := "6751a6539368608a65512d1a4b7ede4a9cd5004f@136.112.142.137:26656"
+	b := "374573900e4365bea5d946dd69c7343e56e4f375@34.72.243.200:26656"
+	cli := &mockNodeClient{peers: []node.Peer{{ID: "6751a6539368608a65512d1a4b7ede4a9cd5004f", Addr: "136.112.142.137:26656"}}}
+	ctx := context.Background()
+
+	err := handlePeersDial(ctx, &bytes.Buffer{}, cli, []string{a}, false, node.DialOptions{})
+	if exitcodes.CodeForError(err) != exitcodes.PreconditionFailed || cli.dialed != nil {
+		t.Errorf("without --yes: error = %v, dialed %v", err, cli.dialed)
+	}
+
+	flagYes = true
+	err = handlePeersDial(ctx, &bytes.Buffer{}, cli, []string{"not-a-peer"}, false, node.DialOptions{})
+	if exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("malformed address: error = %v, want invalid args", err)
+	}
+
+	flagOutput = "json"
+	var buf bytes.Buffer
+	if err := handlePeersDial(ctx, &buf, cli, []string{a + "," + b}, false, node.DialOptions{Persistent: true}); err != nil {
+		t.Fatalf("handlePeersDial() error: %v", err)
+	}
+	if len(cli.dialed) != 2 || !cli.dialOpts.Persistent {
+		t.Errorf("dialed %v with %+v", cli.dialed, cli.dialOpts)
+	}
+	var out struct {
+		Route     string     `json:"route"`
+		Connected []string   `json:"connected"`
+		Total     int        `json:"total"`
+		Peers     []peerInfo `json:"peers"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, buf.String())
+	}
+	if out.Route != "dial_peers" || out.Total != 1 || len(out.Connected) != 1 || out.Connected[0] != a {
+		t.Errorf("output = %+v", out)
+	}
+
+	flagOutput = "text"
+	cli.dialErr = fmt.Errorf("dial_seeds: %w", node.ErrUnsafeRPCDisabled)
+	err = handlePeersDial(ctx, &bytes.Buffer{}, cli, []string{b}, true, node.DialOptions{})
+	if exitcodes.CodeForError(err) != exitcodes.PreconditionFailed {
+		t.Errorf("unsafe RPC disabled: error = %v, want precondition failed", err)
+	}
+}
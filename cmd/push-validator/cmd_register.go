@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -367,7 +368,7 @@ func handleRegisterValidator(d *Deps) error {
 		stLocal, err1 := d.Node.Status(ctx)
 		_, err2 := d.RemoteNode.RemoteStatus(ctx, cfg.RemoteRPCURL())
 		cancel()
-		if err1 == nil && err2 == nil && stLocal.CatchingUp {
+		if err1 == nil && err2 == nil && stLocal.CatchingUp && !overrideSyncCheck(os.Stderr) {
 			if flagOutput == "json" {
 				getPrinter().JSON(map[string]any{"ok": false, "error": "node is still syncing"})
 			} else {
@@ -526,7 +527,7 @@ func runRegisterValidatorWithDeps(d *Deps, cfg config.Config, inputs registratio
 		v = d.Validator
 		prompter = d.Prompter
 	} else {
-		v = validator.NewWith(validator.Options{BinPath: findPchaind(), HomeDir: cfg.HomeDir, ChainID: cfg.ChainID, Keyring: cfg.KeyringBackend, GenesisDomain: cfg.GenesisDomain, Denom: cfg.Denom})
+		v = validator.NewWith(validator.Options{BinPath: findPchaind(), HomeDir: cfg.HomeDir, ChainID: cfg.ChainID, Keyring: cfg.KeyringBackend, GenesisDomain: cfg.GenesisDomain, Denom: cfg.Denom, Fees: flagTxFees, BroadcastMode: flagBroadcastMode})
 		prompter = &ttyPrompter{}
 	}
 
@@ -634,6 +635,7 @@ func runRegisterValidatorWithDeps(d *Deps, cfg config.Config, inputs registratio
 		p.KeyValueLine("EVM Address", evmAddr, "blue")
 		p.KeyValueLine("Chain", "Push Chain", "dim")
 		p.KeyValueLine("Network", "Testnet", "dim")
+		printTxFee(p, cfg.Denom)
 		fmt.Println()
 	}
 
@@ -707,9 +709,14 @@ func runRegisterValidatorWithDeps(d *Deps, cfg config.Config, inputs registratio
 		return fmt.Errorf("validator registration failed: %w", err)
 	}
 
+	txRes, err := confirmTx(v, txHash, flagTxWait, flagTxWaitTimeout)
+	if err != nil {
+		return err
+	}
+
 	// Success output
 	if flagOutput == "json" {
-		getPrinter().JSON(map[string]any{"ok": true, "txhash": txHash, "moniker": moniker, "key_name": keyName, "commission_rate": commissionRate, "stake_amount": stake})
+		getPrinter().JSON(withTxResult(map[string]any{"ok": true, "txhash": txHash, "moniker": moniker, "key_name": keyName, "commission_rate": commissionRate, "stake_amount": stake}, txRes))
 	} else {
 		fmt.Println()
 		p := getPrinter()
@@ -643,6 +643,19 @@ func (m *balanceRetryMockValidator) Balance(ctx context.Context, addr string) (s
 	return "2000000000000000000", nil // 2 PC - sufficient
 }
 
+func (m *balanceRetryMockValidator) Balances(ctx context.Context, addr string) ([]validator.Coin, error) {
+	bal, err := m.Balance(ctx, addr)
+	return []validator.Coin{{Denom: "upc", Amount: bal}}, err
+}
+
+func (m *balanceRetryMockValidator) ListKeys(ctx context.Context) ([]validator.KeyInfo, error) {
+	return nil, nil
+}
+
+func (m *balanceRetryMockValidator) ShowKey(ctx context.Context, name string) (validator.KeyInfo, error) {
+	return validator.KeyInfo{}, nil
+}
+
 func (m *balanceRetryMockValidator) IsValidator(ctx context.Context, addr string) (bool, error) {
 	return m.inner.IsValidator(ctx, addr)
 }
@@ -687,6 +700,10 @@ func (m *balanceRetryMockValidator) Vote(ctx context.Context, args validator.Vot
 	return m.inner.Vote(ctx, args)
 }
 
+func (m *balanceRetryMockValidator) QueryTx(ctx context.Context, hash string) (validator.TxResult, error) {
+	return m.inner.QueryTx(ctx, hash)
+}
+
 func TestRunRegisterValidatorWithDeps_ValidatorAlreadyExists_ReturnsSuccess(t *testing.T) {
 	origOutput := flagOutput
 	origNonInteractive := flagNonInteractive
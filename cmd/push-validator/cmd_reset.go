@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -124,16 +126,27 @@ func handleResetWith(cfg config.Config, sup process.Supervisor, prompter Prompte
 	return nil
 }
 
+// flagKeyLossAck is set by full-reset --i-understand-key-loss and skips the
+// typed moniker confirmation for registered validators.
+var flagKeyLossAck bool
+
 // handleFullReset performs a complete reset, deleting ALL data including validator keys.
 // Requires explicit confirmation unless --yes flag is used.
 func handleFullReset(cfg config.Config, sup process.Supervisor, prompters ...Prompter) error {
-	p := getPrinter()
 	var prompter Prompter
 	if len(prompters) > 0 {
 		prompter = prompters[0]
 	} else {
 		prompter = &ttyPrompter{}
 	}
+	return handleFullResetWith(cfg, sup, prompter, &prodFetcher{})
+}
+
+// handleFullResetWith is the testable core of handleFullReset. The fetcher is
+// used to find out whether the keys about to be deleted belong to a
+// registered validator.
+func handleFullResetWith(cfg config.Config, sup process.Supervisor, prompter Prompter, fetcher ValidatorFetcher) error {
+	p := getPrinter()
 
 	// Require confirmation before stopping or modifying anything
 	if flagOutput != "json" {
@@ -163,6 +176,12 @@ func handleFullReset(cfg config.Config, sup process.Supervisor, prompters ...Pro
 		}
 	}
 
+	// Deleting a registered validator's consensus key loses that identity
+	// for good, so it needs its own confirmation that --yes does not cover
+	if proceed, err := confirmValidatorKeyLoss(cfg, prompter, fetcher, "full-reset"); err != nil || !proceed {
+		return err
+	}
+
 	// Stop node after confirmation
 	if sup.IsRunning() {
 		if flagOutput != "json" {
@@ -213,3 +232,138 @@ func handleFullReset(cfg config.Config, sup process.Supervisor, prompters ...Pro
 
 	return nil
 }
+
+// confirmValidatorKeyLoss guards action (full-reset or init --force) when
+// priv_validator_key.json belongs to a registered validator (or when that
+// cannot be ruled out). The operator must type the moniker unless
+// --i-understand-key-loss was given. It returns false without an error when
+// the operator backs out.
+func confirmValidatorKeyLoss(cfg config.Config, prompter Prompter, fetcher ValidatorFetcher, action string) (bool, error) {
+	keyPath := filepath.Join(cfg.HomeDir, "config", "priv_validator_key.json")
+	if _, err := os.Stat(keyPath); err != nil {
+		return true, nil // no consensus key, nothing irreplaceable to lose
+	}
+
+	p := getPrinter()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	myVal, err := fetcher.GetMyValidator(ctx, cfg)
+	cancel()
+	if err == nil && !myVal.IsValidator {
+		return true, nil
+	}
+
+	subject := "a registered validator"
+	if err != nil {
+		subject = "a possibly registered validator (status check failed)"
+	} else if myVal.Moniker != "" {
+		subject = fmt.Sprintf("registered validator %q", myVal.Moniker)
+	}
+
+	if flagKeyLossAck {
+		if flagOutput != "json" {
+			p.Warn(fmt.Sprintf("Deleting the consensus key of %s (--i-understand-key-loss)", subject))
+		}
+		return true, nil
+	}
+
+	refusal := fmt.Errorf("%s would delete the consensus key of %s: back up keys with 'push-validator export-key', then re-run with --i-understand-key-loss", action, subject)
+	if flagOutput == "json" {
+		p.JSON(map[string]any{"ok": false, "error": refusal.Error()})
+		return false, silentErr{refusal}
+	}
+
+	fmt.Println()
+	fmt.Println(p.Colors.Error(p.Colors.Emoji("🛑") + "  The consensus key in this home belongs to " + subject))
+	fmt.Println("Deleting priv_validator_key.json loses that validator identity permanently.")
+	fmt.Println("Back up the keys first:")
+	fmt.Println(p.Colors.Apply(p.Colors.Theme.Command, "  push-validator export-key"))
+	fmt.Println()
+	if flagNonInteractive {
+		return false, refusal
+	}
+
+	phrase := myVal.Moniker
+	if err != nil || phrase == "" {
+		phrase = "delete validator keys"
+	}
+	response, pErr := prompter.ReadLine(fmt.Sprintf("Type %q to delete the validator keys: ", phrase))
+	if pErr != nil || strings.TrimSpace(response) != phrase {
+		fmt.Println(p.Colors.Info(action + " cancelled"))
+		return false, nil
+	}
+	return true, nil
+}
+
+// handleResetPlan prints what reset (or full-reset when full is set) would
+// delete and keep, using the same target lists as the real operation.
+// Nothing is stopped or removed.
+func handleResetPlan(cfg config.Config, sup process.Supervisor, full bool) error {
+	p := getPrinter()
+	action := "reset"
+	var (
+		plan *admin.ResetPlan
+		err  error
+	)
+	if full {
+		action = "full-reset"
+		plan, err = admin.PlanFullReset(admin.FullResetOptions{HomeDir: cfg.HomeDir})
+	} else {
+		plan, err = admin.PlanReset(admin.ResetOptions{HomeDir: cfg.HomeDir, KeepAddrBook: true})
+	}
+	if err != nil {
+		if flagOutput == "json" {
+			p.JSON(map[string]any{"ok": false, "dry_run": true, "action": action, "error": err.Error()})
+			return silentErr{err}
+		}
+		return fmt.Errorf("failed to plan %s: %w", action, err)
+	}
+	running := sup != nil && sup.IsRunning()
+
+	if flagOutput == "json" {
+		p.JSON(map[string]any{
+			"ok":           true,
+			"dry_run":      true,
+			"action":       action,
+			"home_dir":     plan.HomeDir,
+			"remove":       plan.Remove,
+			"preserve":     plan.Preserve,
+			"total_size":   plan.TotalSize,
+			"node_running": running,
+		})
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println(p.Colors.Header(fmt.Sprintf("Dry run: %s in %s", action, plan.HomeDir)))
+	fmt.Println()
+	if len(plan.Remove) == 0 {
+		fmt.Println(p.Colors.Info("Nothing would be removed"))
+	} else {
+		fmt.Println(p.Colors.Error(fmt.Sprintf("Would remove (%s):", ui.FormatBytes(plan.TotalSize))))
+		for _, e := range plan.Remove {
+			fmt.Println(p.Colors.Error("  ✗ " + formatPlanEntry(e)))
+		}
+	}
+	if len(plan.Preserve) > 0 {
+		fmt.Println()
+		fmt.Println(p.Colors.Success("Would keep:"))
+		for _, e := range plan.Preserve {
+			fmt.Println("  ✓ " + formatPlanEntry(e))
+		}
+	}
+	fmt.Println()
+	if running {
+		p.Warn("Node is running and would be stopped first")
+	}
+	p.Info(fmt.Sprintf("Nothing was deleted. Run 'push-validator %s' without --dry-run to apply.", action))
+	return nil
+}
+
+func formatPlanEntry(e admin.PlanEntry) string {
+	name := e.Path
+	if e.IsDir {
+		name += string(os.PathSeparator)
+		return fmt.Sprintf("%-40s %8s  (%d files)", name, ui.FormatBytes(e.Size), e.Files)
+	}
+	return fmt.Sprintf("%-40s %8s", name, ui.FormatBytes(e.Size))
+}
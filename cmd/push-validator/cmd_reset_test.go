@@ -2,10 +2,13 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/pushchain/push-validator-cli/internal/admin"
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
 func TestHandleReset_NonInteractive_NoYes(t *testing.T) {
@@ -791,3 +794,91 @@ func TestHandleResetWith_VerifiesResetOpts(t *testing.T) {
 		t.Error("expected KeepAddrBook=true")
 	}
 }
+
+func TestHandleResetPlan_DeletesNothing(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	home := t.TempDir()
+	keep := filepath.Join(home, "config", "priv_validator_key.json")
+	data := filepath.Join(home, "data", "blockstore.db")
+	for _, p := range []string{keep, data} {
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte("x"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, output := range []string{"text", "json"} {
+		for _, full := range []bool{false, true} {
+			flagOutput = output
+			sup := &mockSupervisor{running: true}
+			if err := handleResetPlan(config.Config{HomeDir: home}, sup, full); err != nil {
+				t.Fatalf("%s full=%v: unexpected error: %v", output, full, err)
+			}
+			if !sup.running {
+				t.Errorf("%s full=%v: dry run must not stop the node", output, full)
+			}
+			for _, p := range []string{keep, data} {
+				if _, err := os.Stat(p); err != nil {
+					t.Errorf("%s full=%v: %s was removed", output, full, p)
+				}
+			}
+		}
+	}
+}
+
+func TestHandleFullReset_RegisteredValidatorGuard(t *testing.T) {
+	origOutput, origYes, origNonInteractive, origAck := flagOutput, flagYes, flagNonInteractive, flagKeyLossAck
+	defer func() {
+		flagOutput, flagYes, flagNonInteractive, flagKeyLossAck = origOutput, origYes, origNonInteractive, origAck
+	}()
+
+	newHome := func(t *testing.T) (config.Config, string) {
+		home := t.TempDir()
+		key := filepath.Join(home, "config", "priv_validator_key.json")
+		_ = os.MkdirAll(filepath.Dir(key), 0o755)
+		if err := os.WriteFile(key, []byte(`{}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return config.Config{HomeDir: home}, key
+	}
+	registered := &mockFetcher{myValidator: validator.MyValidatorInfo{IsValidator: true, Moniker: "my-node"}}
+
+	tests := []struct {
+		name        string
+		output      string
+		nonInteract bool
+		ack         bool
+		fetcher     *mockFetcher
+		responses   []string
+		wantDeleted bool
+		wantErr     bool
+	}{
+		{"not a validator", "text", false, false, &mockFetcher{}, nil, true, false},
+		{"typed moniker", "text", false, false, registered, []string{"my-node"}, true, false},
+		{"wrong moniker cancels", "text", false, false, registered, []string{"other"}, false, false},
+		{"non-interactive refuses", "text", true, false, registered, nil, false, true},
+		{"json refuses", "json", false, false, registered, nil, false, true},
+		{"ack flag skips prompt", "json", false, true, registered, nil, true, false},
+		{"status unknown still guarded", "text", false, false, &mockFetcher{myValidatorErr: fmt.Errorf("rpc down")}, []string{"delete validator keys"}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagOutput, flagYes, flagNonInteractive, flagKeyLossAck = tt.output, true, tt.nonInteract, tt.ack
+			cfg, key := newHome(t)
+			prompter := &mockPrompter{responses: tt.responses}
+
+			err := handleFullResetWith(cfg, &mockSupervisor{}, prompter, tt.fetcher)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			_, statErr := os.Stat(key)
+			if deleted := os.IsNotExist(statErr); deleted != tt.wantDeleted {
+				t.Errorf("key deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pushchain/push-validator-cli/internal/dashboard"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
+// flagRestakeMinAmount skips the restake when commission plus outstanding
+// rewards are below this many PC (--min-amount).
+var flagRestakeMinAmount float64
+
 // handleRestakeRewardsAll orchestrates the restake-rewards-all flow:
 // - verify node is synced
 // - verify validator is registered
@@ -21,6 +27,9 @@ import (
 // - submit delegation transaction
 // - display results
 func handleRestakeRewardsAll(d *Deps) error {
+	if flagRestakeMinAmount < 0 {
+		return exitcodes.InvalidArgsErrorf("--min-amount must not be negative")
+	}
 	if err := checkNodeRunning(d.Sup); err != nil {
 		return err
 	}
@@ -57,7 +66,7 @@ func handleRestakeRewardsAll(d *Deps) error {
 		return fmt.Errorf("failed to check sync status")
 	}
 
-	if stLocal.CatchingUp {
+	if stLocal.CatchingUp && !overrideSyncCheck(os.Stderr) {
 		if flagOutput == "json" {
 			getPrinter().JSON(map[string]any{"ok": false, "error": "node is still syncing"})
 		} else {
@@ -154,9 +163,26 @@ func handleRestakeRewardsAll(d *Deps) error {
 	totalRewards := commissionFloat + outstandingFloat
 	const rewardThreshold = 0.01 // Minimum 0.01 PC to be worthwhile
 
+	// Below --min-amount is a normal outcome for scheduled runs, not an error
+	if flagRestakeMinAmount > 0 && totalRewards < flagRestakeMinAmount {
+		if flagOutput == "json" {
+			getPrinter().JSON(map[string]any{
+				"ok":                true,
+				"rewards":           fmt.Sprintf("%.6f", totalRewards),
+				"min_amount":        fmt.Sprintf("%.6f", flagRestakeMinAmount),
+				"restake_performed": false,
+				"message":           "below threshold, skipped",
+			})
+		} else {
+			fmt.Println(p.Colors.Info(fmt.Sprintf("Rewards of %.6f PC are below --min-amount %.6f PC; below threshold, skipped.", totalRewards, flagRestakeMinAmount)))
+			fmt.Println()
+		}
+		return nil
+	}
+
 	if totalRewards < rewardThreshold {
 		if flagOutput == "json" {
-			getPrinter().JSON(map[string]any{"ok": true, "rewards_available": false, "message": "no significant rewards available"})
+			getPrinter().JSON(map[string]any{"ok": true, "rewards_available": false, "rewards": fmt.Sprintf("%.6f", totalRewards), "restake_performed": false, "message": "no significant rewards available"})
 		} else {
 			fmt.Println(p.Colors.Warning(p.Colors.Emoji("⚠️") + " No significant rewards available (less than 0.01 PC)"))
 			fmt.Println()
@@ -224,6 +250,12 @@ func handleRestakeRewardsAll(d *Deps) error {
 		fmt.Println()
 	}
 
+	// The restake spends the withdrawn funds, so it must not race the withdrawal
+	withdrawRes, err := confirmTx(d.Validator, txHash, flagTxWait, flagTxWaitTimeout)
+	if err != nil {
+		return err
+	}
+
 	// Step 6: Calculate available amount for restaking
 	const feeReserve = 0.15 // Reserve 0.15 PC for gas fees
 	maxRestakeable := totalRewards - feeReserve
@@ -231,11 +263,12 @@ func handleRestakeRewardsAll(d *Deps) error {
 	if maxRestakeable <= 0 {
 		if flagOutput == "json" {
 			getPrinter().JSON(map[string]any{
-				"ok":              true,
-				"withdraw_txhash": txHash,
-				"withdrawn":       fmt.Sprintf("%.6f", totalRewards),
-				"restaked":        "0",
-				"message":         "insufficient balance for restaking after gas reserve",
+				"ok":                true,
+				"withdraw_txhash":   txHash,
+				"withdrawn":         fmt.Sprintf("%.6f", totalRewards),
+				"restaked":          "0",
+				"restake_performed": false,
+				"message":           "insufficient balance for restaking after gas reserve",
 			})
 		} else {
 			fmt.Println(p.Colors.Warning(p.Colors.Emoji("⚠️") + " Insufficient balance for restaking after gas reserve"))
@@ -251,6 +284,7 @@ func handleRestakeRewardsAll(d *Deps) error {
 		p.Section("Available for Restaking")
 		p.KeyValueLine("Withdrawn Amount", dashboard.FormatSmartNumber(fmt.Sprintf("%.6f", totalRewards))+" PC", "blue")
 		p.KeyValueLine("Gas Reserve", dashboard.FormatSmartNumber(fmt.Sprintf("%.2f", feeReserve))+" PC", "dim")
+		printTxFee(p, d.Cfg.Denom)
 		p.KeyValueLine("Available to Stake", dashboard.FormatSmartNumber(fmt.Sprintf("%.6f", maxRestakeable))+" PC", "blue")
 		fmt.Println()
 	}
@@ -360,15 +394,25 @@ func handleRestakeRewardsAll(d *Deps) error {
 		fmt.Println(" " + p.Colors.Success(p.Colors.Emoji("✓")))
 	}
 
+	restakeRes, err := confirmTx(d.Validator, delegateTxHash, flagTxWait, flagTxWaitTimeout)
+	if err != nil {
+		return err
+	}
+
 	// Success output
 	if flagOutput == "json" {
-		getPrinter().JSON(map[string]any{
+		out := map[string]any{
 			"ok":                true,
 			"withdraw_txhash":   txHash,
 			"restake_txhash":    delegateTxHash,
 			"withdrawn":         fmt.Sprintf("%.6f", totalRewards),
 			"restaked":          fmt.Sprintf("%.6f", restakeAmount),
-		})
+			"restake_performed": true,
+		}
+		if withdrawRes != nil && restakeRes != nil {
+			out["withdraw_height"], out["restake_height"] = withdrawRes.Height, restakeRes.Height
+		}
+		getPrinter().JSON(out)
 	} else {
 		fmt.Println()
 		p.Success(p.Colors.Emoji("✅") + " Successfully restaked rewards!")
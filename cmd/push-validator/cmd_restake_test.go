@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/node"
 	"github.com/pushchain/push-validator-cli/internal/validator"
 )
@@ -696,3 +697,40 @@ func TestHandleRestakeRewardsAll_Text_FullSuccess(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestHandleRestakeRewardsAll_MinAmount(t *testing.T) {
+	origOutput, origYes, origMin := flagOutput, flagYes, flagRestakeMinAmount
+	defer func() { flagOutput, flagYes, flagRestakeMinAmount = origOutput, origYes, origMin }()
+	flagOutput = "json"
+	flagYes = true
+
+	deps := func() *Deps {
+		return restakeDeps(func(d *Deps) {
+			d.Node = &mockNodeClient{status: node.Status{Height: 100}}
+			d.RemoteNode = &mockNodeClient{status: node.Status{Height: 100}}
+			d.Fetcher = &mockFetcher{
+				myValidator: validator.MyValidatorInfo{IsValidator: true, Address: "pushvaloper1test"},
+				commission:  "0.5",
+				outstanding: "0.3",
+			}
+			// Any tx attempt fails, so a nil error means nothing was sent
+			d.Validator = &mockValidator{withdrawErr: fmt.Errorf("withdraw should not run")}
+		})
+	}
+
+	flagRestakeMinAmount = 1
+	if err := handleRestakeRewardsAll(deps()); err != nil {
+		t.Fatalf("below threshold: expected skip with nil error, got %v", err)
+	}
+
+	// At or above the threshold the restake goes ahead
+	flagRestakeMinAmount = 0.8
+	if err := handleRestakeRewardsAll(deps()); err == nil {
+		t.Fatal("above threshold: expected the withdrawal to be attempted")
+	}
+
+	flagRestakeMinAmount = -1
+	if err := handleRestakeRewardsAll(deps()); exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("negative --min-amount: error = %v, want invalid args", err)
+	}
+}
@@ -1,13 +1,13 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
 
-	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
-	"github.com/pushchain/push-validator-cli/internal/process"
+	"github.com/pushchain/push-validator-cli/internal/files"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 )
 
@@ -25,14 +25,16 @@ var restartCmd = &cobra.Command{
 			_ = os.Setenv("PCHAIND", restartBin)
 		}
 
-		// Verify cosmovisor is available
-		detection := cosmovisor.Detect(cfg.HomeDir)
-		if !detection.Available {
-			return fmt.Errorf("cosmovisor binary not found; install it or ensure it's in PATH")
+		sup, _, err := selectSupervisor(cfg)
+		if err != nil {
+			return err
 		}
-		sup := newSupervisor(cfg.HomeDir)
+		withCosmovisor := usesCosmovisor(sup)
 
-		_, err := sup.Restart(process.StartOpts{HomeDir: cfg.HomeDir, Moniker: os.Getenv("MONIKER"), BinPath: findPchaind()})
+		_, err = sup.Restart(nodeStartOpts(cfg))
+		if errors.Is(err, files.ErrPrivValStateBehind) {
+			return doubleSignRefusal(err)
+		}
 		if err != nil {
 			ui.PrintError(ui.ErrorMessage{
 				Problem: "Failed to restart node",
@@ -48,9 +50,9 @@ var restartCmd = &cobra.Command{
 			return err
 		}
 		if flagOutput == "json" {
-			p.JSON(map[string]any{"ok": true, "action": "restart", "cosmovisor": true})
+			p.JSON(map[string]any{"ok": true, "action": "restart", "cosmovisor": withCosmovisor})
 		} else {
-			p.Success("✓ Node restarted with Cosmovisor")
+			p.Success("✓ Node restarted" + startedWith(withCosmovisor))
 			fmt.Println()
 			fmt.Println(p.Colors.Info("Useful commands:"))
 			fmt.Println(p.Colors.Apply(p.Colors.Theme.Command, "  push-validator status"))
@@ -66,5 +68,7 @@ var restartCmd = &cobra.Command{
 
 func init() {
 	restartCmd.Flags().StringVar(&restartBin, "bin", "", "Path to pchaind binary")
+	addCosmovisorFlags(restartCmd)
+	addRunGuards(restartCmd, guardIfRunning)
 	rootCmd.AddCommand(restartCmd)
 }
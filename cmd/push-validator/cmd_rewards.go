@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// rewardsFetcher queries a validator's claimable rewards;
+// validator.GetRawValidatorRewards in production.
+type rewardsFetcher func(ctx context.Context, cfg config.Config, addr string) (validator.Rewards, error)
+
+// handleRewards prints the commission and outstanding rewards of the local
+// validator, or of addr when set, without withdrawing anything.
+func handleRewards(d *Deps, addr string) error {
+	return handleRewardsWith(d, addr, validator.GetRawValidatorRewards)
+}
+
+// handleRewardsWith is the testable core of handleRewards.
+func handleRewardsWith(d *Deps, addr string, fetch rewardsFetcher) error {
+	if addr != "" {
+		prefix, _, err := validator.DecodeBech32(addr)
+		if err != nil || prefix != validator.ValoperPrefix {
+			return exitcodes.InvalidArgsErrorf("--address must be a %s... validator operator address", validator.ValoperPrefix)
+		}
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		myVal, err := d.Fetcher.GetMyValidator(ctx, d.Cfg)
+		cancel()
+		if err != nil {
+			return rewardsFailed(d, fmt.Errorf("failed to check validator status: %w", err))
+		}
+		if !myVal.IsValidator {
+			err := exitcodes.PreconditionErrorf("this node is not registered as a validator; pass --address to query another validator")
+			if flagOutput == "json" {
+				d.Printer.JSON(map[string]any{"ok": false, "error": err.Error()})
+				return silentErr{err}
+			}
+			return err
+		}
+		addr = myVal.Address
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	r, err := fetch(ctx, d.Cfg, addr)
+	cancel()
+	if err != nil {
+		return rewardsFailed(d, fmt.Errorf("failed to get rewards: %w", err))
+	}
+
+	unit := lookupDenom(d.Cfg.Denom)
+	if flagOutput == "json" {
+		d.Printer.JSON(map[string]any{
+			"ok":                true,
+			"validator_address": addr,
+			"denom":             d.Cfg.Denom,
+			"display_denom":     unit.Display,
+			"exponent":          unit.Exponent,
+			"commission":        rewardAmountEntry(r.Commission, unit),
+			"outstanding":       rewardAmountEntry(r.Outstanding, unit),
+		})
+		return nil
+	}
+
+	if flagOutput == "table" {
+		writeRewardsTable(os.Stdout, addr, r, d.Cfg.Denom, unit)
+		return nil
+	}
+
+	p := d.Printer
+	fmt.Println()
+	p.Header("Validator Rewards")
+	p.KeyValueLine("Validator", addr, "")
+	p.KeyValueLine("Commission", formatRewardAmount(r.Commission, d.Cfg.Denom, unit), "green")
+	p.KeyValueLine("Outstanding", formatRewardAmount(r.Outstanding, d.Cfg.Denom, unit), "green")
+	fmt.Println()
+	fmt.Println(p.Colors.Description("Read-only; nothing was withdrawn. To claim, run:"))
+	fmt.Println(p.Colors.Apply(p.Colors.Theme.Command, "  push-validator withdraw-rewards"))
+	fmt.Println()
+	return nil
+}
+
+// writeRewardsTable renders the rewards as a one-row table for --output
+// table, in display units.
+func writeRewardsTable(w io.Writer, addr string, r validator.Rewards, denom string, unit denomUnit) {
+	amount := func(a string) string {
+		if unit.Exponent == 0 {
+			return rawAmount(a)
+		}
+		return formatDenomAmount(a, unit.Exponent)
+	}
+	display := unit.Display
+	if unit.Exponent == 0 {
+		display = denom
+	}
+	writeOutputTable(w,
+		[]string{"VALIDATOR", "COMMISSION", "OUTSTANDING", "UNIT"},
+		[][]string{{addr, amount(r.Commission), amount(r.Outstanding), display}})
+}
+
+// rewardsFailed reports a lookup error as a network failure.
+func rewardsFailed(d *Deps, err error) error {
+	coded := exitcodes.NetworkErr(err.Error())
+	if flagOutput == "json" {
+		d.Printer.JSON(map[string]any{"ok": false, "error": err.Error()})
+		return silentErr{coded}
+	}
+	return coded
+}
+
+// rewardAmountEntry is the JSON view of a reward amount in raw and display units.
+func rewardAmountEntry(amount string, unit denomUnit) map[string]any {
+	return map[string]any{
+		"amount_raw":     rawAmount(amount),
+		"amount_display": formatDenomAmount(amount, unit.Exponent),
+	}
+}
+
+// formatRewardAmount renders amount as "1.5 PC (1500000000000000000 upc)".
+func formatRewardAmount(amount, denom string, unit denomUnit) string {
+	if unit.Exponent == 0 {
+		return fmt.Sprintf("%s %s", rawAmount(amount), denom)
+	}
+	return fmt.Sprintf("%s %s (%s %s)", formatDenomAmount(amount, unit.Exponent), unit.Display, rawAmount(amount), denom)
+}
+
+func init() {
+	var address string
+	rewardsCmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "Show claimable commission and outstanding rewards",
+		Long: `Show the validator's claimable commission and outstanding rewards, in both
+the base denom and display units. Nothing is withdrawn; use withdraw-rewards
+to claim them.
+
+By default the local validator is queried. Pass --address to look up any
+validator by its operator address.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleRewards(newDeps(), address)
+		},
+	}
+	rewardsCmd.Flags().StringVar(&address, "address", "", "Validator operator address (pushvaloper...) to query instead of this node")
+	rootCmd.AddCommand(rewardsCmd)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+func TestHandleRewards(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	var queried string
+	fetch := func(_ context.Context, _ config.Config, addr string) (validator.Rewards, error) {
+		queried = addr
+		return validator.Rewards{Commission: "1500000000000000000", Outstanding: "0"}, nil
+	}
+	for _, output := range []string{"text", "json", "table"} {
+		flagOutput = output
+		d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Fetcher: &mockFetcher{
+			myValidator: validator.MyValidatorInfo{IsValidator: true, Address: "pushvaloper1local"},
+		}}
+		if err := handleRewardsWith(d, "", fetch); err != nil {
+			t.Fatalf("%s: unexpected error: %v", output, err)
+		}
+		if queried != "pushvaloper1local" {
+			t.Errorf("%s: queried %q, want the local validator", output, queried)
+		}
+	}
+
+	other, err := validator.EncodeBech32("0x"+strings.Repeat("ab", 20), validator.ValoperPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flagOutput = "text"
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Fetcher: &mockFetcher{myValidatorErr: fmt.Errorf("unused")}}
+	if err := handleRewardsWith(d, other, fetch); err != nil {
+		t.Fatalf("--address: unexpected error: %v", err)
+	}
+	if queried != other {
+		t.Errorf("queried %q, want %q", queried, other)
+	}
+}
+
+func TestHandleRewards_Errors(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	ok := func(context.Context, config.Config, string) (validator.Rewards, error) {
+		return validator.Rewards{Commission: "0", Outstanding: "0"}, nil
+	}
+	failing := func(context.Context, config.Config, string) (validator.Rewards, error) {
+		return validator.Rewards{}, fmt.Errorf("connection refused")
+	}
+	registered := &mockFetcher{myValidator: validator.MyValidatorInfo{IsValidator: true, Address: "pushvaloper1local"}}
+
+	for _, output := range []string{"text", "json"} {
+		flagOutput = output
+		d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Fetcher: registered}
+		if err := handleRewardsWith(d, "", failing); err == nil || !strings.Contains(err.Error(), "connection refused") {
+			t.Errorf("%s: expected fetch error, got %v", output, err)
+		}
+
+		d.Fetcher = &mockFetcher{}
+		if err := handleRewardsWith(d, "", ok); err == nil || !strings.Contains(err.Error(), "not registered") {
+			t.Errorf("%s: expected not-a-validator error, got %v", output, err)
+		}
+	}
+
+	flagOutput = "text"
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Fetcher: registered}
+	for _, addr := range []string{"push1notavaloper", "0x1234"} {
+		if err := handleRewardsWith(d, addr, ok); err == nil || !strings.Contains(err.Error(), "--address") {
+			t.Errorf("%s: expected invalid address error, got %v", addr, err)
+		}
+	}
+}
+
+func TestFormatRewardAmount(t *testing.T) {
+	if got := formatRewardAmount("1500000000000000000", "upc", lookupDenom("upc")); got != "1.5 PC (1500000000000000000 upc)" {
+		t.Errorf("formatRewardAmount(upc) = %q", got)
+	}
+	if got := formatRewardAmount("42", "stake", lookupDenom("stake")); got != "42 stake" {
+		t.Errorf("formatRewardAmount(stake) = %q", got)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/node"
+)
+
+// rpcGetter fetches an RPC path from base; node.Get in production.
+type rpcGetter func(ctx context.Context, base, path string) ([]byte, error)
+
+// handleRPC GETs path from the RPC at base and writes the response to w:
+// indented JSON for --output json, YAML for --output yaml, raw otherwise.
+// Error bodies from the node are written too, since they carry the reason.
+func handleRPC(w io.Writer, base, path string, timeout time.Duration, get rpcGetter) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	body, err := get(ctx, base, path)
+	if len(body) > 0 {
+		writeRPCBody(w, body)
+	}
+	if err != nil {
+		return exitcodes.NetworkErrf("%s/%s: %v", base, path, err)
+	}
+	return nil
+}
+
+// writeRPCBody formats body per --output, falling back to the raw bytes when
+// it isn't JSON.
+func writeRPCBody(w io.Writer, body []byte) {
+	switch flagOutput {
+	case "json":
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, body, "", "  "); err == nil {
+			buf.WriteByte('\n')
+			_, _ = w.Write(buf.Bytes())
+			return
+		}
+	case "yaml":
+		var v any
+		if err := json.Unmarshal(body, &v); err == nil {
+			if data, err := yaml.Marshal(v); err == nil {
+				_, _ = w.Write(data)
+				return
+			}
+		}
+	}
+	_, _ = w.Write(body)
+	if !bytes.HasSuffix(body, []byte("\n")) {
+		fmt.Fprintln(w)
+	}
+}
+
+func init() {
+	var (
+		remote  bool
+		timeout time.Duration
+	)
+	rpcCmd := &cobra.Command{
+		Use:   "rpc <path>",
+		Short: "GET an arbitrary CometBFT RPC endpoint (e.g. net_info, 'block?height=100')",
+		Long: `Issue a GET against the node's CometBFT RPC and print the response.
+
+The path may include a query string; quote it so the shell leaves '?' and '&'
+alone:
+
+  push-validator rpc net_info
+  push-validator rpc 'block?height=100' -o json
+
+Requests go to the local RPC (--rpc) unless --remote is given, which targets
+the genesis node instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := loadCfg()
+			base := cfg.RPCLocal
+			if base == "" {
+				base = "http://127.0.0.1:26657"
+			}
+			if remote {
+				base = cfg.RemoteRPCURL()
+			}
+			return handleRPC(os.Stdout, base, args[0], timeout, node.Get)
+		},
+	}
+	rpcCmd.Flags().BoolVar(&remote, "remote", false, "Query the genesis RPC instead of the local node")
+	rpcCmd.Flags().DurationVar(&timeout, "timeout", 10*time.Second, "Request timeout")
+	rootCmd.AddCommand(rpcCmd)
+}
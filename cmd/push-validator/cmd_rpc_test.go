@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandleRPC(t *testing.T) {
+	origOutput := flagOutput
+	t.Cleanup(func() { flagOutput = origOutput })
+
+	body := []byte(`{"result":{"n_peers":"2"}}`)
+	var gotBase, gotPath string
+	get := func(ctx context.Context, base, path string) ([]byte, error) {
+		gotBase, gotPath = base, path
+		return body, nil
+	}
+
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{"text", `{"result":{"n_peers":"2"}}` + "\n"},
+		{"json", "{\n  \"result\": {\n    \"n_peers\": \"2\"\n  }\n}\n"},
+		{"yaml", "result:\n    n_peers: \"2\"\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.output, func(t *testing.T) {
+			flagOutput = tt.output
+			var buf bytes.Buffer
+			if err := handleRPC(&buf, "http://127.0.0.1:26657", "net_info", time.Second, get); err != nil {
+				t.Fatalf("handleRPC() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("output = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+	if gotBase != "http://127.0.0.1:26657" || gotPath != "net_info" {
+		t.Errorf("getter called with %q %q", gotBase, gotPath)
+	}
+
+	t.Run("error keeps body", func(t *testing.T) {
+		flagOutput = "text"
+		failing := func(ctx context.Context, base, path string) ([]byte, error) {
+			return []byte(`{"error":"bad height"}`), fmt.Errorf("RPC returned HTTP 500")
+		}
+		var buf bytes.Buffer
+		err := handleRPC(&buf, "http://127.0.0.1:26657", "block?height=x", time.Second, failing)
+		if err == nil || !strings.Contains(err.Error(), "HTTP 500") {
+			t.Fatalf("expected HTTP 500 error, got %v", err)
+		}
+		if !strings.Contains(buf.String(), "bad height") {
+			t.Errorf("error body not printed: %q", buf.String())
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+)
+
+// runGuards selects the node-state guard flags a command accepts.
+type runGuards int
+
+const (
+	guardIfStopped runGuards = 1 << iota // --only-if-stopped
+	guardIfRunning                       // --only-if-running
+)
+
+// runGuardFlags holds one command's guard flag values.
+type runGuardFlags struct {
+	ifStopped bool
+	ifRunning bool
+	strict    bool
+}
+
+// addRunGuards gives cmd the guard flags in guards, plus --guard-strict, and
+// checks them before its RunE. A command whose guard does not hold does
+// nothing and exits 0, or 3 (precondition failed) with --guard-strict, so
+// scripts can run it unconditionally.
+func addRunGuards(cmd *cobra.Command, guards runGuards) {
+	var g runGuardFlags
+	if guards&guardIfStopped != 0 {
+		cmd.Flags().BoolVar(&g.ifStopped, "only-if-stopped", false, "Do nothing (exit 0) if the node is already running")
+	}
+	if guards&guardIfRunning != 0 {
+		cmd.Flags().BoolVar(&g.ifRunning, "only-if-running", false, "Do nothing (exit 0) if the node is not running")
+	}
+	cmd.Flags().BoolVar(&g.strict, "guard-strict", false, "Exit 3 instead of 0 when an --only-if-* guard skips the command")
+
+	run := cmd.RunE
+	cmd.RunE = func(c *cobra.Command, args []string) error {
+		if !g.ifStopped && !g.ifRunning {
+			return run(c, args)
+		}
+		sup, _, err := selectSupervisor(loadCfg())
+		if err != nil {
+			return err
+		}
+		return runGuarded(c.Name(), sup.IsRunning(), g, func() error { return run(c, args) })
+	}
+}
+
+// runGuarded calls run when g's guard holds for a node that is or isn't
+// running, and otherwise reports the command as skipped.
+func runGuarded(name string, running bool, g runGuardFlags, run func() error) error {
+	if g.ifStopped && g.ifRunning {
+		return exitcodes.InvalidArgsError("--only-if-stopped and --only-if-running are mutually exclusive")
+	}
+	reason := ""
+	switch {
+	case g.ifStopped && running:
+		reason = "node is running (--only-if-stopped)"
+	case g.ifRunning && !running:
+		reason = "node is not running (--only-if-running)"
+	}
+	if reason == "" {
+		return run()
+	}
+
+	p := getPrinter()
+	if flagOutput == "json" {
+		p.JSON(map[string]any{"ok": !g.strict, "action": name, "skipped": true, "reason": reason})
+	} else {
+		p.Info(fmt.Sprintf("Skipped %s: %s", name, reason))
+	}
+	if g.strict {
+		return exitcodes.PreconditionErrorf("%s skipped: %s", name, reason)
+	}
+	return nil
+}
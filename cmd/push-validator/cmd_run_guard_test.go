@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+)
+
+func TestRunGuarded(t *testing.T) {
+	tests := []struct {
+		name     string
+		running  bool
+		g        runGuardFlags
+		wantRun  bool
+		wantCode int
+	}{
+		{"start while stopped", false, runGuardFlags{ifStopped: true}, true, exitcodes.Success},
+		{"start while running", true, runGuardFlags{ifStopped: true}, false, exitcodes.Success},
+		{"start while running, strict", true, runGuardFlags{ifStopped: true, strict: true}, false, exitcodes.PreconditionFailed},
+		{"stop while running", true, runGuardFlags{ifRunning: true}, true, exitcodes.Success},
+		{"stop while stopped", false, runGuardFlags{ifRunning: true}, false, exitcodes.Success},
+		{"stop while stopped, strict", false, runGuardFlags{ifRunning: true, strict: true}, false, exitcodes.PreconditionFailed},
+		{"both guards", true, runGuardFlags{ifStopped: true, ifRunning: true}, false, exitcodes.InvalidArgs},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ran := false
+			err := runGuarded("start", tt.running, tt.g, func() error { ran = true; return nil })
+			if ran != tt.wantRun {
+				t.Errorf("ran = %v, want %v", ran, tt.wantRun)
+			}
+			if code := exitcodes.CodeForError(err); code != tt.wantCode {
+				t.Errorf("exit code = %d (%v), want %d", code, err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAddRunGuards_Flags(t *testing.T) {
+	for _, tt := range []struct {
+		cmd       string
+		ifStopped bool
+		ifRunning bool
+	}{
+		{"start", true, false},
+		{"stop", false, true},
+		{"restart", false, true},
+		{"reset", true, false},
+		{"full-reset", true, false},
+		{"backup", true, true},
+	} {
+		cmd, _, err := rootCmd.Find([]string{tt.cmd})
+		if err != nil {
+			t.Fatalf("%s: %v", tt.cmd, err)
+		}
+		if got := cmd.Flags().Lookup("only-if-stopped") != nil; got != tt.ifStopped {
+			t.Errorf("%s --only-if-stopped = %v, want %v", tt.cmd, got, tt.ifStopped)
+		}
+		if got := cmd.Flags().Lookup("only-if-running") != nil; got != tt.ifRunning {
+			t.Errorf("%s --only-if-running = %v, want %v", tt.cmd, got, tt.ifRunning)
+		}
+		if cmd.Flags().Lookup("guard-strict") == nil {
+			t.Errorf("%s has no --guard-strict", tt.cmd)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/githubapi"
+	"github.com/pushchain/push-validator-cli/internal/node"
+	syncmon "github.com/pushchain/push-validator-cli/internal/sync"
+	"github.com/pushchain/push-validator-cli/internal/update"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// Canned chain and release data served by the self-test mock.
+const (
+	selfTestHeight  = 4242
+	selfTestMoniker = "self-test"
+	selfTestRelease = "v99.0.0"
+)
+
+// selfTestStep exercises one subsystem against the mock and returns a short
+// description of what it saw.
+type selfTestStep struct {
+	name string
+	run  func(serverURL, homeDir string) (string, error)
+}
+
+var selfTestSteps = []selfTestStep{
+	{"Node RPC", selfTestRPC},
+	{"Sync probe", selfTestSync},
+	{"Update check", selfTestUpdate},
+	{"Validators fetch", selfTestValidators},
+}
+
+// handleSelfTest runs every self-test step and reports pass/fail per subsystem.
+func handleSelfTest(d *Deps) error {
+	results, err := runSelfTest()
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Status != "pass" {
+			failed++
+		}
+	}
+
+	if flagOutput == "json" {
+		checks := make([]map[string]any, 0, len(results))
+		for _, r := range results {
+			checks = append(checks, map[string]any{"name": r.Name, "ok": r.Status == "pass", "detail": r.Message})
+		}
+		d.Printer.JSON(map[string]any{"ok": failed == 0, "checks": checks})
+		if failed > 0 {
+			return silentErr{exitcodes.ValidationErrf("self-test failed: %d of %d checks", failed, len(results))}
+		}
+		return nil
+	}
+
+	c := d.Printer.Colors
+	fmt.Println(c.Header(" SELF-TEST "))
+	fmt.Println()
+	for _, r := range results {
+		printCheck(r, c)
+	}
+	fmt.Println()
+	if failed > 0 {
+		fmt.Println(c.Error(fmt.Sprintf("✗ %d of %d checks failed", failed, len(results))))
+		return exitcodes.ValidationErrf("self-test failed: %d of %d checks", failed, len(results))
+	}
+	fmt.Println(c.Success(fmt.Sprintf("✓ All %d checks passed", len(results))))
+	return nil
+}
+
+// runSelfTest starts the mock server and a scratch home directory, then runs
+// each step. The returned error covers setup only; step failures are results.
+func runSelfTest() ([]checkResult, error) {
+	srv := newSelfTestServer()
+	defer srv.Close()
+
+	home, err := os.MkdirTemp("", "push-validator-selftest-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp home: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(home) }()
+
+	results := make([]checkResult, 0, len(selfTestSteps))
+	for _, step := range selfTestSteps {
+		detail, err := step.run(srv.URL, home)
+		r := checkResult{Name: step.name, Status: "pass", Message: detail}
+		if err != nil {
+			r.Status, r.Message = "fail", err.Error()
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// newSelfTestServer answers the node RPC (/status, /health) and the GitHub
+// release endpoints with canned data. GitHub clients reach it through
+// githubapi.RedirectTransport, so paths match the real API.
+func newSelfTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":-1,"result":{}}`))
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"result":{"node_info":{"id":"selftestnode","moniker":%q,"network":"push_42101-1"},"sync_info":{"latest_block_height":"%d","catching_up":false}}}`, selfTestMoniker, selfTestHeight)
+	})
+
+	asset := selfTestAssetName()
+	download := "https://github.com/pushchain/push-validator-cli/releases/download/" + selfTestRelease + "/"
+	mux.HandleFunc("/repos/pushchain/push-validator-cli/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(update.Release{
+			TagName: selfTestRelease,
+			Assets: []update.Asset{
+				{Name: asset, BrowserDownloadURL: download + asset, Size: 1024},
+				{Name: "checksums.txt", BrowserDownloadURL: download + "checksums.txt"},
+			},
+		})
+	})
+	mux.HandleFunc("/pushchain/push-validator-cli/releases/download/"+selfTestRelease+"/checksums.txt", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, "%064d  %s\n", 0, asset)
+	})
+	return httptest.NewServer(mux)
+}
+
+// selfTestAssetName is the release archive name update expects on this platform.
+func selfTestAssetName() string {
+	return fmt.Sprintf("push-validator_%s_%s_%s.tar.gz", selfTestRelease[1:], runtime.GOOS, runtime.GOARCH)
+}
+
+func selfTestRPC(serverURL, _ string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	st, err := node.New(serverURL).Status(ctx)
+	if err != nil {
+		return "", fmt.Errorf("status request failed: %w", err)
+	}
+	if st.Height != selfTestHeight || st.Moniker != selfTestMoniker || st.CatchingUp {
+		return "", fmt.Errorf("unexpected status: height=%d moniker=%q catching_up=%v", st.Height, st.Moniker, st.CatchingUp)
+	}
+	return fmt.Sprintf("status parsed (height %d, moniker %s)", st.Height, st.Moniker), nil
+}
+
+func selfTestSync(serverURL, _ string) (string, error) {
+	res := syncmon.Probe(serverURL, serverURL)
+	switch {
+	case !res.Alive:
+		return "", fmt.Errorf("/health did not answer")
+	case !res.Synced:
+		return "", fmt.Errorf("/status reported catching up")
+	case res.LocalHeight != selfTestHeight || res.RemoteHeight != selfTestHeight:
+		return "", fmt.Errorf("unexpected heights: local=%d remote=%d", res.LocalHeight, res.RemoteHeight)
+	}
+	return fmt.Sprintf("synced at height %d", res.LocalHeight), nil
+}
+
+func selfTestUpdate(serverURL, _ string) (string, error) {
+	target, err := url.Parse(serverURL)
+	if err != nil {
+		return "", err
+	}
+	client := &http.Client{Timeout: 10 * time.Second, Transport: &githubapi.RedirectTransport{Target: target}}
+	u, err := update.NewWith("v0.0.1", client)
+	if err != nil {
+		return "", err
+	}
+	res, err := u.Check()
+	if err != nil {
+		return "", fmt.Errorf("release check failed: %w", err)
+	}
+	if !res.UpdateAvailable || "v"+res.LatestVersion != selfTestRelease {
+		return "", fmt.Errorf("unexpected check result: latest=%s update_available=%v", res.LatestVersion, res.UpdateAvailable)
+	}
+	asset, err := update.GetAssetForPlatform(res.Release)
+	if err != nil {
+		return "", err
+	}
+	if err := u.ChecksumAvailable(res.Release, asset.Name); err != nil {
+		return "", fmt.Errorf("checksum lookup failed: %w", err)
+	}
+	return fmt.Sprintf("found %s with checksum for %s", selfTestRelease, asset.Name), nil
+}
+
+// selfTestPchaind answers the validator queries the fetcher makes.
+const selfTestPchaind = `#!/bin/sh
+case "$*" in
+*"staking validators"*)
+	echo '{"validators":[{"operator_address":"pushvaloper1selftesta","description":{"moniker":"alpha"},"status":"BOND_STATUS_BONDED","tokens":"2000000000000000000","commission":{"commission_rates":{"rate":"0.100000000000000000"}}},{"operator_address":"pushvaloper1selftestb","description":{"moniker":"beta"},"status":"BOND_STATUS_UNBONDED","tokens":"1000000000000000000","jailed":true}],"pagination":{"next_key":""}}'
+	;;
+*"slashing signing-infos"*)
+	echo '{"info":[],"pagination":{"next_key":""}}'
+	;;
+*)
+	exit 1
+	;;
+esac
+`
+
+func selfTestValidators(_, homeDir string) (string, error) {
+	binDir := filepath.Join(homeDir, "cosmovisor", "genesis", "bin")
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(binDir, "pchaind"), []byte(selfTestPchaind), 0o755); err != nil {
+		return "", fmt.Errorf("failed to write mock pchaind: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cfg := config.Config{HomeDir: homeDir, GenesisDomain: "self-test.invalid"}
+	list, err := validator.NewFetcher().GetAllValidators(ctx, cfg)
+	if err != nil {
+		return "", err
+	}
+	if list.Total != 2 || list.Validators[0].Moniker != "alpha" || list.Validators[0].Status != "BONDED" || !list.Validators[1].Jailed {
+		return "", fmt.Errorf("unexpected validator list: %+v", list.Validators)
+	}
+	return fmt.Sprintf("parsed %d validators from mock pchaind", list.Total), nil
+}
+
+func init() {
+	rootCmd.AddCommand(&cobra.Command{
+		Use:    "self-test",
+		Short:  "Exercise the CLI's RPC, sync, update and validator plumbing against a local mock",
+		Hidden: true,
+		Long: `Run the CLI's core code paths against an in-process mock of the node RPC
+and GitHub releases API, plus a mock pchaind in a temporary directory. Nothing
+touches a real chain, node or release. Useful for release gating and for
+checking that the CLI works on this host.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleSelfTest(newDeps())
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunSelfTest(t *testing.T) {
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	}
+	results, err := runSelfTest()
+	if err != nil {
+		t.Fatalf("runSelfTest() error = %v", err)
+	}
+	if len(results) != len(selfTestSteps) {
+		t.Fatalf("expected %d results, got %d", len(selfTestSteps), len(results))
+	}
+	for _, r := range results {
+		if r.Status != "pass" {
+			t.Errorf("%s: %s", r.Name, r.Message)
+		}
+	}
+}
+
+func TestSelfTestValidators_MissingMockFails(t *testing.T) {
+	// A file where the bin directory should be stops the mock being written
+	home := t.TempDir()
+	if err := os.WriteFile(filepath.Join(home, "cosmovisor"), []byte("not a dir"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := selfTestValidators("", home); err == nil {
+		t.Fatal("expected failure when the mock pchaind cannot be installed")
+	}
+}
+
+func TestHandleSelfTest_JSON(t *testing.T) {
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	}
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "json"
+
+	d := &Deps{Printer: testPrinter()}
+	if err := handleSelfTest(d); err != nil {
+		t.Fatalf("handleSelfTest() error = %v", err)
+	}
+}
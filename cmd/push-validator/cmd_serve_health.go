@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/metrics"
+)
+
+// healthReport is the JSON body of /healthz and /readyz.
+type healthReport struct {
+	OK           bool   `json:"ok"`
+	Running      bool   `json:"running"`
+	RPCListening bool   `json:"rpc_listening"`
+	CatchingUp   bool   `json:"catching_up"`
+	Height       int64  `json:"height,omitempty"`
+	RemoteHeight int64  `json:"remote_height,omitempty"`
+	BlocksBehind int64  `json:"blocks_behind,omitempty"`
+	Peers        int    `json:"peers,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// liveness fills the process and RPC fields of r and reports whether both
+// are up.
+func liveness(d *Deps, r *healthReport) bool {
+	r.Running = d.Sup.IsRunning()
+	r.RPCListening = r.Running && d.RPCCheck(d.Cfg.RPCHostPort(), 800*time.Millisecond)
+	switch {
+	case !r.Running:
+		r.Reason = "node process is not running"
+	case !r.RPCListening:
+		r.Reason = "node RPC is not listening"
+	}
+	return r.Running && r.RPCListening
+}
+
+// newHealthHandler serves /healthz, which is 200 while the node process runs
+// and its RPC listens, and /readyz, which is 200 only when the node is also
+// synced by the same test the start flow uses. Other responses are 503.
+// collect takes a metrics snapshot for the readiness check.
+func newHealthHandler(d *Deps, collect func(context.Context) metrics.Snapshot) http.Handler {
+	write := func(w http.ResponseWriter, r healthReport) {
+		w.Header().Set("Content-Type", "application/json")
+		if !r.OK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(r)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, req *http.Request) {
+		var r healthReport
+		r.OK = liveness(d, &r)
+		write(w, r)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, req *http.Request) {
+		var r healthReport
+		if !liveness(d, &r) {
+			write(w, r)
+			return
+		}
+		ctx, cancel := context.WithTimeout(req.Context(), 5*time.Second)
+		snap := collect(ctx)
+		cancel()
+		r.CatchingUp = snap.Chain.CatchingUp
+		r.Height = snap.Chain.LocalHeight
+		r.RemoteHeight = snap.Chain.RemoteHeight
+		r.Peers = snap.Network.Peers
+		if r.RemoteHeight > r.Height {
+			r.BlocksBehind = r.RemoteHeight - r.Height
+		}
+		switch {
+		case r.Height == 0:
+			r.Reason = "node status unavailable"
+		case !snap.Chain.Synced(d.Cfg.SyncTolerance):
+			r.Reason = fmt.Sprintf("node is syncing (%d blocks behind, tolerance %d)", r.BlocksBehind, d.Cfg.SyncTolerance)
+		default:
+			r.OK = true
+		}
+		write(w, r)
+	})
+	return mux
+}
+
+// serveHealth serves h on ln until ctx is cancelled, then shuts down,
+// letting in-flight probes finish.
+func serveHealth(ctx context.Context, ln net.Listener, h http.Handler) error {
+	srv := &http.Server{Handler: h, ReadHeaderTimeout: 5 * time.Second}
+	errc := make(chan error, 1)
+	go func() { errc <- srv.Serve(ln) }()
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	shutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutCtx); err != nil {
+		return err
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func init() {
+	var addr string
+	serveHealthCmd := &cobra.Command{
+		Use:   "serve-health",
+		Short: "Serve /healthz and /readyz for load balancers and probes",
+		Long: `Run a small HTTP server reporting node health, for load balancer and
+Kubernetes probes.
+
+  /healthz  200 while the node process runs and its RPC listens
+  /readyz   200 when the node is also synced: not catching up and within
+            --sync-tolerance blocks of the network (default 5)
+
+Other responses are 503. Both return a JSON body with the underlying values.
+The server stops cleanly on Ctrl+C or SIGTERM.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			d := newDeps()
+			ln, err := net.Listen("tcp", addr)
+			if err != nil {
+				return fmt.Errorf("serve-health: %w", err)
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer stop()
+			fmt.Fprintf(os.Stderr, "Serving /healthz and /readyz on %s\n", ln.Addr())
+			collect := func(ctx context.Context) metrics.Snapshot {
+				return metrics.NewWithoutCPU().Collect(ctx, d.Cfg.RPCLocal, d.Cfg.RemoteRPCURL())
+			}
+			return serveHealth(ctx, ln, newHealthHandler(d, collect))
+		},
+	}
+	serveHealthCmd.Flags().StringVar(&addr, "addr", ":8080", "Address to listen on")
+	rootCmd.AddCommand(serveHealthCmd)
+}
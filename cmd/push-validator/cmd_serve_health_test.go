@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/metrics"
+)
+
+func TestHealthHandler(t *testing.T) {
+	snapAt := func(local, remote int64, catchingUp bool) metrics.Snapshot {
+		var s metrics.Snapshot
+		s.Chain.LocalHeight, s.Chain.RemoteHeight, s.Chain.CatchingUp = local, remote, catchingUp
+		return s
+	}
+	tests := []struct {
+		name        string
+		running     bool
+		rpcUp       bool
+		snap        metrics.Snapshot
+		tolerance   int64
+		wantHealthz int
+		wantReadyz  int
+	}{
+		{"stopped", false, false, metrics.Snapshot{}, 5, 503, 503},
+		{"rpc down", true, false, metrics.Snapshot{}, 5, 503, 503},
+		{"catching up", true, true, snapAt(100, 100, true), 5, 200, 503},
+		{"behind tolerance", true, true, snapAt(100, 106, false), 5, 200, 503},
+		{"within tolerance", true, true, snapAt(100, 105, false), 5, 200, 200},
+		{"custom tolerance edge", true, true, snapAt(100, 120, false), 20, 200, 200},
+		{"past custom tolerance", true, true, snapAt(100, 121, false), 20, 200, 503},
+		{"zero tolerance", true, true, snapAt(100, 101, false), 0, 200, 503},
+		{"remote unknown", true, true, snapAt(100, 0, false), 5, 200, 200},
+		{"status unavailable", true, true, metrics.Snapshot{}, 5, 200, 503},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testCfg()
+			cfg.SyncTolerance = tt.tolerance
+			d := &Deps{
+				Cfg:      cfg,
+				Sup:      &mockSupervisor{running: tt.running},
+				RPCCheck: func(string, time.Duration) bool { return tt.rpcUp },
+			}
+			h := newHealthHandler(d, func(context.Context) metrics.Snapshot { return tt.snap })
+			for path, want := range map[string]int{"/healthz": tt.wantHealthz, "/readyz": tt.wantReadyz} {
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+				var body healthReport
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+					t.Fatalf("%s: invalid JSON %q: %v", path, rec.Body.String(), err)
+				}
+				if rec.Code != want || body.OK != (want == 200) {
+					t.Errorf("%s = %d %+v, want %d", path, rec.Code, body, want)
+				}
+				if !body.OK && body.Reason == "" {
+					t.Errorf("%s: failing response has no reason", path)
+				}
+			}
+		})
+	}
+}
+
+func TestServeHealth_ShutsDownOnCancel(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serveHealth(ctx, ln, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	}()
+
+	resp, err := http.Get("http://" + ln.Addr().String() + "/healthz")
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	resp.Body.Close()
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("serveHealth() = %v, want nil after cancel", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serveHealth did not return after cancel")
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// handleShowAddress prints the account address of keyName from the local
+// keyring. Unlike whoami it makes no chain queries.
+func handleShowAddress(d *Deps, keyName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	k, err := d.Validator.ShowKey(ctx, keyName)
+	if err != nil {
+		if flagOutput == "json" {
+			d.Printer.JSON(map[string]any{"ok": false, "error": err.Error(), "name": keyName})
+		} else {
+			d.Printer.Error(err.Error())
+			fmt.Println(d.Printer.Colors.Info("List available keys with: push-validator keys list"))
+		}
+		return silentErr{err}
+	}
+
+	if flagOutput == "json" {
+		d.Printer.JSON(map[string]any{
+			"ok":          true,
+			"name":        k.Name,
+			"address":     k.Address,
+			"evm_address": validator.Bech32ToHex(k.Address),
+		})
+		return nil
+	}
+	d.Printer.KeyValueLine("Address", k.Address, "blue")
+	d.Printer.KeyValueLine("EVM Address", validator.Bech32ToHex(k.Address), "blue")
+	return nil
+}
+
+func init() {
+	var keyName string
+	showAddressCmd := &cobra.Command{
+		Use:   "show-address",
+		Short: "Show the validator account address from the local keyring (offline)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleShowAddress(newDeps(), keyName)
+		},
+	}
+	showAddressCmd.Flags().StringVar(&keyName, "key", getenvDefault("KEY_NAME", "validator-key"), "Key name in the keyring (env: KEY_NAME)")
+	rootCmd.AddCommand(showAddressCmd)
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+func TestHandleShowAddress(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	mv := &mockValidator{showKeyResult: validator.KeyInfo{Name: "validator-key", Address: "push1abc"}}
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter(), Validator: mv}
+	if err := handleShowAddress(d, "validator-key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d.Validator = &mockValidator{showKeyErr: errMock}
+	if err := handleShowAddress(d, "missing"); err == nil {
+		t.Fatal("expected error for missing key")
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/snapshot"
 	"github.com/pushchain/push-validator-cli/internal/ui"
 	"github.com/spf13/cobra"
@@ -131,13 +134,113 @@ func runSnapshotExtractCore(ctx context.Context, svc snapshot.Service, cfg confi
 	return nil
 }
 
+// runSnapshotCreateCore stops the node (after confirming), optionally prunes
+// old state, archives data/ and restarts the node if it was running.
+func runSnapshotCreateCore(ctx context.Context, d *Deps, svc snapshot.Service, outPath string, prune bool) (err error) {
+	p := getPrinter()
+	cfg := d.Cfg
+
+	wasRunning := d.Sup.IsRunning()
+	if wasRunning {
+		if !flagYes {
+			if flagNonInteractive || flagOutput == "json" {
+				return exitcodes.PreconditionError("snapshot create stops the node: use --yes to confirm in non-interactive or JSON mode")
+			}
+			fmt.Println(p.Colors.Warning(p.Colors.Emoji("⚠️") + "  The node will be stopped while the snapshot is written and restarted afterwards"))
+			fmt.Println()
+			response, rErr := d.Prompter.ReadLine("Stop the node and create a snapshot? (y/N): ")
+			if rErr != nil || strings.ToLower(strings.TrimSpace(response)) != "y" {
+				fmt.Println(p.Colors.Info("Snapshot cancelled"))
+				return nil
+			}
+		}
+		if flagOutput != "json" {
+			fmt.Println(p.Colors.Info("Stopping node..."))
+		}
+		if stopErr := d.Sup.Stop(); stopErr != nil {
+			return fmt.Errorf("failed to stop node: %w", stopErr)
+		}
+		defer func() {
+			if flagOutput != "json" {
+				fmt.Println(p.Colors.Info("Restarting node..."))
+			}
+			_, startErr := d.Sup.Start(nodeStartOpts(cfg))
+			if startErr != nil && err == nil {
+				err = fmt.Errorf("snapshot written but node restart failed: %w", doubleSignRefusal(startErr))
+			}
+		}()
+	}
+
+	if prune {
+		if flagOutput != "json" {
+			fmt.Println(p.Colors.Info("Pruning old application state..."))
+		}
+		pruneCtx, cancel := context.WithTimeout(ctx, 2*time.Hour)
+		out, pruneErr := d.Runner.Run(pruneCtx, findPchaind(), "prune", "default", "--home", cfg.HomeDir)
+		cancel()
+		if pruneErr != nil {
+			return fmt.Errorf("pchaind prune failed: %w: %s", pruneErr, strings.TrimSpace(string(out)))
+		}
+	}
+
+	if flagOutput != "json" {
+		dim, reset := "\033[2m", "\033[0m"
+		if os.Getenv("NO_COLOR") != "" {
+			dim, reset = "", ""
+		}
+		dest := outPath
+		if dest == "" {
+			dest = snapshot.DefaultExportPath(cfg.HomeDir)
+		}
+		fmt.Printf("  %s%-12s %s%s\n", dim, "Source:", ui.ShortenPath(cfg.HomeDir+"/data"), reset)
+		fmt.Printf("  %s%-12s %s%s\n", dim, "Destination:", ui.ShortenPath(dest), reset)
+	}
+
+	res, err := svc.Create(ctx, snapshot.CreateOptions{
+		HomeDir:    cfg.HomeDir,
+		OutputPath: outPath,
+		Progress: func(phase snapshot.ProgressPhase, current, total int64, message string) {
+			if flagOutput != "json" && phase == snapshot.PhaseCreate && message != "" {
+				fmt.Printf("\r  → Archiving: %-60s", truncate(message, 60))
+			}
+		},
+	})
+	if flagOutput != "json" {
+		fmt.Println() // Clear archiving line
+	}
+	if err != nil {
+		return fmt.Errorf("snapshot create failed: %w", err)
+	}
+
+	if flagOutput == "json" {
+		p.JSON(map[string]any{
+			"ok":            true,
+			"path":          res.Path,
+			"checksum_path": res.ChecksumPath,
+			"sha256":        res.SHA256,
+			"size":          res.Size,
+			"files":         res.Files,
+			"pruned":        prune,
+			"node_stopped":  wasRunning,
+		})
+		return nil
+	}
+	p.Success(fmt.Sprintf("✓ Snapshot created: %s (%s, %d files)", res.Path, ui.FormatBytes(res.Size), res.Files))
+	p.KeyValueLine("Checksum", res.ChecksumPath, "dim")
+	p.KeyValueLine("SHA-256", res.SHA256, "dim")
+	fmt.Println()
+	fmt.Println(p.Colors.Info("Serve this directory as a snapshot URL; peers can use it with:"))
+	fmt.Println(p.Colors.Apply(p.Colors.Theme.Command, "  push-validator snapshot download --snapshot-url <url>"))
+	return nil
+}
+
 func init() {
 	var snapshotURL string
 
 	snapshotCmd := &cobra.Command{
 		Use:   "snapshot",
 		Short: "Snapshot management commands",
-		Long:  `Commands for managing blockchain snapshots, including downloading and verifying.`,
+		Long:  `Commands for managing blockchain snapshots: downloading, extracting and creating them.`,
 	}
 
 	downloadCmd := &cobra.Command{
@@ -196,8 +299,37 @@ Examples:
 	extractCmd.Flags().String("target", "", "Target directory for extraction (default: ~/.pchain/data)")
 	extractCmd.Flags().Bool("force", false, "Force extraction even if snapshot already exists")
 
+	// Create command
+	var (
+		createOut   string
+		createPrune bool
+	)
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Archive local chain data as a snapshot for other nodes",
+		Long: `Create a snapshot of this node's data directory for other operators.
+
+The node is stopped while the archive is written (you are asked to confirm
+unless --yes is set) and restarted afterwards. The archive has the same
+layout and naming that 'snapshot download' expects, with a sibling .sha256
+file, so the output directory can be served as a --snapshot-url as-is.
+
+priv_validator_state.json is never included: a node restored from the
+snapshot must not inherit this validator's signing state.
+
+Examples:
+  push-validator snapshot create
+  push-validator snapshot create --prune --out /srv/snapshots/latest.tar.lz4`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSnapshotCreateCore(cmd.Context(), newDeps(), snapshot.New(), createOut, createPrune)
+		},
+	}
+	createCmd.Flags().StringVar(&createOut, "out", "", "Archive path (default: ~/.pchain/snapshot-export/latest.tar.lz4)")
+	createCmd.Flags().BoolVar(&createPrune, "prune", false, "Run 'pchaind prune default' before archiving to drop old state")
+
 	snapshotCmd.AddCommand(downloadCmd)
 	snapshotCmd.AddCommand(extractCmd)
+	snapshotCmd.AddCommand(createCmd)
 	rootCmd.AddCommand(snapshotCmd)
 }
 
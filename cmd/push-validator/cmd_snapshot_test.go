@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/snapshot"
 )
 
@@ -17,6 +19,9 @@ type mockSnapshotService struct {
 	extractErr  error
 	cacheValid  bool
 	cacheErr    error
+	createRes   *snapshot.CreateResult
+	createErr   error
+	createOpts  snapshot.CreateOptions
 }
 
 func (m *mockSnapshotService) Download(ctx context.Context, opts snapshot.Options) error {
@@ -38,6 +43,14 @@ func (m *mockSnapshotService) IsCacheValid(ctx context.Context, opts snapshot.Op
 	return m.cacheValid, m.cacheErr
 }
 
+func (m *mockSnapshotService) Create(ctx context.Context, opts snapshot.CreateOptions) (*snapshot.CreateResult, error) {
+	m.createOpts = opts
+	if opts.Progress != nil {
+		opts.Progress(snapshot.PhaseCreate, 1, -1, "data/application.db/000001.log")
+	}
+	return m.createRes, m.createErr
+}
+
 func TestTruncate_Short(t *testing.T) {
 	result := truncate("short", 10)
 	if result != "short" {
@@ -441,3 +454,101 @@ func TestRunSnapshotDownloadCore_TextOutput_DownloadMessage(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestRunSnapshotCreateCore(t *testing.T) {
+	origOutput, origYes, origNonInteractive := flagOutput, flagYes, flagNonInteractive
+	defer func() { flagOutput, flagYes, flagNonInteractive = origOutput, origYes, origNonInteractive }()
+	res := &snapshot.CreateResult{Path: "/tmp/latest.tar.lz4", ChecksumPath: "/tmp/latest.tar.lz4.sha256", SHA256: "abc", Size: 1024, Files: 3}
+
+	t.Run("stops and restarts a running node", func(t *testing.T) {
+		flagOutput, flagYes = "text", true
+		sup := &mockSupervisor{running: true}
+		svc := &mockSnapshotService{createRes: res}
+		d := &Deps{Cfg: testCfg(), Sup: sup, Runner: newMockRunner()}
+		if err := runSnapshotCreateCore(context.Background(), d, svc, "/tmp/latest.tar.lz4", false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if svc.createOpts.OutputPath != "/tmp/latest.tar.lz4" || svc.createOpts.HomeDir != d.Cfg.HomeDir {
+			t.Errorf("unexpected create options: %+v", svc.createOpts)
+		}
+		if !sup.running {
+			t.Error("expected node to be restarted")
+		}
+	})
+
+	t.Run("stopped node is left stopped", func(t *testing.T) {
+		flagOutput, flagYes = "json", false
+		sup := &mockSupervisor{}
+		d := &Deps{Cfg: testCfg(), Sup: sup, Runner: newMockRunner()}
+		if err := runSnapshotCreateCore(context.Background(), d, &mockSnapshotService{createRes: res}, "", false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sup.running {
+			t.Error("node should not be started")
+		}
+	})
+
+	t.Run("declined confirmation", func(t *testing.T) {
+		flagOutput, flagYes, flagNonInteractive = "text", false, false
+		sup := &mockSupervisor{running: true}
+		svc := &mockSnapshotService{createRes: res}
+		d := &Deps{Cfg: testCfg(), Sup: sup, Prompter: &mockPrompter{responses: []string{"n"}}}
+		if err := runSnapshotCreateCore(context.Background(), d, svc, "", false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !sup.running || svc.createOpts.HomeDir != "" {
+			t.Error("declining should leave the node running and create nothing")
+		}
+	})
+
+	t.Run("non-interactive without --yes", func(t *testing.T) {
+		flagOutput, flagYes, flagNonInteractive = "text", false, true
+		d := &Deps{Cfg: testCfg(), Sup: &mockSupervisor{running: true}}
+		if err := runSnapshotCreateCore(context.Background(), d, &mockSnapshotService{}, "", false); err == nil {
+			t.Error("expected confirmation error")
+		}
+	})
+
+	t.Run("JSON output without --yes", func(t *testing.T) {
+		flagOutput, flagYes, flagNonInteractive = "json", false, false
+		sup := &mockSupervisor{running: true}
+		svc := &mockSnapshotService{createRes: res}
+		d := &Deps{Cfg: testCfg(), Sup: sup}
+		err := runSnapshotCreateCore(context.Background(), d, svc, "", false)
+		if err == nil || exitcodes.CodeForError(err) != exitcodes.PreconditionFailed {
+			t.Fatalf("expected precondition error, got %v", err)
+		}
+		if !sup.running || svc.createOpts.HomeDir != "" {
+			t.Error("node must not be stopped without --yes")
+		}
+	})
+
+	t.Run("prune failure restarts the node", func(t *testing.T) {
+		flagOutput, flagYes, flagNonInteractive = "text", true, false
+		sup := &mockSupervisor{running: true}
+		runner := newMockRunner()
+		cfg := testCfg()
+		runner.errors[findPchaind()+" prune default --home "+cfg.HomeDir] = fmt.Errorf("exit status 1")
+		svc := &mockSnapshotService{createRes: res}
+		d := &Deps{Cfg: cfg, Sup: sup, Runner: runner}
+		if err := runSnapshotCreateCore(context.Background(), d, svc, "", true); err == nil {
+			t.Fatal("expected prune error")
+		}
+		if svc.createOpts.HomeDir != "" {
+			t.Error("snapshot should not be created after a failed prune")
+		}
+		if !sup.running {
+			t.Error("expected node to be restarted after failure")
+		}
+	})
+
+	t.Run("restart failure is reported", func(t *testing.T) {
+		flagOutput, flagYes = "text", true
+		sup := &mockSupervisor{running: true, startErr: fmt.Errorf("port in use")}
+		d := &Deps{Cfg: testCfg(), Sup: sup, Runner: newMockRunner()}
+		err := runSnapshotCreateCore(context.Background(), d, &mockSnapshotService{createRes: res}, "", false)
+		if err == nil || !strings.Contains(err.Error(), "restart failed") {
+			t.Errorf("expected restart error, got %v", err)
+		}
+	})
+}
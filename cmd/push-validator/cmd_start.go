@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,8 +16,9 @@ import (
 	"github.com/pushchain/push-validator-cli/internal/admin"
 	"github.com/pushchain/push-validator-cli/internal/bootstrap"
 	"github.com/pushchain/push-validator-cli/internal/config"
-	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
 	"github.com/pushchain/push-validator-cli/internal/dashboard"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
 	"github.com/pushchain/push-validator-cli/internal/metrics"
 	"github.com/pushchain/push-validator-cli/internal/node"
 	"github.com/pushchain/push-validator-cli/internal/process"
@@ -27,8 +29,14 @@ import (
 )
 
 var (
-	startBin      string
-	startNoPrompt bool
+	startBin           string
+	startNoPrompt      bool
+	startWaitReady     bool
+	startMinPeers      int
+	startReadyTimeout  time.Duration
+	startResetPVState  bool
+	startDoubleSignAck bool
+	startLogFormat     string
 )
 
 var startCmd = &cobra.Command{
@@ -38,24 +46,36 @@ var startCmd = &cobra.Command{
 		cfg := loadCfg()
 		p := getPrinter()
 
-		// Check if initialization is needed (genesis.json or validator keys missing)
-		genesisPath := filepath.Join(cfg.HomeDir, "config", "genesis.json")
-		privValKeyPath := filepath.Join(cfg.HomeDir, "config", "priv_validator_key.json")
-		nodeKeyPath := filepath.Join(cfg.HomeDir, "config", "node_key.json")
-
-		// Initialize if genesis OR validator keys are missing
-		// (needed for first-time setup and post-full-reset scenarios)
-		needsInit := false
-		if _, err := os.Stat(genesisPath); os.IsNotExist(err) {
-			needsInit = true
+		portWarnings, err := cfg.ValidatePorts()
+		if err != nil {
+			return exitcodes.InvalidArgsError(err.Error())
 		}
-		if _, err := os.Stat(privValKeyPath); os.IsNotExist(err) {
-			needsInit = true
+		if flagOutput != "json" {
+			for _, w := range portWarnings {
+				p.Warn(w)
+			}
 		}
-		if _, err := os.Stat(nodeKeyPath); os.IsNotExist(err) {
-			needsInit = true
+		if startLogFormat != "" {
+			if err := files.NodeKeys["log_format"].Validate(startLogFormat); err != nil {
+				return exitcodes.InvalidArgsErrorf("invalid --log-format: %v", err)
+			}
 		}
 
+		// Pick the supervisor before any setup work so use_cosmovisor=always
+		// fails fast when Cosmovisor is missing
+		sup, detection, err := selectSupervisor(cfg)
+		if err != nil {
+			return err
+		}
+		withCosmovisor := usesCosmovisor(sup)
+		if withCosmovisor {
+			migrateLegacyLayout(cfg.HomeDir, &ttyPrompter{})
+		}
+
+		// Initialize if config, genesis or validator keys are missing
+		// (needed for first-time setup and post-full-reset scenarios)
+		needsInit := !homeInitialized(cfg.HomeDir)
+
 		if needsInit {
 			// Auto-initialize on first start
 			if flagOutput != "json" {
@@ -80,6 +100,7 @@ var startCmd = &cobra.Command{
 				SnapshotURL:      cfg.SnapshotURL,
 				Progress:         progressCallback,
 				SnapshotProgress: createSnapshotProgressCallback(flagOutput),
+				GenesisHash:      cfg.GenesisHash,
 			}); err != nil {
 				ui.PrintError(ui.ErrorMessage{
 					Problem: "Initialization failed",
@@ -103,8 +124,14 @@ var startCmd = &cobra.Command{
 			}
 		}
 
-		// If node is initialized but data is empty (e.g., post-reset), restore snapshot
-		if !needsInit && !snapshot.IsSnapshotPresent(cfg.HomeDir) {
+		// If node is initialized but data is empty (e.g., post-reset), restore
+		// snapshot, unless init configured state sync to fill it instead
+		if !needsInit && !snapshot.IsSnapshotPresent(cfg.HomeDir) && files.StateSyncEnabled(cfg.HomeDir) {
+			if flagOutput != "json" {
+				p.Info("No blockchain data found — state sync is enabled, the node will sync from a trusted block")
+				fmt.Println()
+			}
+		} else if !needsInit && !snapshot.IsSnapshotPresent(cfg.HomeDir) {
 			if flagOutput != "json" {
 				p.Info("No blockchain data found — restoring from snapshot...")
 				fmt.Println()
@@ -125,10 +152,7 @@ var startCmd = &cobra.Command{
 				return fmt.Errorf("extract snapshot: %w", err)
 			}
 			// Ensure priv_validator_state.json exists after extraction
-			pvsPath := filepath.Join(cfg.HomeDir, "data", "priv_validator_state.json")
-			if _, err := os.Stat(pvsPath); os.IsNotExist(err) {
-				_ = os.WriteFile(pvsPath, []byte(`{"height":"0","round":0,"step":0}`+"\n"), 0o644)
-			}
+			_ = files.EnsurePrivValState(cfg.HomeDir)
 			if flagOutput != "json" {
 				fmt.Println()
 				p.Success("Snapshot restored")
@@ -136,12 +160,7 @@ var startCmd = &cobra.Command{
 			}
 		}
 
-		// Verify cosmovisor is available
-		detection := cosmovisor.Detect(cfg.HomeDir)
-		if !detection.Available {
-			return fmt.Errorf("cosmovisor binary not found; install it or ensure it's in PATH")
-		}
-		if flagOutput != "json" && !detection.SetupComplete {
+		if flagOutput != "json" && withCosmovisor && !detection.SetupComplete {
 			p.Info("Initializing Cosmovisor...")
 		}
 
@@ -163,8 +182,6 @@ var startCmd = &cobra.Command{
 			}
 		}
 
-		sup := newSupervisor(cfg.HomeDir)
-
 		// Check if node is already running
 		isAlreadyRunning := sup.IsRunning()
 
@@ -176,7 +193,17 @@ var startCmd = &cobra.Command{
 					p.Success("Node is running")
 				}
 			} else {
-				fmt.Println("→ Starting node with Cosmovisor...")
+				fmt.Println("→ Starting node" + startedWith(withCosmovisor) + "...")
+			}
+		}
+
+		// A corrupt double-sign guard makes pchaind refuse to start
+		if !isAlreadyRunning {
+			if err := repairPrivValState(cfg.HomeDir, &ttyPrompter{}, startResetPVState); err != nil {
+				return err
+			}
+			if err := checkSignedHeight(cfg.HomeDir, startDoubleSignAck); err != nil {
+				return err
 			}
 		}
 
@@ -184,7 +211,21 @@ var startCmd = &cobra.Command{
 		if startBin != "" {
 			_ = os.Setenv("PCHAIND", startBin)
 		}
-		_, err := sup.Start(process.StartOpts{HomeDir: cfg.HomeDir, Moniker: os.Getenv("MONIKER"), BinPath: findPchaind()})
+		// Persist the ports in config.toml and app.toml so later restarts
+		// (including the peer-refresh cron) and other commands pick them up
+		if rpcPort, p2pPort := files.ListenPorts(cfg.HomeDir); rpcPort != cfg.RPCPort || p2pPort != cfg.P2PPort {
+			_ = files.New(cfg.HomeDir).SetListenPorts(cfg.RPCPort, cfg.P2PPort)
+		}
+		if jsonPort, wsPort := files.JSONRPCPorts(cfg.HomeDir); jsonPort != cfg.JSONRPCPort || wsPort != cfg.JSONRPCWSPort {
+			_ = files.New(cfg.HomeDir).SetJSONRPCPorts(cfg.JSONRPCPort, cfg.JSONRPCWSPort)
+		}
+		// Persisted like the ports so restarts keep the format
+		if startLogFormat != "" {
+			if err := files.SetNodeConfig(cfg.HomeDir, "log_format", startLogFormat); err != nil {
+				return fmt.Errorf("failed to set log_format: %w", err)
+			}
+		}
+		_, err = sup.Start(nodeStartOpts(cfg))
 		if err != nil {
 			ui.PrintError(ui.ErrorMessage{
 				Problem: "Failed to start node",
@@ -196,7 +237,7 @@ var startCmd = &cobra.Command{
 				Actions: []string{
 					"Check: ls <home>/config/genesis.json",
 					"Confirm pchaind version matches network",
-					"Verify ports 26656/26657 are available",
+					fmt.Sprintf("Verify ports %d/%d are available (or choose others with --p2p-port/--rpc-port)", cfg.P2PPort, cfg.RPCPort),
 				},
 			})
 			return err
@@ -213,7 +254,7 @@ var startCmd = &cobra.Command{
 			if !sup.IsRunning() {
 				break // process already exited — no point waiting
 			}
-			if process.IsRPCListening("127.0.0.1:26657", 800*time.Millisecond) {
+			if process.IsRPCListening(cfg.RPCHostPort(), 800*time.Millisecond) {
 				nodeAlive = true
 				break
 			}
@@ -248,40 +289,186 @@ var startCmd = &cobra.Command{
 			return fmt.Errorf("node process is not running")
 		}
 
-		if flagOutput == "json" {
-			p.JSON(map[string]any{"ok": true, "action": "start", "already_running": isAlreadyRunning, "cosmovisor": true})
-		} else {
-			if !isAlreadyRunning {
-				p.Success("Node started with Cosmovisor")
-			}
+		return finishStart(cmd.Context(), cfg, p, isAlreadyRunning, withCosmovisor)
+	},
+}
 
-			// Install peer refresh cron job (silent, idempotent)
-			if err := node.InstallPeerRefreshCron(cfg.HomeDir); err != nil {
-				// Non-fatal - just log if verbose
-				if flagVerbose {
-					fmt.Printf("  [DEBUG] Could not install peer refresh cron: %v\n", err)
-				}
+// Post-start steps, replaceable in tests.
+var (
+	installPeerRefreshCron = node.InstallPeerRefreshCron
+	postStartFlow          = handlePostStartFlow
+)
+
+// finishStart is what start does once the node process is up: the optional
+// --wait-ready gate, the result, and the post-start flow that waits for sync
+// and offers registration. None of it changes the home, so the home lock is
+// released first and stop or restart work from another terminal meanwhile.
+// The flow's snapshot recovery steps take the lock again (see lockHome).
+func finishStart(ctx context.Context, cfg config.Config, p ui.Printer, isAlreadyRunning, withCosmovisor bool) error {
+	releaseHomeLock()
+
+	var ready *readiness
+	if startWaitReady {
+		if flagOutput != "json" {
+			fmt.Printf("→ Waiting for RPC and at least %d peer(s) (timeout %s)...\n", startMinPeers, startReadyTimeout)
+		}
+		r := waitForReady(ctx, node.New(cfg.RPCLocal), cfg.RPCHostPort(), startMinPeers, startReadyTimeout, 2*time.Second, process.IsRPCListening)
+		ready = &r
+		if !r.Ready {
+			err := exitcodes.NetworkErrf("node not ready after %s: rpc_listening=%v, peers %d/%d", startReadyTimeout, r.RPCListening, r.Peers, r.MinPeers)
+			if flagOutput == "json" {
+				p.JSON(map[string]any{"ok": false, "action": "start", "already_running": isAlreadyRunning, "cosmovisor": withCosmovisor, "ready": r, "error": err.Error()})
+				return silentErr{err}
 			}
+			return err
+		}
+	}
 
-			// Check validator status and show appropriate next steps (skip if --no-prompt)
-			if !startNoPrompt {
-				fmt.Println()
-				if !handlePostStartFlow(cfg, &p) {
-					// If post-start flow fails, just continue (node is already started)
-					return nil
-				}
+	if flagOutput == "json" {
+		out := map[string]any{"ok": true, "action": "start", "already_running": isAlreadyRunning, "cosmovisor": withCosmovisor}
+		if ready != nil {
+			out["ready"] = *ready
+		}
+		p.JSON(out)
+	} else {
+		if !isAlreadyRunning {
+			p.Success("Node started" + startedWith(withCosmovisor))
+		}
+		if ready != nil {
+			p.Success(fmt.Sprintf("Node ready: RPC listening, %d peer(s) connected", ready.Peers))
+		}
+
+		// Install peer refresh cron job (silent, idempotent)
+		if err := installPeerRefreshCron(cfg.HomeDir); err != nil {
+			// Non-fatal, only worth a debug line
+			ui.Log().Debugf("Could not install peer refresh cron: %v", err)
+		}
+
+		// Check validator status and show appropriate next steps (skip if --no-prompt)
+		if !startNoPrompt {
+			fmt.Println()
+			if !postStartFlow(cfg, &p) {
+				// If post-start flow fails, just continue (node is already started)
+				return nil
 			}
 		}
-		return nil
-	},
+	}
+	return nil
 }
 
 func init() {
 	startCmd.Flags().StringVar(&startBin, "bin", "", "Path to pchaind binary")
 	startCmd.Flags().BoolVar(&startNoPrompt, "no-prompt", false, "Skip post-start prompts (for use in scripts)")
+	startCmd.Flags().BoolVar(&startWaitReady, "wait-ready", false, "Block until RPC is listening and the node has peers")
+	startCmd.Flags().IntVar(&startMinPeers, "min-peers", 1, "Peers required by --wait-ready")
+	startCmd.Flags().DurationVar(&startReadyTimeout, "ready-timeout", 2*time.Minute, "How long --wait-ready waits before failing")
+	startCmd.Flags().StringVar(&startLogFormat, "log-format", "", "Node log format to save in config.toml: plain or json")
+	startCmd.Flags().BoolVar(&startResetPVState, "reset-priv-val-state", false, "Replace a corrupt priv_validator_state.json without prompting (only if this key signs nowhere else)")
+	startCmd.Flags().BoolVar(&startDoubleSignAck, "i-understand-double-sign-risk", false, "Start although priv_validator_state.json is below the last recorded signed height, as after a reset")
+	addCosmovisorFlags(startCmd)
+	addRunGuards(startCmd, guardIfStopped)
 	rootCmd.AddCommand(startCmd)
 }
 
+// repairPrivValState offers to replace a corrupt priv_validator_state.json
+// with the zero stub. The file is the node's double-sign guard, so this only
+// runs with the node stopped and after the operator confirms the key is not
+// signing anywhere else, either at the prompt or with --reset-priv-val-state.
+// --yes alone is never enough.
+func repairPrivValState(home string, prompter Prompter, reset bool) error {
+	err := files.CheckPrivValState(home)
+	if err == nil || !errors.Is(err, files.ErrPrivValStateCorrupt) {
+		return err
+	}
+
+	p := getPrinter()
+	if !reset {
+		if flagNonInteractive || flagOutput == "json" || !prompter.IsInteractive() {
+			return exitcodes.PreconditionErrorf("%v; once you are sure this validator key is not running on another machine, rerun with --reset-priv-val-state to replace it", err)
+		}
+		fmt.Println(p.Colors.Warning(p.Colors.Emoji("⚠️") + "  " + err.Error()))
+		fmt.Println("   This file records the last height the validator signed, to prevent double-signing.")
+		fmt.Println("   Only replace it if this validator key is not running on any other machine.")
+		fmt.Println()
+		response, rerr := prompter.ReadLine("Replace it with a fresh state file? (y/N): ")
+		if rerr != nil || strings.ToLower(strings.TrimSpace(response)) != "y" {
+			return exitcodes.PreconditionErrorf("%v; not replaced", err)
+		}
+	}
+
+	moved, err := files.ResetPrivValState(home)
+	if err != nil {
+		return fmt.Errorf("failed to replace priv_validator_state.json: %w", err)
+	}
+	if flagOutput != "json" {
+		p.Success(fmt.Sprintf("Wrote fresh priv_validator_state.json (corrupt copy kept at %s)", moved))
+	}
+	return nil
+}
+
+// checkSignedHeight refuses to start while priv_validator_state.json is
+// below the height reset or backup recorded the validator as having signed,
+// since the zeroed double-sign guard would let it sign those heights again.
+// With accept the start goes ahead and the record is cleared, so the
+// operator is asked once.
+func checkSignedHeight(home string, accept bool) error {
+	err := files.CheckSignedHeight(home)
+	if err == nil || !errors.Is(err, files.ErrPrivValStateBehind) {
+		return err
+	}
+	if !accept {
+		return exitcodes.PreconditionErrorf("%v; starting now risks double-signing. If this validator key is not running on another machine, rerun with --i-understand-double-sign-risk", err)
+	}
+	if flagOutput != "json" {
+		getPrinter().Warn(fmt.Sprintf("%v; starting anyway (--i-understand-double-sign-risk)", err))
+	}
+	return files.ClearSignedHeight(home)
+}
+
+// doubleSignRefusal explains a supervisor refusing to start the node below
+// the recorded signed height, and how to override it from start. Other
+// errors are returned unchanged.
+func doubleSignRefusal(err error) error {
+	if !errors.Is(err, files.ErrPrivValStateBehind) {
+		return err
+	}
+	return exitcodes.PreconditionErrorf("%v; starting now risks double-signing. If this validator key is not running on another machine, run 'push-validator start --i-understand-double-sign-risk'", err)
+}
+
+// readiness is the outcome of the start --wait-ready gate.
+type readiness struct {
+	Ready        bool `json:"ready"`
+	RPCListening bool `json:"rpc_listening"`
+	Peers        int  `json:"peers"`
+	MinPeers     int  `json:"min_peers"`
+}
+
+// waitForReady polls until RPC answers on hostport and cli reports at least
+// minPeers peers, or timeout elapses. It only checks that the node can
+// broadcast; it does not wait for sync.
+func waitForReady(ctx context.Context, cli node.Client, hostport string, minPeers int, timeout, poll time.Duration, rpcCheck func(string, time.Duration) bool) readiness {
+	r := readiness{MinPeers: minPeers}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		r.RPCListening = rpcCheck(hostport, 800*time.Millisecond)
+		if r.RPCListening {
+			if peers, err := cli.Peers(ctx); err == nil {
+				r.Peers = len(peers)
+			}
+		}
+		if r.RPCListening && r.Peers >= minPeers {
+			r.Ready = true
+			return r
+		}
+		select {
+		case <-ctx.Done():
+			return r
+		case <-time.After(poll):
+		}
+	}
+}
+
 // defaultSnapshotSyncThreshold is the number of blocks behind the chain tip
 // at which the CLI will proactively download a fresh snapshot rather than
 // syncing block-by-block. Override via PUSH_SNAPSHOT_THRESHOLD env var.
@@ -305,21 +492,13 @@ func handlePostStartFlow(cfg config.Config, p *ui.Printer) bool {
 
 	collector := metrics.NewWithoutCPU()
 	syncCtx, syncCancel := context.WithTimeout(context.Background(), 5*time.Second)
-	snap := collector.Collect(syncCtx, "http://127.0.0.1:26657", cfg.GenesisDomain)
+	snap := collector.Collect(syncCtx, cfg.RPCLocal, cfg.GenesisDomain)
 	syncCancel()
 
-	// Consider synced only if:
-	// 1. CatchingUp is false AND
-	// 2. Local height is within 5 blocks of remote height (or remote height unavailable)
-	const syncTolerance = 5
-	isSyncing := snap.Chain.CatchingUp ||
-		(snap.Chain.RemoteHeight > 0 && snap.Chain.LocalHeight < snap.Chain.RemoteHeight-syncTolerance)
-
-	// DEBUG: Log sync status if verbose
-	if flagVerbose {
-		fmt.Printf("[DEBUG] Sync Check: CatchingUp=%v, LocalHeight=%d, RemoteHeight=%d, IsSyncing=%v\n",
-			snap.Chain.CatchingUp, snap.Chain.LocalHeight, snap.Chain.RemoteHeight, isSyncing)
-	}
+	isSyncing := !snap.Chain.Synced(cfg.SyncTolerance) && !overrideSyncCheck(os.Stderr)
+
+	ui.Log().Debugf("Sync check: catching_up=%v local_height=%d remote_height=%d syncing=%v",
+		snap.Chain.CatchingUp, snap.Chain.LocalHeight, snap.Chain.RemoteHeight, isSyncing)
 
 	if isSyncing {
 		// Node is still syncing - wait for sync to complete before validator checks
@@ -339,7 +518,11 @@ func handlePostStartFlow(cfg config.Config, p *ui.Printer) bool {
 			}
 
 			snapshotErr := func() error {
-				sup := newSupervisor(cfg.HomeDir)
+				if err := lockHome(cfg.HomeDir, "push-validator start"); err != nil {
+					return err
+				}
+				defer releaseHomeLock()
+				sup := newSupervisor(cfg)
 
 				fmt.Println(p.Colors.Info("    Stopping node..."))
 				if err := sup.Stop(); err != nil {
@@ -376,19 +559,12 @@ func handlePostStartFlow(cfg config.Config, p *ui.Printer) bool {
 				}
 
 				// Ensure priv_validator_state.json exists after extraction
-				pvsPath := filepath.Join(cfg.HomeDir, "data", "priv_validator_state.json")
-				if _, err := os.Stat(pvsPath); os.IsNotExist(err) {
-					_ = os.WriteFile(pvsPath, []byte(`{"height":"0","round":0,"step":0}`+"\n"), 0o644)
-				}
+				_ = files.EnsurePrivValState(cfg.HomeDir)
 
 				fmt.Println(p.Colors.Info("    Restarting node..."))
-				_, err := sup.Start(process.StartOpts{
-					HomeDir: cfg.HomeDir,
-					Moniker: os.Getenv("MONIKER"),
-					BinPath: findPchaind(),
-				})
+				_, err := sup.Start(nodeStartOpts(cfg))
 				if err != nil {
-					return fmt.Errorf("restart failed: %w", err)
+					return fmt.Errorf("restart failed: %w", doubleSignRefusal(err))
 				}
 				time.Sleep(5 * time.Second)
 				return nil
@@ -410,11 +586,15 @@ func handlePostStartFlow(cfg config.Config, p *ui.Printer) bool {
 
 		// Wait for sync to complete using sync monitor
 		// Use correct supervisor based on whether Cosmovisor is available (determines log path)
-		sup := newSupervisor(cfg.HomeDir)
+		sup := newSupervisor(cfg)
 		remoteURL := cfg.RemoteRPCURL()
 
 		// Create reset function for retry logic
 		resetFunc := func() error {
+			if err := lockHome(cfg.HomeDir, "push-validator start"); err != nil {
+				return err
+			}
+			defer releaseHomeLock()
 			fmt.Println(p.Colors.Info("    Stopping node..."))
 			if err := sup.Stop(); err != nil {
 				// Ignore stop errors - node might not be running
@@ -447,13 +627,9 @@ func handlePostStartFlow(cfg config.Config, p *ui.Printer) bool {
 			}
 
 			fmt.Println(p.Colors.Info("    Restarting node..."))
-			_, err := sup.Start(process.StartOpts{
-				HomeDir: cfg.HomeDir,
-				Moniker: os.Getenv("MONIKER"),
-				BinPath: findPchaind(),
-			})
+			_, err := sup.Start(nodeStartOpts(cfg))
 			if err != nil {
-				return fmt.Errorf("restart failed: %w", err)
+				return fmt.Errorf("restart failed: %w", doubleSignRefusal(err))
 			}
 			time.Sleep(5 * time.Second) // Give node time to initialize
 			return nil
@@ -461,16 +637,16 @@ func handlePostStartFlow(cfg config.Config, p *ui.Printer) bool {
 
 		syncErr := syncmon.RunWithRetry(context.Background(), syncmon.RetryOptions{
 			Options: syncmon.Options{
-				LocalRPC:     "http://127.0.0.1:26657",
-				RemoteRPC:    remoteURL,
-				LogPath:      sup.LogPath(),
-				Window:       30,
-				Compact:      false,
-				Out:          os.Stdout,
-				Interval:     120 * time.Millisecond,
-				Quiet:        flagQuiet,
-				Debug:        flagDebug,
-				StuckTimeout: 30 * time.Minute, // Detect stuck sync
+				LocalRPC:      cfg.RPCLocal,
+				RemoteRPC:     remoteURL,
+				LogPath:       sup.LogPath(),
+				Window:        30,
+				Compact:       false,
+				Out:           os.Stdout,
+				Interval:      120 * time.Millisecond,
+				Quiet:         flagQuiet,
+				StuckTimeout:  30 * time.Minute, // Detect stuck sync
+				SyncTolerance: cfg.SyncTolerance,
 			},
 			MaxRetries: 3,
 			ResetFunc:  resetFunc,
@@ -484,8 +660,8 @@ func handlePostStartFlow(cfg config.Config, p *ui.Printer) bool {
 				printNodeUnavailableAfterSyncFailure(p, sup, "Node process stopped during sync")
 				return false
 			}
-			if !process.IsRPCListening("127.0.0.1:26657", 800*time.Millisecond) {
-				printNodeUnavailableAfterSyncFailure(p, sup, "Node process is running but RPC is not listening on 127.0.0.1:26657")
+			if !process.IsRPCListening(cfg.RPCHostPort(), 800*time.Millisecond) {
+				printNodeUnavailableAfterSyncFailure(p, sup, "Node process is running but RPC is not listening on "+cfg.RPCHostPort())
 				return false
 			}
 			fmt.Println(p.Colors.Apply(p.Colors.Theme.Description, "    Try: push-validator reset && push-validator start"))
@@ -517,9 +693,7 @@ func handlePostStartFlow(cfg config.Config, p *ui.Printer) bool {
 	valResult := checkValidatorRegistration(v, 2)
 
 	if valResult.Error != nil {
-		if flagVerbose {
-			fmt.Printf("  [DEBUG] IsValidator error: %v\n", valResult.Error)
-		}
+		ui.Log().Debugf("IsValidator error: %v", valResult.Error)
 		fmt.Println(p.Colors.Warning("  " + p.Colors.Emoji("⚠") + " Could not verify validator status (will retry in dashboard)"))
 		showDashboardPrompt(cfg, p)
 		return false
@@ -596,7 +770,7 @@ func (prodDashboardRunner) Run(cfg config.Config) error {
 		NoEmoji:         flagNoEmoji,
 		CLIVersion:      Version,
 		Debug:           false,
-		Supervisor:      newSupervisor(cfg.HomeDir),
+		Supervisor:      newSupervisor(cfg),
 		BinPath:         findPchaind(),
 	}
 	return runDashboardInteractive(opts)
@@ -750,6 +924,15 @@ func createSnapshotProgressCallback(output string) snapshot.ProgressFunc {
 		}
 		switch phase {
 		case snapshot.PhaseDownload:
+			if total <= 0 && message != "" {
+				// Status lines (resume, retry, interruption) between byte updates
+				if bar != nil {
+					fmt.Println()
+					bar = nil
+				}
+				fmt.Printf("  → %s\n", message)
+				return
+			}
 			if bar == nil && total > 0 {
 				bar = ui.NewProgressBar(os.Stdout, total)
 			}
@@ -1,12 +1,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/lockfile"
+	"github.com/pushchain/push-validator-cli/internal/node"
 	"github.com/pushchain/push-validator-cli/internal/snapshot"
+	ui "github.com/pushchain/push-validator-cli/internal/ui"
 )
 
 func TestCreateSnapshotProgressCallback_JSONMode(t *testing.T) {
@@ -32,6 +41,15 @@ func TestCreateSnapshotProgressCallback_Download_NoTotal(t *testing.T) {
 	cb(snapshot.PhaseDownload, 200, -1, "")
 }
 
+func TestCreateSnapshotProgressCallback_Download_StatusMessage(t *testing.T) {
+	cb := createSnapshotProgressCallback("text")
+	// Retry/interruption messages arrive with unknown total and reset the bar
+	cb(snapshot.PhaseDownload, 0, 1000, "")
+	cb(snapshot.PhaseDownload, 500, 1000, "1.0 MB/s, ETA 1s")
+	cb(snapshot.PhaseDownload, 0, -1, "Retry 1/3 (waiting 2s)...")
+	cb(snapshot.PhaseDownload, 500, 1000, "")
+}
+
 func TestCreateSnapshotProgressCallback_Verify_WithMessage(t *testing.T) {
 	cb := createSnapshotProgressCallback("text")
 	// Test verify phase prints message
@@ -266,3 +284,191 @@ func TestReadLogTail_MissingFile(t *testing.T) {
 		t.Fatalf("readLogTail() = %q, want empty string", got)
 	}
 }
+
+func TestWaitForReady(t *testing.T) {
+	listening := func(string, time.Duration) bool { return true }
+	notListening := func(string, time.Duration) bool { return false }
+	twoPeers := &mockNodeClient{peers: []node.Peer{{ID: "a"}, {ID: "b"}}}
+
+	t.Run("ready", func(t *testing.T) {
+		r := waitForReady(context.Background(), twoPeers, "127.0.0.1:26657", 1, time.Second, 10*time.Millisecond, listening)
+		if !r.Ready || !r.RPCListening || r.Peers != 2 || r.MinPeers != 1 {
+			t.Errorf("unexpected readiness: %+v", r)
+		}
+	})
+
+	t.Run("too few peers", func(t *testing.T) {
+		r := waitForReady(context.Background(), twoPeers, "127.0.0.1:26657", 3, 50*time.Millisecond, 10*time.Millisecond, listening)
+		if r.Ready || r.Peers != 2 {
+			t.Errorf("unexpected readiness: %+v", r)
+		}
+	})
+
+	t.Run("rpc down", func(t *testing.T) {
+		r := waitForReady(context.Background(), twoPeers, "127.0.0.1:26657", 1, 50*time.Millisecond, 10*time.Millisecond, notListening)
+		if r.Ready || r.RPCListening || r.Peers != 0 {
+			t.Errorf("unexpected readiness: %+v", r)
+		}
+	})
+
+	t.Run("peers error", func(t *testing.T) {
+		cli := &mockNodeClient{peersErr: fmt.Errorf("connection refused")}
+		r := waitForReady(context.Background(), cli, "127.0.0.1:26657", 1, 50*time.Millisecond, 10*time.Millisecond, listening)
+		if r.Ready || !r.RPCListening {
+			t.Errorf("unexpected readiness: %+v", r)
+		}
+	})
+}
+
+func TestRepairPrivValState(t *testing.T) {
+	origYes, origNonInteractive, origOutput := flagYes, flagNonInteractive, flagOutput
+	t.Cleanup(func() { flagYes, flagNonInteractive, flagOutput = origYes, origNonInteractive, origOutput })
+	flagOutput = "text"
+
+	corrupt := func(t *testing.T) string {
+		home := t.TempDir()
+		_ = os.MkdirAll(filepath.Join(home, "data"), 0o755)
+		_ = os.WriteFile(files.PrivValStatePath(home), []byte(`{"height":"5`), 0o644)
+		return home
+	}
+
+	t.Run("valid state untouched", func(t *testing.T) {
+		home := t.TempDir()
+		if err := repairPrivValState(home, &nonInteractivePrompter{}, false); err != nil {
+			t.Fatalf("repairPrivValState() = %v", err)
+		}
+	})
+
+	t.Run("non-interactive refuses", func(t *testing.T) {
+		flagYes = false
+		home := corrupt(t)
+		err := repairPrivValState(home, &nonInteractivePrompter{}, false)
+		if err == nil || !strings.Contains(err.Error(), "--reset-priv-val-state") {
+			t.Fatalf("expected --reset-priv-val-state hint, got %v", err)
+		}
+		if files.CheckPrivValState(home) == nil {
+			t.Error("state was replaced without confirmation")
+		}
+	})
+
+	t.Run("--yes alone refuses", func(t *testing.T) {
+		flagYes = true
+		defer func() { flagYes = false }()
+		home := corrupt(t)
+		err := repairPrivValState(home, &nonInteractivePrompter{}, false)
+		if err == nil || exitcodes.CodeForError(err) != exitcodes.PreconditionFailed {
+			t.Fatalf("expected precondition error with bare --yes, got %v", err)
+		}
+		if files.CheckPrivValState(home) == nil {
+			t.Error("state was replaced under a bare --yes")
+		}
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		flagYes = false
+		home := corrupt(t)
+		if err := repairPrivValState(home, &mockPrompter{responses: []string{"n"}, interactive: true}, false); err == nil {
+			t.Fatal("expected error when declined")
+		}
+	})
+
+	t.Run("confirmed", func(t *testing.T) {
+		flagYes = false
+		home := corrupt(t)
+		if err := repairPrivValState(home, &mockPrompter{responses: []string{"y"}, interactive: true}, false); err != nil {
+			t.Fatalf("repairPrivValState() = %v", err)
+		}
+		if b, _ := os.ReadFile(files.PrivValStatePath(home)); string(b) != files.PrivValStateStub {
+			t.Errorf("state = %q, want stub", b)
+		}
+	})
+
+	t.Run("--reset-priv-val-state", func(t *testing.T) {
+		home := corrupt(t)
+		if err := repairPrivValState(home, &nonInteractivePrompter{}, true); err != nil {
+			t.Fatalf("repairPrivValState() = %v", err)
+		}
+		if err := files.CheckPrivValState(home); err != nil {
+			t.Errorf("state still corrupt: %v", err)
+		}
+	})
+}
+
+func TestCheckSignedHeight(t *testing.T) {
+	origOutput := flagOutput
+	t.Cleanup(func() { flagOutput = origOutput })
+	flagOutput = "json"
+
+	// A validator that signed up to 900, then reset
+	home := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(home, "data"), 0o755)
+	_ = os.WriteFile(files.PrivValStatePath(home), []byte(`{"height":"900","round":0,"step":3}`), 0o644)
+	if err := checkSignedHeight(home, false); err != nil {
+		t.Fatalf("nothing recorded: %v", err)
+	}
+	if _, err := files.RecordSignedHeight(home); err != nil {
+		t.Fatal(err)
+	}
+	_ = os.WriteFile(files.PrivValStatePath(home), []byte(files.PrivValStateStub), 0o644)
+
+	err := checkSignedHeight(home, false)
+	if exitcodes.CodeForError(err) != exitcodes.PreconditionFailed || !strings.Contains(err.Error(), "--i-understand-double-sign-risk") {
+		t.Fatalf("zeroed state: error = %v, want precondition with the flag hint", err)
+	}
+
+	if err := checkSignedHeight(home, true); err != nil {
+		t.Fatalf("accepted: %v", err)
+	}
+	// Accepting once is remembered
+	if err := checkSignedHeight(home, false); err != nil {
+		t.Errorf("after accepting: %v", err)
+	}
+}
+
+func TestFinishStart_ReleasesHomeLockForPostStartFlow(t *testing.T) {
+	origOutput, origNoPrompt, origWait := flagOutput, startNoPrompt, startWaitReady
+	origCron, origFlow := installPeerRefreshCron, postStartFlow
+	defer func() {
+		flagOutput, startNoPrompt, startWaitReady = origOutput, origNoPrompt, origWait
+		installPeerRefreshCron, postStartFlow = origCron, origFlow
+		releaseHomeLock()
+	}()
+	flagOutput, startNoPrompt, startWaitReady = "text", false, false
+	installPeerRefreshCron = func(string) error { return nil }
+
+	cfg := testCfg()
+	cfg.HomeDir = t.TempDir()
+	start, _, _ := rootCmd.Find([]string{"start"})
+	if err := acquireHomeLock(start, cfg.HomeDir); err != nil {
+		t.Fatal(err)
+	}
+
+	ran := false
+	postStartFlow = func(config.Config, *ui.Printer) bool {
+		ran = true
+		// stop from another terminal, while start waits for sync
+		stop, err := lockfile.TryAcquire(filepath.Join(cfg.HomeDir, lockfile.HomeName))
+		if err != nil {
+			t.Errorf("stop could not take the home lock during the post-start flow: %v", err)
+			return true
+		}
+		_ = stop.SetOwner("push-validator stop")
+		// Snapshot recovery then waits its turn instead of racing stop
+		if err := lockHome(cfg.HomeDir, "push-validator start"); exitcodes.CodeForError(err) != exitcodes.PreconditionFailed {
+			t.Errorf("lockHome while stop holds the lock: err = %v, want precondition failed", err)
+		}
+		_ = stop.Release()
+		if err := lockHome(cfg.HomeDir, "push-validator start"); err != nil {
+			t.Errorf("lockHome after stop finished: %v", err)
+		}
+		releaseHomeLock()
+		return true
+	}
+
+	if err := finishStart(context.Background(), cfg, getPrinter(), true, false); err != nil {
+		t.Fatalf("finishStart() error = %v", err)
+	}
+	if !ran {
+		t.Error("post-start flow did not run")
+	}
+}
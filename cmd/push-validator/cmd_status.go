@@ -2,6 +2,7 @@ package main
 
 import (
     "context"
+    "errors"
     "fmt"
     "net/url"
     "os/exec"
@@ -9,11 +10,15 @@ import (
     "time"
 
     "github.com/charmbracelet/lipgloss"
+    "golang.org/x/sync/errgroup"
     "github.com/pushchain/push-validator-cli/internal/config"
     "github.com/pushchain/push-validator-cli/internal/dashboard"
+    "github.com/pushchain/push-validator-cli/internal/exitcodes"
     "github.com/pushchain/push-validator-cli/internal/process"
     "github.com/pushchain/push-validator-cli/internal/metrics"
+    "github.com/pushchain/push-validator-cli/internal/node"
     ui "github.com/pushchain/push-validator-cli/internal/ui"
+    "github.com/pushchain/push-validator-cli/internal/validator"
 )
 
 // statusResult models the key process and RPC fields shown by the
@@ -35,6 +40,9 @@ type statusResult struct {
 
     // Validator status
     IsValidator  bool   `json:"is_validator,omitempty"`
+    // ValidatorUnknown is set when the validator lookup failed because a
+    // node RPC didn't answer, so IsValidator=false means "not known yet"
+    ValidatorUnknown bool `json:"validator_unknown,omitempty"`
 
     // Network information
     Peers        int    `json:"peers,omitempty"`
@@ -67,49 +75,120 @@ type statusResult struct {
 
     // Errors
     Error        string `json:"error,omitempty"`
+    rpcFailed    bool   // Error came from the RPC status query
+    syncTolerance int64 // blocks behind RemoteHeight that still count as synced
 }
 
+// synced reports whether the node counts as synced by the same rule as the
+// start flow and the dashboard (metrics.Chain.Synced).
+func (r statusResult) synced() bool {
+    return metrics.Chain{LocalHeight: r.Height, RemoteHeight: r.RemoteHeight, CatchingUp: r.CatchingUp}.Synced(r.syncTolerance)
+}
+
+// strictStatusError returns the most specific error for an unhealthy node, or
+// nil when it is running, reachable, synced and peered. Checks run from the
+// hardest failure down so a stopped node is never reported as "catching up".
+// Only the selected components are checked; nil comps checks everything.
+func strictStatusError(res statusResult, comps statusComponents) error {
+    switch {
+    case comps.has(statusProcess) && !res.Running:
+        return exitcodes.ErrNotRunning
+    case comps.needsRPC() && (!res.RPCListening || res.rpcFailed):
+        return exitcodes.ErrRPCUnreachable
+    case res.Error != "":
+        return exitcodes.NewError(exitcodes.GeneralError, res.Error)
+    case comps.has(statusSync) && !res.synced():
+        return exitcodes.ErrCatchingUp
+    case comps.has(statusPeers) && res.Peers == 0:
+        return exitcodes.ErrNoPeers
+    }
+    return nil
+}
+
+// statusProbeLimit bounds how many status probes run at once.
+const statusProbeLimit = 4
+
 // computeStatus gathers comprehensive status information including system metrics,
-// network details, and validator information.
+// network details, and validator information. The network probes are
+// independent and run concurrently under one deadline, so a slow endpoint
+// costs its own latency rather than adding to the others'. Each probe is
+// best-effort: a failure leaves its fields at their zero values.
 func computeStatus(d *Deps) statusResult {
     cfg := d.Cfg
     sup := d.Sup
-    res := statusResult{}
+    res := statusResult{syncTolerance: cfg.SyncTolerance}
     res.Running = sup.IsRunning()
     if pid, ok := sup.PID(); ok {
         res.PID = pid
     }
 
-    rpc := cfg.RPCLocal
-    if rpc == "" { rpc = "http://127.0.0.1:26657" }
-    res.RPCURL = rpc
-    hostport := "127.0.0.1:26657"
-    if u, err := url.Parse(rpc); err == nil && u.Host != "" { hostport = u.Host }
+    rpc := probeRPC(d, &res)
 
-    // Check RPC listening with timeout
-    rpcCheck := d.RPCCheck
-    if rpcCheck == nil {
-        rpcCheck = process.IsRPCListening
-    }
-    rpcCtx, rpcCancel := context.WithTimeout(context.Background(), 1*time.Second)
-    rpcListeningDone := make(chan bool, 1)
-    go func() {
-        rpcListeningDone <- rpcCheck(hostport, 500*time.Millisecond)
-    }()
-    select {
-    case res.RPCListening = <-rpcListeningDone:
-        // Got response
-    case <-rpcCtx.Done():
-        res.RPCListening = false
-    }
-    rpcCancel()
+    ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+    defer cancel()
+    var g errgroup.Group
+    g.SetLimit(statusProbeLimit)
+
+    var (
+        st                      node.Status
+        stErr                   error
+        snap                    metrics.Snapshot
+        peers                   []node.Peer
+        myVal                   validator.MyValidatorInfo
+        myValErr                error
+        commRewards, outRewards string
+    )
+    if res.RPCListening {
+        g.Go(func() error {
+            stCtx, stCancel := context.WithTimeout(ctx, 2*time.Second)
+            defer stCancel()
+            st, stErr = d.Node.Status(stCtx)
+            return nil
+        })
+        // Remote height, peer count and system metrics, with a strict
+        // timeout since Collect makes several requests
+        g.Go(func() error {
+            snapCtx, snapCancel := context.WithTimeout(ctx, 1000*time.Millisecond)
+            defer snapCancel()
+            snapChan := make(chan metrics.Snapshot, 1)
+            go func() {
+                snapChan <- metrics.NewWithoutCPU().Collect(snapCtx, rpc, cfg.RemoteRPCURL())
+            }()
+            select {
+            case snap = <-snapChan:
+            case <-time.After(1200 * time.Millisecond):
+                // Timeout - use empty snapshot
+            }
+            return nil
+        })
+        g.Go(func() error {
+            peerCtx, peerCancel := context.WithTimeout(ctx, 2*time.Second)
+            defer peerCancel()
+            peers, _ = d.Node.Peers(peerCtx)
+            return nil
+        })
+    }
+    // Validator details come from the remote RPC, so they are fetched even
+    // when the local node is down
+    g.Go(func() error {
+        valCtx, valCancel := context.WithTimeout(ctx, 3*time.Second)
+        myVal, myValErr = d.Fetcher.GetMyValidator(valCtx, cfg)
+        valCancel()
+        if myVal.IsValidator && res.RPCListening {
+            rewardCtx, rewardCancel := context.WithTimeout(ctx, 2*time.Second)
+            commRewards, outRewards, _ = d.Fetcher.GetRewards(rewardCtx, cfg, myVal.Address)
+            rewardCancel()
+        }
+        return nil
+    })
+    g.Go(func() error {
+        res.BinaryVer = getBinaryVersion(cfg)
+        return nil
+    })
+    _ = g.Wait()
 
     if res.RPCListening {
-        cli := d.Node
-        ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-        defer cancel()
-        st, err := cli.Status(ctx)
-        if err == nil {
+        if stErr == nil {
             res.CatchingUp = st.CatchingUp
             res.Height = st.Height
             // Extract node identity from status
@@ -117,10 +196,6 @@ func computeStatus(d *Deps) statusResult {
             if st.Moniker != "" { res.Moniker = st.Moniker }
             if st.Network != "" { res.Network = st.Network }
 
-            // Fetch comprehensive validator details (best-effort, 3s timeout)
-            valCtx, valCancel := context.WithTimeout(context.Background(), 3*time.Second)
-            myVal, _ := d.Fetcher.GetMyValidator(valCtx, cfg)
-            valCancel()
             res.IsValidator = myVal.IsValidator
             if myVal.IsValidator {
                 res.ValidatorMoniker = myVal.Moniker
@@ -141,32 +216,10 @@ func computeStatus(d *Deps) statusResult {
                     res.MissedBlocks = myVal.SlashingInfo.MissedBlocks
                 }
                 res.Tombstoned = myVal.SlashingInfo.Tombstoned
-
-                // Fetch rewards (best-effort, 2s timeout)
-                rewardCtx, rewardCancel := context.WithTimeout(context.Background(), 2*time.Second)
-                commRewards, outRewards, _ := d.Fetcher.GetRewards(rewardCtx, cfg, myVal.Address)
-                rewardCancel()
                 res.CommissionRewards = commRewards
                 res.OutstandingRewards = outRewards
             }
 
-            // Enrich with remote height and peers (best-effort, with strict timeout)
-            remote := cfg.RemoteRPCURL()
-            col := metrics.NewWithoutCPU()
-            ctx2, cancel2 := context.WithTimeout(context.Background(), 1000*time.Millisecond)
-            snapChan := make(chan metrics.Snapshot, 1)
-            go func() {
-                snapChan <- col.Collect(ctx2, rpc, remote)
-            }()
-            var snap metrics.Snapshot
-            select {
-            case snap = <-snapChan:
-                // Got response
-            case <-time.After(1200 * time.Millisecond):
-                // Timeout - use empty snapshot
-            }
-            cancel2()
-
             if snap.Chain.RemoteHeight > 0 {
                 res.RemoteHeight = snap.Chain.RemoteHeight
                 // Calculate sync progress percentage
@@ -179,15 +232,8 @@ func computeStatus(d *Deps) statusResult {
             if snap.Network.Peers > 0 {
                 res.Peers = snap.Network.Peers
             }
-
-            // Fetch peer list for detailed display (best-effort, 2s timeout)
-            peerCtx, peerCancel := context.WithTimeout(context.Background(), 2*time.Second)
-            peers, _ := cli.Peers(peerCtx)
-            peerCancel()
-            if len(peers) > 0 {
-                for _, p := range peers {
-                    res.PeerList = append(res.PeerList, p.ID)
-                }
+            for _, p := range peers {
+                res.PeerList = append(res.PeerList, p.ID)
             }
 
             if snap.Network.LatencyMS > 0 { res.LatencyMS = snap.Network.LatencyMS }
@@ -202,15 +248,16 @@ func computeStatus(d *Deps) statusResult {
                 res.DiskPct = diskPct * 100
             }
         } else {
-            res.Error = fmt.Sprintf("RPC status error: %v", err)
+            res.Error = fmt.Sprintf("RPC status error: %v", stErr)
+            res.rpcFailed = true
         }
     }
 
-    // If validator info wasn't fetched (node stopped / RPC down), try via remote RPC
+    res.ValidatorUnknown = errors.Is(myValErr, validator.ErrRPCNotReady)
+
+    // If validator info wasn't applied (node stopped / RPC down), use the
+    // remote lookup's summary
     if !res.IsValidator && res.ValidatorMoniker == "" {
-        valCtx, valCancel := context.WithTimeout(context.Background(), 3*time.Second)
-        myVal, _ := d.Fetcher.GetMyValidator(valCtx, cfg)
-        valCancel()
         res.IsValidator = myVal.IsValidator
         if myVal.IsValidator {
             res.ValidatorMoniker = myVal.Moniker
@@ -222,12 +269,38 @@ func computeStatus(d *Deps) statusResult {
         }
     }
 
-    // Fetch binary version (best-effort)
-    res.BinaryVer = getBinaryVersion(cfg)
-
     return res
 }
 
+// probeRPC sets res.RPCURL and res.RPCListening from a quick TCP check of
+// the local RPC port, and returns the RPC base URL.
+func probeRPC(d *Deps, res *statusResult) string {
+    rpc := d.Cfg.RPCLocal
+    if rpc == "" { rpc = "http://127.0.0.1:26657" }
+    res.RPCURL = rpc
+    hostport := "127.0.0.1:26657"
+    if u, err := url.Parse(rpc); err == nil && u.Host != "" { hostport = u.Host }
+
+    // Check RPC listening with timeout
+    rpcCheck := d.RPCCheck
+    if rpcCheck == nil {
+        rpcCheck = process.IsRPCListening
+    }
+    rpcCtx, rpcCancel := context.WithTimeout(context.Background(), 1*time.Second)
+    defer rpcCancel()
+    rpcListeningDone := make(chan bool, 1)
+    go func() {
+        rpcListeningDone <- rpcCheck(hostport, 500*time.Millisecond)
+    }()
+    select {
+    case res.RPCListening = <-rpcListeningDone:
+        // Got response
+    case <-rpcCtx.Done():
+        res.RPCListening = false
+    }
+    return rpc
+}
+
 // parseBinaryVersionOutput extracts the version string from pchaind version --long output.
 func parseBinaryVersionOutput(output []byte) string {
     lines := strings.Split(string(output), "\n")
@@ -281,7 +354,7 @@ func printStatusText(result statusResult) {
     syncIcon := c.StatusIcon("offline")
     syncVal := "Stopped"
     if result.RPCListening {
-        if result.CatchingUp {
+        if !result.synced() {
             syncIcon = c.StatusIcon("syncing")
             syncVal = "Catching Up"
         } else {
@@ -295,6 +368,9 @@ func printStatusText(result statusResult) {
     if result.IsValidator {
         validatorIcon = c.StatusIcon("online")
         validatorVal = "Registered"
+    } else if result.ValidatorUnknown {
+        validatorIcon = c.StatusIcon("warning")
+        validatorVal = "Unknown (RPC not ready)"
     }
 
     heightVal := ui.FormatNumber(result.Height)
@@ -345,7 +421,7 @@ func printStatusText(result statusResult) {
 
     if result.RPCListening && result.RemoteHeight > 0 {
         // Use dashboard-style progress rendering with block counts
-        syncLine := renderSyncProgressDashboard(result.Height, result.RemoteHeight, result.CatchingUp)
+        syncLine := renderSyncProgressDashboard(result.Height, result.RemoteHeight, !result.synced())
         chainLines = append(chainLines, syncLine)
     } else {
         // Fallback to simple format if RPC not available
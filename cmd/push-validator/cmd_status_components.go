@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+)
+
+// Parts of `status` that --components can select.
+const (
+	statusProcess = "process"
+	statusRPC     = "rpc"
+	statusSync    = "sync"
+	statusPeers   = "peers"
+)
+
+var statusComponentNames = []string{statusProcess, statusRPC, statusSync, statusPeers}
+
+// statusComponentFields are the JSON fields each component reports.
+var statusComponentFields = map[string][]string{
+	statusProcess: {"running", "pid"},
+	statusRPC:     {"rpc_listening", "rpc_url"},
+	statusSync:    {"catching_up", "height"},
+	statusPeers:   {"peers", "peer_list"},
+}
+
+// statusComponents is the set of parts `status` collects; nil selects all of
+// them (the full status, including validator details and system metrics).
+type statusComponents map[string]bool
+
+// parseStatusComponents parses a --components list such as "process,rpc".
+// An empty list selects everything.
+func parseStatusComponents(list string) (statusComponents, error) {
+	if strings.TrimSpace(list) == "" {
+		return nil, nil
+	}
+	comps := statusComponents{}
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := statusComponentFields[name]; !ok {
+			return nil, exitcodes.InvalidArgsErrorf("unknown status component %q (known: %s)", name, strings.Join(statusComponentNames, ", "))
+		}
+		comps[name] = true
+	}
+	if len(comps) == 0 {
+		return nil, exitcodes.InvalidArgsError("--components must name at least one component")
+	}
+	return comps, nil
+}
+
+// has reports whether name is selected.
+func (c statusComponents) has(name string) bool {
+	return c == nil || c[name]
+}
+
+// needsRPC reports whether any selected component reads the local RPC.
+func (c statusComponents) needsRPC() bool {
+	return c.has(statusRPC) || c.has(statusSync) || c.has(statusPeers)
+}
+
+// computeSelectedStatus collects only the selected components. Unlike
+// computeStatus it skips validator, remote height and system metric
+// queries, so a liveness check costs at most a TCP dial and one or two
+// local RPC calls.
+func computeSelectedStatus(d *Deps, comps statusComponents) statusResult {
+	res := statusResult{syncTolerance: d.Cfg.SyncTolerance}
+	if comps.has(statusProcess) {
+		res.Running = d.Sup.IsRunning()
+		if pid, ok := d.Sup.PID(); ok {
+			res.PID = pid
+		}
+	}
+	if !comps.needsRPC() {
+		return res
+	}
+	rpc := probeRPC(d, &res)
+	if !res.RPCListening {
+		if comps.has(statusSync) || comps.has(statusPeers) {
+			res.Error = fmt.Sprintf("RPC not listening at %s", rpc)
+			res.rpcFailed = true
+		}
+		return res
+	}
+	if comps.has(statusSync) {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		st, err := d.Node.Status(ctx)
+		cancel()
+		if err != nil {
+			res.Error = fmt.Sprintf("RPC status error: %v", err)
+			res.rpcFailed = true
+			return res
+		}
+		res.CatchingUp = st.CatchingUp
+		res.Height = st.Height
+	}
+	if comps.has(statusPeers) {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		peers, err := d.Node.Peers(ctx)
+		cancel()
+		if err != nil {
+			res.Error = fmt.Sprintf("RPC peers error: %v", err)
+			res.rpcFailed = true
+			return res
+		}
+		res.Peers = len(peers)
+		for _, p := range peers {
+			res.PeerList = append(res.PeerList, p.ID)
+		}
+	}
+	return res
+}
+
+// selectedStatusFields returns the fields of res that belong to comps, plus
+// any error, for JSON and YAML output.
+func selectedStatusFields(res statusResult, comps statusComponents) map[string]any {
+	data, _ := json.Marshal(res)
+	all := map[string]any{}
+	_ = json.Unmarshal(data, &all)
+
+	out := map[string]any{}
+	for _, name := range statusComponentNames {
+		if !comps.has(name) {
+			continue
+		}
+		for _, field := range statusComponentFields[name] {
+			if v, ok := all[field]; ok {
+				out[field] = v
+			} else if field == "peers" {
+				out[field] = 0 // omitempty drops a zero peer count
+			}
+		}
+	}
+	if res.Error != "" {
+		out["error"] = res.Error
+	}
+	return out
+}
+
+// writeStatusTable prints out, a statusResult or selectedStatusFields map,
+// for --output table: one FIELD VALUE row per JSON field, sorted by name.
+func writeStatusTable(w io.Writer, out any) {
+	data, _ := json.Marshal(out)
+	fields := map[string]any{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber() // keep heights out of exponent notation
+	_ = dec.Decode(&fields)
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	rows := make([][]string, 0, len(names))
+	for _, name := range names {
+		v := fields[name]
+		if list, ok := v.([]any); ok {
+			parts := make([]string, len(list))
+			for i, item := range list {
+				parts[i] = fmt.Sprint(item)
+			}
+			v = strings.Join(parts, ",")
+		}
+		rows = append(rows, []string{name, fmt.Sprint(v)})
+	}
+	writeOutputTable(w, []string{"FIELD", "VALUE"}, rows)
+}
+
+// printSelectedStatusText prints one line per selected component.
+func printSelectedStatusText(res statusResult, comps statusComponents) {
+	if comps.has(statusProcess) {
+		state := "stopped"
+		if res.Running {
+			state = "running"
+			if res.PID != 0 {
+				state = fmt.Sprintf("running (pid %d)", res.PID)
+			}
+		}
+		fmt.Printf("process: %s\n", state)
+	}
+	if comps.has(statusRPC) {
+		state := "not listening"
+		if res.RPCListening {
+			state = "listening"
+		}
+		fmt.Printf("rpc: %s (%s)\n", state, res.RPCURL)
+	}
+	if comps.has(statusSync) && res.RPCListening && !res.rpcFailed {
+		state := "in sync"
+		if !res.synced() {
+			state = "catching up"
+		}
+		fmt.Printf("sync: %s at height %d\n", state, res.Height)
+	}
+	if comps.has(statusPeers) && res.RPCListening && !res.rpcFailed {
+		fmt.Printf("peers: %d\n", res.Peers)
+	}
+	if res.Error != "" {
+		fmt.Printf("error: %s\n", res.Error)
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/node"
+)
+
+func TestParseStatusComponents(t *testing.T) {
+	if comps, err := parseStatusComponents(""); err != nil || comps != nil {
+		t.Fatalf("empty list = %v, %v; want nil (everything)", comps, err)
+	}
+	comps, err := parseStatusComponents(" RPC, peers ,")
+	if err != nil || len(comps) != 2 || !comps.has(statusRPC) || !comps.has(statusPeers) || comps.has(statusSync) {
+		t.Fatalf("parse = %v, %v", comps, err)
+	}
+	for _, bad := range []string{"rpc,disk", ","} {
+		if _, err := parseStatusComponents(bad); exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+			t.Errorf("parseStatusComponents(%q) err = %v, want invalid args", bad, err)
+		}
+	}
+}
+
+func TestComputeSelectedStatus(t *testing.T) {
+	t.Run("process only skips the network", func(t *testing.T) {
+		d := &Deps{
+			Cfg: testCfg(),
+			Sup: &mockSupervisor{running: true, pid: 7},
+			RPCCheck: func(string, time.Duration) bool {
+				t.Fatal("RPC probed for process-only status")
+				return false
+			},
+		}
+		comps, _ := parseStatusComponents("process")
+		res := computeSelectedStatus(d, comps)
+		if !res.Running || res.PID != 7 {
+			t.Errorf("process = %v/%d, want running/7", res.Running, res.PID)
+		}
+		out := selectedStatusFields(res, comps)
+		if len(out) != 2 || out["running"] != true {
+			t.Errorf("fields = %v, want only running and pid", out)
+		}
+		if err := strictStatusError(res, comps); err != nil {
+			t.Errorf("strict = %v, want nil for a running node without RPC", err)
+		}
+	})
+
+	t.Run("rpc and peers", func(t *testing.T) {
+		d := &Deps{
+			Cfg:      testCfg(),
+			Sup:      &mockSupervisor{},
+			Node:     &mockNodeClient{peers: []node.Peer{{ID: "a"}, {ID: "b"}}},
+			RPCCheck: func(string, time.Duration) bool { return true },
+		}
+		comps, _ := parseStatusComponents("rpc,peers")
+		res := computeSelectedStatus(d, comps)
+		if !res.RPCListening || res.Peers != 2 {
+			t.Errorf("rpc/peers = %v/%d, want true/2", res.RPCListening, res.Peers)
+		}
+		out := selectedStatusFields(res, comps)
+		if _, ok := out["running"]; ok {
+			t.Errorf("unselected process field in output: %v", out)
+		}
+		// The node is "stopped" per the supervisor, but process was not selected
+		if err := strictStatusError(res, comps); err != nil {
+			t.Errorf("strict = %v, want nil", err)
+		}
+	})
+
+	t.Run("sync with RPC down", func(t *testing.T) {
+		d := &Deps{
+			Cfg:      testCfg(),
+			Sup:      &mockSupervisor{},
+			RPCCheck: func(string, time.Duration) bool { return false },
+		}
+		comps, _ := parseStatusComponents("sync")
+		res := computeSelectedStatus(d, comps)
+		if res.Error == "" {
+			t.Error("expected an error when sync is requested and RPC is down")
+		}
+		if err := strictStatusError(res, comps); !errors.Is(err, exitcodes.ErrRPCUnreachable) {
+			t.Errorf("strict = %v, want RPC unreachable", err)
+		}
+	})
+
+	t.Run("strict checks only selected parts", func(t *testing.T) {
+		res := statusResult{Running: true, RPCListening: true, CatchingUp: true}
+		comps, _ := parseStatusComponents("process,peers")
+		if err := strictStatusError(res, comps); !errors.Is(err, exitcodes.ErrNoPeers) {
+			t.Errorf("strict = %v, want no peers (catching up not selected)", err)
+		}
+	})
+}
+
+func TestWriteStatusTable(t *testing.T) {
+	t.Setenv("COLUMNS", "200")
+	t.Setenv("NO_COLOR", "1")
+	res := statusResult{Running: true, PID: 42, Height: 12345678, PeerList: []string{"a", "b"}}
+	var buf bytes.Buffer
+	writeStatusTable(&buf, res)
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if !strings.HasPrefix(lines[0], "FIELD") || !strings.Contains(lines[0], "VALUE") {
+		t.Fatalf("missing header: %q", lines[0])
+	}
+	got := map[string]string{}
+	for _, line := range lines[1:] {
+		f := strings.Fields(line)
+		got[f[0]] = strings.Join(f[1:], " ")
+	}
+	for field, want := range map[string]string{"height": "12345678", "pid": "42", "running": "true", "peer_list": "a,b"} {
+		if got[field] != want {
+			t.Errorf("%s = %q, want %q\n%s", field, got[field], want, buf.String())
+		}
+	}
+
+	// Selected components keep only their fields
+	buf.Reset()
+	writeStatusTable(&buf, selectedStatusFields(res, statusComponents{statusSync: true}))
+	if strings.Contains(buf.String(), "pid") || !strings.Contains(buf.String(), "height") {
+		t.Errorf("unexpected selected table:\n%s", buf.String())
+	}
+}
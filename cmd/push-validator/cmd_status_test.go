@@ -2,10 +2,12 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/node"
 	"github.com/pushchain/push-validator-cli/internal/validator"
 	"gopkg.in/yaml.v3"
@@ -255,6 +257,8 @@ func TestPrintStatusText_NotPanics(t *testing.T) {
 		},
 		// Running without PID
 		{Running: true, PID: 0, RPCListening: true},
+		// Validator lookup failed because the RPC wasn't ready
+		{Running: true, RPCListening: true, ValidatorUnknown: true},
 	}
 
 	for i, c := range cases {
@@ -310,6 +314,46 @@ func TestComputeStatus_ProcessRunning_RPCDown(t *testing.T) {
 	}
 }
 
+func TestComputeStatus_ProbesRunConcurrently(t *testing.T) {
+	const delay = 300 * time.Millisecond
+	cfg := testCfg()
+	cfg.GenesisDomain = "127.0.0.1:1" // remote probe fails fast
+	node.SetRetries(0)
+	defer node.SetRetries(node.DefaultRetries)
+	d := &Deps{
+		Cfg: cfg,
+		Sup: &mockSupervisor{running: true, pid: 7},
+		Node: &mockNodeClient{
+			status:      node.Status{Height: 500, NodeID: "abc"},
+			statusDelay: delay,
+			peersErr:    fmt.Errorf("net_info unavailable"),
+			peersDelay:  delay,
+		},
+		Fetcher: &mockFetcher{
+			myValidator:      validator.MyValidatorInfo{IsValidator: true, Moniker: "val-1"},
+			myValidatorDelay: delay,
+		},
+		RPCCheck: func(string, time.Duration) bool { return true },
+		Runner:   newMockRunner(),
+	}
+
+	start := time.Now()
+	res := computeStatus(d)
+	elapsed := time.Since(start)
+
+	// Run one after another the three delayed probes would take 3*delay
+	if elapsed >= 2*delay {
+		t.Errorf("computeStatus took %v, want under %v", elapsed, 2*delay)
+	}
+	if res.Height != 500 || res.NodeID != "abc" || !res.IsValidator || res.ValidatorMoniker != "val-1" {
+		t.Errorf("result = %+v", res)
+	}
+	// The failed peers probe degrades to no peer list, not an error
+	if res.Error != "" || len(res.PeerList) != 0 {
+		t.Errorf("Error = %q, PeerList = %v", res.Error, res.PeerList)
+	}
+}
+
 func TestComputeStatus_RPCUp_StatusError(t *testing.T) {
 	d := &Deps{
 		Cfg:      testCfg(),
@@ -374,6 +418,32 @@ func TestComputeStatus_RPCUp_StatusSuccess_NotValidator(t *testing.T) {
 	}
 }
 
+func TestComputeStatus_ValidatorUnknown(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"rpc not ready", fmt.Errorf("%w: query validators: timeout", validator.ErrRPCNotReady), true},
+		{"other error", errors.New("keyring locked"), false},
+		{"no error", nil, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Deps{
+				Cfg:      testCfg(),
+				Sup:      &mockSupervisor{running: false},
+				Fetcher:  &mockFetcher{myValidatorErr: tt.err},
+				RPCCheck: func(string, time.Duration) bool { return false },
+				Runner:   newMockRunner(),
+			}
+			res := computeStatus(d)
+			if res.ValidatorUnknown != tt.want || res.IsValidator {
+				t.Errorf("ValidatorUnknown = %v, IsValidator = %v; want %v, false", res.ValidatorUnknown, res.IsValidator, tt.want)
+			}
+		})
+	}
+}
+
 func TestComputeStatus_RPCUp_IsValidator(t *testing.T) {
 	d := &Deps{
 		Cfg: testCfg(),
@@ -618,3 +688,36 @@ func TestComputeStatus_RPCURLDefault(t *testing.T) {
 		t.Errorf("RPCURL = %q, want default", res.RPCURL)
 	}
 }
+
+func TestStrictStatusError(t *testing.T) {
+	healthy := statusResult{Running: true, RPCListening: true, Peers: 5}
+	tests := []struct {
+		name string
+		mod  func(*statusResult)
+		want error
+		code int
+	}{
+		{"healthy", func(r *statusResult) {}, nil, exitcodes.Success},
+		{"not running", func(r *statusResult) { r.Running = false; r.RPCListening = false; r.Peers = 0 }, exitcodes.ErrNotRunning, exitcodes.NotRunning},
+		{"rpc down", func(r *statusResult) { r.RPCListening = false }, exitcodes.ErrRPCUnreachable, exitcodes.RPCUnreachable},
+		{"rpc status error", func(r *statusResult) { r.Error = "RPC status error: timeout"; r.rpcFailed = true }, exitcodes.ErrRPCUnreachable, exitcodes.RPCUnreachable},
+		{"local error", func(r *statusResult) { r.Error = "read config: permission denied" }, nil, exitcodes.GeneralError},
+		{"catching up without peers", func(r *statusResult) { r.CatchingUp = true; r.Peers = 0 }, exitcodes.ErrCatchingUp, exitcodes.CatchingUp},
+		{"no peers", func(r *statusResult) { r.Peers = 0 }, exitcodes.ErrNoPeers, exitcodes.NoPeers},
+		{"behind within tolerance", func(r *statusResult) { r.Height, r.RemoteHeight, r.syncTolerance = 95, 100, 5 }, nil, exitcodes.Success},
+		{"behind past tolerance", func(r *statusResult) { r.Height, r.RemoteHeight, r.syncTolerance = 94, 100, 5 }, exitcodes.ErrCatchingUp, exitcodes.CatchingUp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := healthy
+			tt.mod(&res)
+			err := strictStatusError(res, nil)
+			if tt.want != nil && !errors.Is(err, tt.want) || tt.code == exitcodes.Success && err != nil {
+				t.Errorf("strictStatusError() = %v, want %v", err, tt.want)
+			}
+			if got := exitcodes.CodeForError(err); got != tt.code {
+				t.Errorf("exit code = %d, want %d", got, tt.code)
+			}
+		})
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-
-	"github.com/pushchain/push-validator-cli/internal/process"
 )
 
 var stopCmd = &cobra.Command{
@@ -15,7 +13,10 @@ var stopCmd = &cobra.Command{
 		cfg := loadCfg()
 		p := getPrinter()
 
-		sup := process.NewCosmovisor(cfg.HomeDir)
+		sup, _, err := selectSupervisor(cfg)
+		if err != nil {
+			return err
+		}
 		if err := sup.Stop(); err != nil {
 			if flagOutput == "json" {
 				p.JSON(map[string]any{"ok": false, "error": err.Error()})
@@ -39,5 +40,7 @@ var stopCmd = &cobra.Command{
 }
 
 func init() {
+	addCosmovisorFlags(stopCmd)
+	addRunGuards(stopCmd, guardIfRunning)
 	rootCmd.AddCommand(stopCmd)
 }
@@ -37,7 +37,8 @@ type syncCoreOpts struct {
 	stuckTimeout time.Duration
 	skipFinal    bool
 	quiet        bool
-	debug        bool
+	pathPrefix   string
+	tolerance    int64
 }
 
 // runSyncCore contains the testable sync logic.
@@ -51,16 +52,17 @@ func runSyncCore(ctx context.Context, runner SyncRunner, opts syncCoreOpts, outp
 		}
 	}
 	if err := runner.Run(ctx, syncmon.Options{
-		LocalRPC:     opts.rpc,
-		RemoteRPC:    opts.remote,
-		LogPath:      opts.logPath,
-		Window:       opts.window,
-		Compact:      opts.compact,
-		Out:          output,
-		Interval:     opts.interval,
-		Quiet:        opts.quiet,
-		Debug:        opts.debug,
-		StuckTimeout: stuckTimeout,
+		LocalRPC:      opts.rpc,
+		RemoteRPC:     opts.remote,
+		LogPath:       opts.logPath,
+		Window:        opts.window,
+		Compact:       opts.compact,
+		Out:           output,
+		Interval:      opts.interval,
+		Quiet:         opts.quiet,
+		StuckTimeout:  stuckTimeout,
+		PathPrefix:    opts.pathPrefix,
+		SyncTolerance: opts.tolerance,
 	}); err != nil {
 		if errors.Is(err, syncmon.ErrSyncStuck) {
 			return exitcodes.NewError(exitcodes.SyncStuck, err.Error())
@@ -85,6 +87,7 @@ func init() {
 	var syncSkipFinal bool
 	var syncInterval time.Duration
 	var syncStuckTimeout time.Duration
+	var syncPathPrefix string
 
 	syncCmd := &cobra.Command{
 		Use:   "sync",
@@ -97,7 +100,7 @@ func init() {
 			if syncRemote == "" {
 				syncRemote = cfg.RemoteRPCURL()
 			}
-			sup := newSupervisor(cfg.HomeDir)
+			sup := newSupervisor(cfg)
 			if err := checkNodeRunning(sup); err != nil {
 				return err
 			}
@@ -111,7 +114,8 @@ func init() {
 				stuckTimeout: syncStuckTimeout,
 				skipFinal:    syncSkipFinal,
 				quiet:        flagQuiet,
-				debug:        flagDebug,
+				pathPrefix:   syncPathPrefix,
+				tolerance:    cfg.SyncTolerance,
 			}, cmd.OutOrStdout())
 		},
 	}
@@ -122,5 +126,6 @@ func init() {
 	syncCmd.Flags().DurationVar(&syncInterval, "interval", 120*time.Millisecond, "Update interval (e.g. 1s, 2s)")
 	syncCmd.Flags().BoolVar(&syncSkipFinal, "skip-final-message", false, "Suppress completion message (for automation)")
 	syncCmd.Flags().DurationVar(&syncStuckTimeout, "stuck-timeout", 0, "Stuck detection timeout (e.g. 2m, 5m). 0 uses default or PNM_SYNC_STUCK_TIMEOUT")
+	syncCmd.Flags().StringVar(&syncPathPrefix, "rpc-path-prefix", "", "Path the local RPC is served under behind a reverse proxy (e.g. /rpc)")
 	rootCmd.AddCommand(syncCmd)
 }
@@ -132,13 +132,13 @@ func TestRunSyncCore_PassesOptions(t *testing.T) {
 	var buf bytes.Buffer
 	runner := &mockSyncRunner{}
 	_ = runSyncCore(context.Background(), runner, syncCoreOpts{
-		rpc:      "http://local:26657",
-		remote:   "http://remote:26657",
-		logPath:  "/tmp/test.log",
-		window:   50,
-		compact:  true,
-		quiet:    true,
-		debug:    true,
+		rpc:       "http://local:26657",
+		remote:    "http://remote:26657",
+		logPath:   "/tmp/test.log",
+		window:    50,
+		compact:   true,
+		quiet:     true,
+		tolerance: 20,
 	}, &buf)
 	if runner.opts.LocalRPC != "http://local:26657" {
 		t.Errorf("expected LocalRPC to be passed, got: %s", runner.opts.LocalRPC)
@@ -158,7 +158,17 @@ func TestRunSyncCore_PassesOptions(t *testing.T) {
 	if !runner.opts.Quiet {
 		t.Error("expected Quiet true")
 	}
-	if !runner.opts.Debug {
-		t.Error("expected Debug true")
+	if runner.opts.SyncTolerance != 20 {
+		t.Errorf("expected SyncTolerance 20, got: %d", runner.opts.SyncTolerance)
+	}
+}
+
+func TestRunSyncCore_PathPrefix(t *testing.T) {
+	runner := &mockSyncRunner{}
+	if err := runSyncCore(context.Background(), runner, syncCoreOpts{pathPrefix: "/rpc", skipFinal: true}, &bytes.Buffer{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if runner.opts.PathPrefix != "/rpc" {
+		t.Errorf("PathPrefix = %q, want /rpc", runner.opts.PathPrefix)
 	}
 }
@@ -9,8 +9,20 @@ import (
 	"time"
 
 	"golang.org/x/term"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// unjail --wait settings
+var (
+	flagUnjailWait        bool
+	flagUnjailWaitTimeout = 3 * time.Minute
 )
 
+// unjailPollInterval is how often --wait re-checks the validator status.
+var unjailPollInterval = 5 * time.Second
+
 // handleUnjail orchestrates the validator unjail flow:
 // - verify node is synced
 // - verify validator is jailed with expired jail period
@@ -49,7 +61,7 @@ func handleUnjail(d *Deps) error {
 		return fmt.Errorf("failed to check sync status")
 	}
 
-	if stLocal.CatchingUp {
+	if stLocal.CatchingUp && !overrideSyncCheck(os.Stderr) {
 		if flagOutput == "json" {
 			getPrinter().JSON(map[string]any{"ok": false, "error": "node is still syncing"})
 		} else {
@@ -118,6 +130,24 @@ func handleUnjail(d *Deps) error {
 		fmt.Println(" " + p.Colors.Success(p.Colors.Emoji("✓")))
 	}
 
+	// A tombstoned validator can never be unjailed; don't spend gas finding out
+	if myVal.SlashingInfo.Tombstoned {
+		const msg = "validator is tombstoned and cannot be unjailed"
+		if flagOutput == "json" {
+			getPrinter().JSON(map[string]any{"ok": false, "error": msg, "jail_reason": myVal.SlashingInfo.JailReason})
+		} else {
+			fmt.Println()
+			fmt.Println(p.Colors.Error(p.Colors.Emoji("❌") + " Validator is tombstoned"))
+			fmt.Println()
+			fmt.Println("This validator was permanently jailed for double-signing. Tombstoning cannot")
+			fmt.Println("be reversed, so an unjail transaction would fail and only cost gas.")
+			fmt.Println()
+			fmt.Println(p.Colors.Info("To validate again, register a new validator with new consensus keys."))
+			fmt.Println()
+		}
+		return fmt.Errorf("%s", msg)
+	}
+
 	// Step 3: Check if jail period has expired
 	if flagOutput != "json" {
 		fmt.Print(p.Colors.Apply(p.Colors.Theme.Prompt, p.Colors.Emoji("🔍")+" Checking jail expiry..."))
@@ -260,6 +290,7 @@ func handleUnjail(d *Deps) error {
 
 	// Step 6: Submit unjail transaction
 	if flagOutput != "json" {
+		printTxFee(p, d.Cfg.Denom)
 		fmt.Print(p.Colors.Apply(p.Colors.Theme.Prompt, p.Colors.Emoji("📤")+" Submitting unjail transaction..."))
 	}
 
@@ -284,9 +315,20 @@ func handleUnjail(d *Deps) error {
 		fmt.Println(" " + p.Colors.Success(p.Colors.Emoji("✓")))
 	}
 
+	// --wait also waits for the tx itself, so an on-chain failure is reported
+	// instead of polling for a bond that will never happen
+	txRes, err := confirmTx(d.Validator, txHash, flagUnjailWait, flagUnjailWaitTimeout)
+	if err != nil {
+		return err
+	}
+
+	if flagUnjailWait {
+		return waitForUnjail(d, txHash)
+	}
+
 	// Success output
 	if flagOutput == "json" {
-		getPrinter().JSON(map[string]any{"ok": true, "txhash": txHash})
+		getPrinter().JSON(withTxResult(map[string]any{"ok": true, "txhash": txHash}, txRes))
 	} else {
 		fmt.Println()
 		p.Success(p.Colors.Emoji("✅") + " Validator successfully unjailed!")
@@ -315,6 +357,79 @@ func handleUnjail(d *Deps) error {
 	return nil
 }
 
+// waitForUnjail polls the validator after the unjail tx until it is no
+// longer jailed and is bonded again, or until --wait-timeout elapses.
+func waitForUnjail(d *Deps, txHash string) error {
+	p := getPrinter()
+	if flagOutput != "json" {
+		fmt.Println()
+		p.KeyValueLine("Transaction Hash", txHash, "green")
+		fmt.Println()
+		fmt.Println(p.Colors.Info(fmt.Sprintf("Waiting for validator to be bonded (timeout %s)...", flagUnjailWaitTimeout)))
+	}
+
+	lastState := ""
+	myVal, err := pollUntilBonded(d.Fetcher, d.Cfg, flagUnjailWaitTimeout, func(v validator.MyValidatorInfo, elapsed time.Duration) {
+		state := fmt.Sprintf("status %s, jailed %v", v.Status, v.Jailed)
+		if flagOutput != "json" && state != lastState {
+			fmt.Printf("  [%s] %s\n", elapsed.Round(time.Second), state)
+			lastState = state
+		}
+	})
+	if err != nil {
+		if flagOutput == "json" {
+			getPrinter().JSON(map[string]any{"ok": false, "txhash": txHash, "error": err.Error(), "status": myVal.Status, "jailed": myVal.Jailed})
+			return silentErr{err}
+		}
+		fmt.Println()
+		fmt.Println(p.Colors.Warning(p.Colors.Emoji("⚠️") + " " + err.Error()))
+		fmt.Println(p.Colors.Info("The unjail transaction was submitted; check again with:"))
+		fmt.Println(p.Colors.Apply(p.Colors.Theme.Command, "  push-validator status"))
+		fmt.Println()
+		return silentErr{err}
+	}
+
+	if flagOutput == "json" {
+		getPrinter().JSON(map[string]any{"ok": true, "txhash": txHash, "status": myVal.Status, "jailed": false})
+		return nil
+	}
+	fmt.Println()
+	p.Success(p.Colors.Emoji("✅") + " Validator is unjailed and bonded")
+	fmt.Println()
+	return nil
+}
+
+// pollUntilBonded re-fetches the validator every unjailPollInterval until it
+// is unjailed and BONDED. onPoll is called with every successful fetch. On
+// timeout the last seen state is returned with an error.
+func pollUntilBonded(fetcher ValidatorFetcher, cfg config.Config, timeout time.Duration, onPoll func(validator.MyValidatorInfo, time.Duration)) (validator.MyValidatorInfo, error) {
+	start := time.Now()
+	deadline := start.Add(timeout)
+	var last validator.MyValidatorInfo
+	for {
+		// The production fetcher caches for 30s; polling needs fresh data
+		if inv, ok := fetcher.(interface{ InvalidateMyValidator() }); ok {
+			inv.InvalidateMyValidator()
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		v, err := fetcher.GetMyValidator(ctx, cfg)
+		cancel()
+		if err == nil {
+			last = v
+			if onPoll != nil {
+				onPoll(v, time.Since(start))
+			}
+			if !v.Jailed && v.Status == "BONDED" {
+				return v, nil
+			}
+		}
+		if time.Now().Add(unjailPollInterval).After(deadline) {
+			return last, fmt.Errorf("timed out after %s waiting for validator to be bonded", timeout)
+		}
+		time.Sleep(unjailPollInterval)
+	}
+}
+
 // isJailPeriodExpired checks if the jail period has passed
 func isJailPeriodExpired(jailedUntil string) bool {
 	if jailedUntil == "" || jailedUntil == "1970-01-01T00:00:00Z" {
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/config"
 	"github.com/pushchain/push-validator-cli/internal/node"
 	"github.com/pushchain/push-validator-cli/internal/validator"
 )
@@ -612,3 +614,128 @@ func TestHandleUnjail_TextOutput_JailedButPeriodExpired(t *testing.T) {
 	}
 }
 
+
+// sequenceFetcher returns the scripted validator states in order, repeating
+// the last one, and records cache invalidations.
+type sequenceFetcher struct {
+	mockFetcher
+	states      []validator.MyValidatorInfo
+	calls       int
+	invalidated int
+}
+
+func (s *sequenceFetcher) GetMyValidator(ctx context.Context, cfg config.Config) (validator.MyValidatorInfo, error) {
+	i := min(s.calls, len(s.states)-1)
+	s.calls++
+	return s.states[i], nil
+}
+
+func (s *sequenceFetcher) InvalidateMyValidator() { s.invalidated++ }
+
+func TestHandleUnjail_TombstonedRefused(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	for _, output := range []string{"json", "text"} {
+		flagOutput = output
+		mv := &mockValidator{unjailResult: "TX"}
+		d := unjailDeps(func(d *Deps) {
+			d.Fetcher = &mockFetcher{myValidator: validator.MyValidatorInfo{
+				IsValidator: true,
+				Address:     "pushvaloper1test",
+				Jailed:      true,
+				SlashingInfo: validator.SlashingInfo{
+					Tombstoned:  true,
+					JailReason:  "Double Sign",
+					JailedUntil: "9999-12-31T23:59:59Z",
+				},
+			}}
+			d.Validator = mv
+		})
+
+		err := handleUnjail(d)
+		if err == nil || !containsSubstr(err.Error(), "tombstoned") {
+			t.Fatalf("%s: expected tombstoned error, got %v", output, err)
+		}
+	}
+}
+
+func TestPollUntilBonded(t *testing.T) {
+	origInterval := unjailPollInterval
+	defer func() { unjailPollInterval = origInterval }()
+	unjailPollInterval = time.Millisecond
+
+	jailed := validator.MyValidatorInfo{IsValidator: true, Jailed: true, Status: "UNBONDED"}
+	unbonded := validator.MyValidatorInfo{IsValidator: true, Status: "UNBONDED"}
+	bonded := validator.MyValidatorInfo{IsValidator: true, Status: "BONDED"}
+
+	t.Run("becomes bonded", func(t *testing.T) {
+		f := &sequenceFetcher{states: []validator.MyValidatorInfo{jailed, unbonded, bonded}}
+		var polls int
+		v, err := pollUntilBonded(f, testCfg(), time.Second, func(validator.MyValidatorInfo, time.Duration) { polls++ })
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v.Status != "BONDED" || polls != 3 {
+			t.Errorf("got status %s after %d polls", v.Status, polls)
+		}
+		if f.invalidated != 3 {
+			t.Errorf("expected the cache to be bypassed on every poll, got %d invalidations", f.invalidated)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		f := &sequenceFetcher{states: []validator.MyValidatorInfo{jailed}}
+		v, err := pollUntilBonded(f, testCfg(), 20*time.Millisecond, nil)
+		if err == nil || !containsSubstr(err.Error(), "timed out") {
+			t.Fatalf("expected timeout, got %v", err)
+		}
+		if !v.Jailed {
+			t.Error("expected last seen state to be returned")
+		}
+	})
+}
+
+func TestHandleUnjail_Wait(t *testing.T) {
+	origOutput, origNonInteractive := flagOutput, flagNonInteractive
+	origWait, origTimeout, origInterval := flagUnjailWait, flagUnjailWaitTimeout, unjailPollInterval
+	defer func() {
+		flagOutput, flagNonInteractive = origOutput, origNonInteractive
+		flagUnjailWait, flagUnjailWaitTimeout, unjailPollInterval = origWait, origTimeout, origInterval
+	}()
+	flagNonInteractive = true
+	flagUnjailWait, flagUnjailWaitTimeout, unjailPollInterval = true, time.Second, time.Millisecond
+
+	pastTime := time.Now().Add(-1 * time.Hour).Format(time.RFC3339Nano)
+	jailed := validator.MyValidatorInfo{
+		IsValidator:  true,
+		Address:      "pushvaloper1test",
+		Jailed:       true,
+		Status:       "UNBONDED",
+		SlashingInfo: validator.SlashingInfo{JailedUntil: pastTime},
+	}
+	bonded := jailed
+	bonded.Jailed, bonded.Status = false, "BONDED"
+
+	for _, output := range []string{"json", "text"} {
+		flagOutput = output
+		runner := newMockRunner()
+		binPath := findPchaind()
+		runner.outputs[binPath+" debug addr pushvaloper1test"] = []byte("Bech32 Acc: push1account\n")
+		runner.outputs[binPath+" debug addr push1account"] = []byte("Address (hex): AABB1234\n")
+
+		f := &sequenceFetcher{states: []validator.MyValidatorInfo{jailed, jailed, bonded}}
+		d := unjailDeps(func(d *Deps) {
+			d.Fetcher = f
+			d.Validator = &mockValidator{unjailResult: "TX_HASH_123"}
+			d.Runner = runner
+		})
+
+		if err := handleUnjail(d); err != nil {
+			t.Fatalf("%s: unexpected error: %v", output, err)
+		}
+		if f.calls != 3 {
+			t.Errorf("%s: expected 1 pre-check and 2 polls, got %d fetches", output, f.calls)
+		}
+	}
+}
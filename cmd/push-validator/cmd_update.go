@@ -1,16 +1,17 @@
 package main
 
 import (
-	"bytes"
 	"fmt"
 	"io"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/archive"
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/diskspace"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/update"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 	"github.com/spf13/cobra"
@@ -22,6 +23,7 @@ type CLIUpdater interface {
 	FetchReleaseByTag(tag string) (*update.Release, error)
 	Download(asset *update.Asset, progress update.ProgressFunc) ([]byte, error)
 	VerifyChecksum(data []byte, release *update.Release, assetName string) error
+	ChecksumAvailable(release *update.Release, assetName string) error
 	ExtractBinary(archiveData []byte) ([]byte, error)
 	Install(binaryData []byte) error
 	Rollback() error
@@ -34,19 +36,30 @@ type updateCoreOpts struct {
 	skipVerify     bool
 	currentVersion string
 	binaryPath     string
+	fromFile       string // install from a local archive instead of GitHub
+	dryRun         bool   // resolve and print the plan without downloading
+	strict         bool   // with checkOnly, fail with ErrUpdateAvailable when a newer release exists
 }
 
 // runUpdateCore contains the core update logic, testable with a mocked CLIUpdater.
 func runUpdateCore(updater CLIUpdater, cfg config.Config, opts updateCoreOpts, p ui.Printer, prompter Prompter, output io.Writer, verifyBinary func(string) (string, error)) error {
+	if opts.fromFile != "" {
+		return runOfflineUpdate(updater, cfg, opts, p, prompter, verifyBinary)
+	}
 
 	// Fetch release (latest or specific version)
+	quiet := opts.dryRun && flagOutput == "json"
 	var release *update.Release
 	var err error
 	if opts.version != "" {
-		p.Info(fmt.Sprintf("Fetching release %s...", opts.version))
+		if !quiet {
+			p.Info(fmt.Sprintf("Fetching release %s...", opts.version))
+		}
 		release, err = updater.FetchReleaseByTag(opts.version)
 	} else {
-		p.Info("Checking for updates...")
+		if !quiet {
+			p.Info("Checking for updates...")
+		}
 		release, err = updater.FetchLatestRelease()
 	}
 	if err != nil {
@@ -58,12 +71,17 @@ func runUpdateCore(updater CLIUpdater, cfg config.Config, opts updateCoreOpts, p
 
 	// Save result to cache
 	updateAvailable := update.IsNewerVersion(opts.currentVersion, release.TagName)
-	_ = update.SaveCache(cfg.HomeDir, &update.CacheEntry{
+	_ = update.SaveCache(cfg.UpdateCacheLocation(), &update.CacheEntry{
 		CheckedAt:       time.Now(),
 		LatestVersion:   latestVersion,
 		UpdateAvailable: updateAvailable,
+		Interval:        cfg.UpdateCheckInterval,
 	})
 
+	if opts.dryRun {
+		return printUpdatePlan(updater, release, opts, p, updateAvailable || opts.force)
+	}
+
 	// Check if update needed
 	if !opts.force && !update.IsNewerVersion(opts.currentVersion, release.TagName) {
 		p.Success(fmt.Sprintf("Already up to date (v%s)", currentVersion))
@@ -95,6 +113,9 @@ func runUpdateCore(updater CLIUpdater, cfg config.Config, opts updateCoreOpts, p
 	// Check only mode
 	if opts.checkOnly {
 		p.Info("Run 'push-validator update' to install")
+		if opts.strict && updateAvailable {
+			return exitcodes.ErrUpdateAvailable
+		}
 		return nil
 	}
 
@@ -117,6 +138,9 @@ func runUpdateCore(updater CLIUpdater, cfg config.Config, opts updateCoreOpts, p
 	if err != nil {
 		return err
 	}
+	if err := checkUpdateDiskSpace(opts, asset.Size); err != nil {
+		return err
+	}
 
 	// Download with progress bar
 	p.Info(fmt.Sprintf("Downloading %s...", asset.Name))
@@ -140,49 +164,212 @@ func runUpdateCore(updater CLIUpdater, cfg config.Config, opts updateCoreOpts, p
 		p.Warn("Skipping checksum verification (not recommended)")
 	}
 
+	if _, err := installUpdateArchive(updater, archiveData, opts, p, verifyBinary); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	p.Success(fmt.Sprintf("Updated to v%s", latestVersion))
+	fmt.Println()
+
+	// Check if node is running and suggest restart
+	if checkNodeRunningInDir(cfg.HomeDir) {
+		p.Info("Node is running. Run 'push-validator restart' to use the new version.")
+	}
+
+	return nil
+}
+
+// printUpdatePlan reports what an update would do without downloading the
+// release asset. The platform asset and checksums.txt are resolved so the
+// plan fails the same way the real update would.
+func printUpdatePlan(updater CLIUpdater, release *update.Release, opts updateCoreOpts, p ui.Printer, wouldUpdate bool) error {
+	currentVersion := strings.TrimPrefix(opts.currentVersion, "v")
+	latestVersion := strings.TrimPrefix(release.TagName, "v")
+
+	if !wouldUpdate {
+		if flagOutput == "json" {
+			p.JSON(map[string]any{
+				"ok":               true,
+				"dry_run":          true,
+				"status":           "up_to_date",
+				"update_available": false,
+				"current_version":  currentVersion,
+				"latest_version":   latestVersion,
+			})
+			return nil
+		}
+		p.Success(fmt.Sprintf("Already up to date (v%s), nothing to do", currentVersion))
+		return nil
+	}
+
+	asset, err := update.GetAssetForPlatform(release)
+	if err != nil {
+		return err
+	}
+	var checksumErr error
+	if !opts.skipVerify {
+		if checksumErr = updater.ChecksumAvailable(release, asset.Name); checksumErr != nil {
+			checksumErr = fmt.Errorf("checksum verification would fail: %w", checksumErr)
+		}
+	}
+
+	if flagOutput == "json" {
+		plan := map[string]any{
+			"ok":                 checksumErr == nil,
+			"dry_run":            true,
+			"status":             "update_available",
+			"update_available":   true,
+			"current_version":    currentVersion,
+			"latest_version":     latestVersion,
+			"asset":              asset.Name,
+			"size":               asset.Size,
+			"install_path":       opts.binaryPath,
+			"checksum_available": !opts.skipVerify && checksumErr == nil,
+		}
+		if checksumErr != nil {
+			plan["error"] = checksumErr.Error()
+		}
+		p.JSON(plan)
+	} else {
+		fmt.Println()
+		p.Info(fmt.Sprintf("Update available: v%s → v%s", currentVersion, latestVersion))
+		fmt.Printf("  Asset:        %s (%s)\n", asset.Name, ui.FormatBytes(asset.Size))
+		fmt.Printf("  Install path: %s\n", opts.binaryPath)
+		switch {
+		case opts.skipVerify:
+			fmt.Println("  Checksum:     skipped (--no-verify)")
+		case checksumErr == nil:
+			fmt.Println("  Checksum:     available in checksums.txt")
+		}
+		fmt.Println()
+	}
+	if checksumErr != nil {
+		if flagOutput == "json" {
+			return silentErr{checksumErr}
+		}
+		return checksumErr
+	}
+	if flagOutput != "json" {
+		p.Info("Dry run: nothing was downloaded or installed. Run without --dry-run to apply.")
+	}
+	return nil
+}
+
+// updateSpaceFactor is the free space an update needs next to the installed
+// binary, as a multiple of the archive size: the archive expands ~3x, and both
+// the new binary and the backup of the current one are written.
+const updateSpaceFactor = 6
+
+// checkUpdateDiskSpace fails before anything is downloaded or replaced when
+// the install directory is too full. --force skips it.
+func checkUpdateDiskSpace(opts updateCoreOpts, archiveSize int64) error {
+	if opts.force || opts.binaryPath == "" {
+		return nil
+	}
+	if err := diskspace.Check(filepath.Dir(opts.binaryPath), archiveSize*updateSpaceFactor); err != nil {
+		return exitcodes.PreconditionErrorf("%v (pass --force to skip this check)", err)
+	}
+	return nil
+}
+
+// installUpdateArchive extracts the binary from archiveData, installs it and
+// verifies the result, rolling back if the new binary does not run. Returns
+// the output of verifyBinary.
+func installUpdateArchive(updater CLIUpdater, archiveData []byte, opts updateCoreOpts, p ui.Printer, verifyBinary func(string) (string, error)) (string, error) {
 	// Extract binary
 	p.Info("Extracting binary...")
 	binaryData, err := updater.ExtractBinary(archiveData)
 	if err != nil {
-		return fmt.Errorf("extraction failed: %w", err)
+		return "", fmt.Errorf("extraction failed: %w", err)
 	}
 
 	// Install
 	p.Info("Installing...")
 	if err := updater.Install(binaryData); err != nil {
-		return fmt.Errorf("installation failed: %w", err)
+		return "", fmt.Errorf("installation failed: %w", err)
 	}
 
 	// Verify new binary
 	p.Info("Verifying installation...")
+	var installed string
 	if verifyBinary != nil {
-		if _, verErr := verifyBinary(opts.binaryPath); verErr != nil {
-			p.Warn("Verification failed, rolling back...")
+		out, verErr := verifyBinary(opts.binaryPath)
+		if verErr != nil {
+			p.Warn("New binary failed its smoke test, rolling back...")
 			if rbErr := updater.Rollback(); rbErr != nil {
-				return fmt.Errorf("rollback failed: %w (original error: %v)", rbErr, verErr)
+				return "", fmt.Errorf("rollback failed: %w (original error: %v)", rbErr, verErr)
 			}
-			return fmt.Errorf("new binary verification failed, rolled back: %w", verErr)
+			return "", fmt.Errorf("new binary failed its smoke test and was rolled back: %w", verErr)
 		}
+		installed = out
+	}
+	return installed, nil
+}
+
+// runOfflineUpdate installs push-validator from a pre-staged archive without
+// contacting GitHub. The archive is validated (and checked against a sibling
+// .sha256 or .sha512 file when present) before the live binary is touched.
+func runOfflineUpdate(updater CLIUpdater, cfg config.Config, opts updateCoreOpts, p ui.Printer, prompter Prompter, verifyBinary func(string) (string, error)) error {
+	p.Info(fmt.Sprintf("Reading %s...", opts.fromFile))
+	local, err := archive.ReadLocal(opts.fromFile, !opts.skipVerify)
+	if err != nil {
+		return err
+	}
+	switch {
+	case opts.skipVerify:
+		p.Warn("Skipping checksum verification (not recommended)")
+	case local.ChecksumVerified:
+		p.Success("Checksum verified")
+	default:
+		p.Warn(fmt.Sprintf("No %s.sha256 or .sha512 found, skipping checksum verification", filepath.Base(opts.fromFile)))
+	}
+
+	if !opts.force && !flagYes {
+		response, err := prompter.ReadLine(fmt.Sprintf("Install push-validator from %s? [Y/n]: ", filepath.Base(opts.fromFile)))
+		if err != nil {
+			p.Warn("Update cancelled")
+			return nil
+		}
+		response = strings.ToLower(response)
+		if response != "" && response != "y" && response != "yes" {
+			p.Warn("Update cancelled")
+			return nil
+		}
+	}
+
+	if err := checkUpdateDiskSpace(opts, int64(len(local.Data))); err != nil {
+		return err
+	}
+	installed, err := installUpdateArchive(updater, local.Data, opts, p, verifyBinary)
+	if err != nil {
+		return err
 	}
 
 	fmt.Println()
-	p.Success(fmt.Sprintf("Updated to v%s", latestVersion))
+	if installed != "" {
+		p.Success(fmt.Sprintf("Installed %s from %s", installed, filepath.Base(opts.fromFile)))
+	} else {
+		p.Success(fmt.Sprintf("Installed from %s", filepath.Base(opts.fromFile)))
+	}
 	fmt.Println()
 
-	// Check if node is running and suggest restart
 	if checkNodeRunningInDir(cfg.HomeDir) {
 		p.Info("Node is running. Run 'push-validator restart' to use the new version.")
 	}
-
 	return nil
 }
 
 func init() {
 	var (
-		checkOnly  bool
-		force      bool
-		version    string
-		skipVerify bool
+		checkOnly    bool
+		force        bool
+		version      string
+		skipVerify   bool
+		fromFile     string
+		noVerifyExec bool
+		dryRun       bool
+		strict       bool
 	)
 
 	updateCmd := &cobra.Command{
@@ -196,9 +383,22 @@ verifies the checksum, and replaces the current binary.
 Examples:
   push-validator update              # Update to latest version
   push-validator update --check      # Check only, don't install
+  push-validator update --check --strict  # Exit 20 if an update is available
   push-validator update --force      # Skip confirmation
-  push-validator update --version v1.2.0  # Install specific version`,
+  push-validator update --version v1.2.0  # Install specific version
+  push-validator update --dry-run    # Show what would be installed
+  push-validator update --from-file ./push-validator_1.2.0_linux_amd64.tar.gz  # Offline install`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if fromFile != "" && (checkOnly || version != "") {
+				return fmt.Errorf("--from-file cannot be combined with --check or --version")
+			}
+			if dryRun && (checkOnly || fromFile != "") {
+				return fmt.Errorf("--dry-run cannot be combined with --check or --from-file")
+			}
+			if strict && !checkOnly {
+				return fmt.Errorf("--strict requires --check")
+			}
+
 			// Create updater
 			updater, err := update.New(Version)
 			if err != nil {
@@ -206,6 +406,7 @@ Examples:
 			}
 
 			cfg := loadCfg()
+			updater.LockDir = cfg.HomeDir
 			opts := updateCoreOpts{
 				checkOnly:      checkOnly,
 				force:          force,
@@ -213,16 +414,16 @@ Examples:
 				skipVerify:     skipVerify,
 				currentVersion: Version,
 				binaryPath:     updater.BinaryPath,
+				fromFile:       fromFile,
+				dryRun:         dryRun,
+				strict:         strict,
 			}
 
-			verifyBinary := func(path string) (string, error) {
-				verifyCmd := exec.Command(path, "version")
-				var stdout bytes.Buffer
-				verifyCmd.Stdout = &stdout
-				if err := verifyCmd.Run(); err != nil {
-					return "", err
-				}
-				return strings.TrimSpace(stdout.String()), nil
+			// Run the installed binary before declaring success so a corrupt
+			// or incompatible build is rolled back instead of left in place
+			verifyBinary := update.SmokeTest
+			if noVerifyExec {
+				verifyBinary = nil
 			}
 
 			return runUpdateCore(updater, cfg, opts, getPrinter(), &ttyPrompter{}, os.Stdout, verifyBinary)
@@ -230,9 +431,13 @@ Examples:
 	}
 
 	updateCmd.Flags().BoolVar(&checkOnly, "check", false, "Only check for updates, don't install")
-	updateCmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+	updateCmd.Flags().BoolVar(&strict, "strict", false, "With --check, exit with code 20 when an update is available")
+	updateCmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt and disk-space check")
 	updateCmd.Flags().StringVar(&version, "version", "", "Install specific version (e.g., v1.2.0)")
 	updateCmd.Flags().BoolVar(&skipVerify, "no-verify", false, "Skip checksum verification (not recommended)")
+	updateCmd.Flags().BoolVar(&noVerifyExec, "no-verify-exec", false, "Skip running the new binary after install (no automatic rollback)")
+	updateCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the release, asset and install path that would be used without downloading or installing")
+	updateCmd.Flags().StringVar(&fromFile, "from-file", "", "Install from a local release archive instead of downloading (checked against <archive>.sha256 or .sha512 if present)")
 
 	rootCmd.AddCommand(updateCmd)
 }
@@ -196,9 +196,14 @@ func handleEditValidator(d *Deps) error {
 		fmt.Println(" " + p.Colors.Success(p.Colors.Emoji("✓")))
 	}
 
+	txRes, err := confirmTx(d.Validator, txHash, flagTxWait, flagTxWaitTimeout)
+	if err != nil {
+		return err
+	}
+
 	// Success output
 	if flagOutput == "json" {
-		getPrinter().JSON(map[string]any{"ok": true, "txhash": txHash})
+		getPrinter().JSON(withTxResult(map[string]any{"ok": true, "txhash": txHash}, txRes))
 	} else {
 		fmt.Println()
 		p.Success(p.Colors.Emoji("✅") + " Validator profile updated successfully!")
@@ -1,13 +1,20 @@
 package main
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
+	"github.com/pushchain/push-validator-cli/internal/diskspace"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/update"
 )
 
@@ -68,10 +75,14 @@ type mockCLIUpdater struct {
 	downloadData  []byte
 	downloadErr   error
 	checksumErr   error
+	availableErr  error
 	extractData   []byte
 	extractErr    error
 	installErr    error
 	rollbackErr   error
+
+	downloaded bool
+	installed  bool
 }
 
 func (m *mockCLIUpdater) FetchLatestRelease() (*update.Release, error) {
@@ -81,6 +92,7 @@ func (m *mockCLIUpdater) FetchReleaseByTag(tag string) (*update.Release, error)
 	return m.tagRelease, m.tagErr
 }
 func (m *mockCLIUpdater) Download(asset *update.Asset, progress update.ProgressFunc) ([]byte, error) {
+	m.downloaded = true
 	if progress != nil {
 		progress(100, 100)
 	}
@@ -89,10 +101,14 @@ func (m *mockCLIUpdater) Download(asset *update.Asset, progress update.ProgressF
 func (m *mockCLIUpdater) VerifyChecksum(data []byte, release *update.Release, assetName string) error {
 	return m.checksumErr
 }
+func (m *mockCLIUpdater) ChecksumAvailable(release *update.Release, assetName string) error {
+	return m.availableErr
+}
 func (m *mockCLIUpdater) ExtractBinary(archiveData []byte) ([]byte, error) {
 	return m.extractData, m.extractErr
 }
 func (m *mockCLIUpdater) Install(binaryData []byte) error {
+	m.installed = true
 	return m.installErr
 }
 func (m *mockCLIUpdater) Rollback() error {
@@ -204,6 +220,26 @@ func TestRunUpdateCore_CheckOnly(t *testing.T) {
 	}
 }
 
+func TestRunUpdateCore_CheckOnlyStrict(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	cfg := testCfg()
+	m := &mockCLIUpdater{latestRelease: testRelease("v2.0.0")}
+	opts := updateCoreOpts{currentVersion: "v1.0.0", checkOnly: true, strict: true}
+
+	err := runUpdateCore(m, cfg, opts, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil)
+	if !errors.Is(err, exitcodes.ErrUpdateAvailable) || exitcodes.CodeForError(err) != exitcodes.UpdateAvailable {
+		t.Fatalf("expected ErrUpdateAvailable, got %v", err)
+	}
+
+	opts.currentVersion = "v2.0.0"
+	if err := runUpdateCore(m, cfg, opts, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil); err != nil {
+		t.Fatalf("up to date should succeed, got %v", err)
+	}
+}
+
 func TestRunUpdateCore_DownloadError(t *testing.T) {
 	origOutput := flagOutput
 	defer func() { flagOutput = origOutput }()
@@ -589,3 +625,209 @@ func TestRunUpdateCore_LongChangelog(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+// testArchive returns a tar.gz holding a single file name with body.
+func testArchive(t *testing.T, name string, body []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(body)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = tw.Write(body)
+	_ = tw.Close()
+	_ = gz.Close()
+	return buf.Bytes()
+}
+
+// writeTestArchive writes a minimal tar.gz containing name to dir and returns its path.
+func writeTestArchive(t *testing.T, dir, archiveName, name string) string {
+	t.Helper()
+	path := filepath.Join(dir, archiveName)
+	if err := os.WriteFile(path, testArchive(t, name, []byte("binary")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestInstallUpdateArchive_SmokeTestFailureRollsBack(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts not supported on windows")
+	}
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	dir := t.TempDir()
+	binPath := filepath.Join(dir, "push-validator")
+	original := []byte("#!/bin/sh\necho v1.0.0\n")
+	if err := os.WriteFile(binPath, original, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	u := &update.Updater{CurrentVersion: "1.0.0", BinaryPath: binPath}
+	broken := testArchive(t, "push-validator", []byte("#!/bin/sh\nexit 1\n"))
+
+	_, err := installUpdateArchive(u, broken, updateCoreOpts{binaryPath: binPath}, testPrinter(), update.SmokeTest)
+	if err == nil || !strings.Contains(err.Error(), "rolled back") {
+		t.Fatalf("expected rolled-back error, got %v", err)
+	}
+	if got, _ := os.ReadFile(binPath); !bytes.Equal(got, original) {
+		t.Errorf("binary after failed update = %q, want original restored", got)
+	}
+	if out, err := update.SmokeTest(binPath); err != nil || out != "v1.0.0" {
+		t.Errorf("restored binary SmokeTest() = %q, %v", out, err)
+	}
+}
+
+func TestRunUpdateCore_FromFile(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	path := writeTestArchive(t, t.TempDir(), "push-validator_1.2.0_linux_amd64.tar.gz", "push-validator")
+	// Fetch and download fail so any network access shows up as an error
+	m := &mockCLIUpdater{
+		latestErr:   fmt.Errorf("offline"),
+		downloadErr: fmt.Errorf("offline"),
+		extractData: []byte("binary"),
+	}
+
+	err := runUpdateCore(m, testCfg(), updateCoreOpts{
+		currentVersion: "v1.0.0",
+		force:          true,
+		fromFile:       path,
+	}, testPrinter(), &nonInteractivePrompter{}, io.Discard, func(string) (string, error) { return "push-validator v1.2.0", nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRunUpdateCore_FromFile_Rejected(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	dir := t.TempDir()
+	notArchive := filepath.Join(dir, "notes.txt")
+	_ = os.WriteFile(notArchive, []byte("hello"), 0o644)
+	badSum := writeTestArchive(t, dir, "pv.tar.gz", "push-validator")
+	_ = os.WriteFile(badSum+".sha256", []byte(strings.Repeat("0", 64)+"  pv.tar.gz\n"), 0o644)
+
+	for name, path := range map[string]string{
+		"missing":      filepath.Join(dir, "missing.tar.gz"),
+		"not archive":  notArchive,
+		"bad checksum": badSum,
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Install fails loudly if it is reached
+			m := &mockCLIUpdater{extractData: []byte("binary"), installErr: fmt.Errorf("live binary touched")}
+			err := runUpdateCore(m, testCfg(), updateCoreOpts{force: true, fromFile: path}, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil)
+			if err == nil || strings.Contains(err.Error(), "live binary touched") {
+				t.Fatalf("expected validation error before install, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRunUpdateCore_DryRun(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	for _, output := range []string{"text", "json"} {
+		flagOutput = output
+		t.Run(output+"/update available", func(t *testing.T) {
+			m := &mockCLIUpdater{latestRelease: testRelease("v2.0.0")}
+			err := runUpdateCore(m, testCfg(), updateCoreOpts{
+				currentVersion: "v1.0.0",
+				binaryPath:     "/tmp/fake",
+				dryRun:         true,
+			}, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if m.downloaded || m.installed {
+				t.Errorf("dry run must not download or install (downloaded=%v installed=%v)", m.downloaded, m.installed)
+			}
+		})
+		t.Run(output+"/up to date", func(t *testing.T) {
+			m := &mockCLIUpdater{latestRelease: testRelease("v1.0.0"), availableErr: fmt.Errorf("not consulted")}
+			err := runUpdateCore(m, testCfg(), updateCoreOpts{
+				currentVersion: "v1.0.0",
+				dryRun:         true,
+			}, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+		t.Run(output+"/checksum unavailable", func(t *testing.T) {
+			m := &mockCLIUpdater{latestRelease: testRelease("v2.0.0"), availableErr: fmt.Errorf("checksum not found")}
+			err := runUpdateCore(m, testCfg(), updateCoreOpts{
+				currentVersion: "v1.0.0",
+				dryRun:         true,
+			}, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil)
+			if err == nil || !strings.Contains(err.Error(), "checksum") {
+				t.Fatalf("expected checksum error, got %v", err)
+			}
+			if m.downloaded {
+				t.Error("dry run must not download")
+			}
+		})
+	}
+}
+
+func TestRunUpdateCore_DryRunNoAssetForPlatform(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	release := testRelease("v2.0.0")
+	release.Assets = nil
+	m := &mockCLIUpdater{latestRelease: release}
+	err := runUpdateCore(m, testCfg(), updateCoreOpts{
+		currentVersion: "v1.0.0",
+		dryRun:         true,
+	}, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil)
+	if err == nil {
+		t.Fatal("expected error when no asset matches the platform")
+	}
+}
+
+func TestRunUpdateCore_InsufficientDiskSpace(t *testing.T) {
+	origOutput, origYes, origFree := flagOutput, flagYes, diskspace.Free
+	defer func() { flagOutput, flagYes, diskspace.Free = origOutput, origYes, origFree }()
+	flagOutput = "text"
+	flagYes = true
+	diskspace.Free = func(string) (int64, error) { return 1 << 20, nil }
+
+	m := &mockCLIUpdater{
+		latestRelease: testRelease("v2.0.0"),
+		downloadData:  []byte("fake-archive"),
+		extractData:   []byte("fake-binary"),
+	}
+	opts := updateCoreOpts{
+		currentVersion: "v1.0.0",
+		skipVerify:     true,
+		binaryPath:     filepath.Join(t.TempDir(), "push-validator"),
+	}
+
+	err := runUpdateCore(m, testCfg(), opts, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil)
+	if err == nil || !strings.Contains(err.Error(), "insufficient disk space") || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("expected disk space error mentioning --force, got %v", err)
+	}
+	if code := exitcodes.CodeForError(err); code != exitcodes.PreconditionFailed {
+		t.Errorf("exit code = %d, want %d", code, exitcodes.PreconditionFailed)
+	}
+	if m.downloaded || m.installed {
+		t.Error("nothing should be downloaded or installed when the preflight fails")
+	}
+
+	// --force skips the check
+	opts.force = true
+	if err := runUpdateCore(m, testCfg(), opts, testPrinter(), &nonInteractivePrompter{}, io.Discard, nil); err != nil {
+		t.Fatalf("unexpected error with --force: %v", err)
+	}
+	if !m.installed {
+		t.Error("expected install with --force")
+	}
+}
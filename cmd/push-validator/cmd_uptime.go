@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// uptimeFetcher computes this node's uptime; validator.GetMyUptime in production.
+type uptimeFetcher func(ctx context.Context, cfg config.Config) (validator.Uptime, error)
+
+// handleUptime prints the validator's signing uptime within the slashing
+// window and how many more blocks it can miss before being jailed.
+func handleUptime(d *Deps, watch bool, interval time.Duration) error {
+	return handleUptimeWith(d, watch, interval, validator.GetMyUptime)
+}
+
+// handleUptimeWith is the testable core of handleUptime.
+func handleUptimeWith(d *Deps, watch bool, interval time.Duration, fetch uptimeFetcher) error {
+	if watch && flagOutput == "json" {
+		return fmt.Errorf("--watch cannot be used with --output json")
+	}
+	if watch && interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	if !watch {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+		u, err := fetch(ctx, d.Cfg)
+		cancel()
+		if err != nil {
+			if flagOutput == "json" {
+				d.Printer.JSON(map[string]any{"ok": false, "error": err.Error()})
+				return silentErr{err}
+			}
+			return fmt.Errorf("failed to get uptime: %w", err)
+		}
+		if flagOutput == "json" {
+			d.Printer.JSON(map[string]any{"ok": true, "uptime": u})
+			return nil
+		}
+		if flagOutput == "table" {
+			writeUptimeTable(os.Stdout, u)
+			return nil
+		}
+		renderUptime(d, u)
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+	for {
+		fetchCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
+		u, err := fetch(fetchCtx, d.Cfg)
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if isTTY {
+			fmt.Print("\033[H\033[2J")
+		}
+		fmt.Println(d.Printer.Colors.Description(fmt.Sprintf("%s  (refreshing every %s, Ctrl+C to exit)", time.Now().Format("15:04:05"), interval)))
+		if err != nil {
+			d.Printer.Warn(fmt.Sprintf("failed to get uptime: %v", err))
+		} else if flagOutput == "table" {
+			writeUptimeTable(os.Stdout, u)
+		} else {
+			renderUptime(d, u)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// renderUptime prints the uptime readout, colored by how much of the
+// downtime budget is used.
+func renderUptime(d *Deps, u validator.Uptime) {
+	p := d.Printer
+	c := p.Colors
+	color := c.Success
+	switch u.Level {
+	case validator.UptimeWarning:
+		color = c.Warning
+	case validator.UptimeCritical:
+		color = c.Error
+	}
+
+	fmt.Println()
+	p.Header("Validator Uptime")
+	fmt.Printf("%s %s\n", c.Label("Uptime:"), color(fmt.Sprintf("%.2f%%", u.UptimePct)))
+	p.KeyValueLine("Missed Blocks", fmt.Sprintf("%d / %d (window)", u.Missed, u.Window), "")
+	p.KeyValueLine("Jail Threshold", fmt.Sprintf("uptime below %.2f%% (more than %d missed)", u.ThresholdPct, u.MaxMissed), "dim")
+	fmt.Printf("%s %s\n", c.Label("Misses Until Jail:"), color(fmt.Sprintf("%d", u.MissesLeft)))
+	fmt.Println()
+
+	switch {
+	case u.Tombstoned:
+		fmt.Println(c.Error(c.Emoji("❌") + " Validator is tombstoned (double-sign) and cannot be unjailed"))
+	case u.Level == validator.UptimeCritical:
+		fmt.Println(c.Error(c.Emoji("🛑") + " Close to the downtime jail threshold. Check the node now:"))
+		fmt.Println(c.Apply(c.Theme.Command, "  push-validator status"))
+	case u.Level == validator.UptimeWarning:
+		fmt.Println(c.Warning(c.Emoji("⚠️") + " Over half of the allowed downtime in this window is used"))
+	default:
+		fmt.Println(c.Success(c.Emoji("✓") + " Signing normally"))
+	}
+	fmt.Println()
+}
+
+// writeUptimeTable renders u as a one-row table for --output table.
+func writeUptimeTable(w io.Writer, u validator.Uptime) {
+	writeOutputTable(w,
+		[]string{"UPTIME", "MISSED", "WINDOW", "JAIL_BELOW", "MISSES_LEFT", "LEVEL", "TOMBSTONED"},
+		[][]string{{
+			fmt.Sprintf("%.2f%%", u.UptimePct),
+			fmt.Sprintf("%d", u.Missed),
+			fmt.Sprintf("%d", u.Window),
+			fmt.Sprintf("%.2f%%", u.ThresholdPct),
+			fmt.Sprintf("%d", u.MissesLeft),
+			u.Level,
+			fmt.Sprintf("%v", u.Tombstoned),
+		}})
+}
+
+func init() {
+	var (
+		watch    bool
+		interval time.Duration
+	)
+	uptimeCmd := &cobra.Command{
+		Use:     "uptime",
+		Aliases: []string{"missed-blocks"},
+		Short:   "Show signing uptime and blocks left before downtime jailing",
+		Long: `Show the validator's uptime within the chain's slashing window.
+
+The missed-blocks counter from the validator's signing info is compared with
+the slashing params (signed_blocks_window, min_signed_per_window) to show how
+many more blocks can be missed before the validator is jailed for downtime.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return handleUptime(newDeps(), watch, interval)
+		},
+	}
+	uptimeCmd.Flags().BoolVar(&watch, "watch", false, "Refresh continuously until interrupted")
+	uptimeCmd.Flags().DurationVar(&interval, "interval", 10*time.Second, "Refresh interval for --watch")
+	rootCmd.AddCommand(uptimeCmd)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+func TestHandleUptime(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	params := validator.SlashingParams{SignedBlocksWindow: 100, MinSignedPerWindow: 0.5}
+	for _, output := range []string{"text", "json", "table"} {
+		for _, missed := range []int64{0, 30, 45} {
+			flagOutput = output
+			d := &Deps{Cfg: testCfg(), Printer: getPrinter()}
+			err := handleUptimeWith(d, false, 0, func(context.Context, config.Config) (validator.Uptime, error) {
+				return validator.ComputeUptime(missed, params), nil
+			})
+			if err != nil {
+				t.Fatalf("%s missed=%d: unexpected error: %v", output, missed, err)
+			}
+		}
+	}
+}
+
+func TestHandleUptime_Errors(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+
+	failing := func(context.Context, config.Config) (validator.Uptime, error) {
+		return validator.Uptime{}, fmt.Errorf("signing info not found")
+	}
+	for _, output := range []string{"text", "json"} {
+		flagOutput = output
+		d := &Deps{Cfg: testCfg(), Printer: getPrinter()}
+		if err := handleUptimeWith(d, false, 0, failing); err == nil || !strings.Contains(err.Error(), "signing info not found") {
+			t.Errorf("%s: expected fetch error, got %v", output, err)
+		}
+	}
+
+	flagOutput = "json"
+	d := &Deps{Cfg: testCfg(), Printer: getPrinter()}
+	if err := handleUptimeWith(d, true, time.Second, failing); err == nil || !strings.Contains(err.Error(), "--watch") {
+		t.Errorf("expected --watch/json conflict error, got %v", err)
+	}
+	flagOutput = "text"
+	if err := handleUptimeWith(d, true, 0, failing); err == nil {
+		t.Error("expected error for non-positive interval")
+	}
+}
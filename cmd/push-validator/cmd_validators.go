@@ -1,14 +1,18 @@
 package main
 
 import (
+    "bytes"
     "context"
+    "encoding/json"
     "fmt"
+    "os"
     "sort"
     "strconv"
     "strings"
     "time"
 
     "github.com/pushchain/push-validator-cli/internal/dashboard"
+    "github.com/pushchain/push-validator-cli/internal/exitcodes"
     ui "github.com/pushchain/push-validator-cli/internal/ui"
     "github.com/pushchain/push-validator-cli/internal/validator"
 )
@@ -31,10 +35,157 @@ func truncateAddress(addr string, maxWidth int) string {
     return addr
 }
 
+// Filters for the validators command, set by --jailed, --status and --sort.
+var (
+    validatorsJailed bool
+    validatorsStatus string
+    validatorsSort   string
+)
+
+// validatorsEVM adds EVM addresses to JSON output and the full operator
+// address to the table (--evm).
+var validatorsEVM bool
+
+// Paging for the validators command, set by --page and --page-size. The
+// table is always paged; JSON only when --page is given.
+var (
+    validatorsPage     int
+    validatorsPageSize int
+    validatorsPaged    bool // --page was given
+)
+
+// validatorsPageBounds returns the bounds of 1-based page over n
+// validators and the number of pages, or an error naming the valid range.
+func validatorsPageBounds(n, page, size int) (start, end, pages int, err error) {
+    if size < 1 {
+        return 0, 0, 0, exitcodes.InvalidArgsErrorf("validators: --page-size must be at least 1, got %d", size)
+    }
+    pages = max((n+size-1)/size, 1)
+    if page < 1 || page > pages {
+        return 0, 0, pages, exitcodes.InvalidArgsErrorf("validators: page %d out of range (1-%d for %d validators at %d per page)", page, pages, n, size)
+    }
+    start = (page - 1) * size
+    return start, min(start+size, n), pages, nil
+}
+
+// validatorsFiltered reports whether any filter or sort flag is set.
+func validatorsFiltered() bool {
+    return validatorsJailed || validatorsStatus != "" || validatorsSort != ""
+}
+
+func validateValidatorsFlags() error {
+    switch strings.ToLower(validatorsStatus) {
+    case "", "bonded", "unbonding", "unbonded":
+    default:
+        return fmt.Errorf("validators: invalid --status %q (use bonded, unbonding or unbonded)", validatorsStatus)
+    }
+    switch strings.ToLower(validatorsSort) {
+    case "", "power", "missed", "commission", "moniker":
+    default:
+        return fmt.Errorf("validators: invalid --sort %q (use power, missed, commission or moniker)", validatorsSort)
+    }
+    return nil
+}
+
+// filterValidators applies --jailed and --status to the fetched list.
+func filterValidators(vals []validator.ValidatorInfo, jailedOnly bool, status string) []validator.ValidatorInfo {
+    out := make([]validator.ValidatorInfo, 0, len(vals))
+    for _, v := range vals {
+        if jailedOnly && !v.Jailed { continue }
+        if status != "" && !strings.EqualFold(v.Status, status) { continue }
+        out = append(out, v)
+    }
+    return out
+}
+
+func commissionPct(v validator.ValidatorInfo) float64 {
+    c, _ := strconv.ParseFloat(strings.TrimSuffix(v.Commission, "%"), 64)
+    return c
+}
+
+// sortValidators orders vals by key: power and missed highest first,
+// commission lowest first, moniker alphabetically. Ties keep fetch order.
+func sortValidators(vals []validator.ValidatorInfo, key string) {
+    var less func(a, b validator.ValidatorInfo) bool
+    switch strings.ToLower(key) {
+    case "power":
+        less = func(a, b validator.ValidatorInfo) bool { return a.VotingPower > b.VotingPower }
+    case "missed":
+        less = func(a, b validator.ValidatorInfo) bool { return a.MissedBlocks > b.MissedBlocks }
+    case "commission":
+        less = func(a, b validator.ValidatorInfo) bool { return commissionPct(a) < commissionPct(b) }
+    case "moniker":
+        less = func(a, b validator.ValidatorInfo) bool { return strings.ToLower(a.Moniker) < strings.ToLower(b.Moniker) }
+    default:
+        return
+    }
+    sort.SliceStable(vals, func(i, j int) bool { return less(vals[i], vals[j]) })
+}
+
+// selectRawValidators keeps the chain's native JSON objects for the given
+// validators, in their order.
+func selectRawValidators(raw []byte, keep []validator.ValidatorInfo) ([]byte, error) {
+    var parsed struct {
+        Validators []json.RawMessage `json:"validators"`
+    }
+    if err := json.Unmarshal(raw, &parsed); err != nil { return nil, err }
+    byAddr := make(map[string]json.RawMessage, len(parsed.Validators))
+    for _, r := range parsed.Validators {
+        var v struct {
+            OperatorAddress string `json:"operator_address"`
+        }
+        if json.Unmarshal(r, &v) == nil { byAddr[v.OperatorAddress] = r }
+    }
+    selected := make([]json.RawMessage, 0, len(keep))
+    for _, v := range keep {
+        if r, ok := byAddr[v.OperatorAddress]; ok { selected = append(selected, r) }
+    }
+    return json.MarshalIndent(map[string]any{
+        "validators": selected,
+        "pagination": map[string]any{"next_key": nil, "total": strconv.Itoa(len(selected))},
+    }, "", "  ")
+}
+
+// addEVMAddresses adds an "evm_address" field to each validator object in the
+// chain's JSON output, leaving the rest of every object untouched.
+func addEVMAddresses(raw []byte) ([]byte, error) {
+    var top map[string]json.RawMessage
+    if err := json.Unmarshal(raw, &top); err != nil { return nil, err }
+    var vals []json.RawMessage
+    if err := json.Unmarshal(top["validators"], &vals); err != nil { return nil, err }
+    for i, r := range vals {
+        var v struct {
+            OperatorAddress string `json:"operator_address"`
+        }
+        if err := json.Unmarshal(r, &v); err != nil { return nil, err }
+        field, _ := json.Marshal(validator.Bech32ToHex(v.OperatorAddress))
+        obj := bytes.TrimSpace(r)
+        sep := ","
+        if len(bytes.TrimSpace(obj[1:len(obj)-1])) == 0 { sep = "" }
+        vals[i] = append(append(append([]byte(nil), obj[:len(obj)-1]...), []byte(sep+`"evm_address":`)...), append(field, '}')...)
+    }
+    b, err := json.Marshal(vals)
+    if err != nil { return nil, err }
+    top["validators"] = b
+    return json.MarshalIndent(top, "", "  ")
+}
+
+// myValidatorAddress returns this node's validator operator address, or ""
+// when it isn't a validator or the lookup fails.
+func myValidatorAddress(d *Deps) string {
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+    if myVal, err := d.Fetcher.GetMyValidator(ctx, d.Cfg); err == nil {
+        return myVal.Address
+    }
+    return ""
+}
+
 // handleValidatorsWithFormat prints either a pretty table (default)
 // or raw JSON (--output=json at root) of the current validator set.
 func handleValidatorsWithFormat(d *Deps, jsonOut bool) error {
     cfg := d.Cfg
+    if err := validateValidatorsFlags(); err != nil { return err }
     // For JSON output, query raw data directly (matches chain's native format)
     if jsonOut {
         remote := fmt.Sprintf("https://%s", cfg.GenesisDomain)
@@ -47,6 +198,31 @@ func handleValidatorsWithFormat(d *Deps, jsonOut bool) error {
             }
             return fmt.Errorf("validators: %w", err)
         }
+        if validatorsFiltered() || validatorsPaged {
+            // Decide membership and order from the parsed list, but keep
+            // emitting the chain's own objects
+            valList, err := d.Fetcher.GetAllValidators(ctx, cfg)
+            if err != nil { return fmt.Errorf("validators: %w", err) }
+            keep := filterValidators(valList.Validators, validatorsJailed, validatorsStatus)
+            sortValidators(keep, validatorsSort)
+            if validatorsPaged {
+                // Pages follow the table's order
+                if validatorsSort == "" {
+                    sortValidatorsForDisplay(keep, myValidatorAddress(d))
+                }
+                start, end, _, err := validatorsPageBounds(len(keep), validatorsPage, validatorsPageSize)
+                if err != nil { return err }
+                keep = keep[start:end]
+            }
+            if output, err = selectRawValidators(output, keep); err != nil {
+                return fmt.Errorf("validators: parse output: %w", err)
+            }
+        }
+        if validatorsEVM {
+            if output, err = addEVMAddresses(output); err != nil {
+                return fmt.Errorf("validators: parse output: %w", err)
+            }
+        }
         // passthrough raw JSON
         fmt.Println(string(output))
         return nil
@@ -66,18 +242,27 @@ func handleValidatorsWithFormat(d *Deps, jsonOut bool) error {
         return nil
     }
 
+    filtered := filterValidators(valList.Validators, validatorsJailed, validatorsStatus)
+    if len(filtered) == 0 {
+        fmt.Println("No validators match the given filters")
+        return nil
+    }
     // Fetch my validator info to highlight in table
-    myValidatorAddr := ""
-    myValCtx, myValCancel := context.WithTimeout(context.Background(), 10*time.Second)
-    if myVal, err := d.Fetcher.GetMyValidator(myValCtx, cfg); err == nil {
-        myValidatorAddr = myVal.Address
+    myValidatorAddr := myValidatorAddress(d)
+
+    // An explicit --sort wins; otherwise use the dashboard order
+    sortValidators(filtered, validatorsSort)
+    if validatorsSort == "" {
+        sortValidatorsForDisplay(filtered, myValidatorAddr)
     }
-    myValCancel()
+    total := len(filtered)
+    start, end, pages, err := validatorsPageBounds(total, validatorsPage, validatorsPageSize)
+    if err != nil { return err }
+    filtered = filtered[start:end]
 
     type validatorDisplay struct {
         moniker       string
         status        string
-        statusOrder   int
         jailed        bool
         tokensPC      float64
         commissionPct float64
@@ -85,32 +270,25 @@ func handleValidatorsWithFormat(d *Deps, jsonOut bool) error {
         cosmosAddr    string
         evmAddress    string
         isMyValidator bool
+        missedBlocks  int64
     }
-    vals := make([]validatorDisplay, len(valList.Validators))
+    vals := make([]validatorDisplay, len(filtered))
 
-    for i, v := range valList.Validators {
+    for i, v := range filtered {
         vals[i] = validatorDisplay{
             moniker:       v.Moniker,
             operatorAddr:  v.OperatorAddress,
             cosmosAddr:    v.OperatorAddress,
             jailed:        v.Jailed,
             isMyValidator: myValidatorAddr != "" && v.OperatorAddress == myValidatorAddr,
+            missedBlocks:  v.MissedBlocks,
         }
         if vals[i].moniker == "" {
             vals[i].moniker = "unknown"
         }
 
         // Status is already converted (BONDED, UNBONDING, UNBONDED)
-        switch v.Status {
-        case "BONDED":
-            vals[i].status, vals[i].statusOrder = "BONDED", 1
-        case "UNBONDING":
-            vals[i].status, vals[i].statusOrder = "UNBONDING", 2
-        case "UNBONDED":
-            vals[i].status, vals[i].statusOrder = "UNBONDED", 3
-        default:
-            vals[i].status, vals[i].statusOrder = v.Status, 4
-        }
+        vals[i].status = v.Status
 
         // Parse tokens to PC
         if v.Tokens != "" && v.Tokens != "0" {
@@ -130,18 +308,14 @@ func handleValidatorsWithFormat(d *Deps, jsonOut bool) error {
         // Convert address to EVM format synchronously (pure Go, no subprocess)
         vals[i].evmAddress = validator.Bech32ToHex(v.OperatorAddress)
     }
-    sort.Slice(vals, func(i, j int) bool {
-        // My validator always comes first
-        if vals[i].isMyValidator != vals[j].isMyValidator {
-            return vals[i].isMyValidator
-        }
-        if vals[i].statusOrder != vals[j].statusOrder { return vals[i].statusOrder < vals[j].statusOrder }
-        return vals[i].tokensPC > vals[j].tokensPC
-    })
-    c := ui.NewColorConfig()
-    fmt.Println()
-    fmt.Println(c.Header(" 👥 Active Push Chain Validators "))
+    showMissed := strings.EqualFold(validatorsSort, "missed")
     headers := []string{"VALIDATOR", "STATUS", "STAKE(PC)", "COMM%", "EVM_ADDR"}
+    if showMissed {
+        headers = []string{"VALIDATOR", "STATUS", "STAKE(PC)", "COMM%", "MISSED", "EVM_ADDR"}
+    }
+    if validatorsEVM {
+        headers = append(headers, "OPERATOR_ADDR")
+    }
     rows := make([][]string, 0, len(vals))
     for _, v := range vals {
         // Check if this is my validator
@@ -163,18 +337,39 @@ func handleValidatorsWithFormat(d *Deps, jsonOut bool) error {
             fmt.Sprintf("%.0f%%", v.commissionPct),
             v.evmAddress,
         }
+        if showMissed {
+            row = append(row[:4], ui.FormatNumber(v.missedBlocks), v.evmAddress)
+        }
+        if validatorsEVM {
+            row = append(row, v.operatorAddr)
+        }
+        rows = append(rows, row)
+    }
+    if flagOutput == "table" {
+        writeOutputTable(os.Stdout, headers, rows)
+        return nil
+    }
 
-        // Apply green highlighting to the entire row if it's my validator
+    c := ui.NewColorConfig()
+    // Apply green highlighting to the entire row if it's my validator
+    for i, v := range vals {
         if v.isMyValidator {
-            for i := range row {
-                row[i] = c.Success(row[i])
+            for j := range rows[i] {
+                rows[i][j] = c.Success(rows[i][j])
             }
         }
-
-        rows = append(rows, row)
     }
+    fmt.Println()
+    fmt.Println(c.Header(" 👥 Active Push Chain Validators "))
     fmt.Print(ui.Table(c, headers, rows, nil))
-    fmt.Printf("Total Validators: %d\n", len(vals))
+    switch {
+    case pages > 1:
+        fmt.Printf("Page %d of %d (%d total)\n", validatorsPage, pages, total)
+    case total != valList.Total:
+        fmt.Printf("Showing %d of %d validators\n", total, valList.Total)
+    default:
+        fmt.Printf("Total Validators: %d\n", total)
+    }
     fmt.Println(c.Info("💡 Tip: Use --output=json for full addresses and raw data"))
     return nil
 }
@@ -1,9 +1,14 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"testing"
 
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
@@ -209,3 +214,268 @@ func TestHandleValidatorsWithFormat_TableOutput_NoMyValidator(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func filterTestValidators() []validator.ValidatorInfo {
+	return []validator.ValidatorInfo{
+		{OperatorAddress: "pushvaloper1aaa", Moniker: "charlie", Status: "BONDED", VotingPower: 100, Commission: "10%", MissedBlocks: 3},
+		{OperatorAddress: "pushvaloper1bbb", Moniker: "alpha", Status: "UNBONDED", VotingPower: 50, Commission: "5%", Jailed: true, MissedBlocks: 900},
+		{OperatorAddress: "pushvaloper1ccc", Moniker: "Bravo", Status: "UNBONDING", VotingPower: 10, Commission: "20%", Jailed: true, MissedBlocks: 40},
+	}
+}
+
+func monikers(vals []validator.ValidatorInfo) string {
+	var out []string
+	for _, v := range vals {
+		out = append(out, v.Moniker)
+	}
+	return fmt.Sprint(out)
+}
+
+func TestFilterValidators(t *testing.T) {
+	vals := filterTestValidators()
+	if got := monikers(filterValidators(vals, true, "")); got != "[alpha Bravo]" {
+		t.Errorf("jailed filter = %s", got)
+	}
+	if got := monikers(filterValidators(vals, false, "bonded")); got != "[charlie]" {
+		t.Errorf("status filter = %s", got)
+	}
+	if got := monikers(filterValidators(vals, true, "unbonding")); got != "[Bravo]" {
+		t.Errorf("combined filter = %s", got)
+	}
+}
+
+func TestSortValidators(t *testing.T) {
+	tests := map[string]string{
+		"power":      "[charlie alpha Bravo]",
+		"missed":     "[alpha Bravo charlie]",
+		"commission": "[alpha charlie Bravo]",
+		"moniker":    "[alpha Bravo charlie]",
+	}
+	for key, want := range tests {
+		vals := filterTestValidators()
+		sortValidators(vals, key)
+		if got := monikers(vals); got != want {
+			t.Errorf("sort %s = %s, want %s", key, got, want)
+		}
+	}
+}
+
+func TestValidateValidatorsFlags(t *testing.T) {
+	defer func() { validatorsStatus, validatorsSort = "", "" }()
+
+	validatorsStatus, validatorsSort = "Bonded", "missed"
+	if err := validateValidatorsFlags(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	validatorsStatus = "active"
+	if err := validateValidatorsFlags(); err == nil {
+		t.Error("expected error for invalid status")
+	}
+	validatorsStatus, validatorsSort = "", "uptime"
+	if err := validateValidatorsFlags(); err == nil {
+		t.Error("expected error for invalid sort")
+	}
+}
+
+func TestHandleValidatorsWithFormat_JSONOutput_Filtered(t *testing.T) {
+	defer func() { validatorsJailed, validatorsSort = false, "" }()
+	validatorsJailed, validatorsSort = true, "missed"
+
+	runner := newMockRunner()
+	cfg := testCfg()
+	key := findPchaind() + " query staking validators --node " + fmt.Sprintf("https://%s", cfg.GenesisDomain) + " -o json"
+	runner.outputs[key] = []byte(`{"validators":[{"operator_address":"pushvaloper1aaa"},{"operator_address":"pushvaloper1bbb"},{"operator_address":"pushvaloper1ccc"}]}`)
+
+	d := &Deps{
+		Cfg:     cfg,
+		Runner:  runner,
+		Fetcher: &mockFetcher{allValidators: validator.ValidatorList{Total: 3, Validators: filterTestValidators()}},
+		Printer: getPrinter(),
+	}
+	if err := handleValidatorsWithFormat(d, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, err := selectRawValidators(runner.outputs[key], []validator.ValidatorInfo{{OperatorAddress: "pushvaloper1ccc"}, {OperatorAddress: "pushvaloper1aaa"}})
+	if err != nil {
+		t.Fatalf("selectRawValidators: %v", err)
+	}
+	var parsed struct {
+		Validators []struct {
+			OperatorAddress string `json:"operator_address"`
+		} `json:"validators"`
+	}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(parsed.Validators) != 2 || parsed.Validators[0].OperatorAddress != "pushvaloper1ccc" {
+		t.Errorf("unexpected selection: %s", out)
+	}
+}
+
+func TestHandleValidatorsWithFormat_TableOutput_Filtered(t *testing.T) {
+	defer func() { validatorsJailed, validatorsStatus, validatorsSort = false, "", "" }()
+
+	d := &Deps{
+		Cfg: testCfg(),
+		Fetcher: &mockFetcher{
+			allValidators: validator.ValidatorList{Total: 3, Validators: filterTestValidators()},
+			myValidator:   validator.MyValidatorInfo{IsValidator: true, Address: "pushvaloper1bbb"},
+		},
+		Runner:  newMockRunner(),
+		Printer: getPrinter(),
+	}
+
+	validatorsJailed, validatorsSort = true, "missed"
+	if err := handleValidatorsWithFormat(d, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Filters that match nothing are not an error
+	validatorsJailed, validatorsStatus, validatorsSort = true, "bonded", ""
+	if err := handleValidatorsWithFormat(d, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAddEVMAddresses(t *testing.T) {
+	valoper := validator.HexToBech32("0x00112233445566778899aabbccddeeff00112233", validator.ValoperPrefix)
+	raw := []byte(`{"validators":[{"operator_address":"` + valoper + `","tokens":"5"},{"operator_address":"bogus"},{}],"pagination":{"total":"3"}}`)
+	out, err := addEVMAddresses(raw)
+	if err != nil {
+		t.Fatalf("addEVMAddresses() error = %v", err)
+	}
+	var parsed struct {
+		Validators []map[string]string `json:"validators"`
+		Pagination map[string]string   `json:"pagination"`
+	}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	// Bech32ToHex returns upper-case hex digits
+	want := []string{"0x00112233445566778899AABBCCDDEEFF00112233", "—", "—"}
+	for i, v := range parsed.Validators {
+		if v["evm_address"] != want[i] {
+			t.Errorf("validator %d evm_address = %q, want %q", i, v["evm_address"], want[i])
+		}
+	}
+	if parsed.Validators[0]["tokens"] != "5" || parsed.Pagination["total"] != "3" {
+		t.Errorf("other fields not preserved: %s", out)
+	}
+
+	if _, err := addEVMAddresses([]byte("not json")); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestValidatorsPageBounds(t *testing.T) {
+	tests := []struct {
+		n, page, size     int
+		start, end, pages int
+		wantErr           bool
+	}{
+		{n: 45, page: 1, size: 20, start: 0, end: 20, pages: 3},
+		{n: 45, page: 3, size: 20, start: 40, end: 45, pages: 3},
+		{n: 0, page: 1, size: 20, start: 0, end: 0, pages: 1},
+		{n: 45, page: 4, size: 20, wantErr: true},
+		{n: 45, page: 0, size: 20, wantErr: true},
+		{n: 45, page: 1, size: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		start, end, pages, err := validatorsPageBounds(tt.n, tt.page, tt.size)
+		if tt.wantErr {
+			if exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+				t.Errorf("validatorsPageBounds(%d, %d, %d) err = %v, want invalid args", tt.n, tt.page, tt.size, err)
+			}
+			continue
+		}
+		if err != nil || start != tt.start || end != tt.end || pages != tt.pages {
+			t.Errorf("validatorsPageBounds(%d, %d, %d) = %d, %d, %d, %v; want %d, %d, %d",
+				tt.n, tt.page, tt.size, start, end, pages, err, tt.start, tt.end, tt.pages)
+		}
+	}
+}
+
+func TestHandleValidatorsWithFormat_TableOutput_Paged(t *testing.T) {
+	origNoColor, origPage, origSize := flagNoColor, validatorsPage, validatorsPageSize
+	defer func() { flagNoColor, validatorsPage, validatorsPageSize = origNoColor, origPage, origSize }()
+	flagNoColor = true
+
+	d := &Deps{
+		Cfg:     testCfg(),
+		Fetcher: &mockFetcher{allValidators: validator.ValidatorList{Total: 3, Validators: filterTestValidators()}},
+		Runner:  newMockRunner(),
+		Printer: getPrinter(),
+	}
+	run := func() (string, error) {
+		origStdout := os.Stdout
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.Stdout = w
+		herr := handleValidatorsWithFormat(d, false)
+		w.Close()
+		os.Stdout = origStdout
+		out, _ := io.ReadAll(r)
+		return string(out), herr
+	}
+
+	// Dashboard order: charlie (bonded), Bravo (unbonding), alpha (unbonded)
+	validatorsPage, validatorsPageSize = 2, 2
+	out, err := run()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "Page 2 of 2 (3 total)") || !strings.Contains(out, "alpha") || strings.Contains(out, "charlie") {
+		t.Errorf("page 2 output:\n%s", out)
+	}
+
+	validatorsPage = 3
+	if _, err := run(); exitcodes.CodeForError(err) != exitcodes.InvalidArgs || !strings.Contains(err.Error(), "out of range (1-2") {
+		t.Errorf("out-of-range page: err = %v", err)
+	}
+}
+
+func TestHandleValidatorsWithFormat_OutputTable(t *testing.T) {
+	origOutput, origPage, origSize := flagOutput, validatorsPage, validatorsPageSize
+	defer func() { flagOutput, validatorsPage, validatorsPageSize = origOutput, origPage, origSize }()
+	flagOutput, validatorsPage, validatorsPageSize = "table", 1, 20
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("COLUMNS", "200")
+
+	d := &Deps{
+		Cfg:     testCfg(),
+		Fetcher: &mockFetcher{allValidators: validator.ValidatorList{Total: 3, Validators: filterTestValidators()}},
+		Runner:  newMockRunner(),
+		Printer: getPrinter(),
+	}
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	herr := handleValidatorsWithFormat(d, false)
+	w.Close()
+	os.Stdout = origStdout
+	data, _ := io.ReadAll(r)
+	if herr != nil {
+		t.Fatalf("unexpected error: %v", herr)
+	}
+
+	// Header and one line per validator; no banner, footer or tips
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines, want 4:\n%s", len(lines), data)
+	}
+	if !strings.HasPrefix(lines[0], "VALIDATOR") || strings.Contains(string(data), "Tip") || strings.Contains(string(data), "Total") {
+		t.Errorf("unexpected table output:\n%s", data)
+	}
+	col := strings.Index(lines[0], "STATUS")
+	for _, line := range lines[1:] {
+		if line[col-2:col] != "  " || line[col] == ' ' {
+			t.Errorf("STATUS column not aligned at %d: %q", col, line)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/pushchain/push-validator-cli/internal/dashboard"
+	ui "github.com/pushchain/push-validator-cli/internal/ui"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// Set by validators --watch and --interval.
+var (
+	validatorsWatch    bool
+	validatorsInterval time.Duration
+)
+
+// validatorSetDiff is what changed in the validator set between two refreshes.
+type validatorSetDiff struct {
+	Added    []validator.ValidatorInfo
+	Removed  []validator.ValidatorInfo
+	Jailed   []validator.ValidatorInfo // jailed now, not jailed before
+	Unjailed []validator.ValidatorInfo
+}
+
+// diffValidatorSets compares two refreshes by operator address.
+func diffValidatorSets(prev, cur []validator.ValidatorInfo) validatorSetDiff {
+	var d validatorSetDiff
+	before := make(map[string]validator.ValidatorInfo, len(prev))
+	for _, v := range prev {
+		before[v.OperatorAddress] = v
+	}
+	seen := make(map[string]bool, len(cur))
+	for _, v := range cur {
+		seen[v.OperatorAddress] = true
+		old, ok := before[v.OperatorAddress]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, v)
+		case v.Jailed && !old.Jailed:
+			d.Jailed = append(d.Jailed, v)
+		case !v.Jailed && old.Jailed:
+			d.Unjailed = append(d.Unjailed, v)
+		}
+	}
+	for _, v := range prev {
+		if !seen[v.OperatorAddress] {
+			d.Removed = append(d.Removed, v)
+		}
+	}
+	return d
+}
+
+// sortValidatorsForDisplay applies the dashboard's validator list ordering.
+func sortValidatorsForDisplay(vals []validator.ValidatorInfo, myAddr string) {
+	sort.SliceStable(vals, func(i, j int) bool {
+		return dashboard.ValidatorOrderLess(
+			myAddr != "" && vals[i].OperatorAddress == myAddr, myAddr != "" && vals[j].OperatorAddress == myAddr,
+			vals[i].Status, vals[j].Status,
+			vals[i].VotingPower, vals[j].VotingPower,
+		)
+	})
+}
+
+// handleValidatorsWatch redraws the validator list every interval until
+// interrupted, marking changes since the previous refresh.
+func handleValidatorsWatch(d *Deps, interval time.Duration) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	w := d.Output
+	if w == nil {
+		w = os.Stdout
+	}
+	return watchValidators(ctx, d, w, interval, term.IsTerminal(int(os.Stdout.Fd())))
+}
+
+// watchValidators is the testable core of handleValidatorsWatch. With
+// --output json it writes one JSON object per refresh (NDJSON).
+func watchValidators(ctx context.Context, d *Deps, w io.Writer, interval time.Duration, isTTY bool) error {
+	if err := validateValidatorsFlags(); err != nil {
+		return err
+	}
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	if flagOutput == "yaml" {
+		return fmt.Errorf("--watch supports --output text or json")
+	}
+	jsonOut := flagOutput == "json"
+	if isTTY && !jsonOut {
+		// Hide the cursor while redrawing; restored on Ctrl+C
+		fmt.Fprint(w, "\033[?25l")
+		defer fmt.Fprint(w, "\033[?25h\n")
+	}
+
+	myAddr := ""
+	myCtx, myCancel := context.WithTimeout(ctx, 10*time.Second)
+	if myVal, err := d.Fetcher.GetMyValidator(myCtx, d.Cfg); err == nil {
+		myAddr = myVal.Address
+	}
+	myCancel()
+
+	var prev []validator.ValidatorInfo
+	first := true
+	for {
+		// The cache TTL can outlast --interval; each refresh must query the chain
+		if inv, ok := d.Fetcher.(interface{ Invalidate() }); ok && !first {
+			inv.Invalidate()
+		}
+		fetchCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+		list, err := d.Fetcher.GetAllValidators(fetchCtx, d.Cfg)
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		now := time.Now()
+		if err != nil {
+			if jsonOut {
+				writeNDJSON(w, map[string]any{"ok": false, "time": now.UTC().Format(time.RFC3339), "error": err.Error()})
+			} else {
+				if isTTY {
+					fmt.Fprint(w, "\033[H\033[2J")
+				}
+				fmt.Fprintln(w, d.Printer.Colors.Description(fmt.Sprintf("%s  (refreshing every %s, Ctrl+C to exit)", now.Format("15:04:05"), interval)))
+				fmt.Fprintln(w, d.Printer.Colors.Warning(fmt.Sprintf("failed to fetch validators: %v", err)))
+			}
+		} else {
+			cur := filterValidators(list.Validators, validatorsJailed, validatorsStatus)
+			if validatorsSort != "" {
+				sortValidators(cur, validatorsSort)
+			} else {
+				sortValidatorsForDisplay(cur, myAddr)
+			}
+			var diff validatorSetDiff
+			if !first {
+				diff = diffValidatorSets(prev, cur)
+			}
+			if jsonOut {
+				writeValidatorsFrameJSON(w, now, cur, list.Total, diff)
+			} else {
+				renderValidatorsFrame(w, d.Printer.Colors, now, interval, cur, list.Total, diff, myAddr, isTTY)
+			}
+			prev, first = cur, false
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// watchValidator is one validator in a --watch JSON frame.
+type watchValidator struct {
+	OperatorAddress string `json:"operator_address"`
+	Moniker         string `json:"moniker"`
+	Status          string `json:"status"`
+	VotingPower     int64  `json:"voting_power"`
+	Commission      string `json:"commission"`
+	Jailed          bool   `json:"jailed"`
+	MissedBlocks    int64  `json:"missed_blocks"`
+}
+
+func operatorAddrs(vals []validator.ValidatorInfo) []string {
+	out := make([]string, 0, len(vals))
+	for _, v := range vals {
+		out = append(out, v.OperatorAddress)
+	}
+	return out
+}
+
+func writeNDJSON(w io.Writer, v any) {
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeValidatorsFrameJSON writes one refresh as a single JSON line.
+func writeValidatorsFrameJSON(w io.Writer, now time.Time, vals []validator.ValidatorInfo, total int, diff validatorSetDiff) {
+	out := make([]watchValidator, 0, len(vals))
+	for _, v := range vals {
+		out = append(out, watchValidator{
+			OperatorAddress: v.OperatorAddress,
+			Moniker:         v.Moniker,
+			Status:          v.Status,
+			VotingPower:     v.VotingPower,
+			Commission:      v.Commission,
+			Jailed:          v.Jailed,
+			MissedBlocks:    v.MissedBlocks,
+		})
+	}
+	writeNDJSON(w, map[string]any{
+		"ok":         true,
+		"time":       now.UTC().Format(time.RFC3339),
+		"total":      total,
+		"validators": out,
+		"changes": map[string][]string{
+			"added":    operatorAddrs(diff.Added),
+			"removed":  operatorAddrs(diff.Removed),
+			"jailed":   operatorAddrs(diff.Jailed),
+			"unjailed": operatorAddrs(diff.Unjailed),
+		},
+	})
+}
+
+// renderValidatorsFrame redraws the validator table with a CHANGE column
+// marking validators that are new, newly jailed or unjailed.
+func renderValidatorsFrame(w io.Writer, c *ui.ColorConfig, now time.Time, interval time.Duration, vals []validator.ValidatorInfo, total int, diff validatorSetDiff, myAddr string, isTTY bool) {
+	change := map[string]string{}
+	for _, v := range diff.Added {
+		change[v.OperatorAddress] = "new"
+	}
+	for _, v := range diff.Jailed {
+		change[v.OperatorAddress] = "jailed"
+	}
+	for _, v := range diff.Unjailed {
+		change[v.OperatorAddress] = "unjailed"
+	}
+
+	if isTTY {
+		fmt.Fprint(w, "\033[H\033[2J")
+	}
+	fmt.Fprintln(w, c.Description(fmt.Sprintf("%s  (refreshing every %s, Ctrl+C to exit)", now.Format("15:04:05"), interval)))
+	fmt.Fprintln(w, c.Header(" Push Chain Validators "))
+
+	headers := []string{"CHANGE", "VALIDATOR", "STATUS", "POWER", "COMM%"}
+	rows := make([][]string, 0, len(vals))
+	for _, v := range vals {
+		moniker := v.Moniker
+		if moniker == "" {
+			moniker = "unknown"
+		}
+		if myAddr != "" && v.OperatorAddress == myAddr {
+			moniker += " [My Validator]"
+		}
+		status := v.Status
+		if v.Jailed {
+			status += " (JAILED)"
+		}
+		row := []string{change[v.OperatorAddress], moniker, status, dashboard.FormatLargeNumber(v.VotingPower), v.Commission}
+		switch change[v.OperatorAddress] {
+		case "new", "unjailed":
+			row[0] = c.Success(row[0])
+		case "jailed":
+			row[0] = c.Error(row[0])
+		}
+		rows = append(rows, row)
+	}
+	fmt.Fprint(w, ui.Table(c, headers, rows, nil))
+
+	if len(diff.Removed) > 0 {
+		names := make([]string, 0, len(diff.Removed))
+		for _, v := range diff.Removed {
+			names = append(names, fmt.Sprintf("%s (%s)", v.Moniker, truncateAddress(v.OperatorAddress, 24)))
+		}
+		fmt.Fprintln(w, c.Warning("Removed since last refresh: "+strings.Join(names, ", ")))
+	}
+	if len(vals) != total {
+		fmt.Fprintf(w, "Showing %d of %d validators\n", len(vals), total)
+	} else {
+		fmt.Fprintf(w, "Total Validators: %d\n", len(vals))
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// watchListFetcher returns one validator list per call and cancels the watch
+// once the lists run out.
+type watchListFetcher struct {
+	mockFetcher
+	lists       []validator.ValidatorList
+	calls       int
+	invalidated int
+	cancel      context.CancelFunc
+}
+
+func (f *watchListFetcher) Invalidate() { f.invalidated++ }
+
+func (f *watchListFetcher) GetAllValidators(ctx context.Context, cfg config.Config) (validator.ValidatorList, error) {
+	i := f.calls
+	f.calls++
+	if i >= len(f.lists) {
+		f.cancel()
+		return validator.ValidatorList{}, context.Canceled
+	}
+	return f.lists[i], nil
+}
+
+func TestDiffValidatorSets(t *testing.T) {
+	prev := []validator.ValidatorInfo{
+		{OperatorAddress: "a", Moniker: "alpha"},
+		{OperatorAddress: "b", Moniker: "beta"},
+		{OperatorAddress: "c", Moniker: "gamma", Jailed: true},
+	}
+	cur := []validator.ValidatorInfo{
+		{OperatorAddress: "a", Moniker: "alpha", Jailed: true},
+		{OperatorAddress: "c", Moniker: "gamma"},
+		{OperatorAddress: "d", Moniker: "delta"},
+	}
+	d := diffValidatorSets(prev, cur)
+	got := [][]string{operatorAddrs(d.Added), operatorAddrs(d.Removed), operatorAddrs(d.Jailed), operatorAddrs(d.Unjailed)}
+	want := [][]string{{"d"}, {"b"}, {"a"}, {"c"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diff = %v, want %v", got, want)
+	}
+}
+
+func TestSortValidatorsForDisplay(t *testing.T) {
+	vals := []validator.ValidatorInfo{
+		{OperatorAddress: "u", Status: "UNBONDED", VotingPower: 900},
+		{OperatorAddress: "b1", Status: "BONDED", VotingPower: 10},
+		{OperatorAddress: "b2", Status: "BONDED", VotingPower: 50},
+		{OperatorAddress: "me", Status: "UNBONDING", VotingPower: 1},
+	}
+	sortValidatorsForDisplay(vals, "me")
+	if got := operatorAddrs(vals); !reflect.DeepEqual(got, []string{"me", "b2", "b1", "u"}) {
+		t.Errorf("order = %v", got)
+	}
+}
+
+func TestWatchValidators_NDJSON(t *testing.T) {
+	origOutput, origJailed, origStatus, origSort := flagOutput, validatorsJailed, validatorsStatus, validatorsSort
+	defer func() {
+		flagOutput, validatorsJailed, validatorsStatus, validatorsSort = origOutput, origJailed, origStatus, origSort
+	}()
+	flagOutput, validatorsJailed, validatorsStatus, validatorsSort = "json", false, "", ""
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := &watchListFetcher{cancel: cancel, lists: []validator.ValidatorList{
+		{Total: 2, Validators: []validator.ValidatorInfo{{OperatorAddress: "a", Status: "BONDED"}, {OperatorAddress: "b", Status: "BONDED"}}},
+		{Total: 2, Validators: []validator.ValidatorInfo{{OperatorAddress: "a", Status: "BONDED", Jailed: true}, {OperatorAddress: "c", Status: "BONDED"}}},
+	}}
+	d := &Deps{Cfg: testCfg(), Fetcher: f, Printer: getPrinter()}
+
+	var buf bytes.Buffer
+	if err := watchValidators(ctx, d, &buf, time.Millisecond, false); err != nil {
+		t.Fatalf("watchValidators() error = %v", err)
+	}
+
+	type frame struct {
+		OK         bool                `json:"ok"`
+		Total      int                 `json:"total"`
+		Validators []watchValidator    `json:"validators"`
+		Changes    map[string][]string `json:"changes"`
+	}
+	var frames []frame
+	sc := bufio.NewScanner(&buf)
+	for sc.Scan() {
+		var fr frame
+		if err := json.Unmarshal(sc.Bytes(), &fr); err != nil {
+			t.Fatalf("line is not JSON: %v\n%s", err, sc.Text())
+		}
+		frames = append(frames, fr)
+	}
+	if len(frames) != 2 {
+		t.Fatalf("got %d frames, want 2:\n%s", len(frames), buf.String())
+	}
+	if len(frames[0].Changes["added"]) != 0 {
+		t.Errorf("first frame should have no changes: %v", frames[0].Changes)
+	}
+	want := map[string][]string{"added": {"c"}, "removed": {"b"}, "jailed": {"a"}, "unjailed": {}}
+	if !reflect.DeepEqual(frames[1].Changes, want) {
+		t.Errorf("changes = %v, want %v", frames[1].Changes, want)
+	}
+	if !frames[1].Validators[0].Jailed {
+		t.Errorf("validators = %+v", frames[1].Validators)
+	}
+	if f.invalidated != f.calls-1 {
+		t.Errorf("cache invalidated %d times over %d fetches, want before every refresh after the first", f.invalidated, f.calls)
+	}
+}
+
+func TestWatchValidators_Text(t *testing.T) {
+	origOutput, origJailed, origStatus, origSort := flagOutput, validatorsJailed, validatorsStatus, validatorsSort
+	defer func() {
+		flagOutput, validatorsJailed, validatorsStatus, validatorsSort = origOutput, origJailed, origStatus, origSort
+	}()
+	flagOutput, validatorsJailed, validatorsStatus, validatorsSort = "text", false, "", ""
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := &watchListFetcher{cancel: cancel, lists: []validator.ValidatorList{
+		{Total: 2, Validators: []validator.ValidatorInfo{{OperatorAddress: "pushvaloper1a", Moniker: "alpha", Status: "BONDED"}, {OperatorAddress: "pushvaloper1b", Moniker: "beta", Status: "BONDED"}}},
+		{Total: 1, Validators: []validator.ValidatorInfo{{OperatorAddress: "pushvaloper1a", Moniker: "alpha", Status: "BONDED", Jailed: true}}},
+	}}
+	f.myValidator = validator.MyValidatorInfo{Address: "pushvaloper1a"}
+	p := getPrinter()
+	p.Colors = testColorConfig()
+	d := &Deps{Cfg: testCfg(), Fetcher: f, Printer: p}
+
+	var buf bytes.Buffer
+	if err := watchValidators(ctx, d, &buf, time.Millisecond, true); err != nil {
+		t.Fatalf("watchValidators() error = %v", err)
+	}
+	out := buf.String()
+	last := out[strings.LastIndex(out, "\033[H\033[2J"):]
+	for _, want := range []string{"jailed", "alpha [My Validator]", "BONDED (JAILED)", "Removed since last refresh: beta", "Total Validators: 1"} {
+		if !strings.Contains(last, want) {
+			t.Errorf("last frame missing %q:\n%s", want, last)
+		}
+	}
+	if !strings.HasPrefix(out, "\033[?25l") || !strings.HasSuffix(out, "\033[?25h\n") {
+		t.Error("cursor should be hidden during the watch and restored after")
+	}
+}
+
+func TestWatchValidators_InvalidFlags(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	d := &Deps{Cfg: testCfg(), Fetcher: &mockFetcher{}, Printer: getPrinter()}
+
+	flagOutput = "text"
+	if err := watchValidators(context.Background(), d, &bytes.Buffer{}, 0, false); err == nil {
+		t.Error("expected error for zero interval")
+	}
+	flagOutput = "yaml"
+	if err := watchValidators(context.Background(), d, &bytes.Buffer{}, time.Second, false); err == nil {
+		t.Error("expected error for yaml output")
+	}
+}
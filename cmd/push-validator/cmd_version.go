@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -10,57 +11,128 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/update"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 )
 
+var (
+	versionCheck bool
+	versionAll   bool
+)
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Show version",
-	Run: func(cmd *cobra.Command, args []string) {
+	Long: `Show the CLI version, commit and build date.
+
+With --check, also query GitHub for the latest release and report whether an
+update is available. Plain 'version' never touches the network.
+
+With --all, also report the installed pchaind and cosmovisor versions and the
+chain app version that the local node and the genesis RPC return from
+/abci_info. Anything that can't be determined is shown as unavailable.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if versionAll {
+			return writeAllVersions(os.Stdout, gatherVersions(loadCfg(), findPchaind(), defaultVersionSources()))
+		}
+		if versionCheck {
+			return runVersionCheck(os.Stdout, func(version string) (updateChecker, error) {
+				return update.New(version)
+			})
+		}
+		info := map[string]any{
+			"version":    Version,
+			"commit":     Commit,
+			"build_date": BuildDate,
+			"dev_build":  update.IsDevBuild(Version),
+		}
 		switch flagOutput {
 		case "json":
 			enc := json.NewEncoder(os.Stdout)
 			enc.SetIndent("", "  ")
-			_ = enc.Encode(map[string]string{
-				"version":    Version,
-				"commit":     Commit,
-				"build_date": BuildDate,
-			})
+			_ = enc.Encode(info)
 		case "yaml":
-			data, _ := yaml.Marshal(map[string]string{
-				"version":    Version,
-				"commit":     Commit,
-				"build_date": BuildDate,
-			})
+			data, _ := yaml.Marshal(info)
 			fmt.Println(string(data))
 		default:
-			fmt.Printf("push-validator %s (%s) built %s\n", Version, Commit, BuildDate)
+			fmt.Println(versionLine())
 		}
+		return nil
 	},
 }
 
+// versionLine is the one-line build description, marking development
+// builds so their version isn't mistaken for a release.
+func versionLine() string {
+	line := fmt.Sprintf("push-validator %s (%s) built %s", Version, Commit, BuildDate)
+	if update.IsDevBuild(Version) {
+		line += " [development build]"
+	}
+	return line
+}
+
+// runVersionCheck synchronously checks for a newer release and prints the
+// build info with the latest version. Unlike the background check, failures
+// are returned so the command exits non-zero.
+func runVersionCheck(w io.Writer, newUpdater func(string) (updateChecker, error)) error {
+	updater, err := newUpdater(Version)
+	if err != nil {
+		return exitcodes.NetworkErrf("update check failed: %v", err)
+	}
+	result, err := updater.Check()
+	if err != nil {
+		return exitcodes.NetworkErrf("update check failed: %v", err)
+	}
+
+	info := map[string]any{
+		"version":          Version,
+		"commit":           Commit,
+		"build_date":       BuildDate,
+		"latest_version":   "v" + strings.TrimPrefix(result.LatestVersion, "v"),
+		"update_available": result.UpdateAvailable,
+		"dev_build":        result.DevBuild,
+	}
+	switch flagOutput {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(info)
+	case "yaml":
+		data, _ := yaml.Marshal(info)
+		_, err := fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	fmt.Fprintln(w, versionLine())
+	fmt.Fprintf(w, "Latest release: %s\n", info["latest_version"])
+	switch {
+	case result.DevBuild:
+		fmt.Fprintln(w, "Development build; not compared with releases. Run 'push-validator update --force' to switch to the latest release")
+	case result.UpdateAvailable:
+		fmt.Fprintln(w, "Update available. Run: push-validator update")
+	default:
+		fmt.Fprintln(w, "Up to date")
+	}
+	return nil
+}
+
 var completionCmd = &cobra.Command{
 	Use:   "completion [bash|zsh|fish|powershell]",
 	Short: "Generate shell completion",
 	Args:  cobra.ExactArgs(1),
+	Long: `Generate a shell completion script on stdout.
+
+Use 'completion install' to write it where the shell loads it from.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		switch args[0] {
-		case "bash":
-			return rootCmd.GenBashCompletion(os.Stdout)
-		case "zsh":
-			return rootCmd.GenZshCompletion(os.Stdout)
-		case "fish":
-			return rootCmd.GenFishCompletion(os.Stdout, true)
-		case "powershell":
-			return rootCmd.GenPowerShellCompletionWithDesc(os.Stdout)
-		default:
-			return fmt.Errorf("unknown shell: %s", args[0])
-		}
+		return genCompletion(os.Stdout, args[0])
 	},
 }
 
 func init() {
+	versionCmd.Flags().BoolVar(&versionCheck, "check", false, "Check GitHub for a newer release (requires network)")
+	versionCmd.Flags().BoolVar(&versionAll, "all", false, "Also show pchaind, cosmovisor and chain app versions")
+	versionCmd.MarkFlagsMutuallyExclusive("check", "all")
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(completionCmd)
 }
@@ -71,11 +143,11 @@ type updateChecker interface {
 }
 
 // checkForUpdateBackground performs a non-blocking update check.
-// Uses cache to avoid checking more than once per 10 minutes.
+// Uses cache to avoid checking more than once per --update-check-interval.
 // Stores result in updateCheckResult global for use by PersistentPostRun.
 func checkForUpdateBackground() {
 	cfg := loadCfg()
-	result := checkForUpdateWith(cfg.HomeDir, Version, update.LoadCache, update.SaveCache, func(version string) (updateChecker, error) {
+	result := checkForUpdateWith(cfg.UpdateCacheLocation(), Version, cfg.UpdateCheckInterval, update.LoadCache, update.SaveCache, func(version string) (updateChecker, error) {
 		return update.New(version)
 	})
 	if result != nil {
@@ -88,8 +160,11 @@ func checkForUpdateBackground() {
 // checkForUpdateFresh performs a fresh update check, bypassing cache.
 // Used by status and dashboard commands for immediate notification.
 func checkForUpdateFresh() {
+	if update.IsDevBuild(Version) {
+		return
+	}
 	cfg := loadCfg()
-	result, err := update.ForceCheck(cfg.HomeDir, Version)
+	result, err := update.ForceCheck(cfg.UpdateCacheLocation(), Version, cfg.UpdateCheckInterval)
 	if err != nil {
 		return // Silently fail
 	}
@@ -102,14 +177,20 @@ func checkForUpdateFresh() {
 
 // checkForUpdateWith is the testable core of checkForUpdateBackground.
 func checkForUpdateWith(
-	homeDir string,
+	cacheDir string,
 	version string,
+	interval time.Duration,
 	loadCache func(string) (*update.CacheEntry, error),
 	saveCache func(string, *update.CacheEntry) error,
 	newUpdater func(string) (updateChecker, error),
 ) *update.CheckResult {
+	// Development builds can't be meaningfully compared with releases
+	if update.IsDevBuild(version) {
+		return nil
+	}
+
 	// Check cache first (avoid network calls if recently checked)
-	cache, err := loadCache(homeDir)
+	cache, err := loadCache(cacheDir)
 	if err == nil && update.IsCacheValid(cache) {
 		// Use cached result, but re-verify in case version changed (e.g., after update)
 		if cache.UpdateAvailable && update.IsNewerVersion(version, cache.LatestVersion) {
@@ -134,10 +215,11 @@ func checkForUpdateWith(
 	}
 
 	// Save to cache
-	_ = saveCache(homeDir, &update.CacheEntry{
+	_ = saveCache(cacheDir, &update.CacheEntry{
 		CheckedAt:       time.Now(),
 		LatestVersion:   result.LatestVersion,
 		UpdateAvailable: result.UpdateAvailable,
+		Interval:        interval,
 	})
 
 	// Store result for notification
@@ -171,6 +253,9 @@ func showUpdateNotification(latestVersion string) {
 
 // shouldSkipUpdateCheck returns true for commands where update notifications are disruptive
 func shouldSkipUpdateCheck(cmd *cobra.Command) bool {
+	if updateCheckDisabled() {
+		return true
+	}
 	cmdName := cmd.Name()
 	// Skip for update, help, version commands
 	if cmdName == "update" || cmdName == "help" || cmdName == "version" {
@@ -178,7 +263,14 @@ func shouldSkipUpdateCheck(cmd *cobra.Command) bool {
 	}
 	// Skip for installation-related commands (called by install.sh)
 	if cmdName == "init" || cmdName == "snapshot" || cmdName == "chain" ||
-		cmdName == "start" || cmdName == "sync" {
+		cmdName == "install-chain" || cmdName == "start" || cmdName == "sync" {
+		return true
+	}
+	// Skip for offline lookups, which must work without network access
+	if cmdName == "node-id" || cmdName == "show-address" {
+		return true
+	}
+	if cmd.Parent() != nil && cmd.Parent().Name() == "address" {
 		return true
 	}
 	// Skip for subcommands of chain (e.g., "chain install")
@@ -192,6 +284,17 @@ func shouldSkipUpdateCheck(cmd *cobra.Command) bool {
 	return false
 }
 
+// updateCheckDisabled reports whether background update checks are off for
+// this invocation: --no-update-check, PUSH_NO_UPDATE_CHECK (any value but
+// "0" or "false"), or script-oriented output (--output json, --quiet).
+func updateCheckDisabled() bool {
+	if flagNoUpdateCheck || flagQuiet || flagOutput == "json" {
+		return true
+	}
+	v := strings.TrimSpace(os.Getenv("PUSH_NO_UPDATE_CHECK"))
+	return v != "" && v != "0" && !strings.EqualFold(v, "false")
+}
+
 // shouldForceFreshUpdateCheck returns true for commands that need immediate update notification.
 // These commands bypass the cache and always make a fresh network call to GitHub.
 func shouldForceFreshUpdateCheck(cmd *cobra.Command) bool {
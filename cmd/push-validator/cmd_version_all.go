@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/pushchain/push-validator-cli/internal/chain"
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
+	"github.com/pushchain/push-validator-cli/internal/node"
+	ui "github.com/pushchain/push-validator-cli/internal/ui"
+	"github.com/pushchain/push-validator-cli/internal/update"
+)
+
+// versionAllTimeout bounds each /abci_info request made by version --all.
+const versionAllTimeout = 5 * time.Second
+
+// versionSources looks up the versions reported by version --all; tests
+// replace them.
+type versionSources struct {
+	binaryVersion     func(path string) (string, error)
+	detectCosmovisor  func(homeDir string) cosmovisor.DetectionResult
+	cosmovisorVersion func(path string) (string, error)
+	abciInfo          func(ctx context.Context, base string) (node.AppInfo, error)
+}
+
+func defaultVersionSources() versionSources {
+	return versionSources{
+		binaryVersion:     chain.BinaryVersion,
+		detectCosmovisor:  cosmovisor.Detect,
+		cosmovisorVersion: cosmovisor.Version,
+		abciInfo:          node.ABCIInfo,
+	}
+}
+
+type cliVersionInfo struct {
+	Version   string `json:"version" yaml:"version"`
+	Commit    string `json:"commit" yaml:"commit"`
+	BuildDate string `json:"build_date" yaml:"build_date"`
+	DevBuild  bool   `json:"dev_build" yaml:"dev_build"`
+}
+
+// binaryVersionInfo describes an installed binary. Version is empty and
+// Error says why when it could not be determined.
+type binaryVersionInfo struct {
+	Available bool   `json:"available" yaml:"available"`
+	Path      string `json:"path,omitempty" yaml:"path,omitempty"`
+	Version   string `json:"version,omitempty" yaml:"version,omitempty"`
+	Error     string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+type cosmovisorVersionInfo struct {
+	binaryVersionInfo `yaml:",inline"`
+	SetupComplete     bool `json:"setup_complete" yaml:"setup_complete"`
+}
+
+// appVersionInfo is what an RPC's /abci_info reports about the chain app.
+type appVersionInfo struct {
+	Available  bool   `json:"available" yaml:"available"`
+	RPC        string `json:"rpc" yaml:"rpc"`
+	Version    string `json:"version,omitempty" yaml:"version,omitempty"`
+	AppVersion uint64 `json:"app_version,omitempty" yaml:"app_version,omitempty"`
+	Height     int64  `json:"height,omitempty" yaml:"height,omitempty"`
+	Error      string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// allVersions is the version --all report.
+type allVersions struct {
+	CLI        cliVersionInfo        `json:"cli" yaml:"cli"`
+	Pchaind    binaryVersionInfo     `json:"pchaind" yaml:"pchaind"`
+	Cosmovisor cosmovisorVersionInfo `json:"cosmovisor" yaml:"cosmovisor"`
+	Node       appVersionInfo        `json:"node" yaml:"node"`       // local node
+	Network    appVersionInfo        `json:"network" yaml:"network"` // genesis RPC
+}
+
+// gatherVersions collects every version in parallel. A source that fails
+// is marked unavailable instead of failing the report.
+func gatherVersions(cfg config.Config, pchaindPath string, src versionSources) allVersions {
+	v := allVersions{CLI: cliVersionInfo{Version: Version, Commit: Commit, BuildDate: BuildDate, DevBuild: update.IsDevBuild(Version)}}
+	var wg sync.WaitGroup
+	run := func(f func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f()
+		}()
+	}
+
+	run(func() {
+		v.Pchaind = binaryVersionInfo{Path: pchaindPath}
+		if out, err := src.binaryVersion(pchaindPath); err != nil {
+			v.Pchaind.Error = err.Error()
+		} else {
+			v.Pchaind.Available, v.Pchaind.Version = true, out
+		}
+	})
+	run(func() {
+		det := src.detectCosmovisor(cfg.HomeDir)
+		v.Cosmovisor.SetupComplete = det.SetupComplete
+		if !det.Available {
+			v.Cosmovisor.Error = det.Reason
+			return
+		}
+		v.Cosmovisor.Available, v.Cosmovisor.Path = true, det.BinaryPath
+		if out, err := src.cosmovisorVersion(det.BinaryPath); err != nil {
+			v.Cosmovisor.Error = err.Error()
+		} else {
+			v.Cosmovisor.Version = out
+		}
+	})
+	appInfo := func(dst *appVersionInfo, base string) {
+		ctx, cancel := context.WithTimeout(context.Background(), versionAllTimeout)
+		defer cancel()
+		*dst = appVersionInfo{RPC: base}
+		info, err := src.abciInfo(ctx, base)
+		if err != nil {
+			dst.Error = err.Error()
+			return
+		}
+		dst.Available, dst.Version, dst.AppVersion, dst.Height = true, info.Version, info.AppVersion, info.Height
+	}
+	run(func() { appInfo(&v.Node, cfg.RPCLocal) })
+	run(func() { appInfo(&v.Network, cfg.RemoteRPCURL()) })
+
+	wg.Wait()
+	return v
+}
+
+// writeAllVersions prints v as a table, or as one object for --output
+// json/yaml.
+func writeAllVersions(w io.Writer, v allVersions) error {
+	switch flagOutput {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case "yaml":
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+
+	cli := v.CLI.Version
+	if v.CLI.DevBuild {
+		cli += " [development build]"
+	}
+	rows := [][]string{{"push-validator", cli, fmt.Sprintf("commit %s, built %s", v.CLI.Commit, v.CLI.BuildDate)}}
+
+	pchaind := []string{"pchaind", v.Pchaind.Version, v.Pchaind.Path}
+	if !v.Pchaind.Available {
+		pchaind[1] = "unavailable"
+		pchaind[2] += ": " + v.Pchaind.Error
+	}
+	rows = append(rows, pchaind)
+
+	cv := []string{"cosmovisor", v.Cosmovisor.Version, v.Cosmovisor.Path}
+	switch {
+	case !v.Cosmovisor.Available:
+		cv[1] = "not installed"
+	case v.Cosmovisor.Version == "":
+		cv[1] = "unknown"
+	}
+	if v.Cosmovisor.Available && !v.Cosmovisor.SetupComplete {
+		cv[2] += " (not set up in this home)"
+	}
+	rows = append(rows, cv)
+
+	for _, app := range []struct {
+		name string
+		info appVersionInfo
+	}{{"chain app (node)", v.Node}, {"chain app (network)", v.Network}} {
+		row := []string{app.name, app.info.Version, app.info.RPC}
+		if !app.info.Available {
+			row[1] = "unavailable"
+			row[2] += ": " + app.info.Error
+		} else if app.info.AppVersion > 0 {
+			row[2] = fmt.Sprintf("%s, app version %d, height %d", app.info.RPC, app.info.AppVersion, app.info.Height)
+		}
+		rows = append(rows, row)
+	}
+
+	c := ui.NewColorConfigFromGlobal()
+	_, err := fmt.Fprint(w, ui.Table(c, []string{"COMPONENT", "VERSION", "DETAILS"}, rows, nil))
+	return err
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
+	"github.com/pushchain/push-validator-cli/internal/node"
+)
+
+func TestGatherVersions(t *testing.T) {
+	cfg := config.Config{HomeDir: t.TempDir(), RPCLocal: "http://127.0.0.1:26657", GenesisDomain: "rpc.example.com"}
+	src := versionSources{
+		binaryVersion: func(path string) (string, error) { return "0.0.5", nil },
+		detectCosmovisor: func(string) cosmovisor.DetectionResult {
+			return cosmovisor.DetectionResult{Available: true, BinaryPath: "/usr/bin/cosmovisor", SetupComplete: true}
+		},
+		cosmovisorVersion: func(string) (string, error) { return "v1.5.0", nil },
+		abciInfo: func(ctx context.Context, base string) (node.AppInfo, error) {
+			if strings.HasPrefix(base, "http://127.0.0.1") {
+				return node.AppInfo{}, errors.New("connection refused")
+			}
+			return node.AppInfo{Version: "0.0.6", AppVersion: 1, Height: 42}, nil
+		},
+	}
+	v := gatherVersions(cfg, "/opt/pchaind", src)
+
+	if v.CLI.Version != Version || v.CLI.Commit != Commit {
+		t.Errorf("CLI = %+v", v.CLI)
+	}
+	if !v.Pchaind.Available || v.Pchaind.Version != "0.0.5" || v.Pchaind.Path != "/opt/pchaind" {
+		t.Errorf("Pchaind = %+v", v.Pchaind)
+	}
+	if !v.Cosmovisor.Available || v.Cosmovisor.Version != "v1.5.0" || !v.Cosmovisor.SetupComplete {
+		t.Errorf("Cosmovisor = %+v", v.Cosmovisor)
+	}
+	if v.Node.Available || v.Node.Error != "connection refused" {
+		t.Errorf("Node = %+v, want unavailable", v.Node)
+	}
+	if !v.Network.Available || v.Network.Version != "0.0.6" || v.Network.RPC != cfg.RemoteRPCURL() {
+		t.Errorf("Network = %+v", v.Network)
+	}
+}
+
+func TestWriteAllVersions(t *testing.T) {
+	origOutput, origNoColor := flagOutput, flagNoColor
+	defer func() { flagOutput, flagNoColor = origOutput, origNoColor }()
+
+	v := allVersions{
+		CLI:     cliVersionInfo{Version: "v1.2.0", Commit: "abc123", BuildDate: "2026-01-01"},
+		Pchaind: binaryVersionInfo{Path: "pchaind", Error: `exec: "pchaind": executable file not found in $PATH`},
+		Cosmovisor: cosmovisorVersionInfo{
+			binaryVersionInfo: binaryVersionInfo{Error: "cosmovisor binary not found in PATH"},
+		},
+		Node:    appVersionInfo{RPC: "http://127.0.0.1:26657", Error: "connection refused"},
+		Network: appVersionInfo{Available: true, RPC: "https://rpc.example.com:443", Version: "0.0.6", AppVersion: 1, Height: 42},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		flagOutput = "json"
+		var buf bytes.Buffer
+		if err := writeAllVersions(&buf, v); err != nil {
+			t.Fatal(err)
+		}
+		var got map[string]map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("output is not one JSON object: %q (%v)", buf.String(), err)
+		}
+		if got["cli"]["version"] != "v1.2.0" || got["pchaind"]["available"] != false || got["network"]["version"] != "0.0.6" {
+			t.Errorf("unexpected JSON: %v", got)
+		}
+		if _, ok := got["cosmovisor"]["setup_complete"]; !ok || got["cosmovisor"]["error"] == nil {
+			t.Errorf("cosmovisor should include the embedded binary fields: %v", got["cosmovisor"])
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		flagOutput, flagNoColor = "text", true
+		var buf bytes.Buffer
+		if err := writeAllVersions(&buf, v); err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		for _, want := range []string{"push-validator", "v1.2.0", "pchaind: exec:", "not installed", "chain app (node)", "unavailable", "app version 1, height 42"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("output missing %q:\n%s", want, out)
+			}
+		}
+	})
+}
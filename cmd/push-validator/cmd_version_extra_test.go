@@ -1,10 +1,14 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
 	"github.com/pushchain/push-validator-cli/internal/update"
 )
 
@@ -32,7 +36,7 @@ func TestCheckForUpdateWith_CacheValid_UpdateAvailable(t *testing.T) {
 		return nil, nil
 	}
 
-	result := checkForUpdateWith("/tmp/test", "v1.0.0", loadCache, saveCache, newUpdater)
+	result := checkForUpdateWith("/tmp/test", "v1.0.0", update.DefaultCheckInterval, loadCache, saveCache, newUpdater)
 	if result == nil {
 		t.Fatal("expected non-nil result for cached update")
 	}
@@ -58,7 +62,7 @@ func TestCheckForUpdateWith_CacheValid_NoUpdate(t *testing.T) {
 		return nil, nil
 	}
 
-	result := checkForUpdateWith("/tmp/test", "v1.0.0", loadCache, saveCache, newUpdater)
+	result := checkForUpdateWith("/tmp/test", "v1.0.0", update.DefaultCheckInterval, loadCache, saveCache, newUpdater)
 	if result != nil {
 		t.Errorf("expected nil result, got %+v", result)
 	}
@@ -73,7 +77,7 @@ func TestCheckForUpdateWith_CacheError_UpdaterCreationFails(t *testing.T) {
 		return nil, fmt.Errorf("failed to create updater")
 	}
 
-	result := checkForUpdateWith("/tmp/test", "v1.0.0", loadCache, saveCache, newUpdater)
+	result := checkForUpdateWith("/tmp/test", "v1.0.0", update.DefaultCheckInterval, loadCache, saveCache, newUpdater)
 	if result != nil {
 		t.Errorf("expected nil result, got %+v", result)
 	}
@@ -88,7 +92,7 @@ func TestCheckForUpdateWith_CacheError_CheckFails(t *testing.T) {
 		return &mockUpdateChecker{err: fmt.Errorf("network error")}, nil
 	}
 
-	result := checkForUpdateWith("/tmp/test", "v1.0.0", loadCache, saveCache, newUpdater)
+	result := checkForUpdateWith("/tmp/test", "v1.0.0", update.DefaultCheckInterval, loadCache, saveCache, newUpdater)
 	if result != nil {
 		t.Errorf("expected nil result, got %+v", result)
 	}
@@ -113,7 +117,7 @@ func TestCheckForUpdateWith_CacheError_UpdateAvailable(t *testing.T) {
 		}, nil
 	}
 
-	result := checkForUpdateWith("/tmp/test", "v1.0.0", loadCache, saveCache, newUpdater)
+	result := checkForUpdateWith("/tmp/test", "v1.0.0", 6*time.Hour, loadCache, saveCache, newUpdater)
 	if result == nil {
 		t.Fatal("expected non-nil result")
 	}
@@ -126,6 +130,9 @@ func TestCheckForUpdateWith_CacheError_UpdateAvailable(t *testing.T) {
 	if savedEntry.LatestVersion != "2.0.0" {
 		t.Errorf("cache LatestVersion = %q, want %q", savedEntry.LatestVersion, "2.0.0")
 	}
+	if savedEntry.Interval != 6*time.Hour {
+		t.Errorf("cache Interval = %v, want 6h", savedEntry.Interval)
+	}
 }
 
 func TestCheckForUpdateWith_CacheError_NoUpdateAvailable(t *testing.T) {
@@ -143,7 +150,7 @@ func TestCheckForUpdateWith_CacheError_NoUpdateAvailable(t *testing.T) {
 		}, nil
 	}
 
-	result := checkForUpdateWith("/tmp/test", "v1.0.0", loadCache, saveCache, newUpdater)
+	result := checkForUpdateWith("/tmp/test", "v1.0.0", update.DefaultCheckInterval, loadCache, saveCache, newUpdater)
 	if result != nil {
 		t.Errorf("expected nil result, got %+v", result)
 	}
@@ -165,8 +172,90 @@ func TestCheckForUpdateWith_CacheValid_SameVersion(t *testing.T) {
 	}
 
 	// IsNewerVersion("v1.0.0", "1.0.0") should be false
-	result := checkForUpdateWith("/tmp/test", "v1.0.0", loadCache, saveCache, newUpdater)
+	result := checkForUpdateWith("/tmp/test", "v1.0.0", update.DefaultCheckInterval, loadCache, saveCache, newUpdater)
 	if result != nil {
 		t.Errorf("expected nil (same version), got %+v", result)
 	}
 }
+
+func TestRunVersionCheck(t *testing.T) {
+	origVersion, origOutput := Version, flagOutput
+	t.Cleanup(func() { Version, flagOutput = origVersion, origOutput })
+	Version = "v1.0.0"
+
+	checker := func(res *update.CheckResult, err error) func(string) (updateChecker, error) {
+		return func(string) (updateChecker, error) { return &mockUpdateChecker{result: res, err: err}, nil }
+	}
+
+	t.Run("update available text", func(t *testing.T) {
+		flagOutput = "text"
+		var buf bytes.Buffer
+		err := runVersionCheck(&buf, checker(&update.CheckResult{CurrentVersion: "1.0.0", LatestVersion: "1.2.0", UpdateAvailable: true}, nil))
+		if err != nil {
+			t.Fatalf("runVersionCheck() error = %v", err)
+		}
+		if out := buf.String(); !strings.Contains(out, "Latest release: v1.2.0") || !strings.Contains(out, "push-validator update") {
+			t.Errorf("unexpected output:\n%s", out)
+		}
+	})
+
+	t.Run("up to date json", func(t *testing.T) {
+		flagOutput = "json"
+		var buf bytes.Buffer
+		err := runVersionCheck(&buf, checker(&update.CheckResult{CurrentVersion: "1.0.0", LatestVersion: "1.0.0"}, nil))
+		if err != nil {
+			t.Fatalf("runVersionCheck() error = %v", err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+		}
+		if got["version"] != "v1.0.0" || got["latest_version"] != "v1.0.0" || got["update_available"] != false {
+			t.Errorf("unexpected JSON: %v", got)
+		}
+	})
+
+	t.Run("check fails", func(t *testing.T) {
+		flagOutput = "text"
+		var buf bytes.Buffer
+		err := runVersionCheck(&buf, checker(nil, fmt.Errorf("connection refused")))
+		if err == nil || !strings.Contains(err.Error(), "connection refused") {
+			t.Fatalf("expected check error, got %v", err)
+		}
+		if code := exitcodes.CodeForError(err); code != exitcodes.NetworkError {
+			t.Errorf("exit code = %d, want %d", code, exitcodes.NetworkError)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("expected no output on failure, got %q", buf.String())
+		}
+	})
+
+	t.Run("development build", func(t *testing.T) {
+		origVersion := Version
+		defer func() { Version = origVersion }()
+		Version = "v1.0.0-3-gabcdef0"
+		flagOutput = "text"
+		var buf bytes.Buffer
+		err := runVersionCheck(&buf, checker(&update.CheckResult{CurrentVersion: "1.0.0-3-gabcdef0", LatestVersion: "1.0.0", DevBuild: true}, nil))
+		if err != nil {
+			t.Fatalf("runVersionCheck() error = %v", err)
+		}
+		if out := buf.String(); !strings.Contains(out, "[development build]") || strings.Contains(out, "Update available") {
+			t.Errorf("unexpected output:\n%s", out)
+		}
+	})
+}
+
+func TestCheckForUpdateWith_DevBuildSkipsCheck(t *testing.T) {
+	newUpdater := func(string) (updateChecker, error) {
+		t.Error("dev build should not check for updates")
+		return &mockUpdateChecker{}, nil
+	}
+	loadCache := func(string) (*update.CacheEntry, error) { return nil, fmt.Errorf("no cache") }
+	saveCache := func(string, *update.CacheEntry) error { return nil }
+	for _, v := range []string{"dev", "v1.0.0-3-gabcdef0", "v1.0.0-dirty"} {
+		if result := checkForUpdateWith("/tmp/test", v, update.DefaultCheckInterval, loadCache, saveCache, newUpdater); result != nil {
+			t.Errorf("%s: result = %+v, want nil", v, result)
+		}
+	}
+}
@@ -21,6 +21,8 @@ func TestShouldSkipUpdateCheck(t *testing.T) {
 		{"chain command", "chain", "", true},
 		{"start command", "start", "", true},
 		{"sync command", "sync", "", true},
+		{"node-id command", "node-id", "", true},
+		{"show-address command", "show-address", "", true},
 		{"status command", "status", "", false},
 		{"balance command", "balance", "", false},
 		{"register-validator command", "register-validator", "", false},
@@ -34,6 +36,7 @@ func TestShouldSkipUpdateCheck(t *testing.T) {
 		{"chain subcommand - download", "download", "chain", true},
 		{"snapshot subcommand - download", "download", "snapshot", true},
 		{"snapshot subcommand - list", "list", "snapshot", true},
+		{"address subcommand - convert", "convert", "address", true},
 		{"non-skip parent subcommand", "sub", "status", false},
 	}
 
@@ -52,6 +55,38 @@ func TestShouldSkipUpdateCheck(t *testing.T) {
 	}
 }
 
+func TestShouldSkipUpdateCheck_Disabled(t *testing.T) {
+	origNoCheck, origQuiet, origOutput := flagNoUpdateCheck, flagQuiet, flagOutput
+	t.Cleanup(func() { flagNoUpdateCheck, flagQuiet, flagOutput = origNoCheck, origQuiet, origOutput })
+
+	status := &cobra.Command{Use: "status"}
+	tests := []struct {
+		name    string
+		noCheck bool
+		quiet   bool
+		output  string
+		env     string
+		want    bool
+	}{
+		{"default", false, false, "text", "", false},
+		{"flag", true, false, "text", "", true},
+		{"env", false, false, "text", "1", true},
+		{"env false", false, false, "text", "false", false},
+		{"env zero", false, false, "text", "0", false},
+		{"json output", false, false, "json", "", true},
+		{"quiet", false, true, "text", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PUSH_NO_UPDATE_CHECK", tt.env)
+			flagNoUpdateCheck, flagQuiet, flagOutput = tt.noCheck, tt.quiet, tt.output
+			if got := shouldSkipUpdateCheck(status); got != tt.want {
+				t.Errorf("shouldSkipUpdateCheck(status) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestShowUpdateNotification_JSONSuppressed(t *testing.T) {
 	// Save and restore flags
 	origOutput := flagOutput
@@ -47,6 +47,8 @@ Examples:
 			return handleVote(newDeps(), args[0], args[1])
 		},
 	}
+	addTxFlags(voteCmd)
+	addTxWaitFlags(voteCmd)
 	rootCmd.AddCommand(voteCmd)
 }
 
@@ -153,6 +155,7 @@ func handleVote(d *Deps, proposalID, option string) error {
 				p.KeyValueLine("Voting Ends", t.Format("2006-01-02 15:04:05"), "")
 			}
 		}
+		printTxFee(p, cfg.Denom)
 		fmt.Println()
 		fmt.Printf("Your vote: %s\n", p.Colors.Apply(p.Colors.Theme.Value, strings.ToUpper(optionLower)))
 		fmt.Println()
@@ -242,14 +245,19 @@ func handleVote(d *Deps, proposalID, option string) error {
 		fmt.Println(" " + p.Colors.Success(p.Colors.Emoji("✓")))
 	}
 
+	txRes, err := confirmTx(d.Validator, txHash, flagTxWait, flagTxWaitTimeout)
+	if err != nil {
+		return err
+	}
+
 	// Success output
 	if flagOutput == "json" {
-		getPrinter().JSON(map[string]any{
+		getPrinter().JSON(withTxResult(map[string]any{
 			"ok":          true,
 			"txhash":      txHash,
 			"proposal_id": proposalID,
 			"vote":        optionLower,
-		})
+		}, txRes))
 	} else {
 		fmt.Println()
 		p.Success(p.Colors.Emoji("✅") + " Vote submitted successfully!")
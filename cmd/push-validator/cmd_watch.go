@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/notify"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// watchOptions configures the jailing/slashing watcher.
+type watchOptions struct {
+	Interval time.Duration
+	// MissedThreshold alerts once the missed-blocks counter reaches it; 0
+	// alerts when over half of the allowed downtime is used.
+	MissedThreshold int64
+	Notifiers       []notify.Notifier
+}
+
+// watchState is what the watcher alerts on. Alerts fire when a field turns
+// true, so a validator that is unjailed and jailed again alerts again.
+type watchState struct {
+	Jailed        bool
+	Tombstoned    bool
+	OverThreshold bool
+}
+
+// watchTransitions returns the event types for fields that turned true.
+func watchTransitions(prev, cur watchState) []string {
+	var events []string
+	if cur.Tombstoned && !prev.Tombstoned {
+		events = append(events, notify.EventTombstoned)
+	} else if cur.Jailed && !prev.Jailed {
+		// Tombstoning also jails; report it once, as the worse event
+		events = append(events, notify.EventJailed)
+	}
+	if cur.OverThreshold && !prev.OverThreshold {
+		events = append(events, notify.EventMissedBlocks)
+	}
+	return events
+}
+
+// handleWatch polls this node's validator until interrupted and notifies on
+// jailing, tombstoning or too many missed blocks.
+func handleWatch(d *Deps, opts watchOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	w := d.Output
+	if w == nil {
+		w = os.Stdout
+	}
+	return watchMyValidator(ctx, d, w, opts, validator.GetMyUptime)
+}
+
+// watchMyValidator is the testable core of handleWatch. Each event is
+// printed (one JSON object per line with --output json) and sent to every
+// notifier; delivery failures are printed and do not stop the watcher.
+func watchMyValidator(ctx context.Context, d *Deps, w io.Writer, opts watchOptions, fetchUptime uptimeFetcher) error {
+	if opts.Interval <= 0 {
+		return exitcodes.InvalidArgsError("--interval must be positive")
+	}
+	if flagOutput == "yaml" {
+		return exitcodes.InvalidArgsError("watch supports --output text or json")
+	}
+	jsonOut := flagOutput == "json"
+	c := d.Printer.Colors
+
+	if !jsonOut {
+		targets := []string{"stdout"}
+		for _, n := range opts.Notifiers {
+			targets = append(targets, n.Name())
+		}
+		fmt.Fprintln(w, c.Description(fmt.Sprintf("Watching validator every %s, notifying %s (Ctrl+C to exit)", opts.Interval, strings.Join(targets, ", "))))
+	}
+
+	var prev watchState
+	for {
+		if inv, ok := d.Fetcher.(interface{ InvalidateMyValidator() }); ok {
+			inv.InvalidateMyValidator()
+		}
+		fetchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		my, err := d.Fetcher.GetMyValidator(fetchCtx, d.Cfg)
+		var u validator.Uptime
+		var uptimeErr error
+		if err == nil && my.IsValidator {
+			u, uptimeErr = fetchUptime(fetchCtx, d.Cfg)
+		}
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		switch {
+		case errors.Is(err, validator.ErrRPCNotReady):
+			watchWarn(w, jsonOut, c.Warning, fmt.Sprintf("node RPC not ready, will retry: %v", err))
+		case err != nil:
+			watchWarn(w, jsonOut, c.Warning, fmt.Sprintf("failed to fetch validator: %v", err))
+		case !my.IsValidator:
+			watchWarn(w, jsonOut, c.Warning, "this node is not registered as a validator")
+		default:
+			cur := watchState{
+				Jailed:     my.Jailed,
+				Tombstoned: my.SlashingInfo.Tombstoned,
+				// Keep the last known threshold state when uptime is unavailable
+				OverThreshold: prev.OverThreshold,
+			}
+			missed := my.SlashingInfo.MissedBlocks
+			if uptimeErr != nil {
+				watchWarn(w, jsonOut, c.Warning, fmt.Sprintf("failed to get missed blocks: %v", uptimeErr))
+			} else {
+				missed = u.Missed
+				cur.Tombstoned = cur.Tombstoned || u.Tombstoned
+				if opts.MissedThreshold > 0 {
+					cur.OverThreshold = u.Missed >= opts.MissedThreshold
+				} else {
+					cur.OverThreshold = u.Level != validator.UptimeOK
+				}
+			}
+			events := watchTransitions(prev, cur)
+			if len(events) > 0 {
+				height := watchHeight(ctx, d)
+				for _, typ := range events {
+					ev := notify.Event{
+						Type:         typ,
+						Moniker:      my.Moniker,
+						Address:      my.Address,
+						Height:       height,
+						MissedBlocks: missed,
+						Time:         time.Now().UTC(),
+					}
+					ev.Message = watchMessage(ev, u)
+					sendWatchEvent(ctx, w, jsonOut, d, ev, opts.Notifiers)
+				}
+			}
+			prev = cur
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+// watchHeight returns the latest block height from the local node, falling
+// back to the remote RPC; 0 when neither answers.
+func watchHeight(ctx context.Context, d *Deps) int64 {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if d.Node != nil {
+		if st, err := d.Node.Status(ctx); err == nil && st.Height > 0 {
+			return st.Height
+		}
+	}
+	if d.RemoteNode != nil {
+		if st, err := d.RemoteNode.Status(ctx); err == nil {
+			return st.Height
+		}
+	}
+	return 0
+}
+
+// watchMessage is the human-readable summary of ev.
+func watchMessage(ev notify.Event, u validator.Uptime) string {
+	name := ev.Moniker
+	if name == "" {
+		name = ev.Address
+	}
+	switch ev.Type {
+	case notify.EventTombstoned:
+		return fmt.Sprintf("%s was tombstoned (double-sign) and cannot be unjailed", name)
+	case notify.EventJailed:
+		return fmt.Sprintf("%s was jailed with %d missed blocks; run push-validator unjail once the node is healthy", name, ev.MissedBlocks)
+	default:
+		if u.MaxMissed > 0 {
+			return fmt.Sprintf("%s missed %d blocks; %d more before downtime jailing", name, ev.MissedBlocks, u.MissesLeft)
+		}
+		return fmt.Sprintf("%s missed %d blocks", name, ev.MissedBlocks)
+	}
+}
+
+// sendWatchEvent prints ev and delivers it to each notifier.
+func sendWatchEvent(ctx context.Context, w io.Writer, jsonOut bool, d *Deps, ev notify.Event, notifiers []notify.Notifier) {
+	c := d.Printer.Colors
+	if jsonOut {
+		writeNDJSON(w, ev)
+	} else {
+		fmt.Fprintf(w, "%s %s %s\n", ev.Time.Local().Format("15:04:05"), c.Error(strings.ToUpper(ev.Type)), ev.Message)
+	}
+	for _, n := range notifiers {
+		nctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+		err := n.Notify(nctx, ev)
+		cancel()
+		if err != nil {
+			watchWarn(w, jsonOut, c.Warning, fmt.Sprintf("%s notification failed: %v", n.Name(), err))
+		}
+	}
+}
+
+// watchWarn reports a non-fatal problem without stopping the watcher.
+func watchWarn(w io.Writer, jsonOut bool, color func(string) string, msg string) {
+	if jsonOut {
+		writeNDJSON(w, map[string]any{"ok": false, "time": time.Now().UTC().Format(time.RFC3339), "error": msg})
+		return
+	}
+	fmt.Fprintln(w, color(msg))
+}
+
+// parseWebhookURL validates a --webhook value.
+func parseWebhookURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", exitcodes.InvalidArgsErrorf("--webhook must be an http(s) URL, got %q", raw)
+	}
+	return raw, nil
+}
+
+func init() {
+	var (
+		webhook string
+		desktop bool
+		opts    watchOptions
+	)
+	watchCmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Notify when the validator is jailed, tombstoned or missing blocks",
+		Long: `Poll this node's validator and send a notification when it is jailed,
+tombstoned, or its missed-blocks counter crosses a threshold.
+
+Events are printed and, when configured, POSTed as JSON to --webhook and shown
+as a desktop notification (--desktop). Each alert fires once per transition:
+the validator must recover before the same alert fires again. Notification
+failures are printed and the watcher keeps running.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if webhook != "" {
+				u, err := parseWebhookURL(webhook)
+				if err != nil {
+					return err
+				}
+				opts.Notifiers = append(opts.Notifiers, notify.Webhook{URL: u})
+			}
+			if desktop {
+				opts.Notifiers = append(opts.Notifiers, notify.Desktop{})
+			}
+			if opts.MissedThreshold < 0 {
+				return exitcodes.InvalidArgsError("--missed-threshold cannot be negative")
+			}
+			return handleWatch(newDeps(), opts)
+		},
+	}
+	watchCmd.Flags().StringVar(&webhook, "webhook", "", "POST events as JSON to this URL")
+	watchCmd.Flags().BoolVar(&desktop, "desktop", false, "Show events as desktop notifications")
+	watchCmd.Flags().DurationVar(&opts.Interval, "interval", time.Minute, "Polling interval")
+	watchCmd.Flags().Int64Var(&opts.MissedThreshold, "missed-threshold", 0, "Alert when missed blocks reach this count (0: over half the allowed downtime)")
+	rootCmd.AddCommand(watchCmd)
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/node"
+	"github.com/pushchain/push-validator-cli/internal/notify"
+	"github.com/pushchain/push-validator-cli/internal/validator"
+)
+
+// recordingNotifier records delivered events, optionally failing each one.
+type recordingNotifier struct {
+	mu     sync.Mutex
+	events []notify.Event
+	err    error
+}
+
+func (r *recordingNotifier) Name() string { return "recorder" }
+
+func (r *recordingNotifier) Notify(ctx context.Context, ev notify.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, ev)
+	return r.err
+}
+
+func (r *recordingNotifier) types() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []string
+	for _, ev := range r.events {
+		out = append(out, ev.Type)
+	}
+	return out
+}
+
+// sequenceUptime returns the scripted uptimes in order, repeating the last.
+func sequenceUptime(ups ...validator.Uptime) uptimeFetcher {
+	var mu sync.Mutex
+	calls := 0
+	return func(ctx context.Context, cfg config.Config) (validator.Uptime, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		i := min(calls, len(ups)-1)
+		calls++
+		return ups[i], nil
+	}
+}
+
+func runWatch(t *testing.T, d *Deps, opts watchOptions, fetch uptimeFetcher) string {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	var buf bytes.Buffer
+	if err := watchMyValidator(ctx, d, &buf, opts, fetch); err != nil {
+		t.Fatalf("watchMyValidator() error = %v", err)
+	}
+	return buf.String()
+}
+
+func TestWatchTransitions(t *testing.T) {
+	tests := []struct {
+		name      string
+		prev, cur watchState
+		want      []string
+	}{
+		{"no change", watchState{}, watchState{}, nil},
+		{"jailed", watchState{}, watchState{Jailed: true}, []string{notify.EventJailed}},
+		{"still jailed", watchState{Jailed: true}, watchState{Jailed: true}, nil},
+		{"tombstoned replaces jailed", watchState{}, watchState{Jailed: true, Tombstoned: true}, []string{notify.EventTombstoned}},
+		{"threshold", watchState{}, watchState{OverThreshold: true}, []string{notify.EventMissedBlocks}},
+		{"jailed after threshold", watchState{OverThreshold: true}, watchState{Jailed: true, OverThreshold: true}, []string{notify.EventJailed}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := watchTransitions(tt.prev, tt.cur); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("watchTransitions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWatchMyValidator_NotifiesOnTransitions(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	active := validator.MyValidatorInfo{IsValidator: true, Moniker: "val-1", Address: "pushvaloper1abc"}
+	jailed := active
+	jailed.Jailed = true
+	f := &sequenceFetcher{states: []validator.MyValidatorInfo{active, active, jailed}}
+	rec := &recordingNotifier{}
+	d := &Deps{Cfg: testCfg(), Fetcher: f, Printer: getPrinter(), Node: &mockNodeClient{status: node.Status{Height: 1234}}}
+
+	out := runWatch(t, d, watchOptions{Interval: time.Millisecond, MissedThreshold: 100, Notifiers: []notify.Notifier{rec}}, sequenceUptime(
+		validator.Uptime{Missed: 10, Level: validator.UptimeOK},
+		validator.Uptime{Missed: 150, MaxMissed: 500, MissesLeft: 350, Level: validator.UptimeOK},
+		validator.Uptime{Missed: 501, Level: validator.UptimeCritical},
+	))
+
+	if got, want := rec.types(), []string{notify.EventMissedBlocks, notify.EventJailed}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("events = %v, want %v\n%s", got, want, out)
+	}
+	ev := rec.events[1]
+	if ev.Moniker != "val-1" || ev.Address != "pushvaloper1abc" || ev.Height != 1234 || ev.MissedBlocks != 501 {
+		t.Errorf("jailed event = %+v", ev)
+	}
+	if f.invalidated == 0 {
+		t.Error("validator cache was not invalidated between polls")
+	}
+	if !strings.Contains(out, "missed 150 blocks; 350 more before downtime jailing") || !strings.Contains(out, "JAILED") {
+		t.Errorf("output missing events:\n%s", out)
+	}
+}
+
+func TestWatchMyValidator_DefaultThresholdUsesUptimeLevel(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "text"
+
+	f := &mockFetcher{myValidator: validator.MyValidatorInfo{IsValidator: true, Moniker: "val-1"}}
+	rec := &recordingNotifier{}
+	d := &Deps{Cfg: testCfg(), Fetcher: f, Printer: getPrinter()}
+
+	runWatch(t, d, watchOptions{Interval: time.Millisecond, Notifiers: []notify.Notifier{rec}}, sequenceUptime(
+		validator.Uptime{Missed: 100, Level: validator.UptimeOK},
+		validator.Uptime{Missed: 300, Level: validator.UptimeWarning},
+	))
+	if got := rec.types(); !reflect.DeepEqual(got, []string{notify.EventMissedBlocks}) {
+		t.Errorf("events = %v, want one missed_blocks", got)
+	}
+}
+
+func TestWatchMyValidator_JSONAndNotifyFailure(t *testing.T) {
+	origOutput := flagOutput
+	defer func() { flagOutput = origOutput }()
+	flagOutput = "json"
+
+	f := &mockFetcher{myValidator: validator.MyValidatorInfo{
+		IsValidator:  true,
+		Moniker:      "val-1",
+		Jailed:       true,
+		SlashingInfo: validator.SlashingInfo{Tombstoned: true},
+	}}
+	rec := &recordingNotifier{err: fmt.Errorf("connection refused")}
+	d := &Deps{Cfg: testCfg(), Fetcher: f, Printer: getPrinter()}
+
+	out := runWatch(t, d, watchOptions{Interval: time.Millisecond, Notifiers: []notify.Notifier{rec}}, func(ctx context.Context, cfg config.Config) (validator.Uptime, error) {
+		return validator.Uptime{}, fmt.Errorf("signing info not found")
+	})
+
+	if got := rec.types(); !reflect.DeepEqual(got, []string{notify.EventTombstoned}) {
+		t.Fatalf("events = %v, want one tombstoned", got)
+	}
+	var sawEvent, sawFailure bool
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatalf("line is not JSON: %q", line)
+		}
+		if m["event"] == notify.EventTombstoned {
+			sawEvent = true
+		}
+		if e, _ := m["error"].(string); strings.Contains(e, "recorder notification failed") {
+			sawFailure = true
+		}
+	}
+	if !sawEvent || !sawFailure {
+		t.Errorf("expected event and notification failure lines:\n%s", out)
+	}
+}
+
+func TestWatchMyValidator_InvalidOptions(t *testing.T) {
+	d := &Deps{Cfg: testCfg(), Fetcher: &mockFetcher{}, Printer: getPrinter()}
+	if err := watchMyValidator(context.Background(), d, &bytes.Buffer{}, watchOptions{}, nil); err == nil {
+		t.Error("expected error for zero interval")
+	}
+	if _, err := parseWebhookURL("hooks.example.com/x"); err == nil {
+		t.Error("expected error for webhook URL without scheme")
+	}
+	if _, err := parseWebhookURL("https://hooks.example.com/x"); err != nil {
+		t.Errorf("parseWebhookURL() error = %v", err)
+	}
+}
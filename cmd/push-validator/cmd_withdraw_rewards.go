@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -50,7 +51,7 @@ func handleWithdrawRewards(d *Deps) error {
 		return fmt.Errorf("failed to check sync status")
 	}
 
-	if stLocal.CatchingUp {
+	if stLocal.CatchingUp && !overrideSyncCheck(os.Stderr) {
 		if flagOutput == "json" {
 			getPrinter().JSON(map[string]any{"ok": false, "error": "node is still syncing"})
 		} else {
@@ -256,6 +257,9 @@ func handleWithdrawRewards(d *Deps) error {
 
 	// Step 7: Ask about commission
 	var includeCommission bool
+	if flagOutput != "json" {
+		printTxFee(p, d.Cfg.Denom)
+	}
 	if d.Prompter.IsInteractive() {
 		input, err := d.Prompter.ReadLine("Include commission rewards in withdrawal? (y/n) [n]: ")
 		if err == nil {
@@ -291,9 +295,14 @@ func handleWithdrawRewards(d *Deps) error {
 		fmt.Println(" " + p.Colors.Success(p.Colors.Emoji("✓")))
 	}
 
+	txRes, err := confirmTx(d.Validator, txHash, flagTxWait, flagTxWaitTimeout)
+	if err != nil {
+		return err
+	}
+
 	// Success output
 	if flagOutput == "json" {
-		getPrinter().JSON(map[string]any{"ok": true, "txhash": txHash})
+		getPrinter().JSON(withTxResult(map[string]any{"ok": true, "txhash": txHash}, txRes))
 	} else {
 		fmt.Println()
 		p.Success(p.Colors.Emoji("✅") + " Rewards successfully withdrawn!")
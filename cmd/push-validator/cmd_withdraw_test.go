@@ -63,6 +63,23 @@ func TestHandleWithdrawRewards_StillSyncing(t *testing.T) {
 	}
 }
 
+func TestHandleWithdrawRewards_AssumeSynced(t *testing.T) {
+	origOutput, origAssume := flagOutput, flagAssumeSynced
+	defer func() { flagOutput, flagAssumeSynced = origOutput, origAssume }()
+	flagOutput, flagAssumeSynced = "json", true
+
+	d := withdrawDeps(func(d *Deps) {
+		d.Node = &mockNodeClient{status: node.Status{CatchingUp: true}}
+		d.Fetcher = &mockFetcher{myValidator: validator.MyValidatorInfo{IsValidator: false}}
+	})
+
+	// Past the sync gate, the next check decides
+	err := handleWithdrawRewards(d)
+	if err == nil || !containsSubstr(err.Error(), "not registered as validator") {
+		t.Errorf("err = %v, want the sync check skipped", err)
+	}
+}
+
 func TestHandleWithdrawRewards_NotValidator(t *testing.T) {
 	origOutput := flagOutput
 	defer func() { flagOutput = origOutput }()
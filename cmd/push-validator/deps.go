@@ -7,13 +7,14 @@ import (
 	"io"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/chain"
 	"github.com/pushchain/push-validator-cli/internal/config"
 	"github.com/pushchain/push-validator-cli/internal/node"
 	"github.com/pushchain/push-validator-cli/internal/process"
+	"github.com/pushchain/push-validator-cli/internal/trace"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 	"github.com/pushchain/push-validator-cli/internal/validator"
 	"golang.org/x/term"
@@ -60,39 +61,9 @@ type execRunner struct{}
 
 func (r *execRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
 	cmd := exec.CommandContext(ctx, name, args...)
-
-	// Set DYLD_LIBRARY_PATH for macOS to find libwasmvm.dylib
-	// Check multiple potential locations for the dylib
-	dylibPaths := []string{}
-
-	// 1. Same directory as binary
-	binDir := filepath.Dir(name)
-	if binDir != "" && binDir != "." {
-		dylibPaths = append(dylibPaths, binDir)
-	}
-
-	// 2. Common cosmovisor locations
-	homeDir, err := os.UserHomeDir()
-	if err == nil {
-		cosmovisorDirs := []string{
-			filepath.Join(homeDir, ".pchain/cosmovisor/genesis/bin"),
-			filepath.Join(homeDir, ".pchain/cosmovisor/current/bin"),
-		}
-		dylibPaths = append(dylibPaths, cosmovisorDirs...)
-	}
-
-	// Build DYLD_LIBRARY_PATH
-	if len(dylibPaths) > 0 {
-		env := os.Environ()
-		existingPath := os.Getenv("DYLD_LIBRARY_PATH")
-		newPath := strings.Join(dylibPaths, ":")
-		if existingPath != "" {
-			newPath = newPath + ":" + existingPath
-		}
-		env = append(env, "DYLD_LIBRARY_PATH="+newPath)
-		cmd.Env = env
-	}
-
+	// Let pchaind find libwasmvm next to the binary or in cosmovisor
+	cmd.Env = chain.WithLibraryPath(os.Environ(), chain.LibraryDirs(name)...)
+	trace.Command(cmd)
 	return cmd.Output()
 }
 
@@ -103,6 +74,16 @@ func (f *prodFetcher) GetMyValidator(ctx context.Context, cfg config.Config) (va
 	return validator.GetCachedMyValidator(ctx, cfg)
 }
 
+// InvalidateMyValidator makes the next GetMyValidator bypass the cache.
+func (f *prodFetcher) InvalidateMyValidator() {
+	validator.InvalidateCachedMyValidator()
+}
+
+// Invalidate makes the next call of every getter bypass the cache.
+func (f *prodFetcher) Invalidate() {
+	validator.InvalidateCache()
+}
+
 func (f *prodFetcher) GetAllValidators(ctx context.Context, cfg config.Config) (validator.ValidatorList, error) {
 	return validator.GetCachedValidatorsList(ctx, cfg)
 }
@@ -141,6 +122,36 @@ func (p *ttyPrompter) ReadLine(prompt string) (string, error) {
 	return strings.TrimSpace(line), nil
 }
 
+// ReadSecret reads a line without echoing it, for passwords.
+func (p *ttyPrompter) ReadSecret(prompt string) (string, error) {
+	fmt.Print(prompt)
+	defer fmt.Println()
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+		if err != nil {
+			return "", fmt.Errorf("no interactive terminal available: %w", err)
+		}
+		defer tty.Close()
+		fd = int(tty.Fd())
+	}
+	b, err := term.ReadPassword(fd)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// readSecret reads hidden input when the prompter supports it and falls
+// back to ReadLine otherwise (e.g. test prompters).
+func readSecret(p Prompter, prompt string) (string, error) {
+	if sp, ok := p.(interface{ ReadSecret(string) (string, error) }); ok {
+		return sp.ReadSecret(prompt)
+	}
+	return p.ReadLine(prompt)
+}
+
 func (p *ttyPrompter) IsInteractive() bool {
 	if flagNonInteractive {
 		return false
@@ -168,7 +179,7 @@ func newDeps() *Deps {
 
 	return &Deps{
 		Cfg:        cfg,
-		Sup:        newSupervisor(cfg.HomeDir),
+		Sup:        newSupervisor(cfg),
 		Printer:    getPrinter(),
 		Runner:     &execRunner{},
 		Fetcher:    &prodFetcher{},
@@ -184,6 +195,8 @@ func newDeps() *Deps {
 			Keyring:       cfg.KeyringBackend,
 			GenesisDomain: cfg.GenesisDomain,
 			Denom:         cfg.Denom,
+			Fees:          flagTxFees,
+			BroadcastMode: flagBroadcastMode,
 		}),
 	}
 }
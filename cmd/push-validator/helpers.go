@@ -3,22 +3,86 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/spf13/cobra"
+
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/lockfile"
 	"github.com/pushchain/push-validator-cli/internal/process"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
-// newSupervisor creates a Cosmovisor-based process supervisor.
-func newSupervisor(homeDir string) process.Supervisor {
-	return process.NewCosmovisor(homeDir)
+// detectCosmovisor is cosmovisor.Detect; tests replace it.
+var detectCosmovisor = cosmovisor.Detect
+
+// flagCosmovisor and flagNoCosmovisor are the per-command overrides of
+// use_cosmovisor, registered by addCosmovisorFlags and applied in loadCfg.
+var flagCosmovisor, flagNoCosmovisor bool
+
+// addCosmovisorFlags adds --cosmovisor and --no-cosmovisor to a command that
+// picks a supervisor.
+func addCosmovisorFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&flagCosmovisor, "cosmovisor", false, "Run the node under Cosmovisor and fail if it isn't installed (overrides PUSH_USE_COSMOVISOR)")
+	cmd.Flags().BoolVar(&flagNoCosmovisor, "no-cosmovisor", false, "Run pchaind directly, without Cosmovisor (overrides PUSH_USE_COSMOVISOR)")
+	cmd.MarkFlagsMutuallyExclusive("cosmovisor", "no-cosmovisor")
+}
+
+// selectSupervisor picks the process supervisor for cfg.UseCosmovisor:
+// Cosmovisor when it is installed (auto) or required (always), pchaind run
+// directly otherwise. always fails rather than fall back when the cosmovisor
+// binary isn't found. The detection result is zero for never. On error the
+// Cosmovisor supervisor is still returned, for callers that only read state.
+func selectSupervisor(cfg config.Config) (process.Supervisor, cosmovisor.DetectionResult, error) {
+	mode, err := config.ParseUseCosmovisor(cfg.UseCosmovisor)
+	if err != nil {
+		return process.NewCosmovisor(cfg.HomeDir), cosmovisor.DetectionResult{}, exitcodes.InvalidArgsErrorf("PUSH_USE_COSMOVISOR: %v", err)
+	}
+	if mode == config.UseCosmovisorNever {
+		return process.New(cfg.HomeDir), cosmovisor.DetectionResult{}, nil
+	}
+	detection := detectCosmovisor(cfg.HomeDir)
+	switch {
+	case detection.Available:
+		return process.NewCosmovisor(cfg.HomeDir), detection, nil
+	case mode == config.UseCosmovisorAlways:
+		return process.NewCosmovisor(cfg.HomeDir), detection, exitcodes.PreconditionErrorf("cosmovisor binary not found and use_cosmovisor is always; install it, ensure it's in PATH or use --no-cosmovisor")
+	}
+	return process.New(cfg.HomeDir), detection, nil
+}
+
+// newSupervisor returns the supervisor selectSupervisor picks for commands
+// that only inspect the node; the commands that start or stop it report
+// selection errors.
+func newSupervisor(cfg config.Config) process.Supervisor {
+	sup, _, _ := selectSupervisor(cfg)
+	return sup
+}
+
+// usesCosmovisor reports whether sup runs the node under Cosmovisor.
+func usesCosmovisor(sup process.Supervisor) bool {
+	_, ok := sup.(*process.CosmovisorSupervisor)
+	return ok
+}
+
+// startedWith completes "Node started" and similar messages.
+func startedWith(withCosmovisor bool) string {
+	if withCosmovisor {
+		return " with Cosmovisor"
+	}
+	return ""
 }
 
 // silentErr wraps an error that has already been displayed to the user.
@@ -27,6 +91,13 @@ type silentErr struct{ error }
 
 func (e silentErr) Unwrap() error { return e.error }
 
+// writeOutputTable prints rows for --output table: a header line and
+// aligned columns fitted to the terminal width or $COLUMNS, with no banner,
+// tips or row colors, so the output can be piped into column-based tools.
+func writeOutputTable(w io.Writer, headers []string, rows [][]string) {
+	fmt.Fprint(w, ui.FitTable(ui.NewColorConfigFromGlobal(), headers, rows, ui.TableWidth()))
+}
+
 // checkNodeRunning verifies the node is running and prints a user-friendly
 // error if not. Returns a silentErr so the message is not repeated.
 func checkNodeRunning(sup process.Supervisor) error {
@@ -82,6 +153,164 @@ func findPchaind() string {
 	return "pchaind"
 }
 
+// nodeStartOpts returns the options for launching the node from cfg: its
+// home, the resolved pchaind binary and every configured listen port.
+func nodeStartOpts(cfg config.Config) process.StartOpts {
+	return process.StartOpts{
+		HomeDir:       cfg.HomeDir,
+		Moniker:       os.Getenv("MONIKER"),
+		BinPath:       findPchaind(),
+		RPCPort:       cfg.RPCPort,
+		P2PPort:       cfg.P2PPort,
+		JSONRPCPort:   cfg.JSONRPCPort,
+		JSONRPCWSPort: cfg.JSONRPCWSPort,
+	}
+}
+
+// pchaindCommands are the commands that shell out to pchaind, directly or
+// through the validator service. Subcommands inherit their parent's entry.
+var pchaindCommands = map[string]bool{
+	"init": true, "start": true, "restart": true, "ensure-running": true,
+	"reset": true, "full-reset": true, "show-address": true,
+	"keys": true, "export-key": true, "balance": true, "register-validator": true,
+	"unjail": true, "withdraw-rewards": true, "increase-stake": true, "restake-rewards": true,
+	"vote": true, "validators": true, "proposals": true, "rewards": true,
+	"uptime": true, "watch": true,
+}
+
+// requirePchaind fails early with one actionable error when cmd needs
+// pchaind and it can't be found, instead of an exec failure deep inside the
+// command. Commands that don't use pchaind always pass.
+func requirePchaind(cmd *cobra.Command) error {
+	if !usesPchaind(cmd) {
+		return nil
+	}
+	bin := findPchaind()
+	// start and restart take their own --bin
+	if f := cmd.Flags().Lookup("bin"); f != nil && f.Changed {
+		bin = f.Value.String()
+	}
+	if _, err := exec.LookPath(bin); err != nil {
+		return exitcodes.PreconditionErrorf("pchaind not found (looked for %q); install it with 'push-validator install-chain' or pass --bin", bin)
+	}
+	return nil
+}
+
+// usesPchaind reports whether cmd or one of its parents is in pchaindCommands.
+func usesPchaind(cmd *cobra.Command) bool {
+	for c := cmd; c != nil && c.HasParent(); c = c.Parent() {
+		if pchaindCommands[c.Name()] {
+			return true
+		}
+	}
+	return false
+}
+
+// homeLockCommands are the commands that change the node or its home and
+// so must not overlap on the same home. Subcommands inherit their parent's
+// entry; read-only commands such as status and logs take no lock.
+var homeLockCommands = map[string]bool{
+	"init": true, "start": true, "stop": true, "restart": true,
+	"ensure-running": true, "reset": true, "full-reset": true,
+}
+
+// homeLock is the lock acquireHomeLock took for the running command.
+var homeLock *lockfile.Lock
+
+// acquireHomeLock takes the home's operation lock for the rest of the run
+// when cmd is in homeLockCommands, and fails fast if another command holds
+// it. The lock is a flock, so one left by a crashed command is reclaimed by
+// the kernel; only a live owner can block.
+func acquireHomeLock(cmd *cobra.Command, home string) error {
+	take := false
+	for c := cmd; c != nil && c.HasParent(); c = c.Parent() {
+		take = take || homeLockCommands[c.Name()]
+	}
+	if !take {
+		return nil
+	}
+	return lockHome(home, cmd.CommandPath())
+}
+
+// lockHome takes the home lock for op unless this process already holds
+// it. Besides acquireHomeLock, it is used to take the lock again for a step
+// that changes the home after the command released it, such as start's
+// snapshot recovery once the node is up.
+func lockHome(home, op string) error {
+	if homeLock != nil {
+		return nil
+	}
+	path := filepath.Join(home, lockfile.HomeName)
+	lock, err := lockfile.TryAcquire(path)
+	if errors.Is(err, lockfile.ErrLocked) {
+		owner := ""
+		if pid, op := lockfile.Owner(path); pid > 0 {
+			owner = fmt.Sprintf(" (%s, PID %d)", op, pid)
+		}
+		return exitcodes.PreconditionErrorf("another operation is in progress on this home%s; wait for it to finish and retry", owner)
+	}
+	if err != nil {
+		// An unwritable home fails later with a clearer error
+		ui.Log().Debugf("home lock not taken: %v", err)
+		return nil
+	}
+	_ = lock.SetOwner(op)
+	homeLock = lock
+	return nil
+}
+
+// releaseHomeLock drops the lock acquireHomeLock took, if any.
+func releaseHomeLock() {
+	_ = homeLock.Release()
+	homeLock = nil
+}
+
+// checkHomeAmbiguity guards node commands against acting on the wrong home
+// when more than one initialized node home exists and --home wasn't given:
+// it warns on w which home is used and lists the others, or fails in
+// --non-interactive mode rather than guess.
+func checkHomeAmbiguity(cmd *cobra.Command, home string, w io.Writer) error {
+	if flagHome != "" || !usesPchaind(cmd) {
+		return nil
+	}
+	homes := config.InitializedHomes(config.HomeCandidates())
+	if len(homes) < 2 {
+		return nil
+	}
+	home = filepath.Clean(home)
+	var others []string
+	for _, h := range homes {
+		if h != home {
+			others = append(others, h)
+		}
+	}
+	if flagNonInteractive {
+		return exitcodes.PreconditionErrorf("multiple initialized node homes found (%s); pass --home to choose one", strings.Join(homes, ", "))
+	}
+	fmt.Fprintf(w, "WARNING: multiple initialized node homes found; using %s (pass --home to choose)\n", home)
+	for _, h := range others {
+		fmt.Fprintf(w, "  also found: %s\n", h)
+	}
+	return nil
+}
+
+// overrideSyncCheck reports whether --assume-synced lets a command go ahead
+// although the node reports it is still syncing, printing the risk to w. In
+// --non-interactive mode the flag only counts together with --yes.
+func overrideSyncCheck(w io.Writer) bool {
+	if !flagAssumeSynced {
+		return false
+	}
+	if flagNonInteractive && !flagYes {
+		fmt.Fprintln(w, "Note: --assume-synced is ignored in --non-interactive mode unless --yes is also given")
+		return false
+	}
+	fmt.Fprintln(w, "WARNING: --assume-synced: the node reports it is still syncing, continuing anyway.")
+	fmt.Fprintln(w, "Signing or broadcasting from a node that is behind can fail, and a validator that is not")
+	fmt.Fprintln(w, "caught up can double-sign and be slashed. Only use this when you know the node is current.")
+	return true
+}
+
 // getenvDefault returns the environment value for k, or default d
 // when k is not set.
 func getenvDefault(k, d string) string {
@@ -309,3 +538,129 @@ func waitForSufficientBalanceWith(v validator.Service, p ui.Printer, prompter Pr
 	fmt.Println()
 	return false
 }
+
+// Settings shared by every tx command: gas/fee overrides, broadcast mode and
+// waiting for inclusion.
+var (
+	flagTxFees         validator.TxFees
+	flagBroadcastMode  = validator.BroadcastSync
+	flagTxWait         bool
+	flagTxWaitTimeout  = 2 * time.Minute
+	txWaitPollInterval = 2 * time.Second
+)
+
+// addTxFlags registers the gas/fee and --broadcast-mode flags on a
+// tx-broadcasting command and validates them before it runs.
+func addTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flagTxFees.Gas, "gas", "", `Gas limit per tx, or "auto" to simulate (default auto)`)
+	cmd.Flags().StringVar(&flagTxFees.GasAdjustment, "gas-adjustment", "", "Multiplier on simulated gas with --gas auto (default 1.3)")
+	cmd.Flags().StringVar(&flagTxFees.GasPrices, "gas-prices", "", "Gas price, e.g. 1000000000upc (default 1000000000 in the chain denom)")
+	cmd.Flags().StringVar(&flagTxFees.Fees, "fees", "", "Flat fee instead of gas price, e.g. 200000000000000upc")
+	cmd.Flags().StringVar(&flagBroadcastMode, "broadcast-mode", validator.BroadcastSync, "sync (after CheckTx), async (immediately) or block (after inclusion)")
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := flagTxFees.Validate(); err != nil {
+			return exitcodes.InvalidArgsError(err.Error())
+		}
+		if !validator.ValidBroadcastMode(flagBroadcastMode) {
+			return exitcodes.InvalidArgsErrorf("invalid --broadcast-mode %q: must be sync, async or block", flagBroadcastMode)
+		}
+		return nil
+	}
+}
+
+// addTxWaitFlags registers --wait and --wait-timeout, which poll for the tx
+// to be included in a block.
+func addTxWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&flagTxWait, "wait", false, "Wait until the tx is included in a block and report its result")
+	cmd.Flags().DurationVar(&flagTxWaitTimeout, "wait-timeout", flagTxWaitTimeout, "How long --wait polls before giving up")
+}
+
+// confirmTx waits for txHash to be included when --wait or --broadcast-mode
+// block asks for it, and returns nil otherwise. An on-chain failure is printed
+// (or emitted as JSON) and returned with the TxFailed exit code.
+func confirmTx(v validator.Service, txHash string, wait bool, timeout time.Duration) (*validator.TxResult, error) {
+	if !wait && flagBroadcastMode != validator.BroadcastBlock {
+		return nil, nil
+	}
+	p := getPrinter()
+	if flagOutput != "json" {
+		fmt.Print(p.Colors.Apply(p.Colors.Theme.Prompt, fmt.Sprintf("⏳ Waiting for tx to be included (timeout %s)...", timeout)))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := validator.WaitForTx(ctx, v.QueryTx, txHash, txWaitPollInterval)
+	if err == nil {
+		if flagOutput != "json" {
+			fmt.Println(" " + p.Colors.Success(p.Colors.Emoji("✓")))
+			p.KeyValueLine("Included at Height", fmt.Sprintf("%d (code 0)", res.Height), "green")
+		}
+		return &res, nil
+	}
+
+	// The returned error is left unwrapped so Execute reports its exit code
+	failed := errors.Is(err, validator.ErrTxFailed)
+	if flagOutput == "json" {
+		out := map[string]any{"ok": false, "txhash": txHash, "error": err.Error()}
+		if failed {
+			out["height"], out["code"], out["raw_log"] = res.Height, res.Code, res.RawLog
+		}
+		p.JSON(out)
+	} else {
+		fmt.Println()
+		if failed {
+			fmt.Println(p.Colors.Error(p.Colors.Emoji("❌") + fmt.Sprintf(" Transaction failed on-chain (code %d)", res.Code)))
+			p.KeyValueLine("Transaction Hash", txHash, "")
+			p.KeyValueLine("Height", fmt.Sprintf("%d", res.Height), "")
+			p.KeyValueLine("Raw Log", res.RawLog, "")
+		} else {
+			fmt.Println(p.Colors.Info("The tx may still be included; check with:"))
+			fmt.Println(p.Colors.Apply(p.Colors.Theme.Command, "  pchaind query tx "+txHash))
+		}
+		fmt.Println()
+	}
+	if failed {
+		return &res, exitcodes.NewError(exitcodes.TxFailed, err.Error())
+	}
+	return nil, exitcodes.NetworkErr(err.Error())
+}
+
+// withTxResult adds the confirmed block height and code to a JSON result.
+func withTxResult(out map[string]any, res *validator.TxResult) map[string]any {
+	if res != nil {
+		out["height"] = res.Height
+		out["code"] = res.Code
+		out["gas_used"] = res.GasUsed
+	}
+	return out
+}
+
+// txFeeSummary describes the fee the next tx will pay, for confirmation
+// prompts. With --gas auto the gas is only known once pchaind simulates the
+// tx, so the settings are shown instead of an amount.
+func txFeeSummary(fees validator.TxFees, denom string) string {
+	if amount, ok := fees.MaxFee(denom); ok {
+		unit := lookupDenom(denom)
+		s := fmt.Sprintf("%s %s", formatDenomAmount(amount.String(), unit.Exponent), unit.Display)
+		if fees.Fees == "" {
+			s = "up to " + s + fmt.Sprintf(" (%s gas)", fees.Gas)
+		}
+		return s
+	}
+	adj := fees.GasAdjustment
+	if adj == "" {
+		adj = validator.DefaultGasAdjustment
+	}
+	prices := fees.GasPrices
+	if prices == "" {
+		prices = validator.DefaultGasPrice
+	}
+	if _, err := strconv.ParseFloat(prices, 64); err == nil {
+		prices += denom
+	}
+	return fmt.Sprintf("estimated at broadcast (auto gas x%s at %s per gas)", adj, prices)
+}
+
+// printTxFee shows the expected tx fee as a key/value line.
+func printTxFee(p ui.Printer, denom string) {
+	p.KeyValueLine("Tx Fee", txFeeSummary(flagTxFees, denom), "dim")
+}
@@ -184,6 +184,19 @@ func (m *balanceIncrementingValidator) Balance(ctx context.Context, addr string)
 	return "500000000000000000", nil // 0.5 PC - sufficient
 }
 
+func (m *balanceIncrementingValidator) Balances(ctx context.Context, addr string) ([]validator.Coin, error) {
+	bal, err := m.Balance(ctx, addr)
+	return []validator.Coin{{Denom: "upc", Amount: bal}}, err
+}
+
+func (m *balanceIncrementingValidator) ListKeys(ctx context.Context) ([]validator.KeyInfo, error) {
+	return nil, nil
+}
+
+func (m *balanceIncrementingValidator) ShowKey(ctx context.Context, name string) (validator.KeyInfo, error) {
+	return validator.KeyInfo{}, nil
+}
+
 func (m *balanceIncrementingValidator) IsValidator(ctx context.Context, addr string) (bool, error) {
 	return false, nil
 }
@@ -228,3 +241,7 @@ func (m *balanceIncrementingValidator) Vote(ctx context.Context, args validator.
 	return "", nil
 }
 
+func (m *balanceIncrementingValidator) QueryTx(ctx context.Context, hash string) (validator.TxResult, error) {
+	return validator.TxResult{}, nil
+}
+
@@ -1,10 +1,21 @@
 package main
 
 import (
+	"errors"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/lockfile"
+	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
 // Tests for getenvDefault from helpers.go
@@ -562,3 +573,178 @@ func containsIgnoringANSI(s, substr string) bool {
 	// The ANSI codes don't interfere with finding plain text substrings
 	return strings.Contains(s, substr)
 }
+
+func TestTxFeeSummary(t *testing.T) {
+	tests := []struct {
+		fees validator.TxFees
+		want string
+	}{
+		{validator.TxFees{}, "estimated at broadcast (auto gas x1.3 at 1000000000upc per gas)"},
+		{validator.TxFees{GasAdjustment: "1.8", GasPrices: "2000000000"}, "estimated at broadcast (auto gas x1.8 at 2000000000upc per gas)"},
+		{validator.TxFees{Gas: "200000"}, "up to 0.0002 PC (200000 gas)"},
+		{validator.TxFees{Fees: "500000000000000000upc"}, "0.5 PC"},
+	}
+	for _, tt := range tests {
+		if got := txFeeSummary(tt.fees, "upc"); got != tt.want {
+			t.Errorf("txFeeSummary(%+v) = %q, want %q", tt.fees, got, tt.want)
+		}
+	}
+}
+
+func TestAddTxFeeFlags(t *testing.T) {
+	orig := flagTxFees
+	defer func() { flagTxFees = orig }()
+
+	cmd := &cobra.Command{Use: "tx", RunE: func(*cobra.Command, []string) error { return nil }}
+	addTxFlags(cmd)
+	if err := cmd.ParseFlags([]string{"--gas", "300000", "--fees", "1000upc"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.PreRunE(cmd, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if flagTxFees.Gas != "300000" || flagTxFees.Fees != "1000upc" {
+		t.Errorf("flags not bound: %+v", flagTxFees)
+	}
+
+	flagTxFees = validator.TxFees{Fees: "1upc", GasPrices: "1upc"}
+	err := cmd.PreRunE(cmd, nil)
+	if exitcodes.CodeForError(err) != exitcodes.InvalidArgs {
+		t.Errorf("conflicting fees error = %v, want invalid args", err)
+	}
+}
+
+func TestConfirmTx(t *testing.T) {
+	origOutput, origMode, origInterval := flagOutput, flagBroadcastMode, txWaitPollInterval
+	defer func() { flagOutput, flagBroadcastMode, txWaitPollInterval = origOutput, origMode, origInterval }()
+	flagOutput, flagBroadcastMode, txWaitPollInterval = "json", validator.BroadcastSync, time.Millisecond
+
+	// Neither --wait nor block mode: nothing is queried
+	mv := &mockValidator{queryTxErr: errMock}
+	if res, err := confirmTx(mv, "ABC", false, time.Second); res != nil || err != nil {
+		t.Errorf("no wait: got %v, %v", res, err)
+	}
+
+	mv = &mockValidator{queryTxPending: 2, queryTxResult: validator.TxResult{Hash: "ABC", Height: 99}}
+	res, err := confirmTx(mv, "ABC", true, time.Second)
+	if err != nil || res == nil || res.Height != 99 || mv.queryTxPending != 0 {
+		t.Errorf("not found yet, then included: got %v, %v", res, err)
+	}
+
+	// Block mode waits without --wait
+	flagBroadcastMode = validator.BroadcastBlock
+	mv = &mockValidator{queryTxResult: validator.TxResult{Hash: "ABC", Height: 5, Code: 11, RawLog: "out of gas"}}
+	res, err = confirmTx(mv, "ABC", false, time.Second)
+	if exitcodes.CodeForError(err) != exitcodes.TxFailed || res == nil || res.Code != 11 {
+		t.Errorf("failed tx: got %v, %v", res, err)
+	}
+
+	mv = &mockValidator{queryTxPending: 1 << 30}
+	_, err = confirmTx(mv, "ABC", true, 20*time.Millisecond)
+	if exitcodes.CodeForError(err) != exitcodes.NetworkError {
+		t.Errorf("timeout: error = %v, want network error", err)
+	}
+}
+
+func TestSelectSupervisor(t *testing.T) {
+	origDetect := detectCosmovisor
+	defer func() { detectCosmovisor = origDetect }()
+	installed := false
+	detectCosmovisor = func(string) cosmovisor.DetectionResult {
+		return cosmovisor.DetectionResult{Available: installed}
+	}
+
+	tests := []struct {
+		mode      string
+		installed bool
+		want      bool // runs under Cosmovisor
+		wantCode  int
+	}{
+		{config.UseCosmovisorAuto, true, true, exitcodes.Success},
+		{config.UseCosmovisorAuto, false, false, exitcodes.Success},
+		{config.UseCosmovisorAlways, true, true, exitcodes.Success},
+		{config.UseCosmovisorAlways, false, true, exitcodes.PreconditionFailed},
+		{config.UseCosmovisorNever, true, false, exitcodes.Success},
+		{"sometimes", true, true, exitcodes.InvalidArgs},
+	}
+	for _, tt := range tests {
+		installed = tt.installed
+		sup, _, err := selectSupervisor(config.Config{HomeDir: t.TempDir(), UseCosmovisor: tt.mode})
+		if got := usesCosmovisor(sup); got != tt.want {
+			t.Errorf("%s (installed %v): cosmovisor = %v, want %v", tt.mode, tt.installed, got, tt.want)
+		}
+		if code := exitcodes.CodeForError(err); code != tt.wantCode {
+			t.Errorf("%s (installed %v): error %v, want code %d", tt.mode, tt.installed, err, tt.wantCode)
+		}
+	}
+}
+
+func TestCosmovisorFlagsOverrideConfig(t *testing.T) {
+	origYes, origNo := flagCosmovisor, flagNoCosmovisor
+	defer func() { flagCosmovisor, flagNoCosmovisor = origYes, origNo }()
+	t.Setenv("PUSH_USE_COSMOVISOR", "always")
+
+	cmd := &cobra.Command{Use: "stop"}
+	addCosmovisorFlags(cmd)
+	if err := cmd.ParseFlags([]string{"--no-cosmovisor"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := loadCfg().UseCosmovisor; got != config.UseCosmovisorNever {
+		t.Errorf("--no-cosmovisor: use_cosmovisor = %q, want never", got)
+	}
+
+	flagNoCosmovisor = false
+	if got := loadCfg().UseCosmovisor; got != config.UseCosmovisorAlways {
+		t.Errorf("no flag: use_cosmovisor = %q, want the env value", got)
+	}
+
+	cmd = &cobra.Command{Use: "stop", RunE: func(*cobra.Command, []string) error { return nil }}
+	addCosmovisorFlags(cmd)
+	cmd.SetArgs([]string{"--cosmovisor", "--no-cosmovisor"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	if err := cmd.Execute(); err == nil {
+		t.Error("--cosmovisor with --no-cosmovisor should fail")
+	}
+}
+
+func TestAcquireHomeLock(t *testing.T) {
+	defer releaseHomeLock()
+	home := t.TempDir()
+	root := &cobra.Command{Use: "push-validator"}
+	reset := &cobra.Command{Use: "reset"}
+	status := &cobra.Command{Use: "status"}
+	root.AddCommand(reset, status)
+
+	// Another command already holds the home
+	other, err := lockfile.TryAcquire(filepath.Join(home, lockfile.HomeName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = other.SetOwner("push-validator start")
+
+	err = acquireHomeLock(reset, home)
+	if exitcodes.CodeForError(err) != exitcodes.PreconditionFailed {
+		t.Fatalf("reset while locked: error = %v, want precondition failed", err)
+	}
+	if !strings.Contains(err.Error(), "another operation is in progress on this home") || !strings.Contains(err.Error(), "push-validator start") {
+		t.Errorf("error = %q, want the in-progress message naming the owner", err)
+	}
+	if err := acquireHomeLock(status, home); err != nil {
+		t.Errorf("read-only command should not take the lock, got %v", err)
+	}
+
+	_ = other.Release()
+	if err := acquireHomeLock(reset, home); err != nil {
+		t.Fatalf("reset after release: %v", err)
+	}
+	if _, err := lockfile.TryAcquire(filepath.Join(home, lockfile.HomeName)); !errors.Is(err, lockfile.ErrLocked) {
+		t.Errorf("lock not held during reset: %v", err)
+	}
+	releaseHomeLock()
+	again, err := lockfile.TryAcquire(filepath.Join(home, lockfile.HomeName))
+	if err != nil {
+		t.Errorf("lock still held after releaseHomeLock: %v", err)
+	}
+	_ = again.Release()
+}
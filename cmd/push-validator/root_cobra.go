@@ -4,16 +4,26 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
 	"github.com/pushchain/push-validator-cli/internal/config"
 	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
+	"github.com/pushchain/push-validator-cli/internal/logline"
+	"github.com/pushchain/push-validator-cli/internal/node"
+	"github.com/pushchain/push-validator-cli/internal/trace"
 	ui "github.com/pushchain/push-validator-cli/internal/ui"
 	"github.com/pushchain/push-validator-cli/internal/update"
+	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
 // Version information - set via -ldflags during build
@@ -38,7 +48,7 @@ var rootCmd = &cobra.Command{
 	Long:          "Manage a Push Chain validator node: init, start, status, sync, and admin tasks.",
 	SilenceUsage:  true,
 	SilenceErrors: true,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		// Initialize global UI config from flags after parsing but before command execution
 		ui.InitGlobal(ui.Config{
 			NoColor:        flagNoColor,
@@ -55,6 +65,43 @@ var rootCmd = &cobra.Command{
 			os.Setenv("NO_COLOR", "1")
 		}
 
+		// Clients with their own transport are wrapped where they are built;
+		// this covers the remaining http.DefaultClient requests
+		if flagTrace {
+			trace.SetOutput(os.Stderr)
+			http.DefaultClient.Transport = trace.Transport(http.DefaultClient.Transport)
+		}
+
+		// Proxy, CA, timeout and download rate for the updater, chain
+		// installer and snapshots; must be set before the background
+		// update check below
+		cfg := loadCfg()
+		httpclient.SetDefault(httpclient.FromConfig(cfg))
+		if _, err := httpclient.RPCTransport(); err != nil {
+			return exitcodes.InvalidArgsError(err.Error())
+		}
+		if cfg.RPCRetry < 0 {
+			return exitcodes.InvalidArgsError("--rpc-retry must not be negative")
+		}
+		node.SetRetries(cfg.RPCRetry)
+		if cfg.SyncTolerance < 0 {
+			return exitcodes.InvalidArgsError("--sync-tolerance must not be negative")
+		}
+		if cfg.RPCTLSInsecure {
+			ui.Log().Warnf("TLS certificate verification is DISABLED for remote RPCs (--rpc-tls-insecure); responses from %s could be forged. Prefer --rpc-ca-cert.", cfg.GenesisDomain)
+		}
+		ui.Log().Debugf("home=%s rpc=%s genesis=%s", cfg.HomeDir, cfg.RPCLocal, cfg.GenesisDomain)
+
+		if err := requirePchaind(cmd); err != nil {
+			return err
+		}
+		if err := checkHomeAmbiguity(cmd, cfg.HomeDir, os.Stderr); err != nil {
+			return err
+		}
+		if err := acquireHomeLock(cmd, cfg.HomeDir); err != nil {
+			return err
+		}
+
 		// Start background update check (non-blocking)
 		// Skip for installation-related commands where notifications are disruptive
 		if !shouldSkipUpdateCheck(cmd) {
@@ -66,6 +113,7 @@ var rootCmd = &cobra.Command{
 				go checkForUpdateBackground()
 			}
 		}
+		return nil
 	},
 	PersistentPostRun: func(cmd *cobra.Command, args []string) {
 		// Show update notification if available (after command completes)
@@ -88,26 +136,62 @@ var (
 	flagVerbose        bool
 	flagQuiet          bool
 	flagDebug          bool
+	flagTrace          bool
 	flagNoColor        bool
 	flagNoEmoji        bool
 	flagYes            bool
 	flagNonInteractive bool
+	flagAssumeSynced   bool
+	flagJSONErrors     bool
+	flagCACert         string
+	flagRPCCACert      string
+	flagRPCInsecure    bool
+	flagHTTPTimeout    time.Duration
+	flagDownloadRate   rateFlag
+	flagNoUpdateCheck  bool
+	flagUpdateInterval time.Duration
+	flagCacheTTL       time.Duration
+	flagRPCPort        int
+	flagRPCRetry       int
+	flagSyncTolerance  int64
+	flagP2PPort        int
+	flagJSONRPCPort    int
+	flagJSONRPCWSPort  int
 )
 
 func init() {
+	cobra.OnFinalize(releaseHomeLock)
+
 	// Persistent flags to override defaults
 	rootCmd.PersistentFlags().StringVar(&flagHome, "home", "", "Node home directory (overrides env)")
 	rootCmd.PersistentFlags().StringVar(&flagBin, "bin", "", "Path to pchaind binary (overrides env)")
 	rootCmd.PersistentFlags().StringVar(&flagRPC, "rpc", "", "Local RPC base (http[s]://host:port)")
+	rootCmd.PersistentFlags().IntVar(&flagRPCPort, "rpc-port", 0, "Node RPC port (default 26657, env: PUSH_RPC_PORT)")
+	rootCmd.PersistentFlags().IntVar(&flagP2PPort, "p2p-port", 0, "Node P2P port (default 26656, env: PUSH_P2P_PORT)")
+	rootCmd.PersistentFlags().IntVar(&flagJSONRPCPort, "json-rpc-port", 0, "Node EVM JSON-RPC port (default 8545, env: PUSH_JSON_RPC_PORT)")
+	rootCmd.PersistentFlags().IntVar(&flagJSONRPCWSPort, "json-rpc-ws-port", 0, "Node EVM JSON-RPC websocket port (default 8546, env: PUSH_JSON_RPC_WS_PORT)")
 	rootCmd.PersistentFlags().StringVar(&flagGenesis, "genesis-domain", "", "Genesis RPC domain or URL")
-	rootCmd.PersistentFlags().StringVarP(&flagOutput, "output", "o", "text", "Output format: json|yaml|text")
+	rootCmd.PersistentFlags().StringVarP(&flagOutput, "output", "o", "text", "Output format: json|yaml|text|table")
 	rootCmd.PersistentFlags().BoolVar(&flagVerbose, "verbose", false, "Verbose output")
 	rootCmd.PersistentFlags().BoolVarP(&flagQuiet, "quiet", "q", false, "Quiet mode: minimal output (suppresses extras)")
 	rootCmd.PersistentFlags().BoolVarP(&flagDebug, "debug", "d", false, "Debug output: extra diagnostic logs")
+	rootCmd.PersistentFlags().BoolVar(&flagTrace, "trace", false, "Log every pchaind subprocess and HTTP request to stderr (secrets redacted)")
 	rootCmd.PersistentFlags().BoolVar(&flagNoColor, "no-color", false, "Disable ANSI colors")
 	rootCmd.PersistentFlags().BoolVar(&flagNoEmoji, "no-emoji", false, "Disable emoji output")
 	rootCmd.PersistentFlags().BoolVarP(&flagYes, "yes", "y", false, "Assume yes for all prompts")
 	rootCmd.PersistentFlags().BoolVar(&flagNonInteractive, "non-interactive", false, "Fail instead of prompting")
+	rootCmd.PersistentFlags().BoolVar(&flagAssumeSynced, "assume-synced", false, "Skip the node sync check before validator transactions (advanced; see docs)")
+	rootCmd.PersistentFlags().BoolVar(&flagJSONErrors, "json-errors", false, "Print errors to stderr as JSON (implied by --output json)")
+	rootCmd.PersistentFlags().StringVar(&flagCACert, "ca-cert", "", "PEM file of extra CA certificates to trust for downloads (e.g. a corporate proxy)")
+	rootCmd.PersistentFlags().StringVar(&flagRPCCACert, "rpc-ca-cert", "", "PEM file of extra CA certificates to trust for the genesis and other remote RPCs (env: PUSH_RPC_CA_CERT)")
+	rootCmd.PersistentFlags().IntVar(&flagRPCRetry, "rpc-retry", node.DefaultRetries, "Retries for node RPC reads after a network error or HTTP 5xx, and WebSocket redials (0 disables)")
+	rootCmd.PersistentFlags().Int64Var(&flagSyncTolerance, "sync-tolerance", config.DefaultSyncTolerance, "Blocks behind the network the node may be and still count as synced")
+	rootCmd.PersistentFlags().BoolVar(&flagRPCInsecure, "rpc-tls-insecure", false, "Skip TLS certificate verification for remote RPCs (insecure; env: PUSH_RPC_TLS_INSECURE)")
+	rootCmd.PersistentFlags().DurationVar(&flagHTTPTimeout, "http-timeout", httpclient.DefaultTimeout, "Timeout for each GitHub API request")
+	rootCmd.PersistentFlags().Var(&flagDownloadRate, "download-rate", "Cap snapshot and binary downloads, e.g. 10MB or 512KiB per second (default unlimited)")
+	rootCmd.PersistentFlags().BoolVar(&flagNoUpdateCheck, "no-update-check", false, "Skip the background check for a newer CLI release (env: PUSH_NO_UPDATE_CHECK)")
+	rootCmd.PersistentFlags().DurationVar(&flagUpdateInterval, "update-check-interval", update.DefaultCheckInterval, "How long a background update check result is reused")
+	rootCmd.PersistentFlags().DurationVar(&flagCacheTTL, "cache-ttl", validator.DefaultCacheTTL, "How long validator, rewards and proposal query results are reused")
 
 	// Replace root help to present grouped, example-rich output.
 	// Only apply custom help to the root command; subcommands use cobra's default help.
@@ -148,6 +232,7 @@ func init() {
 		fmt.Fprintln(w, c.SubHeader("Operations"))
 		fmt.Fprintln(w, c.FormatCommandAligned("stop", "Stop the node process", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("restart", "Restart the node process", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("ensure-running", "Start and sync the node unless it already is", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("logs", "Tail node logs", cmdWidth))
 		fmt.Fprintln(w)
 
@@ -155,10 +240,16 @@ func init() {
 		fmt.Fprintln(w, c.SubHeader("Validator"))
 		fmt.Fprintln(w, c.FormatCommandAligned("validators", "List validators", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("balance [address]", "Check account balance", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("keys list|show|add", "Manage keyring keys", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("show-address", "Show the account address (offline)", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("address convert <addr>", "Convert between bech32 and 0x addresses", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("register-validator", "Register this node as a validator", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("update-details", "Update validator profile details", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("increase-stake", "Increase validator stake", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("unjail", "Restore jailed validator to active status", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("uptime", "Signing uptime and misses left before jailing", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("watch", "Notify on jailing or missed blocks", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("rewards", "Show claimable rewards (read-only)", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("withdraw-rewards", "Withdraw rewards and commission", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("restake-rewards", "Withdraw and restake all rewards", cmdWidth))
 		fmt.Fprintln(w)
@@ -172,6 +263,7 @@ func init() {
 		// Maintenance
 		fmt.Fprintln(w, c.SubHeader("Maintenance"))
 		fmt.Fprintln(w, c.FormatCommandAligned("backup", "Create config/state backup archive", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("export-key", "Export validator keys (encrypted)", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("reset", "Reset chain data (keeps addr book)", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("full-reset", "Complete reset (deletes ALL data)", cmdWidth))
 		fmt.Fprintln(w)
@@ -180,11 +272,21 @@ func init() {
 		fmt.Fprintln(w, c.SubHeader("Utilities"))
 		fmt.Fprintln(w, c.FormatCommandAligned("doctor", "Run diagnostic checks", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("peers", "Show connected peer information", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("peers add <id@host:port>", "Add persistent peers to config.toml", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("peers set-seeds <list>", "Add seed nodes to config.toml", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("metrics", "Print dashboard metrics as JSON", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("serve-health", "Serve /healthz and /readyz for probes", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("events --query <q>", "Stream CometBFT events from the node", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("rpc <path>", "Query any CometBFT RPC endpoint", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("benchmark-rpc <url>...", "Compare RPC endpoint latency and height", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("node-id", "Show this node's P2P ID (offline)", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("config node-set <key> <val>", "Edit a config.toml/app.toml setting", cmdWidth))
 		fmt.Fprintln(w)
 
 		// Upgrades
 		fmt.Fprintln(w, c.SubHeader("Upgrades"))
 		fmt.Fprintln(w, c.FormatCommandAligned("update", "Update push-validator to latest version", cmdWidth))
+		fmt.Fprintln(w, c.FormatCommandAligned("install-chain [version]", "Install or upgrade the pchaind binary", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("cosmovisor status", "Show Cosmovisor status", cmdWidth))
 		fmt.Fprintln(w, c.FormatCommandAligned("cosmovisor upgrade-info", "Generate upgrade JSON", cmdWidth))
 		fmt.Fprintln(w)
@@ -192,82 +294,183 @@ func init() {
 
 	// status command (uses root --output)
 	var statusStrict bool
+	var statusComponentList string
 	statusCmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show node status",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			comps, err := parseStatusComponents(statusComponentList)
+			if err != nil {
+				return err
+			}
 			d := newDeps()
-			res := computeStatus(d)
+			if usesCosmovisor(d.Sup) {
+				migrateLegacyLayout(d.Cfg.HomeDir, d.Prompter)
+			}
+			var res statusResult
+			if comps == nil {
+				res = computeStatus(d)
+			} else {
+				res = computeSelectedStatus(d, comps)
+			}
+			var out any = res
+			if comps != nil {
+				out = selectedStatusFields(res, comps)
+			}
 
-			// Strict mode: exit non-zero if issues detected
-			if statusStrict && (res.Error != "" || !res.Running || res.CatchingUp || res.Peers == 0) {
+			// Strict mode: exit with the most specific health code
+			if strictErr := strictStatusError(res, comps); statusStrict && strictErr != nil {
 				// Still output the status before exiting
 				switch flagOutput {
 				case "json":
 					enc := json.NewEncoder(os.Stdout)
 					enc.SetIndent("", "  ")
-					_ = enc.Encode(res)
+					_ = enc.Encode(out)
 				case "yaml":
-					data, _ := yaml.Marshal(res)
+					data, _ := yaml.Marshal(out)
 					fmt.Println(string(data))
+				case "table":
+					writeStatusTable(os.Stdout, out)
 				case "text", "":
-					if !flagQuiet {
+					if comps != nil {
+						printSelectedStatusText(res, comps)
+					} else if !flagQuiet {
 						printStatusText(res)
 					}
 				}
-				return exitcodes.ValidationErr("node has issues")
+				return strictErr
 			}
 
 			switch flagOutput {
 			case "json":
 				enc := json.NewEncoder(os.Stdout)
 				enc.SetIndent("", "  ")
-				return enc.Encode(res)
+				return enc.Encode(out)
 			case "yaml":
-				data, err := yaml.Marshal(res)
+				data, err := yaml.Marshal(out)
 				if err != nil {
 					return err
 				}
 				fmt.Println(string(data))
 				return nil
+			case "table":
+				writeStatusTable(os.Stdout, out)
+				return nil
 			case "text", "":
-				if flagQuiet {
+				switch {
+				case comps != nil:
+					printSelectedStatusText(res, comps)
+				case flagQuiet:
 					fmt.Printf("running=%v rpc=%v catching_up=%v height=%d\n", res.Running, res.RPCListening, res.CatchingUp, res.Height)
-				} else {
+				default:
 					printStatusText(res)
 				}
 				return nil
 			default:
-				return fmt.Errorf("invalid --output: %s (use json|yaml|text)", flagOutput)
+				return fmt.Errorf("invalid --output: %s (use json|yaml|text|table)", flagOutput)
 			}
 		},
 	}
 	statusCmd.Flags().BoolVar(&statusStrict, "strict", false, "Exit non-zero if node has issues (not running, catching up, no peers, or errors)")
+	statusCmd.Flags().StringVar(&statusComponentList, "components", "", "Only collect these parts: process,rpc,sync,peers (default: everything)")
 	rootCmd.AddCommand(statusCmd)
 
 	// dashboard - interactive TUI for monitoring
 	rootCmd.AddCommand(createDashboardCmd())
 
-	rootCmd.AddCommand(&cobra.Command{Use: "logs", Short: "Tail node logs", RunE: func(cmd *cobra.Command, args []string) error {
+	var (
+		logsGrep   logGrepOptions
+		logsExport logExportOptions
+		logsSince  string
+	)
+	logsCmd := &cobra.Command{Use: "logs", Short: "Tail node logs", RunE: func(cmd *cobra.Command, args []string) error {
 		cfg := loadCfg()
-		sup := newSupervisor(cfg.HomeDir)
+		sup, _, err := selectSupervisor(cfg)
+		if err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("export") {
+			if cmd.Flags().Changed("grep") {
+				return exitcodes.InvalidArgsError("--export and --grep cannot be combined")
+			}
+			if logsSince != "" {
+				if logsExport.Since, err = logline.ParseTime(logsSince, time.Now()); err != nil {
+					return exitcodes.InvalidArgsErrorf("--since: %v", err)
+				}
+			}
+			return handleLogsExport(sup.LogPath(), logsExport)
+		}
+		if logsSince != "" || cmd.Flags().Changed("lines") {
+			return exitcodes.InvalidArgsError("--since and --lines only apply to --export")
+		}
+		if cmd.Flags().Changed("grep") {
+			return handleLogsGrep(os.Stdout, sup.LogPath(), logsGrep)
+		}
 		return handleLogs(sup)
-	}})
-
-	rootCmd.AddCommand(&cobra.Command{Use: "reset", Short: "Reset chain data", RunE: func(cmd *cobra.Command, args []string) error {
+	}}
+	logsCmd.Flags().StringVar(&logsGrep.Pattern, "grep", "", "Search the whole log (and rotated logs) for a pattern, print matches and exit")
+	logsCmd.Flags().BoolVar(&logsGrep.IgnoreCase, "ignore-case", false, "Case-insensitive --grep")
+	logsCmd.Flags().BoolVar(&logsGrep.Regex, "regex", false, "Treat the --grep pattern as a regular expression")
+	logsCmd.Flags().IntVarP(&logsGrep.After, "after-context", "A", 0, "Lines of context after each --grep match")
+	logsCmd.Flags().IntVarP(&logsGrep.Before, "before-context", "B", 0, "Lines of context before each --grep match")
+	logsCmd.Flags().BoolVar(&logsRaw, "raw", false, "Show JSON log lines as logged instead of compact (toggle with j)")
+	logsCmd.Flags().StringVar(&logsExport.Path, "export", "", "Write the log and its rotations, oldest first and without colors, to this file and exit")
+	logsCmd.Flags().StringVar(&logsSince, "since", "", "With --export, start at the first line logged at or after this time: a duration ago (30m), 15:04, 2006-01-02 15:04:05 or RFC 3339")
+	logsCmd.Flags().IntVar(&logsExport.Lines, "lines", 0, "With --export, keep only the last N lines")
+	addCosmovisorFlags(logsCmd)
+	rootCmd.AddCommand(logsCmd)
+
+	var resetDryRun, fullResetDryRun bool
+	resetCmd := &cobra.Command{Use: "reset", Short: "Reset chain data", RunE: func(cmd *cobra.Command, args []string) error {
 		cfg := loadCfg()
-		sup := newSupervisor(cfg.HomeDir)
+		sup, _, err := selectSupervisor(cfg)
+		if err != nil {
+			return err
+		}
+		if resetDryRun {
+			return handleResetPlan(cfg, sup, false)
+		}
 		return handleReset(cfg, sup)
-	}})
-	rootCmd.AddCommand(&cobra.Command{Use: "full-reset", Short: "Complete reset (deletes all keys and data)", RunE: func(cmd *cobra.Command, args []string) error {
+	}}
+	resetCmd.Flags().BoolVar(&resetDryRun, "dry-run", false, "List what would be removed and kept without deleting anything")
+	addCosmovisorFlags(resetCmd)
+	addRunGuards(resetCmd, guardIfStopped)
+	rootCmd.AddCommand(resetCmd)
+	fullResetCmd := &cobra.Command{Use: "full-reset", Short: "Complete reset (deletes all keys and data)", RunE: func(cmd *cobra.Command, args []string) error {
 		cfg := loadCfg()
-		sup := newSupervisor(cfg.HomeDir)
+		sup, _, err := selectSupervisor(cfg)
+		if err != nil {
+			return err
+		}
+		if fullResetDryRun {
+			return handleResetPlan(cfg, sup, true)
+		}
 		return handleFullReset(cfg, sup)
-	}})
-	rootCmd.AddCommand(&cobra.Command{Use: "backup", Short: "Backup config and validator state", RunE: func(cmd *cobra.Command, args []string) error { return handleBackup(newDeps()) }})
+	}}
+	fullResetCmd.Flags().BoolVar(&fullResetDryRun, "dry-run", false, "List what would be removed and kept without deleting anything")
+	addCosmovisorFlags(fullResetCmd)
+	addRunGuards(fullResetCmd, guardIfStopped)
+	fullResetCmd.Flags().BoolVar(&flagKeyLossAck, "i-understand-key-loss", false, "Allow deleting a registered validator's consensus key without typing its moniker")
+	rootCmd.AddCommand(fullResetCmd)
+	backupCmd := &cobra.Command{Use: "backup", Short: "Backup config and validator state", RunE: func(cmd *cobra.Command, args []string) error { return handleBackup(newDeps()) }}
+	backupCmd.Flags().BoolVar(&flagBackupForce, "force", false, "Skip the free disk space check")
+	addRunGuards(backupCmd, guardIfStopped|guardIfRunning)
+	rootCmd.AddCommand(backupCmd)
 	validatorsCmd := &cobra.Command{Use: "validators", Short: "List validators", RunE: func(cmd *cobra.Command, args []string) error {
+		validatorsPaged = cmd.Flags().Changed("page")
+		if validatorsWatch {
+			return handleValidatorsWatch(newDeps(), validatorsInterval)
+		}
 		return handleValidatorsWithFormat(newDeps(), flagOutput == "json")
 	}}
+	validatorsCmd.Flags().BoolVar(&validatorsJailed, "jailed", false, "Only show jailed validators")
+	validatorsCmd.Flags().StringVar(&validatorsStatus, "status", "", "Only show validators with this status: bonded|unbonding|unbonded")
+	validatorsCmd.Flags().StringVar(&validatorsSort, "sort", "", "Sort by: power|missed|commission|moniker")
+	validatorsCmd.Flags().BoolVar(&validatorsEVM, "evm", false, "Include EVM addresses in JSON output and full operator addresses in the table")
+	validatorsCmd.Flags().IntVar(&validatorsPage, "page", 1, "Page of the list to show (JSON output is paged only when set)")
+	validatorsCmd.Flags().IntVar(&validatorsPageSize, "page-size", 20, "Validators per page")
+	validatorsCmd.Flags().BoolVar(&validatorsWatch, "watch", false, "Refresh continuously, marking added, removed and newly jailed validators")
+	validatorsCmd.Flags().DurationVar(&validatorsInterval, "interval", 10*time.Second, "Refresh interval for --watch")
 	rootCmd.AddCommand(validatorsCmd)
 	var balAddr string
 	balanceCmd := &cobra.Command{Use: "balance [address]", Short: "Show balance", Args: cobra.RangeArgs(0, 1), RunE: func(cmd *cobra.Command, args []string) error {
@@ -277,12 +480,15 @@ func init() {
 		return handleBalance(newDeps(), args)
 	}}
 	balanceCmd.Flags().StringVar(&balAddr, "address", "", "Account address")
+	balanceCmd.Flags().StringVar(&balanceDenom, "denom", "", "Show balance in a specific unit (e.g., upc or PC)")
 	rootCmd.AddCommand(balanceCmd)
 	// register-validator: interactive flow with optional flag overrides
 	regCmd := &cobra.Command{Use: "register-validator", Aliases: []string{"register"}, Short: "Register this node as validator", RunE: func(cmd *cobra.Command, args []string) error {
 		return handleRegisterValidator(newDeps())
 	}}
 	regCmd.Flags().BoolVar(&flagRegisterCheckOnly, "check-only", false, "Exit after reporting validator registration status")
+	addTxFlags(regCmd)
+	addTxWaitFlags(regCmd)
 	rootCmd.AddCommand(regCmd)
 
 	// update-details command
@@ -294,6 +500,8 @@ func init() {
 			return handleEditValidator(newDeps())
 		},
 	}
+	addTxFlags(updateDetailsCmd)
+	addTxWaitFlags(updateDetailsCmd)
 	rootCmd.AddCommand(updateDetailsCmd)
 
 	// unjail command
@@ -305,6 +513,9 @@ func init() {
 			return handleUnjail(newDeps())
 		},
 	}
+	unjailCmd.Flags().BoolVar(&flagUnjailWait, "wait", false, "Wait for the unjail tx to be included and the validator to be bonded again")
+	unjailCmd.Flags().DurationVar(&flagUnjailWaitTimeout, "wait-timeout", flagUnjailWaitTimeout, "How long --wait polls before giving up")
+	addTxFlags(unjailCmd)
 	rootCmd.AddCommand(unjailCmd)
 
 	// withdraw-rewards command
@@ -317,6 +528,8 @@ func init() {
 			return handleWithdrawRewards(newDeps())
 		},
 	}
+	addTxFlags(withdrawRewardsCmd)
+	addTxWaitFlags(withdrawRewardsCmd)
 	rootCmd.AddCommand(withdrawRewardsCmd)
 
 	// increase-stake command
@@ -328,6 +541,8 @@ func init() {
 			return handleIncreaseStake(newDeps())
 		},
 	}
+	addTxFlags(increaseStakeCmd)
+	addTxWaitFlags(increaseStakeCmd)
 	rootCmd.AddCommand(increaseStakeCmd)
 
 	// restake-rewards command
@@ -340,33 +555,129 @@ func init() {
 			return handleRestakeRewardsAll(newDeps())
 		},
 	}
+	restakeRewardsCmd.Flags().Float64Var(&flagRestakeMinAmount, "min-amount", 0, "Skip (exit 0) when commission plus outstanding rewards are below this many PC")
+	addTxFlags(restakeRewardsCmd)
+	addTxWaitFlags(restakeRewardsCmd)
 	rootCmd.AddCommand(restakeRewardsCmd)
 
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		var se silentErr
-		if !errors.As(err, &se) {
-			fmt.Fprintln(os.Stderr, err)
+	cmd, err := rootCmd.ExecuteC()
+	if err != nil {
+		code := exitcodes.CodeForError(err)
+		reportError(os.Stderr, cmd, err, code)
+		os.Exit(code)
+	}
+}
+
+// reportError writes a failed command's error to w. With --json-errors or
+// --output json it is always a single JSON object, even for errors the
+// command already displayed; otherwise plain text unless already shown.
+func reportError(w io.Writer, cmd *cobra.Command, err error, code int) {
+	if flagJSONErrors || flagOutput == "json" {
+		command := ""
+		if cmd != nil {
+			command = cmd.CommandPath()
 		}
-		os.Exit(exitcodes.CodeForError(err))
+		b, _ := json.Marshal(map[string]any{
+			"error":    err.Error(),
+			"code":     code,
+			"category": exitcodes.CategoryForCode(code),
+			"command":  command,
+		})
+		fmt.Fprintln(w, string(b))
+		return
+	}
+	var se silentErr
+	if !errors.As(err, &se) {
+		fmt.Fprintln(w, err)
 	}
 }
 
 // loadCfg reads defaults + env via internal/config.Load() and then
-// applies overrides from persistent flags (home, bin, rpc, domain).
+// applies overrides from persistent flags (home, bin, rpc, ports, domain).
 func loadCfg() config.Config {
 	cfg := config.Load()
 	if flagHome != "" {
 		cfg.HomeDir = flagHome
 	}
+	// Ports: --rpc-port/--p2p-port, then PUSH_RPC_PORT/PUSH_P2P_PORT (applied
+	// by config.Load), then the ports the node's config.toml was started with
+	tomlRPC, tomlP2P := files.ListenPorts(cfg.HomeDir)
+	switch {
+	case flagRPCPort != 0:
+		cfg.RPCPort = flagRPCPort
+	case os.Getenv("PUSH_RPC_PORT") == "" && tomlRPC != 0:
+		cfg.RPCPort = tomlRPC
+	}
+	cfg.RPCLocal = config.LocalRPCURL(cfg.RPCPort)
+	switch {
+	case flagP2PPort != 0:
+		cfg.P2PPort = flagP2PPort
+	case os.Getenv("PUSH_P2P_PORT") == "" && tomlP2P != 0:
+		cfg.P2PPort = tomlP2P
+	}
+	// JSON-RPC ports likewise fall back to app.toml
+	tomlJSON, tomlWS := files.JSONRPCPorts(cfg.HomeDir)
+	switch {
+	case flagJSONRPCPort != 0:
+		cfg.JSONRPCPort = flagJSONRPCPort
+	case os.Getenv("PUSH_JSON_RPC_PORT") == "" && tomlJSON != 0:
+		cfg.JSONRPCPort = tomlJSON
+	}
+	switch {
+	case flagJSONRPCWSPort != 0:
+		cfg.JSONRPCWSPort = flagJSONRPCWSPort
+	case os.Getenv("PUSH_JSON_RPC_WS_PORT") == "" && tomlWS != 0:
+		cfg.JSONRPCWSPort = tomlWS
+	}
 	if flagRPC != "" {
 		cfg.RPCLocal = flagRPC
 	}
 	if flagGenesis != "" {
 		cfg.GenesisDomain = flagGenesis
 	}
+	cfg.CACertFile = flagCACert
+	if flagRPCCACert != "" {
+		cfg.RPCCACertFile = flagRPCCACert
+	}
+	if flagRPCInsecure {
+		cfg.RPCTLSInsecure = true
+	}
+	cfg.HTTPTimeout = flagHTTPTimeout
+	cfg.RPCRetry = flagRPCRetry
+	cfg.SyncTolerance = flagSyncTolerance
+	cfg.DownloadRate = int64(flagDownloadRate)
+	cfg.UpdateCheckInterval = flagUpdateInterval
+	cfg.CacheTTL = flagCacheTTL
+	switch {
+	case flagCosmovisor:
+		cfg.UseCosmovisor = config.UseCosmovisorAlways
+	case flagNoCosmovisor:
+		cfg.UseCosmovisor = config.UseCosmovisorNever
+	}
 
 	return cfg
 }
+
+// rateFlag is a --download-rate value such as "10MB", held in bytes/sec.
+type rateFlag int64
+
+func (r *rateFlag) String() string {
+	if *r == 0 {
+		return ""
+	}
+	return strconv.FormatInt(int64(*r), 10)
+}
+
+func (r *rateFlag) Set(s string) error {
+	rate, err := httpclient.ParseRate(s)
+	if err != nil {
+		return err
+	}
+	*r = rateFlag(rate)
+	return nil
+}
+
+func (r *rateFlag) Type() string { return "rate" }
@@ -1,8 +1,17 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/exitcodes"
+	ui "github.com/pushchain/push-validator-cli/internal/ui"
 )
 
 func TestAllSubcommandsRegistered(t *testing.T) {
@@ -157,7 +166,7 @@ func TestFindPchaind_Fallback(t *testing.T) {
 }
 
 func TestPersistentFlags(t *testing.T) {
-	flags := []string{"home", "bin", "rpc", "genesis-domain", "output", "verbose", "quiet", "debug", "no-color", "no-emoji", "yes", "non-interactive"}
+	flags := []string{"home", "bin", "rpc", "genesis-domain", "output", "verbose", "quiet", "debug", "no-color", "no-emoji", "yes", "non-interactive", "json-errors", "ca-cert", "http-timeout", "no-update-check", "update-check-interval"}
 
 	for _, flag := range flags {
 		if rootCmd.PersistentFlags().Lookup(flag) == nil {
@@ -166,6 +175,65 @@ func TestPersistentFlags(t *testing.T) {
 	}
 }
 
+func TestReportError(t *testing.T) {
+	origJSON, origOutput := flagJSONErrors, flagOutput
+	t.Cleanup(func() { flagJSONErrors, flagOutput = origJSON, origOutput })
+
+	cmd, _, err := rootCmd.Find([]string{"unjail"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	failure := exitcodes.PreconditionError("validator is not jailed")
+
+	t.Run("text", func(t *testing.T) {
+		flagJSONErrors, flagOutput = false, "text"
+		var buf bytes.Buffer
+		reportError(&buf, cmd, failure, exitcodes.CodeForError(failure))
+		if buf.String() != "validator is not jailed\n" {
+			t.Errorf("unexpected output %q", buf.String())
+		}
+
+		buf.Reset()
+		reportError(&buf, cmd, silentErr{errors.New("shown")}, exitcodes.GeneralError)
+		if buf.Len() != 0 {
+			t.Errorf("silent error should print nothing, got %q", buf.String())
+		}
+	})
+
+	for _, tt := range []struct {
+		name      string
+		jsonFlag  bool
+		output    string
+		err       error
+		wantError string
+		wantCode  int
+		wantCat   string
+	}{
+		{"json-errors flag", true, "text", failure, "validator is not jailed", exitcodes.PreconditionFailed, exitcodes.CategoryPrecondition},
+		{"implied by output json", false, "json", failure, "validator is not jailed", exitcodes.PreconditionFailed, exitcodes.CategoryPrecondition},
+		{"silent error still reported", true, "text", silentErr{errors.New("shown")}, "shown", exitcodes.GeneralError, exitcodes.CategoryGeneral},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			flagJSONErrors, flagOutput = tt.jsonFlag, tt.output
+			var buf bytes.Buffer
+			reportError(&buf, cmd, tt.err, exitcodes.CodeForError(tt.err))
+
+			var got struct {
+				Error    string `json:"error"`
+				Code     int    `json:"code"`
+				Category string `json:"category"`
+				Command  string `json:"command"`
+			}
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("output is not JSON: %q (%v)", buf.String(), err)
+			}
+			if got.Error != tt.wantError || got.Code != tt.wantCode || got.Category != tt.wantCat || got.Command != "push-validator unjail" {
+				t.Errorf("unexpected error object: %+v", got)
+			}
+		})
+	}
+}
+
 func TestRootCmdProperties(t *testing.T) {
 	if rootCmd.Use != "push-validator" {
 		t.Errorf("rootCmd.Use = %q, want %q", rootCmd.Use, "push-validator")
@@ -228,8 +296,10 @@ func TestRootCmd_PersistentPreRun(t *testing.T) {
 	flagQuiet = true
 	flagDebug = false
 
-	// Call PersistentPreRun directly
-	rootCmd.PersistentPreRun(rootCmd, nil)
+	// Call PersistentPreRunE directly
+	if err := rootCmd.PersistentPreRunE(rootCmd, nil); err != nil {
+		t.Fatalf("PersistentPreRunE() error = %v", err)
+	}
 
 	// Verify NO_COLOR was set
 	if os.Getenv("NO_COLOR") != "1" {
@@ -238,6 +308,139 @@ func TestRootCmd_PersistentPreRun(t *testing.T) {
 	os.Unsetenv("NO_COLOR") // cleanup
 }
 
+func TestRequirePchaind(t *testing.T) {
+	origHome, origBin := flagHome, flagBin
+	defer func() { flagHome, flagBin = origHome, origBin }()
+	flagHome, flagBin = "", ""
+
+	// No pchaind on PATH or in the cosmovisor genesis dir
+	pathDir := t.TempDir()
+	t.Setenv("PATH", pathDir)
+	t.Setenv("HOME_DIR", t.TempDir())
+	t.Setenv("PCHAIND", "")
+	t.Setenv("PCHAIN_BIN", "")
+
+	for _, args := range [][]string{{"keys", "list"}, {"balance"}, {"validators"}, {"init"}} {
+		cmd, _, err := rootCmd.Find(args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = requirePchaind(cmd)
+		if exitcodes.CodeForError(err) != exitcodes.PreconditionFailed ||
+			!strings.Contains(err.Error(), "pchaind not found") || !strings.Contains(err.Error(), "push-validator install-chain") {
+			t.Errorf("%v: err = %v, want friendly precondition error", args, err)
+		}
+	}
+
+	// Commands that don't use pchaind still work without it
+	for _, args := range [][]string{{"version"}, {"completion"}, {"address", "convert"}, {"chain", "install"}, {"install-chain"}, {"status"}, {"node-id"}} {
+		cmd, _, err := rootCmd.Find(args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := requirePchaind(cmd); err != nil {
+			t.Errorf("%v: err = %v, want nil", args, err)
+		}
+	}
+
+	// Found once it is on PATH
+	if err := os.WriteFile(filepath.Join(pathDir, "pchaind"), []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	cmd, _, _ := rootCmd.Find([]string{"keys", "list"})
+	if err := requirePchaind(cmd); err != nil {
+		t.Errorf("with pchaind on PATH: err = %v", err)
+	}
+}
+
+func TestCheckHomeAmbiguity(t *testing.T) {
+	origHome, origNI := flagHome, flagNonInteractive
+	defer func() { flagHome, flagNonInteractive = origHome, origNI }()
+	flagHome, flagNonInteractive = "", false
+
+	user := t.TempDir()
+	t.Setenv("HOME", user)
+	t.Setenv("DAEMON_HOME", "")
+	def := filepath.Join(user, ".pchain")
+	custom := filepath.Join(user, "custom")
+	t.Setenv("HOME_DIR", custom)
+	reset, _, _ := rootCmd.Find([]string{"reset"})
+
+	initHome := func(dir string) {
+		if err := os.MkdirAll(filepath.Join(dir, "config"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "config", "genesis.json"), []byte("{}"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// One initialized home is unambiguous
+	initHome(custom)
+	var buf bytes.Buffer
+	if err := checkHomeAmbiguity(reset, custom, &buf); err != nil || buf.Len() != 0 {
+		t.Errorf("single home: err = %v, output %q", err, buf.String())
+	}
+
+	// Two homes warn, naming the one used and the alternative
+	initHome(def)
+	if err := checkHomeAmbiguity(reset, custom, &buf); err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "using "+custom) || !strings.Contains(out, "also found: "+def) {
+		t.Errorf("warning = %q", out)
+	}
+
+	// Commands that don't touch the node home are not affected
+	buf.Reset()
+	version, _, _ := rootCmd.Find([]string{"version"})
+	if err := checkHomeAmbiguity(version, custom, &buf); err != nil || buf.Len() != 0 {
+		t.Errorf("version: err = %v, output %q", err, buf.String())
+	}
+
+	// --non-interactive refuses to guess
+	flagNonInteractive = true
+	err := checkHomeAmbiguity(reset, custom, &buf)
+	if exitcodes.CodeForError(err) != exitcodes.PreconditionFailed || !strings.Contains(err.Error(), "--home") {
+		t.Errorf("non-interactive: err = %v, want precondition error", err)
+	}
+
+	// An explicit --home settles it
+	flagHome = custom
+	if err := checkHomeAmbiguity(reset, custom, &buf); err != nil {
+		t.Errorf("with --home: err = %v", err)
+	}
+}
+
+func TestOverrideSyncCheck(t *testing.T) {
+	origAssume, origNI, origYes := flagAssumeSynced, flagNonInteractive, flagYes
+	defer func() { flagAssumeSynced, flagNonInteractive, flagYes = origAssume, origNI, origYes }()
+
+	tests := []struct {
+		name                        string
+		assume, nonInteractive, yes bool
+		want                        bool
+		wantOut                     string
+	}{
+		{"flag not set", false, false, false, false, ""},
+		{"interactive", true, false, false, true, "WARNING: --assume-synced"},
+		{"non-interactive without --yes", true, true, false, false, "ignored in --non-interactive"},
+		{"non-interactive with --yes", true, true, true, true, "double-sign"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagAssumeSynced, flagNonInteractive, flagYes = tt.assume, tt.nonInteractive, tt.yes
+			var buf bytes.Buffer
+			if got := overrideSyncCheck(&buf); got != tt.want {
+				t.Errorf("overrideSyncCheck() = %v, want %v", got, tt.want)
+			}
+			if tt.wantOut == "" && buf.Len() != 0 || !strings.Contains(buf.String(), tt.wantOut) {
+				t.Errorf("output = %q, want %q", buf.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
 func TestRootCmd_StatusCommand_JSON(t *testing.T) {
 	origOutput := flagOutput
 	defer func() { flagOutput = origOutput }()
@@ -267,3 +470,35 @@ func TestRootCmd_StatusCommand_Quiet(t *testing.T) {
 	rootCmd.SetArgs([]string{"status", "--quiet"})
 	_ = rootCmd.Execute()
 }
+
+func TestRootCmd_DebugLogKeepsJSONStdoutClean(t *testing.T) {
+	origOutput, origDebug, origHome := flagOutput, flagDebug, flagHome
+	origStdout, origStderr := os.Stdout, os.Stderr
+	defer func() {
+		flagOutput, flagDebug, flagHome = origOutput, origDebug, origHome
+		os.Stdout, os.Stderr = origStdout, origStderr
+		ui.InitGlobal(ui.Config{})
+	}()
+
+	outR, outW, _ := os.Pipe()
+	errR, errW, _ := os.Pipe()
+	os.Stdout, os.Stderr = outW, errW
+	rootCmd.SetArgs([]string{"version", "--output", "json", "--debug", "--home", t.TempDir()})
+	err := rootCmd.Execute()
+	outW.Close()
+	errW.Close()
+	os.Stdout, os.Stderr = origStdout, origStderr
+	if err != nil {
+		t.Fatalf("version: %v", err)
+	}
+	stdout, _ := io.ReadAll(outR)
+	stderr, _ := io.ReadAll(errR)
+
+	var got map[string]any
+	if err := json.Unmarshal(stdout, &got); err != nil {
+		t.Fatalf("stdout is not JSON: %q (%v)", stdout, err)
+	}
+	if !strings.Contains(string(stderr), "[DEBUG] home=") {
+		t.Errorf("debug line missing from stderr: %q", stderr)
+	}
+}
@@ -69,13 +69,32 @@ func (m *mockSupervisor) LogPath() string { return m.logPath }
 
 // mockNodeClient implements node.Client for testing.
 type mockNodeClient struct {
-	status    node.Status
-	statusErr error
-	peers     []node.Peer
-	peersErr  error
+	status      node.Status
+	statusErr   error
+	statusDelay time.Duration
+	peers       []node.Peer
+	peersErr    error
+	peersDelay  time.Duration
+
+	dialed   []string // addresses passed to DialPeers or DialSeeds
+	dialOpts node.DialOptions
+	dialErr  error
+}
+
+// sleepCtx waits for d or until ctx is done.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (m *mockNodeClient) Status(ctx context.Context) (node.Status, error) {
+	if err := sleepCtx(ctx, m.statusDelay); err != nil {
+		return node.Status{}, err
+	}
 	return m.status, m.statusErr
 }
 
@@ -84,6 +103,9 @@ func (m *mockNodeClient) RemoteStatus(ctx context.Context, baseURL string) (node
 }
 
 func (m *mockNodeClient) Peers(ctx context.Context) ([]node.Peer, error) {
+	if err := sleepCtx(ctx, m.peersDelay); err != nil {
+		return nil, err
+	}
 	return m.peers, m.peersErr
 }
 
@@ -93,10 +115,21 @@ func (m *mockNodeClient) SubscribeHeaders(ctx context.Context) (<-chan node.Head
 	return ch, nil
 }
 
+func (m *mockNodeClient) DialPeers(ctx context.Context, peers []string, opts node.DialOptions) error {
+	m.dialed, m.dialOpts = peers, opts
+	return m.dialErr
+}
+
+func (m *mockNodeClient) DialSeeds(ctx context.Context, seeds []string) error {
+	m.dialed = seeds
+	return m.dialErr
+}
+
 // mockValidator implements validator.Service for testing.
 type mockValidator struct {
 	balanceResult   string
 	balanceErr      error
+	balancesResult  []validator.Coin
 	isValidatorRes  bool
 	isValidatorErr  error
 	registerResult  string
@@ -115,16 +148,30 @@ type mockValidator struct {
 	ensureKeyErr    error
 	importKeyResult validator.KeyInfo
 	importKeyErr    error
+	listKeysResult  []validator.KeyInfo
+	listKeysErr     error
+	showKeyResult   validator.KeyInfo
+	showKeyErr      error
 	evmAddrResult          string
 	evmAddrErr             error
 	isAddressValidatorRes  bool
 	isAddressValidatorErr  error
+	queryTxPending         int // QueryTx calls answering ErrTxNotFound first
+	queryTxResult          validator.TxResult
+	queryTxErr             error
 }
 
 func (m *mockValidator) Balance(ctx context.Context, addr string) (string, error) {
 	return m.balanceResult, m.balanceErr
 }
 
+func (m *mockValidator) Balances(ctx context.Context, addr string) ([]validator.Coin, error) {
+	if m.balancesResult != nil || m.balanceErr != nil {
+		return m.balancesResult, m.balanceErr
+	}
+	return []validator.Coin{{Denom: "upc", Amount: m.balanceResult}}, nil
+}
+
 func (m *mockValidator) IsValidator(ctx context.Context, addr string) (bool, error) {
 	return m.isValidatorRes, m.isValidatorErr
 }
@@ -153,6 +200,14 @@ func (m *mockValidator) Vote(ctx context.Context, args validator.VoteArgs) (stri
 	return m.voteResult, m.voteErr
 }
 
+func (m *mockValidator) QueryTx(ctx context.Context, hash string) (validator.TxResult, error) {
+	if m.queryTxPending > 0 {
+		m.queryTxPending--
+		return validator.TxResult{}, validator.ErrTxNotFound
+	}
+	return m.queryTxResult, m.queryTxErr
+}
+
 func (m *mockValidator) EnsureKey(ctx context.Context, name string) (validator.KeyInfo, error) {
 	return m.ensureKeyResult, m.ensureKeyErr
 }
@@ -161,6 +216,14 @@ func (m *mockValidator) ImportKey(ctx context.Context, name string, mnemonic str
 	return m.importKeyResult, m.importKeyErr
 }
 
+func (m *mockValidator) ListKeys(ctx context.Context) ([]validator.KeyInfo, error) {
+	return m.listKeysResult, m.listKeysErr
+}
+
+func (m *mockValidator) ShowKey(ctx context.Context, name string) (validator.KeyInfo, error) {
+	return m.showKeyResult, m.showKeyErr
+}
+
 func (m *mockValidator) GetEVMAddress(ctx context.Context, addr string) (string, error) {
 	return m.evmAddrResult, m.evmAddrErr
 }
@@ -200,6 +263,7 @@ func (m *mockRunner) Run(ctx context.Context, name string, args ...string) ([]by
 type mockFetcher struct {
 	myValidator     validator.MyValidatorInfo
 	myValidatorErr  error
+	myValidatorDelay time.Duration
 	allValidators   validator.ValidatorList
 	allValidatorsErr error
 	commission      string
@@ -210,6 +274,9 @@ type mockFetcher struct {
 }
 
 func (m *mockFetcher) GetMyValidator(ctx context.Context, cfg config.Config) (validator.MyValidatorInfo, error) {
+	if err := sleepCtx(ctx, m.myValidatorDelay); err != nil {
+		return validator.MyValidatorInfo{}, err
+	}
 	return m.myValidator, m.myValidatorErr
 }
 
@@ -244,6 +311,7 @@ func testCfg() config.Config {
 		KeyringBackend: "test",
 		RPCLocal:       "http://127.0.0.1:26657",
 		Denom:          "upc",
+		SyncTolerance:  config.DefaultSyncTolerance,
 	}
 }
 
@@ -3,12 +3,16 @@ package admin
 import (
     "archive/tar"
     "compress/gzip"
+    "encoding/json"
     "fmt"
     "io"
     "os"
     "path/filepath"
     "strings"
     "time"
+
+    "github.com/pushchain/push-validator-cli/internal/diskspace"
+    "github.com/pushchain/push-validator-cli/internal/files"
 )
 
 type ResetOptions struct {
@@ -25,6 +29,21 @@ type FullResetOptions struct {
 type BackupOptions struct {
     HomeDir string
     OutDir  string // if empty, defaults to <HomeDir>/backups
+    ChainID string // recorded in the archive manifest
+    SkipDiskCheck bool // skip the free-space preflight (--force)
+}
+
+// ManifestName is the file written at the root of every backup and key
+// export archive describing what it contains.
+const ManifestName = "manifest.json"
+
+// Manifest records where an archive came from so a restore can check it
+// against the target node before putting anything in place.
+type Manifest struct {
+    Kind      string   `json:"kind"` // "backup" or "keys"
+    ChainID   string   `json:"chain_id"`
+    CreatedAt string   `json:"created_at"`
+    Files     []string `json:"files"`
 }
 
 // Reset clears ALL blockchain data while preserving validator keys and keyring.
@@ -39,8 +58,12 @@ func Reset(opts ResetOptions) error {
         addrBookData, _ = os.ReadFile(addrBookPath)
     }
 
+    // Remember the signed height so start can refuse to run on the zeroed
+    // state this leaves (see files.CheckSignedHeight)
+    _, _ = files.RecordSignedHeight(opts.HomeDir)
+
     // Remove entire data directory (ALL blockchain data including all databases)
-    _ = os.RemoveAll(filepath.Join(opts.HomeDir, "data"))
+    for _, p := range resetTargets(opts.HomeDir) { _ = os.RemoveAll(p) }
 
     // Recreate essential directories (keep logs - useful for debugging)
     _ = os.MkdirAll(filepath.Join(opts.HomeDir, "data"), 0o755)
@@ -60,19 +83,8 @@ func FullReset(opts FullResetOptions) error {
     if opts.HomeDir == "" { return fmt.Errorf("HomeDir required") }
     if opts.BinPath == "" { opts.BinPath = "pchaind" }
 
-    // Remove entire data directory (includes all blockchain data)
-    _ = os.RemoveAll(filepath.Join(opts.HomeDir, "data"))
-
-    // Remove keyring (all keys)
-    _ = os.RemoveAll(filepath.Join(opts.HomeDir, "keyring-file"))
-    _ = os.RemoveAll(filepath.Join(opts.HomeDir, "keyring-test"))
-
-    // Remove validator keys
-    _ = os.Remove(filepath.Join(opts.HomeDir, "config", "priv_validator_key.json"))
-    _ = os.Remove(filepath.Join(opts.HomeDir, "config", "node_key.json"))
-
-    // Clean address book
-    _ = os.Remove(filepath.Join(opts.HomeDir, "config", "addrbook.json"))
+    // Remove blockchain data, keyrings, validator/node keys and address book
+    for _, p := range fullResetTargets(opts.HomeDir) { _ = os.RemoveAll(p) }
 
     // Recreate essential directories (keep logs - useful for debugging)
     _ = os.MkdirAll(filepath.Join(opts.HomeDir, "data"), 0o755)
@@ -87,6 +99,23 @@ func Backup(opts BackupOptions) (string, error) {
     if opts.HomeDir == "" { return "", fmt.Errorf("HomeDir required") }
     outDir := opts.OutDir
     if outDir == "" { outDir = filepath.Join(opts.HomeDir, "backups") }
+
+    // Include important paths
+    include := []string{
+        filepath.Join(opts.HomeDir, "config", "config.toml"),
+        filepath.Join(opts.HomeDir, "config", "app.toml"),
+        filepath.Join(opts.HomeDir, "config", "genesis.json"),
+        filepath.Join(opts.HomeDir, "data", "priv_validator_state.json"),
+    }
+    if !opts.SkipDiskCheck {
+        // Sized as if uncompressed, so a full disk fails before a partial archive is written
+        var total int64
+        for _, p := range include {
+            if st, err := os.Stat(p); err == nil { total += st.Size() }
+        }
+        if err := diskspace.Check(outDir, total); err != nil { return "", err }
+    }
+
     if err := os.MkdirAll(outDir, 0o755); err != nil { return "", err }
     ts := time.Now().Format("20060102-150405")
     outPath := filepath.Join(outDir, fmt.Sprintf("backup-%s.tar.gz", ts))
@@ -98,19 +127,16 @@ func Backup(opts BackupOptions) (string, error) {
     tw := tar.NewWriter(gz)
     defer func() { _ = tw.Close() }()
 
-    // Include important paths
-    include := []string{
-        filepath.Join(opts.HomeDir, "config", "config.toml"),
-        filepath.Join(opts.HomeDir, "config", "app.toml"),
-        filepath.Join(opts.HomeDir, "config", "genesis.json"),
-        filepath.Join(opts.HomeDir, "data", "priv_validator_state.json"),
-    }
+    manifest := Manifest{Kind: "backup", ChainID: opts.ChainID, CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+    _, _ = files.RecordSignedHeight(opts.HomeDir)
     for _, p := range include {
         if err := addFile(tw, p, opts.HomeDir); err != nil {
             // Skip missing files silently
-            _ = err
+            continue
         }
+        manifest.Files = append(manifest.Files, archiveName(p, opts.HomeDir))
     }
+    if err := addManifest(tw, manifest); err != nil { return "", err }
     return outPath, nil
 }
 
@@ -118,11 +144,9 @@ func addFile(tw *tar.Writer, path string, base string) error {
     st, err := os.Stat(path)
     if err != nil { return err }
     if st.IsDir() { return nil }
-    rel := strings.TrimPrefix(path, base)
-    if strings.HasPrefix(rel, string(filepath.Separator)) { rel = rel[1:] }
     hdr, err := tar.FileInfoHeader(st, "")
     if err != nil { return err }
-    hdr.Name = rel
+    hdr.Name = archiveName(path, base)
     if err := tw.WriteHeader(hdr); err != nil { return err }
     f, err := os.Open(path)
     if err != nil { return err }
@@ -131,3 +155,18 @@ func addFile(tw *tar.Writer, path string, base string) error {
     return err
 }
 
+// archiveName returns path relative to base, as stored in the tar header.
+func archiveName(path string, base string) string {
+    rel := strings.TrimPrefix(path, base)
+    if strings.HasPrefix(rel, string(filepath.Separator)) { rel = rel[1:] }
+    return filepath.ToSlash(rel)
+}
+
+func addManifest(tw *tar.Writer, m Manifest) error {
+    b, err := json.MarshalIndent(m, "", "  ")
+    if err != nil { return err }
+    hdr := &tar.Header{Name: ManifestName, Mode: 0o600, Size: int64(len(b)), ModTime: time.Now()}
+    if err := tw.WriteHeader(hdr); err != nil { return err }
+    _, err = tw.Write(b)
+    return err
+}
@@ -2,12 +2,18 @@ package admin
 
 import (
 	"archive/tar"
+	"bytes"
 	"compress/gzip"
+	"encoding/json"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/diskspace"
+	"github.com/pushchain/push-validator-cli/internal/files"
 )
 
 // setupTestHome creates a complete test directory structure with dummy files
@@ -87,6 +93,24 @@ func dirIsEmpty(path string) bool {
 }
 
 func TestReset(t *testing.T) {
+	t.Run("reset records the signed height", func(t *testing.T) {
+		homeDir := setupTestHome(t)
+		state := filepath.Join(homeDir, "data", "priv_validator_state.json")
+		if err := os.WriteFile(state, []byte(`{"height":"5000","round":0,"step":3}`), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := Reset(ResetOptions{HomeDir: homeDir}); err != nil {
+			t.Fatalf("Reset failed: %v", err)
+		}
+		if got := files.RecordedSignedHeight(homeDir); got != 5000 {
+			t.Errorf("recorded signed height = %d, want 5000", got)
+		}
+		if err := files.CheckSignedHeight(homeDir); !errors.Is(err, files.ErrPrivValStateBehind) {
+			t.Errorf("CheckSignedHeight after reset = %v, want ErrPrivValStateBehind", err)
+		}
+	})
+
 	t.Run("successful reset with data removal", func(t *testing.T) {
 		homeDir := setupTestHome(t)
 
@@ -361,6 +385,92 @@ func TestFullReset(t *testing.T) {
 	})
 }
 
+func planPaths(entries []PlanEntry) []string {
+	var out []string
+	for _, e := range entries {
+		out = append(out, e.Path)
+	}
+	return out
+}
+
+func TestPlanReset(t *testing.T) {
+	homeDir := setupTestHome(t)
+
+	plan, err := PlanReset(ResetOptions{HomeDir: homeDir, KeepAddrBook: true})
+	if err != nil {
+		t.Fatalf("PlanReset failed: %v", err)
+	}
+	if got := planPaths(plan.Remove); len(got) != 1 || got[0] != "data" {
+		t.Fatalf("Remove = %v, want [data]", got)
+	}
+	if plan.Remove[0].Files != 4 || plan.TotalSize != plan.Remove[0].Size || plan.TotalSize == 0 {
+		t.Errorf("unexpected data entry: %+v (total %d)", plan.Remove[0], plan.TotalSize)
+	}
+	preserved := strings.Join(planPaths(plan.Preserve), ",")
+	for _, want := range []string{"config", "keyring-file", "logs"} {
+		if !strings.Contains(preserved, want) {
+			t.Errorf("Preserve = %s, missing %s", preserved, want)
+		}
+	}
+
+	// Planning must not touch anything
+	if !fileExists(filepath.Join(homeDir, "data", "blockstore.db")) {
+		t.Fatal("PlanReset deleted data")
+	}
+}
+
+func TestPlanFullReset_MatchesFullReset(t *testing.T) {
+	homeDir := setupTestHome(t)
+
+	plan, err := PlanFullReset(FullResetOptions{HomeDir: homeDir})
+	if err != nil {
+		t.Fatalf("PlanFullReset failed: %v", err)
+	}
+	removed := strings.Join(planPaths(plan.Remove), ",")
+	for _, want := range []string{"data", "keyring-file", "keyring-test",
+		filepath.Join("config", "priv_validator_key.json"), filepath.Join("config", "node_key.json"), filepath.Join("config", "addrbook.json")} {
+		if !strings.Contains(removed, want) {
+			t.Errorf("Remove = %s, missing %s", removed, want)
+		}
+	}
+	// config itself survives; only its surviving files are listed
+	for _, e := range plan.Preserve {
+		if e.Path == "config" {
+			t.Error("config dir should be split into its preserved files")
+		}
+	}
+
+	if err := FullReset(FullResetOptions{HomeDir: homeDir}); err != nil {
+		t.Fatalf("FullReset failed: %v", err)
+	}
+	for _, e := range plan.Remove {
+		p := filepath.Join(homeDir, e.Path)
+		if e.Path == "data" {
+			if !dirIsEmpty(p) {
+				t.Errorf("%s should be empty after full reset", e.Path)
+			}
+			continue
+		}
+		if fileExists(p) {
+			t.Errorf("%s was planned for removal but still exists", e.Path)
+		}
+	}
+	for _, e := range plan.Preserve {
+		if !fileExists(filepath.Join(homeDir, e.Path)) {
+			t.Errorf("%s was planned to be preserved but is gone", e.Path)
+		}
+	}
+}
+
+func TestPlanReset_RequiresHome(t *testing.T) {
+	if _, err := PlanReset(ResetOptions{}); err == nil {
+		t.Error("expected error without HomeDir")
+	}
+	if _, err := PlanFullReset(FullResetOptions{}); err == nil {
+		t.Error("expected error without HomeDir")
+	}
+}
+
 func TestBackup(t *testing.T) {
 	t.Run("successful backup creation", func(t *testing.T) {
 		homeDir := setupTestHome(t)
@@ -426,6 +536,30 @@ func TestBackup(t *testing.T) {
 		}
 	})
 
+	t.Run("backup records manifest", func(t *testing.T) {
+		homeDir := setupTestHome(t)
+
+		backupPath, err := Backup(BackupOptions{HomeDir: homeDir, ChainID: "push_42101-1"})
+		if err != nil {
+			t.Fatalf("Backup failed: %v", err)
+		}
+		data, err := os.ReadFile(backupPath)
+		if err != nil {
+			t.Fatalf("failed to read backup: %v", err)
+		}
+
+		var m Manifest
+		if err := json.Unmarshal(readTarGzEntry(t, data, ManifestName), &m); err != nil {
+			t.Fatalf("invalid manifest: %v", err)
+		}
+		if m.Kind != "backup" || m.ChainID != "push_42101-1" {
+			t.Errorf("unexpected manifest: %+v", m)
+		}
+		if len(m.Files) != 4 {
+			t.Errorf("expected 4 files in manifest, got %v", m.Files)
+		}
+	})
+
 	t.Run("backup without HomeDir", func(t *testing.T) {
 		opts := BackupOptions{}
 
@@ -559,6 +693,25 @@ func TestBackup(t *testing.T) {
 	})
 }
 
+func TestBackup_InsufficientDiskSpace(t *testing.T) {
+	homeDir := setupTestHome(t)
+	origFree := diskspace.Free
+	t.Cleanup(func() { diskspace.Free = origFree })
+	diskspace.Free = func(string) (int64, error) { return 1 << 20, nil }
+
+	_, err := Backup(BackupOptions{HomeDir: homeDir})
+	if !errors.Is(err, diskspace.ErrInsufficient) {
+		t.Fatalf("expected insufficient disk space error, got %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(homeDir, "backups")); !os.IsNotExist(statErr) {
+		t.Error("backups dir should not be created when the preflight fails")
+	}
+
+	if _, err := Backup(BackupOptions{HomeDir: homeDir, SkipDiskCheck: true}); err != nil {
+		t.Fatalf("Backup with SkipDiskCheck failed: %v", err)
+	}
+}
+
 func TestAddFile(t *testing.T) {
 	t.Run("add regular file", func(t *testing.T) {
 		homeDir := t.TempDir()
@@ -780,3 +933,165 @@ func extractBackupFileList(t *testing.T, backupPath string) []string {
 
 	return files
 }
+
+// readTarGzEntry returns the contents of the named entry in a tar.gz blob
+func readTarGzEntry(t *testing.T, data []byte, name string) []byte {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to create gzip reader: %v", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			t.Fatalf("entry %q not found in archive", name)
+		}
+		if err != nil {
+			t.Fatalf("failed to read tar entry: %v", err)
+		}
+		if hdr.Name == name {
+			b, err := io.ReadAll(tr)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", name, err)
+			}
+			return b
+		}
+	}
+}
+
+func TestExportKeys(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		homeDir := setupTestHome(t)
+		outDir := filepath.Join(t.TempDir(), "keys")
+
+		path, err := ExportKeys(ExportKeysOptions{HomeDir: homeDir, OutDir: outDir, ChainID: "push_42101-1", Password: "correct horse"})
+		if err != nil {
+			t.Fatalf("ExportKeys failed: %v", err)
+		}
+		st, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("archive not created: %v", err)
+		}
+		if st.Mode().Perm() != 0o600 {
+			t.Errorf("expected mode 0600, got %o", st.Mode().Perm())
+		}
+
+		raw, _ := os.ReadFile(path)
+		if bytes.Contains(raw, []byte("test_validator")) {
+			t.Error("archive contains plaintext key material")
+		}
+
+		plain, err := DecryptKeyArchive(path, "correct horse")
+		if err != nil {
+			t.Fatalf("DecryptKeyArchive failed: %v", err)
+		}
+		if got := string(readTarGzEntry(t, plain, "config/priv_validator_key.json")); got != `{"address":"test_validator"}` {
+			t.Errorf("unexpected priv_validator_key.json: %s", got)
+		}
+		if got := string(readTarGzEntry(t, plain, "config/node_key.json")); got != `{"id":"test_node"}` {
+			t.Errorf("unexpected node_key.json: %s", got)
+		}
+		var m Manifest
+		if err := json.Unmarshal(readTarGzEntry(t, plain, ManifestName), &m); err != nil {
+			t.Fatalf("invalid manifest: %v", err)
+		}
+		if m.Kind != "keys" || m.ChainID != "push_42101-1" || len(m.Files) != 2 {
+			t.Errorf("unexpected manifest: %+v", m)
+		}
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		homeDir := setupTestHome(t)
+		path, err := ExportKeys(ExportKeysOptions{HomeDir: homeDir, Password: "correct horse"})
+		if err != nil {
+			t.Fatalf("ExportKeys failed: %v", err)
+		}
+		if _, err := DecryptKeyArchive(path, "battery staple"); !errors.Is(err, ErrBadPassword) {
+			t.Errorf("expected ErrBadPassword, got %v", err)
+		}
+	})
+
+	t.Run("not a key archive", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "other.tar.gz")
+		_ = os.WriteFile(path, []byte("not encrypted"), 0o600)
+		if _, err := DecryptKeyArchive(path, "correct horse"); err == nil || errors.Is(err, ErrBadPassword) {
+			t.Errorf("expected format error, got %v", err)
+		}
+	})
+
+	t.Run("short password", func(t *testing.T) {
+		homeDir := setupTestHome(t)
+		if _, err := ExportKeys(ExportKeysOptions{HomeDir: homeDir, Password: "short"}); err == nil {
+			t.Error("expected error for short password")
+		}
+	})
+
+	t.Run("missing key file", func(t *testing.T) {
+		homeDir := setupTestHome(t)
+		os.Remove(filepath.Join(homeDir, "config", "node_key.json"))
+		if _, err := ExportKeys(ExportKeysOptions{HomeDir: homeDir, Password: "correct horse"}); err == nil {
+			t.Error("expected error when node_key.json is missing")
+		}
+	})
+
+	t.Run("without HomeDir", func(t *testing.T) {
+		if _, err := ExportKeys(ExportKeysOptions{Password: "correct horse"}); err == nil {
+			t.Error("expected error when HomeDir is empty")
+		}
+	})
+}
+
+func TestClearForReinit(t *testing.T) {
+	homeDir := setupTestHome(t)
+	if err := os.MkdirAll(filepath.Join(homeDir, "cosmovisor", "genesis", "bin"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	state := `{"height":"1234","round":0,"step":3}`
+	if err := os.WriteFile(files.PrivValStatePath(homeDir), []byte(state), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	backupDir, err := ClearForReinit(ReinitOptions{HomeDir: homeDir})
+	if err != nil {
+		t.Fatalf("ClearForReinit failed: %v", err)
+	}
+	if filepath.Dir(backupDir) != filepath.Join(homeDir, "backups") {
+		t.Errorf("backup dir = %s, want under backups/", backupDir)
+	}
+	for name, want := range map[string]string{
+		"priv_validator_key.json": `{"address":"test_validator"}`,
+		"node_key.json":           `{"id":"test_node"}`,
+		// The old key's double-sign guard travels with it
+		"priv_validator_state.json": state,
+		".signed-height":            "1234\n",
+	} {
+		got, err := os.ReadFile(filepath.Join(backupDir, name))
+		if err != nil || string(got) != want {
+			t.Errorf("backup %s = %q, %v; want %q", name, got, err, want)
+		}
+	}
+
+	for _, gone := range []string{"config", "data", ".signed-height"} {
+		if fileExists(filepath.Join(homeDir, gone)) {
+			t.Errorf("%s should be removed", gone)
+		}
+	}
+	for _, kept := range []string{"keyring-file", "keyring-test", "logs", "cosmovisor"} {
+		if !fileExists(filepath.Join(homeDir, kept)) {
+			t.Errorf("%s should be kept", kept)
+		}
+	}
+}
+
+func TestClearForReinit_NoKeys(t *testing.T) {
+	homeDir := t.TempDir()
+	backupDir, err := ClearForReinit(ReinitOptions{HomeDir: homeDir})
+	if err != nil || backupDir != "" {
+		t.Errorf("ClearForReinit() = %q, %v; want no backup and no error", backupDir, err)
+	}
+}
@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// keyExportMagic prefixes every encrypted key archive so a wrong file is
+// reported as such instead of as a bad password.
+const keyExportMagic = "PVKEYS1\n"
+
+const (
+	keyExportSaltLen    = 16
+	keyExportIterations = 600_000
+	// MinExportPasswordLen is the shortest password ExportKeys accepts.
+	MinExportPasswordLen = 8
+)
+
+// ErrBadPassword is returned when a key archive cannot be decrypted, either
+// because the password is wrong or the file has been modified.
+var ErrBadPassword = errors.New("wrong password or corrupted key archive")
+
+type ExportKeysOptions struct {
+	HomeDir  string
+	OutDir   string // if empty, defaults to <HomeDir>/backups
+	ChainID  string // recorded in the archive manifest
+	Password string
+}
+
+// ExportKeys writes priv_validator_key.json and node_key.json, together with a
+// manifest, into a gzipped tar encrypted with AES-256-GCM under a key derived
+// from opts.Password. Both key files must exist. Returns the archive path.
+func ExportKeys(opts ExportKeysOptions) (string, error) {
+	if opts.HomeDir == "" {
+		return "", fmt.Errorf("HomeDir required")
+	}
+	if len(opts.Password) < MinExportPasswordLen {
+		return "", fmt.Errorf("password must be at least %d characters", MinExportPasswordLen)
+	}
+	files := []string{
+		filepath.Join(opts.HomeDir, "config", "priv_validator_key.json"),
+		filepath.Join(opts.HomeDir, "config", "node_key.json"),
+	}
+	for _, p := range files {
+		if _, err := os.Stat(p); err != nil {
+			return "", fmt.Errorf("key file not found: %w", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	manifest := Manifest{Kind: "keys", ChainID: opts.ChainID, CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+	for _, p := range files {
+		if err := addFile(tw, p, opts.HomeDir); err != nil {
+			return "", err
+		}
+		manifest.Files = append(manifest.Files, archiveName(p, opts.HomeDir))
+	}
+	if err := addManifest(tw, manifest); err != nil {
+		return "", err
+	}
+	if err := tw.Close(); err != nil {
+		return "", err
+	}
+	if err := gz.Close(); err != nil {
+		return "", err
+	}
+
+	sealed, err := sealKeyArchive(buf.Bytes(), opts.Password)
+	if err != nil {
+		return "", err
+	}
+
+	outDir := opts.OutDir
+	if outDir == "" {
+		outDir = filepath.Join(opts.HomeDir, "backups")
+	}
+	if err := os.MkdirAll(outDir, 0o700); err != nil {
+		return "", err
+	}
+	ts := time.Now().Format("20060102-150405")
+	outPath := filepath.Join(outDir, fmt.Sprintf("validator-keys-%s.tar.gz.enc", ts))
+	// O_EXCL: never overwrite an earlier export
+	f, err := os.OpenFile(outPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(sealed); err != nil {
+		_ = f.Close()
+		_ = os.Remove(outPath)
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return outPath, nil
+}
+
+// DecryptKeyArchive reads an archive written by ExportKeys and returns the
+// decrypted tar.gz contents.
+func DecryptKeyArchive(path string, password string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return openKeyArchive(data, password)
+}
+
+func keyExportAEAD(password string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, password, salt, keyExportIterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealKeyArchive lays out magic | salt | nonce | ciphertext. The header is
+// passed as additional data so it cannot be altered undetected.
+func sealKeyArchive(plain []byte, password string) ([]byte, error) {
+	salt := make([]byte, keyExportSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := keyExportAEAD(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	header := append(append([]byte(keyExportMagic), salt...), nonce...)
+	return aead.Seal(header, nonce, plain, header), nil
+}
+
+func openKeyArchive(data []byte, password string) ([]byte, error) {
+	if !bytes.HasPrefix(data, []byte(keyExportMagic)) {
+		return nil, fmt.Errorf("not a push-validator key archive")
+	}
+	saltEnd := len(keyExportMagic) + keyExportSaltLen
+	if len(data) < saltEnd {
+		return nil, fmt.Errorf("key archive truncated")
+	}
+	aead, err := keyExportAEAD(password, data[len(keyExportMagic):saltEnd])
+	if err != nil {
+		return nil, err
+	}
+	headerLen := saltEnd + aead.NonceSize()
+	if len(data) < headerLen+aead.Overhead() {
+		return nil, fmt.Errorf("key archive truncated")
+	}
+	plain, err := aead.Open(nil, data[saltEnd:headerLen], data[headerLen:], data[:headerLen])
+	if err != nil {
+		return nil, ErrBadPassword
+	}
+	return plain, nil
+}
@@ -0,0 +1,208 @@
+package admin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pushchain/push-validator-cli/internal/chain"
+)
+
+// LegacyLayoutOptions locates a home directory laid out by releases that ran
+// pchaind directly instead of through Cosmovisor.
+type LegacyLayoutOptions struct {
+	HomeDir string
+	// BinDirs are where those releases installed pchaind; if empty,
+	// defaults to ~/.local/bin.
+	BinDirs []string
+	// Alive reports whether a process exists; if nil, signal 0 is used.
+	Alive func(pid int) bool
+}
+
+// LegacyAction is one step that brings a legacy home layout in line with
+// the current one.
+type LegacyAction struct {
+	Description string
+	// Destructive actions move or replace existing files and should be
+	// confirmed first.
+	Destructive bool
+	needed      func() bool
+	apply       func() error
+}
+
+// Apply performs the action. It does nothing if the action is no longer
+// needed, so applying the same action twice is safe.
+func (a LegacyAction) Apply() error {
+	if !a.needed() {
+		return nil
+	}
+	return a.apply()
+}
+
+// DetectLegacyLayout returns the actions needed to migrate opts.HomeDir, in
+// the order they should run. It returns nothing once the home directory
+// matches the current layout:
+//
+//   - logs/pchaind.log is moved to logs/cosmovisor.log and a symlink left
+//     in its place (destructive)
+//   - a node still running under pchaind.pid is adopted as cosmovisor.pid
+//   - pchaind installed in a bin directory is copied, with its wasmvm
+//     library, into cosmovisor/genesis/bin
+func DetectLegacyLayout(opts LegacyLayoutOptions) []LegacyAction {
+	if opts.HomeDir == "" {
+		return nil
+	}
+	alive := opts.Alive
+	if alive == nil {
+		alive = processAlive
+	}
+	binDirs := opts.BinDirs
+	if len(binDirs) == 0 {
+		if home, err := os.UserHomeDir(); err == nil {
+			binDirs = []string{filepath.Join(home, ".local", "bin")}
+		}
+	}
+
+	var actions []LegacyAction
+	for _, a := range []LegacyAction{
+		legacyLogAction(opts.HomeDir),
+		legacyPIDAction(opts.HomeDir, alive),
+		legacyBinAction(opts.HomeDir, binDirs),
+	} {
+		if a.needed() {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
+
+func legacyLogAction(home string) LegacyAction {
+	oldLog := filepath.Join(home, "logs", "pchaind.log")
+	newLog := filepath.Join(home, "logs", "cosmovisor.log")
+	return LegacyAction{
+		Description: fmt.Sprintf("move %s to %s and link the old path to it", oldLog, newLog),
+		Destructive: true,
+		needed: func() bool {
+			st, err := os.Lstat(oldLog)
+			if err != nil || !st.Mode().IsRegular() {
+				return false
+			}
+			_, err = os.Lstat(newLog)
+			return os.IsNotExist(err)
+		},
+		apply: func() error {
+			if err := os.Rename(oldLog, newLog); err != nil {
+				return err
+			}
+			return os.Symlink(filepath.Base(newLog), oldLog)
+		},
+	}
+}
+
+func legacyPIDAction(home string, alive func(int) bool) LegacyAction {
+	oldPID := filepath.Join(home, "pchaind.pid")
+	newPID := filepath.Join(home, "cosmovisor.pid")
+	return LegacyAction{
+		Description: fmt.Sprintf("adopt the node running under %s as %s", oldPID, newPID),
+		needed: func() bool {
+			pid, ok := readPID(oldPID)
+			if !ok || !alive(pid) {
+				return false
+			}
+			cur, ok := readPID(newPID)
+			return !ok || !alive(cur)
+		},
+		apply: func() error {
+			return os.Rename(oldPID, newPID)
+		},
+	}
+}
+
+func legacyBinAction(home string, binDirs []string) LegacyAction {
+	genesisBin := filepath.Join(home, "cosmovisor", "genesis", "bin")
+	dest := filepath.Join(genesisBin, "pchaind")
+	src := func() string {
+		for _, dir := range binDirs {
+			p := filepath.Join(dir, "pchaind")
+			if st, err := os.Stat(p); err == nil && st.Mode().IsRegular() {
+				return p
+			}
+		}
+		return ""
+	}
+	a := LegacyAction{
+		Description: fmt.Sprintf("copy pchaind into %s", genesisBin),
+		needed: func() bool {
+			if _, err := os.Lstat(dest); !os.IsNotExist(err) {
+				return false
+			}
+			return src() != ""
+		},
+	}
+	if s := src(); s != "" {
+		a.Description = fmt.Sprintf("copy %s into %s", s, genesisBin)
+	}
+	a.apply = func() error {
+		s := src()
+		if err := os.MkdirAll(genesisBin, 0o755); err != nil {
+			return err
+		}
+		entries, _ := os.ReadDir(filepath.Dir(s))
+		for _, e := range entries {
+			if e.IsDir() || !chain.IsSidecarLib(e.Name(), chain.DefaultSidecarLibs) {
+				continue
+			}
+			if err := copyExecutable(filepath.Join(filepath.Dir(s), e.Name()), filepath.Join(genesisBin, e.Name())); err != nil {
+				return err
+			}
+		}
+		// The binary goes last so a failed library copy is retried next time
+		return copyExecutable(s, dest)
+	}
+	return a
+}
+
+func readPID(path string) (int, bool) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil || pid <= 0 {
+		return 0, false
+	}
+	return pid, true
+}
+
+func processAlive(pid int) bool {
+	return syscall.Kill(pid, 0) == nil
+}
+
+// copyExecutable copies src to dst through a temporary file, so dst never
+// holds a partial binary.
+func copyExecutable(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	tmp := dst + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o755)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
@@ -0,0 +1,131 @@
+package admin
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// setupLegacyHome lays out a home directory the way releases before
+// Cosmovisor left it: logs/pchaind.log, pchaind.pid and pchaind in a bin
+// directory outside the home. It returns the home and bin directories.
+func setupLegacyHome(t *testing.T, pid int) (string, string) {
+	t.Helper()
+	home := t.TempDir()
+	binDir := t.TempDir()
+	mustWrite := func(path, content string, mode os.FileMode) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustWrite(filepath.Join(home, "logs", "pchaind.log"), "old log\n", 0o644)
+	mustWrite(filepath.Join(home, "pchaind.pid"), strconv.Itoa(pid), 0o644)
+	mustWrite(filepath.Join(binDir, "pchaind"), "#!/bin/sh\n", 0o755)
+	mustWrite(filepath.Join(binDir, "libwasmvm.x86_64.so"), "lib", 0o644)
+	return home, binDir
+}
+
+func TestDetectLegacyLayout_MigratesAndResolves(t *testing.T) {
+	const pid = 4242
+	home, binDir := setupLegacyHome(t, pid)
+	opts := LegacyLayoutOptions{
+		HomeDir: home,
+		BinDirs: []string{binDir},
+		Alive:   func(p int) bool { return p == pid },
+	}
+
+	actions := DetectLegacyLayout(opts)
+	if len(actions) != 3 {
+		t.Fatalf("got %d actions, want 3: %+v", len(actions), actions)
+	}
+	if !actions[0].Destructive || actions[1].Destructive || actions[2].Destructive {
+		t.Errorf("only the log move should be destructive: %+v", actions)
+	}
+	for _, a := range actions {
+		if err := a.Apply(); err != nil {
+			t.Fatalf("Apply(%s): %v", a.Description, err)
+		}
+	}
+
+	// The old log path still resolves, to the new log
+	data, err := os.ReadFile(filepath.Join(home, "logs", "pchaind.log"))
+	if err != nil || string(data) != "old log\n" {
+		t.Errorf("logs/pchaind.log = %q, %v", data, err)
+	}
+	if data, err := os.ReadFile(filepath.Join(home, "logs", "cosmovisor.log")); err != nil || string(data) != "old log\n" {
+		t.Errorf("logs/cosmovisor.log = %q, %v", data, err)
+	}
+	if got, ok := readPID(filepath.Join(home, "cosmovisor.pid")); !ok || got != pid {
+		t.Errorf("cosmovisor.pid = %d, %v; want %d", got, ok, pid)
+	}
+	if _, err := os.Stat(filepath.Join(home, "pchaind.pid")); !os.IsNotExist(err) {
+		t.Errorf("pchaind.pid should be gone, stat err = %v", err)
+	}
+	genesisBin := filepath.Join(home, "cosmovisor", "genesis", "bin")
+	st, err := os.Stat(filepath.Join(genesisBin, "pchaind"))
+	if err != nil || st.Mode().Perm()&0o100 == 0 {
+		t.Errorf("genesis pchaind missing or not executable: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(genesisBin, "libwasmvm.x86_64.so")); err != nil {
+		t.Errorf("wasmvm library not copied: %v", err)
+	}
+
+	// Idempotent: nothing left to do, and reapplying changes nothing
+	if again := DetectLegacyLayout(opts); len(again) != 0 {
+		t.Errorf("second detection = %+v, want none", again)
+	}
+	for _, a := range actions {
+		if err := a.Apply(); err != nil {
+			t.Errorf("second Apply(%s): %v", a.Description, err)
+		}
+	}
+	if data, err := os.ReadFile(filepath.Join(home, "logs", "pchaind.log")); err != nil || string(data) != "old log\n" {
+		t.Errorf("after reapply logs/pchaind.log = %q, %v", data, err)
+	}
+}
+
+func TestDetectLegacyLayout_LeavesCurrentLayoutAlone(t *testing.T) {
+	home, binDir := setupLegacyHome(t, 4242)
+	// A current log and a genesis binary already exist; the legacy node is gone
+	if err := os.WriteFile(filepath.Join(home, "logs", "cosmovisor.log"), []byte("new"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	genesisBin := filepath.Join(home, "cosmovisor", "genesis", "bin")
+	if err := os.MkdirAll(genesisBin, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(genesisBin, "pchaind"), []byte("current"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	actions := DetectLegacyLayout(LegacyLayoutOptions{
+		HomeDir: home,
+		BinDirs: []string{binDir},
+		Alive:   func(int) bool { return false },
+	})
+	if len(actions) != 0 {
+		t.Errorf("got actions %+v, want none", actions)
+	}
+}
+
+func TestDetectLegacyLayout_KeepsRunningCosmovisorPID(t *testing.T) {
+	home, binDir := setupLegacyHome(t, 4242)
+	if err := os.WriteFile(filepath.Join(home, "cosmovisor.pid"), []byte("5151"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	actions := DetectLegacyLayout(LegacyLayoutOptions{
+		HomeDir: home,
+		BinDirs: []string{binDir},
+		Alive:   func(int) bool { return true },
+	})
+	for _, a := range actions {
+		if a.Description == legacyPIDAction(home, nil).Description {
+			t.Errorf("pid adopted although cosmovisor.pid is live: %+v", actions)
+		}
+	}
+}
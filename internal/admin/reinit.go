@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/files"
+)
+
+type ReinitOptions struct {
+	HomeDir string
+	OutDir  string // key backup location; if empty, defaults to <HomeDir>/backups
+}
+
+// reinitTargets lists what ClearForReinit deletes, so pchaind init starts
+// over. Keyrings, logs, backups, the snapshot cache and cosmovisor are kept.
+func reinitTargets(home string) []string {
+	return []string{
+		filepath.Join(home, "config"),
+		filepath.Join(home, "data"),
+		filepath.Join(home, ".snapshot_downloaded"),
+		// The new key has signed nothing yet; the old key's height goes
+		// into the backup (see keyBackupSources)
+		files.SignedHeightPath(home),
+	}
+}
+
+// keyBackupSources lists the files ClearForReinit copies before deleting
+// anything: the keys, plus the signing state and recorded signed height so
+// the old validator key is restored together with its double-sign guard.
+func keyBackupSources(home string) []string {
+	return []string{
+		filepath.Join(home, "config", "priv_validator_key.json"),
+		filepath.Join(home, "config", "node_key.json"),
+		files.PrivValStatePath(home),
+		files.SignedHeightPath(home),
+	}
+}
+
+// ClearForReinit copies the validator and node keys and their signing state
+// into a new keys-<timestamp> directory under OutDir, then removes
+// reinitTargets. Nothing is removed if the backup fails. Returns the backup
+// directory, or "" when there was nothing to back up.
+func ClearForReinit(opts ReinitOptions) (string, error) {
+	if opts.HomeDir == "" {
+		return "", fmt.Errorf("HomeDir required")
+	}
+	outDir := opts.OutDir
+	if outDir == "" {
+		outDir = filepath.Join(opts.HomeDir, "backups")
+	}
+
+	// Raise .signed-height to the state file first, so the backed-up copy
+	// holds the highest height even if the state file is lost later
+	if _, err := files.RecordSignedHeight(opts.HomeDir); err != nil {
+		return "", fmt.Errorf("record signed height: %w", err)
+	}
+
+	var backupDir string
+	for _, src := range keyBackupSources(opts.HomeDir) {
+		if _, err := os.Stat(src); err != nil {
+			continue
+		}
+		if backupDir == "" {
+			backupDir = filepath.Join(outDir, "keys-"+time.Now().Format("20060102-150405"))
+			if err := os.MkdirAll(outDir, 0o700); err != nil {
+				return "", err
+			}
+			// Mkdir, not MkdirAll: never mix keys into an earlier backup
+			if err := os.Mkdir(backupDir, 0o700); err != nil {
+				return "", fmt.Errorf("back up keys: %w", err)
+			}
+		}
+		if err := copyKeyFile(src, filepath.Join(backupDir, filepath.Base(src))); err != nil {
+			return "", fmt.Errorf("back up keys: %w", err)
+		}
+	}
+
+	for _, p := range reinitTargets(opts.HomeDir) {
+		if err := os.RemoveAll(p); err != nil {
+			return backupDir, err
+		}
+	}
+	return backupDir, nil
+}
+
+// copyKeyFile copies a key file, readable only by the owner.
+func copyKeyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
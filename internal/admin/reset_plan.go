@@ -0,0 +1,171 @@
+package admin
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pushchain/push-validator-cli/internal/files"
+)
+
+// resetTargets lists what Reset deletes. Everything else in the home
+// directory, including config, keys, keyrings and the address book, is kept.
+func resetTargets(home string) []string {
+	return []string{
+		filepath.Join(home, "data"),
+	}
+}
+
+// fullResetTargets lists what FullReset deletes.
+func fullResetTargets(home string) []string {
+	return []string{
+		filepath.Join(home, "data"),
+		filepath.Join(home, "keyring-file"),
+		filepath.Join(home, "keyring-test"),
+		filepath.Join(home, "config", "priv_validator_key.json"),
+		filepath.Join(home, "config", "node_key.json"),
+		filepath.Join(home, "config", "addrbook.json"),
+		files.SignedHeightPath(home),
+	}
+}
+
+// PlanEntry is one file or directory in a reset plan. Path is relative to
+// the home directory; Size and Files cover everything beneath a directory.
+type PlanEntry struct {
+	Path  string `json:"path"`
+	IsDir bool   `json:"is_dir"`
+	Size  int64  `json:"size"`
+	Files int    `json:"files"`
+}
+
+// ResetPlan describes what a reset would delete and keep, without touching
+// anything. It is built from the same target lists Reset and FullReset use.
+type ResetPlan struct {
+	HomeDir   string      `json:"home_dir"`
+	Remove    []PlanEntry `json:"remove"`
+	Preserve  []PlanEntry `json:"preserve"`
+	TotalSize int64       `json:"total_size"` // bytes that would be removed
+}
+
+// PlanReset returns what Reset would remove and preserve.
+func PlanReset(opts ResetOptions) (*ResetPlan, error) {
+	if opts.HomeDir == "" {
+		return nil, fmt.Errorf("HomeDir required")
+	}
+	return buildPlan(opts.HomeDir, resetTargets(opts.HomeDir))
+}
+
+// PlanFullReset returns what FullReset would remove and preserve.
+func PlanFullReset(opts FullResetOptions) (*ResetPlan, error) {
+	if opts.HomeDir == "" {
+		return nil, fmt.Errorf("HomeDir required")
+	}
+	return buildPlan(opts.HomeDir, fullResetTargets(opts.HomeDir))
+}
+
+func buildPlan(home string, targets []string) (*ResetPlan, error) {
+	plan := &ResetPlan{HomeDir: home, Remove: []PlanEntry{}, Preserve: []PlanEntry{}}
+	targetSet := make(map[string]bool, len(targets))
+	for _, t := range targets {
+		targetSet[t] = true
+		st, err := os.Lstat(t)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		e, err := planEntry(home, t, st)
+		if err != nil {
+			return nil, err
+		}
+		plan.Remove = append(plan.Remove, e)
+		plan.TotalSize += e.Size
+	}
+	preserved, err := preservedEntries(home, home, targets, targetSet)
+	if err != nil {
+		return nil, err
+	}
+	plan.Preserve = append(plan.Preserve, preserved...)
+	return plan, nil
+}
+
+// preservedEntries lists the children of dir that are not removed. A child
+// that contains a target is descended into so only its surviving contents
+// are reported.
+func preservedEntries(home, dir string, targets []string, targetSet map[string]bool) ([]PlanEntry, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out []PlanEntry
+	for _, de := range entries {
+		p := filepath.Join(dir, de.Name())
+		if targetSet[p] {
+			continue
+		}
+		if de.IsDir() && containsTarget(p, targets) {
+			sub, err := preservedEntries(home, p, targets, targetSet)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, sub...)
+			continue
+		}
+		st, err := os.Lstat(p)
+		if err != nil {
+			return nil, err
+		}
+		e, err := planEntry(home, p, st)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out, nil
+}
+
+func containsTarget(dir string, targets []string) bool {
+	for _, t := range targets {
+		if rel, err := filepath.Rel(dir, t); err == nil && rel != "." && filepath.IsLocal(rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// planEntry sizes path, walking it if it is a directory. Symlinks are not
+// followed, matching os.RemoveAll.
+func planEntry(home, path string, st os.FileInfo) (PlanEntry, error) {
+	rel, err := filepath.Rel(home, path)
+	if err != nil {
+		rel = path
+	}
+	e := PlanEntry{Path: rel, IsDir: st.IsDir()}
+	if !st.IsDir() {
+		e.Size, e.Files = st.Size(), 1
+		return e, nil
+	}
+	err = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		e.Size += info.Size()
+		e.Files++
+		return nil
+	})
+	return e, err
+}
@@ -0,0 +1,97 @@
+// Package archive loads release tarballs from local disk for offline installs.
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pushchain/push-validator-cli/internal/checksum"
+)
+
+// Local is a release archive read from disk.
+type Local struct {
+	Path string
+	Data []byte
+	// ChecksumVerified is true when a sibling <path>.sha256 or <path>.sha512
+	// was found and matched.
+	ChecksumVerified bool
+}
+
+// checksumExts are the sibling checksum files ReadLocal looks for, in order.
+var checksumExts = []string{".sha256", ".sha512"}
+
+// ReadLocal reads a .tar.gz archive from path and checks that it is a
+// readable gzip-compressed tarball. When verify is set and <path>.sha256 (or,
+// failing that, <path>.sha512) exists, the archive must match it; a missing
+// checksum file is not an error.
+func ReadLocal(path string, verify bool) (*Local, error) {
+	st, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("archive not found: %w", err)
+	}
+	if !st.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	if err := checkTarGz(data); err != nil {
+		return nil, fmt.Errorf("%s is not a recognized archive (expected .tar.gz): %w", path, err)
+	}
+
+	local := &Local{Path: path, Data: data}
+	if !verify {
+		return local, nil
+	}
+	for _, ext := range checksumExts {
+		expected, err := readChecksumFile(path + ext)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := checksum.Verify(data, path+ext, strings.ToLower(expected)); err != nil {
+			return nil, err
+		}
+		local.ChecksumVerified = true
+		break
+	}
+	return local, nil
+}
+
+// checkTarGz verifies data is gzip and that its first tar header parses.
+func checkTarGz(data []byte) error {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = gz.Close() }()
+	if _, err := tar.NewReader(gz).Next(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// readChecksumFile returns the hash from a "<hash>  filename" or bare-hash file.
+func readChecksumFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if parts := strings.Fields(scanner.Text()); len(parts) >= 1 {
+			return parts[0], nil
+		}
+	}
+	return "", fmt.Errorf("could not parse checksum file %s", path)
+}
@@ -0,0 +1,111 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func makeTarGz(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	content := []byte("#!/bin/sh\n")
+	if err := tw.WriteHeader(&tar.Header{Name: "pchaind", Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = tw.Write(content)
+	_ = tw.Close()
+	_ = gz.Close()
+	return buf.Bytes()
+}
+
+func TestReadLocal(t *testing.T) {
+	dir := t.TempDir()
+	data := makeTarGz(t)
+	path := filepath.Join(dir, "pchaind_linux_amd64.tar.gz")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(data)
+
+	t.Run("no checksum file", func(t *testing.T) {
+		local, err := ReadLocal(path, true)
+		if err != nil {
+			t.Fatalf("ReadLocal() error = %v", err)
+		}
+		if local.ChecksumVerified || !bytes.Equal(local.Data, data) {
+			t.Errorf("unexpected result: verified=%v", local.ChecksumVerified)
+		}
+	})
+
+	t.Run("matching checksum", func(t *testing.T) {
+		_ = os.WriteFile(path+".sha256", []byte(hex.EncodeToString(sum[:])+"  pchaind_linux_amd64.tar.gz\n"), 0o644)
+		t.Cleanup(func() { os.Remove(path + ".sha256") })
+		local, err := ReadLocal(path, true)
+		if err != nil {
+			t.Fatalf("ReadLocal() error = %v", err)
+		}
+		if !local.ChecksumVerified {
+			t.Error("expected checksum to be verified")
+		}
+	})
+
+	t.Run("sha512 checksum", func(t *testing.T) {
+		sum512 := sha512.Sum512(data)
+		_ = os.WriteFile(path+".sha512", []byte(strings.ToUpper(hex.EncodeToString(sum512[:]))+"  pchaind_linux_amd64.tar.gz\n"), 0o644)
+		t.Cleanup(func() { os.Remove(path + ".sha512") })
+		local, err := ReadLocal(path, true)
+		if err != nil {
+			t.Fatalf("ReadLocal() error = %v", err)
+		}
+		if !local.ChecksumVerified {
+			t.Error("expected checksum to be verified")
+		}
+
+		_ = os.WriteFile(path+".sha512", []byte(strings.Repeat("0", 128)), 0o644)
+		if _, err := ReadLocal(path, true); err == nil || !strings.Contains(err.Error(), "mismatch") {
+			t.Fatalf("expected mismatch error, got %v", err)
+		}
+	})
+
+	t.Run("mismatched checksum", func(t *testing.T) {
+		_ = os.WriteFile(path+".sha256", []byte(strings.Repeat("0", 64)), 0o644)
+		t.Cleanup(func() { os.Remove(path + ".sha256") })
+		if _, err := ReadLocal(path, true); err == nil || !strings.Contains(err.Error(), "mismatch") {
+			t.Fatalf("expected mismatch error, got %v", err)
+		}
+		// Skipping verification ignores the checksum file
+		if _, err := ReadLocal(path, false); err != nil {
+			t.Fatalf("ReadLocal(verify=false) error = %v", err)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := ReadLocal(filepath.Join(dir, "nope.tar.gz"), true); err == nil {
+			t.Error("expected error for missing file")
+		}
+	})
+
+	t.Run("not an archive", func(t *testing.T) {
+		bad := filepath.Join(dir, "bad.tar.gz")
+		_ = os.WriteFile(bad, []byte("plain text"), 0o644)
+		if _, err := ReadLocal(bad, true); err == nil || !strings.Contains(err.Error(), "not a recognized archive") {
+			t.Fatalf("expected unrecognized archive error, got %v", err)
+		}
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		if _, err := ReadLocal(dir, true); err == nil {
+			t.Error("expected error for directory")
+		}
+	})
+}
@@ -7,14 +7,20 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/checksum"
+	"github.com/pushchain/push-validator-cli/internal/diskspace"
 	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
 	"github.com/pushchain/push-validator-cli/internal/snapshot"
+	"github.com/pushchain/push-validator-cli/internal/trace"
 )
 
 // Hardcoded fullnode peers for P2P connectivity
@@ -33,9 +39,93 @@ type Options struct {
 	GenesisDomain    string                  // Genesis RPC domain (e.g., donut.rpc.push.org)
 	BinPath          string                  // Path to pchaind binary
 	SnapshotURL      string                  // Base URL for snapshot downloads
-	Progress         func(string)            // Progress message callback
+	Progress         func(string)            // Progress message callback, fed from each Events message
+	Events           func(ProgressEvent)     // Structured progress callback for embedding
 	SnapshotProgress snapshot.ProgressFunc   // Detailed snapshot progress callback
 	SkipSnapshot     bool                    // Skip snapshot download (for separate step)
+	SkipDiskCheck    bool                    // Skip the free-space preflight (--force)
+	StateSync        bool                    // Sync via CometBFT state sync instead of a snapshot
+	StateSyncRPCs    []string                // State sync RPC servers (default: genesis RPC + fullnode peers)
+	NodeSettings     map[string]string       // config.toml/app.toml overrides keyed as in files.NodeKeys
+	GenesisHash      string                  // Expected SHA-256 of genesis.json; empty skips verification
+	GenesisURL       string                  // http(s):// or file:// genesis.json to use instead of the genesis RPC
+}
+
+// Step identifies the stage of Init a ProgressEvent belongs to.
+type Step string
+
+const (
+	StepPrepare   Step = "prepare"           // State sync lookup, disk check, directories
+	StepInit      Step = "init"              // pchaind init
+	StepGenesis   Step = "genesis"           // Genesis fetch and verification
+	StepConfigure Step = "configure"         // Peers, backup, sync mode, node settings
+	StepDownload  Step = "snapshot_download" // Snapshot download and checksum
+	StepExtract   Step = "extract"           // Snapshot extraction
+)
+
+// initSteps orders the steps for ProgressEvent.Current and Total. Skipped
+// steps emit no events, so Current may jump.
+var initSteps = []Step{StepPrepare, StepInit, StepGenesis, StepConfigure, StepDownload, StepExtract}
+
+// ProgressEvent reports Init progress. Current is the 1-based position of
+// Step among Total steps. Byte events during StepDownload and StepExtract set
+// BytesDownloaded and BytesTotal (archive bytes read, for extraction) and
+// carry no Message; every other event has one.
+type ProgressEvent struct {
+	Step            Step
+	Total           int
+	Current         int
+	BytesDownloaded int64
+	BytesTotal      int64
+	Message         string
+}
+
+// ErrGenesisHashMismatch is wrapped by Init when the downloaded genesis does
+// not match Options.GenesisHash.
+var ErrGenesisHashMismatch = errors.New("genesis hash mismatch")
+
+// ErrGenesisChainIDMismatch is wrapped by Init when the genesis fetched from
+// Options.GenesisURL is for a different chain than Options.ChainID.
+var ErrGenesisChainIDMismatch = errors.New("genesis chain ID mismatch")
+
+// ParseGenesisHash normalizes an expected genesis hash: 64 hex characters,
+// optionally prefixed with "sha256:". An empty string is returned as is.
+func ParseGenesisHash(s string) (string, error) {
+	h := strings.ToLower(strings.TrimSpace(s))
+	h = strings.TrimPrefix(h, "sha256:")
+	if h == "" {
+		return "", nil
+	}
+	if len(h) != 64 || strings.Trim(h, "0123456789abcdef") != "" {
+		return "", fmt.Errorf("invalid genesis hash %q: want a hex SHA-256 digest (64 characters)", s)
+	}
+	return h, nil
+}
+
+// ParseGenesisURL checks that s is an http://, https:// or file:// URL and
+// returns it trimmed. An empty string is returned as is.
+func ParseGenesisURL(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", nil
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid genesis URL %q: %w", s, err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return "", fmt.Errorf("invalid genesis URL %q: missing host", s)
+		}
+	case "file":
+		if u.Path == "" {
+			return "", fmt.Errorf("invalid genesis URL %q: missing path", s)
+		}
+	default:
+		return "", fmt.Errorf("invalid genesis URL %q: want http://, https:// or file://", s)
+	}
+	return s, nil
 }
 
 // Service bootstraps a new node with snapshot download.
@@ -61,8 +151,9 @@ type svc struct {
 
 // New builds a default service with real http client and runner.
 func New() Service {
+	tr, _ := httpclient.RPCTransport()
 	return &svc{
-		http:     &http.Client{Timeout: 15 * time.Second},
+		http:     &http.Client{Timeout: 15 * time.Second, Transport: tr},
 		run:      defaultRunner{},
 		snapshot: snapshot.New(),
 	}
@@ -88,10 +179,12 @@ func (defaultRunner) Run(ctx context.Context, name string, args ...string) error
 	cmd := exec.CommandContext(ctx, name, args...)
 	cmd.Stdout = io.Discard
 	cmd.Stderr = io.Discard
+	trace.Command(cmd)
 	return cmd.Run()
 }
 
-// Init initializes a new node by downloading a snapshot.
+// Init initializes a new node by downloading a snapshot, or by configuring
+// state sync when opts.StateSync is set.
 func (s *svc) Init(ctx context.Context, opts Options) error {
 	if opts.HomeDir == "" || opts.ChainID == "" {
 		return errors.New("HomeDir and ChainID required")
@@ -105,16 +198,71 @@ func (s *svc) Init(ctx context.Context, opts Options) error {
 	if opts.BinPath == "" {
 		opts.BinPath = "pchaind"
 	}
-	if opts.GenesisDomain == "" {
+	genesisURL, err := ParseGenesisURL(opts.GenesisURL)
+	if err != nil {
+		return err
+	}
+	if opts.GenesisDomain == "" && genesisURL == "" {
 		return errors.New("GenesisDomain required")
 	}
 	if opts.SnapshotURL == "" {
 		opts.SnapshotURL = snapshot.DefaultSnapshotURL
 	}
 
-	progress := opts.Progress
-	if progress == nil {
-		progress = func(string) {} // no-op if not provided
+	for key, value := range opts.NodeSettings {
+		k, ok := files.NodeKeys[key]
+		if !ok {
+			return fmt.Errorf("unknown node setting %q", key)
+		}
+		if err := k.Validate(value); err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+
+	genesisHash, err := ParseGenesisHash(opts.GenesisHash)
+	if err != nil {
+		return err
+	}
+
+	emit := func(ev ProgressEvent) {
+		ev.Total = len(initSteps)
+		for i, st := range initSteps {
+			if st == ev.Step {
+				ev.Current = i + 1
+			}
+		}
+		if opts.Events != nil {
+			opts.Events(ev)
+		}
+		if opts.Progress != nil && ev.Message != "" {
+			opts.Progress(ev.Message)
+		}
+	}
+	step := StepPrepare
+	progress := func(msg string) { emit(ProgressEvent{Step: step, Message: msg}) }
+	base := baseURL(opts.GenesisDomain)
+
+	// State sync replaces the snapshot download when enough RPC servers are
+	// reachable to verify the trusted block; otherwise fall back to the snapshot
+	var stateSync *files.StateSyncParams
+	if opts.StateSync && !snapshot.IsSnapshotPresent(opts.HomeDir) {
+		progress("Finding trusted block for state sync...")
+		params, err := s.stateSyncParams(ctx, opts.ChainID, stateSyncServers(base, opts.StateSyncRPCs))
+		if err != nil {
+			progress(fmt.Sprintf("Warning: %v, falling back to snapshot download", err))
+		} else {
+			stateSync = &params
+		}
+	}
+
+	// Preflight: make sure the snapshot fits before touching the home dir
+	if stateSync == nil && !opts.SkipSnapshot && !opts.SkipDiskCheck && !snapshot.IsSnapshotPresent(opts.HomeDir) {
+		progress("Checking disk space...")
+		if size, err := snapshot.RemoteSize(ctx, s.http, opts.SnapshotURL); err == nil && size > 0 {
+			if err := diskspace.Check(opts.HomeDir, snapshot.Footprint(size)); err != nil {
+				return fmt.Errorf("%w (the snapshot archive is %s; pass --force to skip this check)", err, diskspace.Human(size))
+			}
+		}
 	}
 
 	// Step 1: Ensure base directories
@@ -129,6 +277,7 @@ func (s *svc) Init(ctx context.Context, opts Options) error {
 	// Step 2: Run `pchaind init` if config is missing
 	cfgPath := filepath.Join(opts.HomeDir, "config", "config.toml")
 	if _, err := os.Stat(cfgPath); os.IsNotExist(err) {
+		step = StepInit
 		progress("Running pchaind init...")
 		if err := s.run.Run(ctx, opts.BinPath, "init", opts.Moniker, "--chain-id", opts.ChainID, "--default-denom", opts.Denom, "--home", opts.HomeDir, "--overwrite"); err != nil {
 			return fmt.Errorf("pchaind init: %w", err)
@@ -141,67 +290,145 @@ func (s *svc) Init(ctx context.Context, opts Options) error {
 		}
 	}
 
-	// Step 3: Fetch genesis from remote
-	progress("Fetching genesis from network...")
-	base := baseURL(opts.GenesisDomain)
-	genesisURL := base + "/genesis"
-	gen, err := s.getGenesis(ctx, genesisURL)
+	// Step 3: Fetch genesis from remote, or from --genesis-url
+	step = StepGenesis
+	source := "--genesis-domain"
+	var gen []byte
+	if genesisURL != "" {
+		source = "--genesis-url"
+		progress(fmt.Sprintf("Fetching genesis from %s...", genesisURL))
+		gen, err = s.getGenesisURL(ctx, genesisURL, opts.ChainID)
+	} else {
+		progress("Fetching genesis from network...")
+		gen, err = s.getGenesis(ctx, base+"/genesis")
+	}
 	if err != nil {
 		return fmt.Errorf("fetch genesis: %w", err)
 	}
+	// Check the genesis before writing it; the hash is always shown so
+	// operators can record it
+	sum := checksum.Sum(checksum.SHA256, gen)
+	progress(fmt.Sprintf("Genesis SHA-256: %s", sum))
+	if genesisHash != "" {
+		if sum != genesisHash {
+			progress("Genesis hash mismatch, genesis.json not written")
+			return fmt.Errorf("%w: expected %s, got %s (check %s points at the intended network)", ErrGenesisHashMismatch, genesisHash, sum, source)
+		}
+		progress("Genesis hash verified")
+	} else {
+		progress("Genesis not verified (no genesis hash set)")
+	}
 	genPath := filepath.Join(opts.HomeDir, "config", "genesis.json")
 	if err := os.WriteFile(genPath, gen, 0o644); err != nil {
 		return err
 	}
 
-	// Step 4: Configure persistent peers
-	progress("Configuring persistent peers...")
+	// Step 4: Configure persistent peers, keeping any the operator already set
+	step = StepConfigure
 	cfgs := files.New(opts.HomeDir)
-	if err := cfgs.SetPersistentPeers(fullnodePeers); err != nil {
-		return err
+	if peers, _ := files.GetNodeConfig(opts.HomeDir, "p2p.persistent_peers"); peers == "" {
+		progress("Configuring persistent peers...")
+		if err := cfgs.SetPersistentPeers(fullnodePeers); err != nil {
+			return err
+		}
 	}
 
 	// Step 5: Backup config before modifications
 	progress("Backing up configuration...")
 	_, _ = cfgs.Backup() // best-effort
 
-	// Step 6: Disable state sync (we're using snapshot download instead)
-	progress("Configuring node for snapshot sync...")
-	if err := cfgs.DisableStateSync(); err != nil {
-		return err
+	// Step 6: Enable state sync, or disable it when using snapshot download
+	if stateSync != nil {
+		progress(fmt.Sprintf("Configuring node for state sync (trust height %d)...", stateSync.TrustHeight))
+		if err := cfgs.EnableStateSync(*stateSync); err != nil {
+			return err
+		}
+	} else {
+		progress("Configuring node for snapshot sync...")
+		if err := cfgs.DisableStateSync(); err != nil {
+			return err
+		}
 	}
 
 	// Step 7: Write priv_validator_state.json if missing
-	pvs := filepath.Join(opts.HomeDir, "data", "priv_validator_state.json")
-	if _, err := os.Stat(pvs); os.IsNotExist(err) {
-		if err := os.MkdirAll(filepath.Dir(pvs), 0o755); err != nil {
-			return err
+	if err := files.EnsurePrivValState(opts.HomeDir); err != nil {
+		return err
+	}
+
+	// Step 8: Apply node setting overrides in place so re-running init keeps them
+	if len(opts.NodeSettings) > 0 {
+		progress("Applying node settings...")
+		keys := make([]string, 0, len(opts.NodeSettings))
+		for k := range opts.NodeSettings {
+			keys = append(keys, k)
 		}
-		if err := os.WriteFile(pvs, []byte("{\n  \"height\": \"0\",\n  \"round\": 0,\n  \"step\": 0\n}\n"), 0o644); err != nil {
-			return err
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := files.SetNodeConfig(opts.HomeDir, k, opts.NodeSettings[k]); err != nil {
+				return fmt.Errorf("set %s: %w", k, err)
+			}
 		}
 	}
 
-	// Step 8: Download and extract snapshot (unless skipped or already present)
-	if opts.SkipSnapshot {
+	// Step 9: Download and extract snapshot (unless skipped or already present)
+	step = StepDownload
+	if stateSync != nil {
+		progress("Skipping snapshot download, the node will state sync on start")
+	} else if opts.SkipSnapshot {
 		progress("Skipping snapshot download (handled separately)")
 	} else if snapshot.IsSnapshotPresent(opts.HomeDir) {
 		progress("Snapshot already exists, skipping download")
 	} else {
 		progress("Downloading blockchain snapshot...")
+		// Track whether the snapshot was checked against a published checksum,
+		// either freshly verified or served from a cache that matched it.
+		verified := false
+		snapProgress := func(phase snapshot.ProgressPhase, current, total int64, message string) {
+			if (phase == snapshot.PhaseVerify || phase == snapshot.PhaseCache) && total > 0 && current == total {
+				verified = true
+			}
+			if phase == snapshot.PhaseDownload && total > 0 {
+				emit(ProgressEvent{Step: StepDownload, BytesDownloaded: current, BytesTotal: total})
+			}
+			if opts.SnapshotProgress != nil {
+				opts.SnapshotProgress(phase, current, total, message)
+			}
+		}
 		if err := s.snapshot.Download(ctx, snapshot.Options{
 			SnapshotURL: opts.SnapshotURL,
 			HomeDir:     opts.HomeDir,
-			Progress:    opts.SnapshotProgress,
+			Progress:    snapProgress,
 		}); err != nil {
+			if errors.Is(err, snapshot.ErrChecksumMismatch) {
+				progress("Snapshot checksum mismatch, download discarded")
+				return fmt.Errorf("snapshot is corrupt or truncated, re-run init to download it again: %w", err)
+			}
+			if errors.Is(err, snapshot.ErrDownloadIncomplete) {
+				progress("Snapshot download interrupted, partial download kept")
+				return fmt.Errorf("snapshot download interrupted, re-run init to resume where it stopped: %w", err)
+			}
 			return fmt.Errorf("download snapshot: %w", err)
 		}
+		if verified {
+			progress("Snapshot checksum verified")
+		} else {
+			progress("Warning: snapshot checksum not published, integrity not verified")
+		}
 
+		step = StepExtract
 		progress("Extracting snapshot...")
+		extractProgress := func(phase snapshot.ProgressPhase, current, total int64, message string) {
+			if phase == snapshot.PhaseExtract && total > 0 {
+				emit(ProgressEvent{Step: StepExtract, BytesDownloaded: current, BytesTotal: total})
+			}
+			if opts.SnapshotProgress != nil {
+				opts.SnapshotProgress(phase, current, total, message)
+			}
+		}
 		if err := s.snapshot.Extract(ctx, snapshot.ExtractOptions{
 			HomeDir:   opts.HomeDir,
 			TargetDir: filepath.Join(opts.HomeDir, "data"),
-			Progress:  opts.SnapshotProgress,
+			Progress:  extractProgress,
 		}); err != nil {
 			return fmt.Errorf("extract snapshot: %w", err)
 		}
@@ -240,6 +467,62 @@ func (s *svc) getGenesis(ctx context.Context, url string) ([]byte, error) {
 	return payload.Result.Genesis, nil
 }
 
+// getGenesisURL reads a genesis.json from an http(s):// or file:// URL and
+// checks it is for chainID. An RPC /genesis response is unwrapped too.
+func (s *svc) getGenesisURL(ctx context.Context, rawURL, chainID string) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	if u.Scheme == "file" {
+		data, err = os.ReadFile(u.Path)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.http.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("status %d", resp.StatusCode)
+		}
+		if data, err = io.ReadAll(resp.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	var doc struct {
+		ChainID string `json:"chain_id"`
+		Result  struct {
+			Genesis json.RawMessage `json:"genesis"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s is not a genesis JSON document: %w", rawURL, err)
+	}
+	if len(doc.Result.Genesis) > 0 {
+		data = doc.Result.Genesis
+		doc.ChainID = ""
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("%s is not a genesis JSON document: %w", rawURL, err)
+		}
+	}
+	if doc.ChainID == "" {
+		return nil, fmt.Errorf("%s has no chain_id", rawURL)
+	}
+	if doc.ChainID != chainID {
+		return nil, fmt.Errorf("%w: %s is for %s, not %s (check --chain-id)", ErrGenesisChainIDMismatch, rawURL, doc.ChainID, chainID)
+	}
+	return data, nil
+}
+
 func baseURL(genesisDomain string) string {
 	d := strings.TrimSpace(genesisDomain)
 	if strings.HasPrefix(d, "http://") || strings.HasPrefix(d, "https://") {
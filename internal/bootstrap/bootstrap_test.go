@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -12,6 +13,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/checksum"
+	"github.com/pushchain/push-validator-cli/internal/diskspace"
 	"github.com/pushchain/push-validator-cli/internal/snapshot"
 )
 
@@ -45,6 +48,10 @@ func (fakeSnapshot) IsCacheValid(ctx context.Context, opts snapshot.Options) (bo
 	return true, nil
 }
 
+func (fakeSnapshot) Create(ctx context.Context, opts snapshot.CreateOptions) (*snapshot.CreateResult, error) {
+	return nil, nil
+}
+
 func TestBootstrap_Init_FullFlow(t *testing.T) {
 	// Skip if sandbox disallows binding
 	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
@@ -524,3 +531,388 @@ func TestBaseURL(t *testing.T) {
 		})
 	}
 }
+
+// progressSnapshot emits canned progress events and returns err from Download.
+type progressSnapshot struct {
+	events []snapshot.ProgressPhase
+	err    error
+}
+
+func (p progressSnapshot) Download(ctx context.Context, opts snapshot.Options) error {
+	for _, phase := range p.events {
+		if opts.Progress != nil {
+			opts.Progress(phase, 1, 1, "")
+		}
+	}
+	return p.err
+}
+
+func (progressSnapshot) Extract(ctx context.Context, opts snapshot.ExtractOptions) error {
+	return nil
+}
+
+func (progressSnapshot) IsCacheValid(ctx context.Context, opts snapshot.Options) (bool, error) {
+	return true, nil
+}
+
+func (progressSnapshot) Create(ctx context.Context, opts snapshot.CreateOptions) (*snapshot.CreateResult, error) {
+	return nil, nil
+}
+
+func TestBootstrap_Init_SnapshotVerification(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/genesis", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"genesis":{"chain_id":"push_42101-1"}}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		snap    progressSnapshot
+		wantMsg string
+		wantErr bool
+	}{
+		{"verified", progressSnapshot{events: []snapshot.ProgressPhase{snapshot.PhaseVerify}}, "Snapshot checksum verified", false},
+		{"cached", progressSnapshot{events: []snapshot.ProgressPhase{snapshot.PhaseCache}}, "Snapshot checksum verified", false},
+		{"unverified", progressSnapshot{}, "integrity not verified", false},
+		{"mismatch", progressSnapshot{err: snapshot.ErrChecksumMismatch}, "checksum mismatch", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var msgs []string
+			svc := NewWith(srv.Client(), &fakeRunner{}, tt.snap)
+			err := svc.Init(context.Background(), Options{
+				HomeDir:       t.TempDir(),
+				ChainID:       "push_42101-1",
+				GenesisDomain: srv.URL,
+				Progress:      func(m string) { msgs = append(msgs, m) },
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Init() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), "re-run init") {
+				t.Errorf("error should tell the user to re-run init, got %v", err)
+			}
+			if !strings.Contains(strings.Join(msgs, "\n"), tt.wantMsg) {
+				t.Errorf("progress missing %q: %v", tt.wantMsg, msgs)
+			}
+		})
+	}
+}
+
+func TestBootstrap_Init_Events(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/genesis", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"genesis":{"chain_id":"push_42101-1"}}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	var events []ProgressEvent
+	var msgs []string
+	snap := progressSnapshot{events: []snapshot.ProgressPhase{snapshot.PhaseDownload, snapshot.PhaseVerify}}
+	svc := NewWith(srv.Client(), &fakeRunner{}, snap)
+	if err := svc.Init(context.Background(), Options{
+		HomeDir:       t.TempDir(),
+		ChainID:       "push_42101-1",
+		GenesisDomain: srv.URL,
+		Events:        func(ev ProgressEvent) { events = append(events, ev) },
+		Progress:      func(m string) { msgs = append(msgs, m) },
+	}); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+
+	var derived []string
+	var steps []Step
+	sawBytes := false
+	for _, ev := range events {
+		if ev.Total != len(initSteps) || ev.Current < 1 || ev.Current > ev.Total || initSteps[ev.Current-1] != ev.Step {
+			t.Errorf("event %+v has inconsistent step position", ev)
+		}
+		if ev.Message != "" {
+			derived = append(derived, ev.Message)
+		}
+		if ev.BytesTotal > 0 {
+			sawBytes = sawBytes || ev.Step == StepDownload
+		}
+		if len(steps) == 0 || steps[len(steps)-1] != ev.Step {
+			steps = append(steps, ev.Step)
+		}
+	}
+	if strings.Join(derived, "\n") != strings.Join(msgs, "\n") {
+		t.Errorf("Progress messages %v, want event messages %v", msgs, derived)
+	}
+	want := []Step{StepPrepare, StepInit, StepGenesis, StepConfigure, StepDownload, StepExtract}
+	if len(steps) != len(want) {
+		t.Fatalf("steps = %v, want %v", steps, want)
+	}
+	for i := range want {
+		if steps[i] != want[i] {
+			t.Fatalf("steps = %v, want %v", steps, want)
+		}
+	}
+	if !sawBytes {
+		t.Error("no byte progress event for the snapshot download")
+	}
+}
+
+func TestBootstrap_Init_GenesisHash(t *testing.T) {
+	genesis := `{"chain_id":"push_42101-1"}`
+	mux := http.NewServeMux()
+	mux.HandleFunc("/genesis", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"genesis":` + genesis + `}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	sum := checksum.Sum(checksum.SHA256, []byte(genesis))
+
+	tests := []struct {
+		name    string
+		hash    string
+		wantMsg string
+		wantErr bool
+	}{
+		{"matching", sum, "Genesis hash verified", false},
+		{"matching with prefix and case", "sha256:" + strings.ToUpper(sum), "Genesis hash verified", false},
+		{"unset", "", "Genesis not verified", false},
+		{"mismatch", strings.Repeat("0", 64), "Genesis hash mismatch", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var msgs []string
+			home := t.TempDir()
+			svc := NewWith(srv.Client(), &fakeRunner{}, fakeSnapshot{})
+			err := svc.Init(context.Background(), Options{
+				HomeDir:       home,
+				ChainID:       "push_42101-1",
+				GenesisDomain: srv.URL,
+				SkipSnapshot:  true,
+				GenesisHash:   tt.hash,
+				Progress:      func(m string) { msgs = append(msgs, m) },
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Init() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			joined := strings.Join(msgs, "\n")
+			if !strings.Contains(joined, "Genesis SHA-256: "+sum) {
+				t.Errorf("computed hash not shown: %v", msgs)
+			}
+			if !strings.Contains(joined, tt.wantMsg) {
+				t.Errorf("progress missing %q: %v", tt.wantMsg, msgs)
+			}
+			_, statErr := os.Stat(filepath.Join(home, "config", "genesis.json"))
+			if tt.wantErr {
+				if !errors.Is(err, ErrGenesisHashMismatch) {
+					t.Errorf("error = %v, want ErrGenesisHashMismatch", err)
+				}
+				if statErr == nil {
+					t.Error("genesis.json written despite hash mismatch")
+				}
+			} else if statErr != nil {
+				t.Errorf("genesis.json not written: %v", statErr)
+			}
+		})
+	}
+}
+
+func TestParseGenesisHash(t *testing.T) {
+	valid := strings.Repeat("ab", 32)
+	for in, want := range map[string]string{"": "", valid: valid, " SHA256:" + strings.ToUpper(valid) + " ": valid} {
+		if got, err := ParseGenesisHash(in); err != nil || got != want {
+			t.Errorf("ParseGenesisHash(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"abc", strings.Repeat("zz", 32), valid + "00"} {
+		if _, err := ParseGenesisHash(in); err == nil {
+			t.Errorf("ParseGenesisHash(%q) expected error", in)
+		}
+	}
+}
+
+func TestBootstrap_Init_InsufficientDiskSpace(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("binding disabled in sandbox")
+	} else {
+		ln.Close()
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead && r.URL.Path == "/latest.tar.lz4" {
+			w.Header().Set("Content-Length", "1073741824") // 1 GiB archive
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	origFree := diskspace.Free
+	t.Cleanup(func() { diskspace.Free = origFree })
+	diskspace.Free = func(string) (int64, error) { return 2 << 30, nil }
+
+	home := filepath.Join(t.TempDir(), "home")
+	opts := Options{
+		HomeDir:       home,
+		ChainID:       "push_42101-1",
+		GenesisDomain: srv.URL,
+		SnapshotURL:   srv.URL,
+	}
+	err := NewWith(srv.Client(), &fakeRunner{}, fakeSnapshot{}).Init(context.Background(), opts)
+	if !errors.Is(err, diskspace.ErrInsufficient) {
+		t.Fatalf("expected insufficient disk space error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "--force") {
+		t.Errorf("error should mention --force: %v", err)
+	}
+	if _, statErr := os.Stat(home); !os.IsNotExist(statErr) {
+		t.Errorf("home dir should not be created when the preflight fails")
+	}
+
+	// SkipDiskCheck gets past the preflight (and fails later on the missing genesis)
+	opts.SkipDiskCheck = true
+	err = NewWith(srv.Client(), &fakeRunner{}, fakeSnapshot{}).Init(context.Background(), opts)
+	if errors.Is(err, diskspace.ErrInsufficient) {
+		t.Fatalf("SkipDiskCheck should bypass the preflight, got %v", err)
+	}
+}
+
+func TestBootstrap_Init_NodeSettings(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("binding disabled in sandbox")
+	} else {
+		ln.Close()
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/genesis", func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]any{"result": map[string]any{"genesis": map[string]any{"chain_id": "push_42101-1"}}}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	home := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, "config", "app.toml"), []byte("pruning = \"default\"\n\n[api]\nenable = false\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	svc := NewWith(srv.Client(), &fakeRunner{}, fakeSnapshot{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	opts := Options{
+		HomeDir:       home,
+		ChainID:       "push_42101-1",
+		GenesisDomain: srv.URL,
+		SkipSnapshot:  true,
+		NodeSettings:  map[string]string{"pruning": "nothing", "tx_index.indexer": "null"},
+	}
+	if err := svc.Init(ctx, opts); err != nil {
+		t.Fatalf("init error: %v", err)
+	}
+	app, _ := os.ReadFile(filepath.Join(home, "config", "app.toml"))
+	cfg, _ := os.ReadFile(filepath.Join(home, "config", "config.toml"))
+	if !strings.Contains(string(app), `pruning = 'nothing'`) || !strings.Contains(string(cfg), `indexer = 'null'`) {
+		t.Fatalf("node settings not applied:\napp.toml: %s\nconfig.toml: %s", app, cfg)
+	}
+
+	// Invalid settings are rejected before anything is written
+	opts.HomeDir = t.TempDir()
+	opts.NodeSettings = map[string]string{"pruning": "sometimes"}
+	if err := svc.Init(ctx, opts); err == nil {
+		t.Fatal("expected error for invalid pruning")
+	}
+	if _, err := os.Stat(filepath.Join(opts.HomeDir, "config")); !os.IsNotExist(err) {
+		t.Error("home should be untouched after validation failure")
+	}
+}
+
+func TestBootstrap_Init_GenesisURL(t *testing.T) {
+	genesis := `{"chain_id":"push_42101-1","initial_height":"1"}`
+	mux := http.NewServeMux()
+	mux.HandleFunc("/custom/genesis.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(genesis))
+	})
+	mux.HandleFunc("/rpc/genesis", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"genesis":` + genesis + `}}`))
+	})
+	mux.HandleFunc("/genesis", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("genesis domain should not be used when GenesisURL is set")
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "genesis.json")
+	if err := os.WriteFile(file, []byte(genesis), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	other := filepath.Join(dir, "other.json")
+	if err := os.WriteFile(other, []byte(`{"chain_id":"localnet-1"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	notJSON := filepath.Join(dir, "genesis.txt")
+	if err := os.WriteFile(notJSON, []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sum := checksum.Sum(checksum.SHA256, []byte(genesis))
+
+	tests := []struct {
+		name     string
+		url      string
+		hash     string
+		wantErr  error
+		wantFail bool
+	}{
+		{"http", srv.URL + "/custom/genesis.json", sum, nil, false},
+		{"rpc response", srv.URL + "/rpc/genesis", "", nil, false},
+		{"file", "file://" + file, sum, nil, false},
+		{"chain id mismatch", "file://" + other, "", ErrGenesisChainIDMismatch, true},
+		{"hash mismatch", "file://" + file, strings.Repeat("0", 64), ErrGenesisHashMismatch, true},
+		{"not json", "file://" + notJSON, "", nil, true},
+		{"missing file", "file://" + filepath.Join(dir, "missing.json"), "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			svc := NewWith(srv.Client(), &fakeRunner{}, fakeSnapshot{})
+			err := svc.Init(context.Background(), Options{
+				HomeDir:       home,
+				ChainID:       "push_42101-1",
+				GenesisDomain: srv.URL,
+				GenesisURL:    tt.url,
+				GenesisHash:   tt.hash,
+				SkipSnapshot:  true,
+			})
+			if (err != nil) != tt.wantFail {
+				t.Fatalf("Init() error = %v, wantFail %v", err, tt.wantFail)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			data, readErr := os.ReadFile(filepath.Join(home, "config", "genesis.json"))
+			if tt.wantFail {
+				if readErr == nil {
+					t.Error("genesis.json written despite failure")
+				}
+			} else if string(data) != genesis {
+				t.Errorf("genesis.json = %q, want %q", data, genesis)
+			}
+		})
+	}
+}
+
+func TestParseGenesisURL(t *testing.T) {
+	for _, in := range []string{"", "https://example.com/genesis.json", " http://10.0.0.1:8000/g ", "file:///srv/genesis.json"} {
+		if _, err := ParseGenesisURL(in); err != nil {
+			t.Errorf("ParseGenesisURL(%q) error: %v", in, err)
+		}
+	}
+	for _, in := range []string{"/srv/genesis.json", "ftp://example.com/genesis.json", "https://", "file://"} {
+		if _, err := ParseGenesisURL(in); err == nil {
+			t.Errorf("ParseGenesisURL(%q) expected error", in)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/files"
+)
+
+// stateSyncTrustOffset is how far below the tip the trusted block is taken.
+// It must be old enough to be covered by the RPC servers' snapshots yet well
+// inside the trust period.
+const stateSyncTrustOffset = 2000
+
+// minStateSyncServers is the number of RPC servers CometBFT requires for
+// light client verification.
+const minStateSyncServers = 2
+
+// stateSyncServers returns the RPC servers to try for state sync: the explicit
+// list when set, otherwise the genesis RPC plus the fullnode peers, assumed to
+// serve RPC on the default port. stateSyncParams drops any that don't answer
+// or are on another chain.
+func stateSyncServers(base string, explicit []string) []string {
+	if len(explicit) > 0 {
+		return explicit
+	}
+	servers := []string{base}
+	for _, p := range fullnodePeers {
+		_, addr, ok := strings.Cut(p, "@")
+		if !ok {
+			continue
+		}
+		host, _, _ := strings.Cut(addr, ":")
+		servers = append(servers, fmt.Sprintf("http://%s:%d", host, config.DefaultRPCPort))
+	}
+	return servers
+}
+
+// stateSyncParams probes servers and picks a trusted height and hash from the
+// first one that answers. Only servers on chainID count, and it fails unless
+// at least minStateSyncServers of them answer.
+func (s *svc) stateSyncParams(ctx context.Context, chainID string, servers []string) (files.StateSyncParams, error) {
+	var (
+		reachable []string
+		latest    int64
+	)
+	for _, srv := range servers {
+		srv = strings.TrimRight(strings.TrimSpace(srv), "/")
+		if srv == "" {
+			continue
+		}
+		h, network, err := s.nodeStatus(ctx, srv)
+		if err != nil || network != chainID {
+			continue
+		}
+		if len(reachable) == 0 {
+			latest = h
+		}
+		reachable = append(reachable, srv)
+	}
+	if len(reachable) < minStateSyncServers {
+		return files.StateSyncParams{}, fmt.Errorf("state sync needs at least %d reachable RPC servers on %s, found %d", minStateSyncServers, chainID, len(reachable))
+	}
+
+	trustHeight := latest - stateSyncTrustOffset
+	if trustHeight <= 0 {
+		return files.StateSyncParams{}, fmt.Errorf("chain height %d is too low for state sync", latest)
+	}
+	hash, err := s.blockHash(ctx, reachable[0], trustHeight)
+	if err != nil {
+		return files.StateSyncParams{}, fmt.Errorf("fetch trust hash at height %d: %w", trustHeight, err)
+	}
+	return files.StateSyncParams{
+		TrustHeight: trustHeight,
+		TrustHash:   hash,
+		RPCServers:  reachable,
+	}, nil
+}
+
+// nodeStatus returns the latest block height and chain ID reported by srv's
+// /status.
+func (s *svc) nodeStatus(ctx context.Context, srv string) (int64, string, error) {
+	var payload struct {
+		Result struct {
+			NodeInfo struct {
+				Network string `json:"network"`
+			} `json:"node_info"`
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := s.getJSON(ctx, srv+"/status", &payload); err != nil {
+		return 0, "", err
+	}
+	h, err := strconv.ParseInt(payload.Result.SyncInfo.LatestBlockHeight, 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+	return h, payload.Result.NodeInfo.Network, nil
+}
+
+// blockHash returns the block ID hash at height from srv's /block.
+func (s *svc) blockHash(ctx context.Context, srv string, height int64) (string, error) {
+	var payload struct {
+		Result struct {
+			BlockID struct {
+				Hash string `json:"hash"`
+			} `json:"block_id"`
+		} `json:"result"`
+	}
+	if err := s.getJSON(ctx, fmt.Sprintf("%s/block?height=%d", srv, height), &payload); err != nil {
+		return "", err
+	}
+	if payload.Result.BlockID.Hash == "" {
+		return "", fmt.Errorf("empty block hash")
+	}
+	return payload.Result.BlockID.Hash, nil
+}
+
+func (s *svc) getJSON(ctx context.Context, url string, v any) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
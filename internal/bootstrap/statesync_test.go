@@ -0,0 +1,136 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newStateSyncServer serves /genesis, /status for network at height 10000
+// and /block.
+func newStateSyncServer(t *testing.T, network string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/genesis", func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]any{"result": map[string]any{"genesis": map[string]any{"chain_id": "push_42101-1"}}}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"result":{"node_info":{"network":%q},"sync_info":{"latest_block_height":"10000"}}}`, network)
+	})
+	mux.HandleFunc("/block", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"result":{"block_id":{"hash":"HASH%s"}}}`, r.URL.Query().Get("height"))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestBootstrap_Init_StateSync(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("binding disabled in sandbox")
+	} else {
+		ln.Close()
+	}
+	srv := newStateSyncServer(t, "push_42101-1")
+	other := newStateSyncServer(t, "push_42101-1")
+
+	home := t.TempDir()
+	var msgs []string
+	svc := NewWith(srv.Client(), &fakeRunner{}, fakeSnapshot{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := svc.Init(ctx, Options{
+		HomeDir:       home,
+		ChainID:       "push_42101-1",
+		GenesisDomain: srv.URL,
+		SnapshotURL:   srv.URL,
+		StateSync:     true,
+		StateSyncRPCs: []string{srv.URL, other.URL},
+		Progress:      func(m string) { msgs = append(msgs, m) },
+	})
+	if err != nil {
+		t.Fatalf("init error: %v", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(home, "config", "config.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"enable = true",
+		"trust_height = 8000",
+		`trust_hash = "HASH8000"`,
+		fmt.Sprintf(`rpc_servers = "%s,%s"`, srv.URL, other.URL),
+		"trust_period = ",
+	}
+	if s := string(b); !containsAll(s, want) {
+		t.Fatalf("statesync not configured: %s", s)
+	}
+	if _, err := os.Stat(filepath.Join(home, "data", ".snapshot_extracted")); !os.IsNotExist(err) {
+		t.Error("snapshot should not be downloaded when state sync is configured")
+	}
+	if joined := strings.Join(msgs, "\n"); strings.Contains(joined, "Warning") {
+		t.Errorf("unexpected warning: %s", joined)
+	}
+}
+
+func TestBootstrap_Init_StateSyncFallsBackToSnapshot(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("binding disabled in sandbox")
+	} else {
+		ln.Close()
+	}
+	srv := newStateSyncServer(t, "push_42101-1")
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	otherChain := newStateSyncServer(t, "other_1-1")
+
+	home := t.TempDir()
+	var msgs []string
+	svc := NewWith(srv.Client(), &fakeRunner{}, fakeSnapshot{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := svc.Init(ctx, Options{
+		HomeDir:       home,
+		ChainID:       "push_42101-1",
+		GenesisDomain: srv.URL,
+		SnapshotURL:   srv.URL,
+		StateSync:     true,
+		StateSyncRPCs: []string{srv.URL, down.URL, otherChain.URL},
+		Progress:      func(m string) { msgs = append(msgs, m) },
+	})
+	if err != nil {
+		t.Fatalf("init error: %v", err)
+	}
+
+	joined := strings.Join(msgs, "\n")
+	if !strings.Contains(joined, "at least 2 reachable RPC servers on push_42101-1, found 1") || !strings.Contains(joined, "falling back to snapshot download") {
+		t.Errorf("expected fallback warning, got: %s", joined)
+	}
+	b, _ := os.ReadFile(filepath.Join(home, "config", "config.toml"))
+	if !strings.Contains(string(b), "enable = false") {
+		t.Errorf("statesync should stay disabled on fallback: %s", b)
+	}
+	if _, err := os.Stat(filepath.Join(home, "data", ".snapshot_extracted")); err != nil {
+		t.Errorf("snapshot not downloaded on fallback: %v", err)
+	}
+}
+
+func TestStateSyncServers_Default(t *testing.T) {
+	got := stateSyncServers("https://rpc.example", nil)
+	if len(got) != 1+len(fullnodePeers) || got[0] != "https://rpc.example" || got[1] != "http://136.112.142.137:26657" {
+		t.Fatalf("stateSyncServers() = %v", got)
+	}
+	if got := stateSyncServers("https://rpc.example", []string{"a", "b"}); len(got) != 2 || got[0] != "a" {
+		t.Fatalf("explicit servers not used: %v", got)
+	}
+}
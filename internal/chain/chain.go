@@ -5,17 +5,25 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
-	"crypto/sha256"
-	"encoding/hex"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/checksum"
+	"github.com/pushchain/push-validator-cli/internal/githubapi"
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
+	"github.com/pushchain/push-validator-cli/internal/lockfile"
+	"github.com/pushchain/push-validator-cli/internal/trace"
 )
 
 const (
@@ -25,11 +33,29 @@ const (
 	latestReleaseURL = "https://api.github.com/repos/pushchain/push-chain-node/releases/latest"
 	releaseByTagURL  = "https://api.github.com/repos/pushchain/push-chain-node/releases/tags/%s"
 
-	httpTimeout = 30 * time.Second
+	// downloadTimeout bounds a whole archive download; API calls use the
+	// shared client's (shorter) timeout
+	downloadTimeout = 30 * time.Minute
 )
 
-// httpClient can be overridden for testing
-var httpClient = &http.Client{Timeout: httpTimeout}
+// httpClient overrides the shared client for API calls and downloads in tests
+var httpClient *http.Client
+
+// apiClient returns the client for GitHub API calls.
+func apiClient() (*http.Client, error) {
+	if httpClient != nil {
+		return httpClient, nil
+	}
+	return httpclient.Default()
+}
+
+// downloadClient returns the client for release asset downloads.
+func downloadClient() (*http.Client, error) {
+	if httpClient != nil {
+		return httpClient, nil
+	}
+	return httpclient.WithTimeout(downloadTimeout)
+}
 
 // Release represents a GitHub release
 type Release struct {
@@ -54,6 +80,71 @@ type ProgressFunc func(downloaded, total int64)
 // Installer handles downloading and installing pchaind
 type Installer struct {
 	HomeDir string // e.g., ~/.pchain
+	// SidecarLibs are glob patterns for shared libraries in the archive that
+	// are installed next to pchaind. DefaultSidecarLibs when nil.
+	SidecarLibs []string
+}
+
+// DefaultSidecarLibs matches the wasmvm library release archives bundle:
+// libwasmvm.dylib on macOS, libwasmvm.x86_64.so or libwasmvm.aarch64.so on
+// Linux.
+var DefaultSidecarLibs = []string{"libwasmvm.*"}
+
+// IsSidecarLib reports whether the base name matches one of patterns.
+func IsSidecarLib(name string, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// libraryPathVars are the loader search path variables set for pchaind.
+// Each platform reads only its own, so setting both is harmless.
+var libraryPathVars = []string{"DYLD_LIBRARY_PATH", "LD_LIBRARY_PATH"}
+
+// WithLibraryPath returns env with dirs prepended to the dynamic loader's
+// search path, so pchaind finds sidecar libraries installed next to it.
+func WithLibraryPath(env []string, dirs ...string) []string {
+	if len(dirs) == 0 {
+		return env
+	}
+	out := make([]string, 0, len(env)+len(libraryPathVars))
+	existing := map[string]string{}
+	for _, kv := range env {
+		k, v, _ := strings.Cut(kv, "=")
+		if slices.Contains(libraryPathVars, k) {
+			existing[k] = v
+			continue
+		}
+		out = append(out, kv)
+	}
+	for _, k := range libraryPathVars {
+		v := strings.Join(dirs, string(os.PathListSeparator))
+		if existing[k] != "" {
+			v += string(os.PathListSeparator) + existing[k]
+		}
+		out = append(out, k+"="+v)
+	}
+	return out
+}
+
+// LibraryDirs are the directories searched for sidecar libraries when
+// running the pchaind at binPath: its own directory, then the default
+// cosmovisor bin directories.
+func LibraryDirs(binPath string) []string {
+	var dirs []string
+	if binDir := filepath.Dir(binPath); binDir != "" && binDir != "." {
+		dirs = append(dirs, binDir)
+	}
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs,
+			filepath.Join(homeDir, ".pchain/cosmovisor/genesis/bin"),
+			filepath.Join(homeDir, ".pchain/cosmovisor/current/bin"),
+		)
+	}
+	return dirs
 }
 
 // NewInstaller creates a new chain installer
@@ -63,25 +154,18 @@ func NewInstaller(homeDir string) *Installer {
 
 // FetchLatestRelease gets the latest release from GitHub
 func FetchLatestRelease() (*Release, error) {
-	req, err := http.NewRequest("GET", latestReleaseURL, nil)
+	client, err := apiClient()
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	req.Header.Set("User-Agent", "push-validator-cli")
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch release: %w", err)
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode == http.StatusNotFound {
+	resp, err := githubapi.Get(client, latestReleaseURL)
+	if errors.Is(err, githubapi.ErrNotFound) {
 		return nil, fmt.Errorf("no releases found")
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error: %s", resp.Status)
+	if err != nil {
+		return nil, err
 	}
+	defer func() { _ = resp.Body.Close() }()
 
 	var release Release
 	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
@@ -98,27 +182,18 @@ func FetchReleaseByTag(tag string) (*Release, error) {
 		tag = "v" + tag
 	}
 
-	url := fmt.Sprintf(releaseByTagURL, tag)
-
-	req, err := http.NewRequest("GET", url, nil)
+	client, err := apiClient()
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	req.Header.Set("User-Agent", "push-validator-cli")
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch release: %w", err)
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode == http.StatusNotFound {
+	resp, err := githubapi.Get(client, fmt.Sprintf(releaseByTagURL, tag))
+	if errors.Is(err, githubapi.ErrNotFound) {
 		return nil, fmt.Errorf("release %s not found", tag)
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error: %s", resp.Status)
+	if err != nil {
+		return nil, err
 	}
+	defer func() { _ = resp.Body.Close() }()
 
 	var release Release
 	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
@@ -130,9 +205,12 @@ func FetchReleaseByTag(tag string) (*Release, error) {
 
 // GetAssetForPlatform finds the correct binary for current OS/arch
 func GetAssetForPlatform(release *Release) (*Asset, error) {
-	osName := runtime.GOOS
-	arch := runtime.GOARCH
+	return GetAssetForPlatformTarget(release, runtime.GOOS, runtime.GOARCH)
+}
 
+// GetAssetForPlatformTarget finds the binary for the given OS/arch, e.g. to
+// stage an arm64 build from an amd64 host
+func GetAssetForPlatformTarget(release *Release, osName, arch string) (*Asset, error) {
 	// Expected format: push-chain_0.0.2_darwin_arm64.tar.gz
 	suffix := fmt.Sprintf("_%s_%s.tar.gz", osName, arch)
 
@@ -146,13 +224,15 @@ func GetAssetForPlatform(release *Release) (*Asset, error) {
 	return nil, fmt.Errorf("no binary found for %s/%s in release %s", osName, arch, release.TagName)
 }
 
-// GetChecksumAsset finds the checksum asset for a specific file
+// GetChecksumAsset finds the checksum asset for a specific file, preferring
+// <asset>.sha256 over <asset>.sha512
 func GetChecksumAsset(release *Release, assetName string) (*Asset, error) {
-	checksumName := assetName + ".sha256"
-	for i := range release.Assets {
-		asset := &release.Assets[i]
-		if asset.Name == checksumName {
-			return asset, nil
+	for _, ext := range []string{".sha256", ".sha512"} {
+		for i := range release.Assets {
+			asset := &release.Assets[i]
+			if asset.Name == assetName+ext {
+				return asset, nil
+			}
 		}
 	}
 	return nil, fmt.Errorf("checksum file not found for %s", assetName)
@@ -160,7 +240,11 @@ func GetChecksumAsset(release *Release, assetName string) (*Asset, error) {
 
 // Download fetches the binary archive with progress
 func (inst *Installer) Download(asset *Asset, progress ProgressFunc) ([]byte, error) {
-	resp, err := http.Get(asset.BrowserDownloadURL)
+	client, err := downloadClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(asset.BrowserDownloadURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download: %w", err)
 	}
@@ -170,10 +254,10 @@ func (inst *Installer) Download(asset *Asset, progress ProgressFunc) ([]byte, er
 		return nil, fmt.Errorf("download failed: %s", resp.Status)
 	}
 
-	var reader io.Reader = resp.Body
+	reader := httpclient.Throttle(context.Background(), resp.Body)
 	if progress != nil {
 		reader = &progressReader{
-			reader:   resp.Body,
+			reader:   reader,
 			total:    resp.ContentLength,
 			progress: progress,
 		}
@@ -217,7 +301,11 @@ func (inst *Installer) VerifyChecksum(data []byte, release *Release, assetName s
 	}
 
 	// Download checksum file
-	resp, err := http.Get(checksumAsset.BrowserDownloadURL)
+	client, err := apiClient()
+	if err != nil {
+		return false, err
+	}
+	resp, err := client.Get(checksumAsset.BrowserDownloadURL)
 	if err != nil {
 		return false, fmt.Errorf("failed to download checksum: %w", err)
 	}
@@ -228,7 +316,7 @@ func (inst *Installer) VerifyChecksum(data []byte, release *Release, assetName s
 		return false, nil
 	}
 
-	// Parse checksum file (format: "sha256  filename" or just "sha256")
+	// Parse checksum file (format: "<hex digest>  filename" or just the digest)
 	var expectedHash string
 	scanner := bufio.NewScanner(resp.Body)
 	for scanner.Scan() {
@@ -247,19 +335,30 @@ func (inst *Installer) VerifyChecksum(data []byte, release *Release, assetName s
 		return false, fmt.Errorf("could not parse checksum file")
 	}
 
-	// Calculate actual hash
-	hash := sha256.Sum256(data)
-	actualHash := hex.EncodeToString(hash[:])
-
-	if actualHash != expectedHash {
-		return false, fmt.Errorf("checksum mismatch: expected %s, got %s", expectedHash, actualHash)
+	// SHA-256 or SHA-512, from the checksum file's suffix or digest length
+	if err := checksum.Verify(data, checksumAsset.Name, expectedHash); err != nil {
+		return false, err
 	}
 
 	return true, nil
 }
 
-// ExtractAndInstall extracts the binary and installs to cosmovisor directory
+// ErrInstallInProgress is returned by ExtractAndInstall when another process
+// holds the update lock in the home directory.
+var ErrInstallInProgress = errors.New("another update is in progress")
+
+// ExtractAndInstall extracts the binary and installs to cosmovisor directory.
+// The update lock in HomeDir is held while files are written.
 func (inst *Installer) ExtractAndInstall(archiveData []byte) (string, error) {
+	lock, err := lockfile.TryAcquire(filepath.Join(inst.HomeDir, lockfile.UpdateName))
+	if errors.Is(err, lockfile.ErrLocked) {
+		return "", ErrInstallInProgress
+	}
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = lock.Release() }()
+
 	gzReader, err := gzip.NewReader(bytes.NewReader(archiveData))
 	if err != nil {
 		return "", fmt.Errorf("failed to create gzip reader: %w", err)
@@ -280,8 +379,11 @@ func (inst *Installer) ExtractAndInstall(archiveData []byte) (string, error) {
 		return "", fmt.Errorf("failed to create upgrades directory: %w", err)
 	}
 
+	libs := inst.SidecarLibs
+	if libs == nil {
+		libs = DefaultSidecarLibs
+	}
 	var pchaindPath string
-	var wasmLibPath string
 
 	for {
 		header, err := tarReader.Next()
@@ -307,13 +409,12 @@ func (inst *Installer) ExtractAndInstall(archiveData []byte) (string, error) {
 			pchaindPath = destPath
 		}
 
-		// Extract libwasmvm.dylib if present (required on macOS)
-		if baseName == "libwasmvm.dylib" {
-			destPath := filepath.Join(cosmovisorBin, "libwasmvm.dylib")
+		// Shared libraries pchaind links against, e.g. libwasmvm
+		if IsSidecarLib(baseName, libs) {
+			destPath := filepath.Join(cosmovisorBin, baseName)
 			if err := extractFile(tarReader, destPath, 0o644); err != nil {
-				return "", fmt.Errorf("failed to extract libwasmvm: %w", err)
+				return "", fmt.Errorf("failed to extract %s: %w", baseName, err)
 			}
-			wasmLibPath = destPath
 		}
 	}
 
@@ -321,8 +422,6 @@ func (inst *Installer) ExtractAndInstall(archiveData []byte) (string, error) {
 		return "", fmt.Errorf("pchaind binary not found in archive")
 	}
 
-	_ = wasmLibPath // Used but not returned
-
 	return pchaindPath, nil
 }
 
@@ -341,14 +440,30 @@ func extractFile(reader io.Reader, destPath string, mode os.FileMode) error {
 	return err
 }
 
-// GetInstalledVersion returns the version of currently installed pchaind
+// GetInstalledVersion returns the version of the pchaind installed in the
+// cosmovisor genesis bin, "installed" if it exists but won't report a
+// version, or "" if it is missing.
 func (inst *Installer) GetInstalledVersion() string {
 	binPath := filepath.Join(inst.HomeDir, "cosmovisor", "genesis", "bin", "pchaind")
 	if _, err := os.Stat(binPath); os.IsNotExist(err) {
 		return ""
 	}
-
-	// Try to run pchaind version
-	// This would require exec, but for simplicity we just check existence
+	if v, err := BinaryVersion(binPath); err == nil && v != "" {
+		return v
+	}
 	return "installed"
 }
+
+// BinaryVersion runs `<path> version` and returns its trimmed output.
+func BinaryVersion(path string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, path, "version")
+	cmd.Env = WithLibraryPath(os.Environ(), LibraryDirs(path)...)
+	trace.Command(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
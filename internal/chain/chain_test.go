@@ -5,8 +5,10 @@ import (
 	"bytes"
 	"compress/gzip"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -15,8 +17,17 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/githubapi"
+	"github.com/pushchain/push-validator-cli/internal/lockfile"
 )
 
+func TestMain(m *testing.M) {
+	// Retries against mock servers should not wait out the real backoff
+	githubapi.BaseDelay = 0
+	os.Exit(m.Run())
+}
+
 func TestNewInstaller(t *testing.T) {
 	homeDir := "/test/home"
 	installer := NewInstaller(homeDir)
@@ -26,6 +37,27 @@ func TestNewInstaller(t *testing.T) {
 	}
 }
 
+func TestGetAssetForPlatformTarget(t *testing.T) {
+	release := &Release{
+		TagName: "v1.0.0",
+		Assets: []Asset{
+			{Name: "push-chain_1.0.0_linux_amd64.tar.gz"},
+			{Name: "push-chain_1.0.0_linux_arm64.tar.gz"},
+			{Name: "push-chain_1.0.0_linux_arm64.tar.gz.sha256"},
+		},
+	}
+	asset, err := GetAssetForPlatformTarget(release, "linux", "arm64")
+	if err != nil {
+		t.Fatalf("GetAssetForPlatformTarget() error = %v", err)
+	}
+	if asset.Name != "push-chain_1.0.0_linux_arm64.tar.gz" {
+		t.Errorf("asset = %s, want linux_arm64 archive", asset.Name)
+	}
+	if _, err := GetAssetForPlatformTarget(release, "darwin", "arm64"); err == nil || !strings.Contains(err.Error(), "darwin/arm64") {
+		t.Errorf("expected error naming darwin/arm64, got %v", err)
+	}
+}
+
 func TestGetAssetForPlatform(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -188,6 +220,29 @@ func TestGetChecksumAsset(t *testing.T) {
 			expectError: false,
 			expectName:  "binary2.tar.gz.sha256",
 		},
+		{
+			name: "sha512 only",
+			release: &Release{
+				Assets: []Asset{
+					{Name: "binary.tar.gz.sha512", BrowserDownloadURL: "https://example.com/binary.tar.gz.sha512"},
+				},
+			},
+			assetName:   "binary.tar.gz",
+			expectError: false,
+			expectName:  "binary.tar.gz.sha512",
+		},
+		{
+			name: "sha256 preferred over sha512",
+			release: &Release{
+				Assets: []Asset{
+					{Name: "binary.tar.gz.sha512", BrowserDownloadURL: "https://example.com/binary.tar.gz.sha512"},
+					{Name: "binary.tar.gz.sha256", BrowserDownloadURL: "https://example.com/binary.tar.gz.sha256"},
+				},
+			},
+			assetName:   "binary.tar.gz",
+			expectError: false,
+			expectName:  "binary.tar.gz.sha256",
+		},
 		{
 			name: "empty assets",
 			release: &Release{
@@ -455,6 +510,44 @@ func TestVerifyChecksum(t *testing.T) {
 	}
 }
 
+func TestVerifyChecksum_SHA512(t *testing.T) {
+	testData := []byte("test binary content")
+	sum := sha512.Sum512(testData)
+	correct := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name        string
+		content     string
+		expectError bool
+	}{
+		{"sha512 matches", correct + "  binary.tar.gz\n", false},
+		{"sha512 mismatch", strings.Repeat("0", 128) + "  binary.tar.gz\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.content))
+			}))
+			defer server.Close()
+
+			release := &Release{Assets: []Asset{
+				{Name: "binary.tar.gz"},
+				{Name: "binary.tar.gz.sha512", BrowserDownloadURL: server.URL},
+			}}
+			verified, err := NewInstaller(t.TempDir()).VerifyChecksum(testData, release, "binary.tar.gz")
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+					t.Fatalf("expected checksum mismatch, got %v", err)
+				}
+				return
+			}
+			if err != nil || !verified {
+				t.Fatalf("VerifyChecksum() = %v, %v; want verified", verified, err)
+			}
+		})
+	}
+}
+
 func TestExtractAndInstall(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -652,6 +745,23 @@ func TestGetInstalledVersion(t *testing.T) {
 	}
 }
 
+func TestGetInstalledVersion_RunsBinary(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the fake binary")
+	}
+	homeDir := t.TempDir()
+	binPath := filepath.Join(homeDir, "cosmovisor", "genesis", "bin", "pchaind")
+	if err := os.MkdirAll(filepath.Dir(binPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(binPath, []byte("#!/bin/sh\necho 1.2.3\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if v := NewInstaller(homeDir).GetInstalledVersion(); v != "1.2.3" {
+		t.Errorf("GetInstalledVersion() = %q, want 1.2.3", v)
+	}
+}
+
 // Helper function to create a tar.gz archive with given files
 func createTarGz(t *testing.T, files map[string][]byte) []byte {
 	t.Helper()
@@ -791,6 +901,54 @@ func TestFetchLatestRelease(t *testing.T) {
 	}
 }
 
+// Test that a configured GitHub token is sent to the API but not to asset downloads
+func TestGitHubToken_SentOnlyToAPI(t *testing.T) {
+	for _, token := range []string{"", "secret-token"} {
+		t.Setenv("GITHUB_TOKEN", token)
+		t.Setenv("PUSH_GITHUB_TOKEN", "")
+
+		var apiAuth, assetAuth string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/asset" {
+				assetAuth = r.Header.Get("Authorization")
+				w.Write([]byte("data"))
+				return
+			}
+			apiAuth = r.Header.Get("Authorization")
+			w.Write([]byte(`{"tag_name":"v1.0.0"}`))
+		}))
+
+		originalClient := httpClient
+		httpClient = &http.Client{
+			Transport: &urlRewritingTransport{
+				originalURL: latestReleaseURL,
+				newURL:      server.URL,
+				transport:   http.DefaultTransport,
+			},
+		}
+
+		if _, err := FetchLatestRelease(); err != nil {
+			t.Fatalf("FetchLatestRelease() error: %v", err)
+		}
+		if _, err := NewInstaller(t.TempDir()).Download(&Asset{BrowserDownloadURL: server.URL + "/asset"}, nil); err != nil {
+			t.Fatalf("Download() error: %v", err)
+		}
+		httpClient = originalClient
+		server.Close()
+
+		want := ""
+		if token != "" {
+			want = "Bearer " + token
+		}
+		if apiAuth != want {
+			t.Errorf("token %q: API Authorization = %q, want %q", token, apiAuth, want)
+		}
+		if assetAuth != "" {
+			t.Errorf("token %q: asset download must not carry Authorization, got %q", token, assetAuth)
+		}
+	}
+}
+
 // Test FetchReleaseByTag with mock server
 func TestFetchReleaseByTag(t *testing.T) {
 	tests := []struct {
@@ -1147,6 +1305,29 @@ func TestExtractAndInstallDirectoryPermissions(t *testing.T) {
 	}
 }
 
+func TestExtractAndInstallRefusesWhileLocked(t *testing.T) {
+	archiveData := createTarGz(t, map[string][]byte{
+		"pchaind": []byte("test binary"),
+	})
+	homeDir := t.TempDir()
+
+	lock, err := lockfile.TryAcquire(filepath.Join(homeDir, lockfile.UpdateName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewInstaller(homeDir).ExtractAndInstall(archiveData); !errors.Is(err, ErrInstallInProgress) {
+		t.Fatalf("expected ErrInstallInProgress, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(homeDir, "cosmovisor")); !os.IsNotExist(err) {
+		t.Error("nothing should be written while another update holds the lock")
+	}
+
+	_ = lock.Release()
+	if _, err := NewInstaller(homeDir).ExtractAndInstall(archiveData); err != nil {
+		t.Fatalf("ExtractAndInstall after release failed: %v", err)
+	}
+}
+
 // Test ExtractAndInstall with tar read error
 func TestExtractAndInstallTarReadError(t *testing.T) {
 	// Create a valid gzip but with corrupted tar content
@@ -1274,6 +1455,90 @@ func TestExtractAndInstallLibWasmVM(t *testing.T) {
 	}
 }
 
+// Test ExtractAndInstall extracts Linux libwasmvm builds and honors SidecarLibs
+func TestExtractAndInstallSidecarLibs(t *testing.T) {
+	archiveData := createTarGz(t, map[string][]byte{
+		"pchaind":                  []byte("pchaind binary"),
+		"lib/libwasmvm.x86_64.so":  []byte("amd64 library"),
+		"lib/libwasmvm.aarch64.so": []byte("arm64 library"),
+		"libextra.so":              []byte("extra library"),
+		"README.md":                []byte("readme"),
+	})
+
+	homeDir := t.TempDir()
+	if _, err := NewInstaller(homeDir).ExtractAndInstall(archiveData); err != nil {
+		t.Fatalf("ExtractAndInstall failed: %v", err)
+	}
+	binDir := filepath.Join(homeDir, "cosmovisor", "genesis", "bin")
+	for _, name := range []string{"libwasmvm.x86_64.so", "libwasmvm.aarch64.so"} {
+		info, err := os.Stat(filepath.Join(binDir, name))
+		if err != nil {
+			t.Errorf("%s was not extracted: %v", name, err)
+		} else if info.Mode().Perm() != 0o644 {
+			t.Errorf("%s mode = %v, want 0644", name, info.Mode().Perm())
+		}
+	}
+	for _, name := range []string{"libextra.so", "README.md"} {
+		if _, err := os.Stat(filepath.Join(binDir, name)); err == nil {
+			t.Errorf("%s should not be extracted by default", name)
+		}
+	}
+
+	homeDir = t.TempDir()
+	inst := NewInstaller(homeDir)
+	inst.SidecarLibs = []string{"libextra.*"}
+	if _, err := inst.ExtractAndInstall(archiveData); err != nil {
+		t.Fatalf("ExtractAndInstall failed: %v", err)
+	}
+	binDir = filepath.Join(homeDir, "cosmovisor", "genesis", "bin")
+	if _, err := os.Stat(filepath.Join(binDir, "libextra.so")); err != nil {
+		t.Errorf("libextra.so was not extracted: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(binDir, "libwasmvm.x86_64.so")); err == nil {
+		t.Error("libwasmvm.x86_64.so extracted despite custom SidecarLibs")
+	}
+}
+
+func TestIsSidecarLib(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"libwasmvm.dylib", true},
+		{"libwasmvm.x86_64.so", true},
+		{"libwasmvm.aarch64.so", true},
+		{"libwasm.so", false},
+		{"pchaind", false},
+	}
+	for _, tt := range tests {
+		if got := IsSidecarLib(tt.name, DefaultSidecarLibs); got != tt.want {
+			t.Errorf("IsSidecarLib(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWithLibraryPath(t *testing.T) {
+	sep := string(os.PathListSeparator)
+	env := WithLibraryPath([]string{"PATH=/usr/bin", "LD_LIBRARY_PATH=/opt/lib"}, "/a", "/b")
+	got := map[string]string{}
+	for _, kv := range env {
+		k, v, _ := strings.Cut(kv, "=")
+		got[k] = v
+	}
+	if got["PATH"] != "/usr/bin" {
+		t.Errorf("PATH = %q, want unchanged", got["PATH"])
+	}
+	if want := "/a" + sep + "/b" + sep + "/opt/lib"; got["LD_LIBRARY_PATH"] != want {
+		t.Errorf("LD_LIBRARY_PATH = %q, want %q", got["LD_LIBRARY_PATH"], want)
+	}
+	if want := "/a" + sep + "/b"; got["DYLD_LIBRARY_PATH"] != want {
+		t.Errorf("DYLD_LIBRARY_PATH = %q, want %q", got["DYLD_LIBRARY_PATH"], want)
+	}
+	if len(env) != 3 {
+		t.Errorf("env has %d entries, want 3: %v", len(env), env)
+	}
+}
+
 // Test Download with connection error
 func TestDownloadConnectionError(t *testing.T) {
 	installer := NewInstaller(t.TempDir())
@@ -0,0 +1,56 @@
+// Package checksum verifies downloads against published SHA-256 or SHA-512
+// digests.
+package checksum
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Algorithm is a supported digest algorithm.
+type Algorithm string
+
+const (
+	SHA256 Algorithm = "sha256"
+	SHA512 Algorithm = "sha512"
+)
+
+// Detect picks the algorithm for a digest published in fileName. A .sha256 or
+// .sha512 suffix decides; otherwise a 128-character digest is SHA-512 and
+// anything else is treated as SHA-256, as combined checksums.txt files
+// always were.
+func Detect(fileName, digest string) Algorithm {
+	switch {
+	case strings.HasSuffix(fileName, ".sha512"):
+		return SHA512
+	case strings.HasSuffix(fileName, ".sha256"):
+		return SHA256
+	case len(digest) == sha512.Size*2:
+		return SHA512
+	default:
+		return SHA256
+	}
+}
+
+// Sum returns the hex-encoded digest of data.
+func Sum(alg Algorithm, data []byte) string {
+	if alg == SHA512 {
+		sum := sha512.Sum512(data)
+		return hex.EncodeToString(sum[:])
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify hashes data with the algorithm detected from fileName and expected,
+// and reports a mismatch as an error.
+func Verify(data []byte, fileName, expected string) error {
+	alg := Detect(fileName, expected)
+	if actual := Sum(alg, data); actual != expected {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", expected, actual)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package checksum
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	sha256Hex := strings.Repeat("a", 64)
+	sha512Hex := strings.Repeat("b", 128)
+	tests := []struct {
+		fileName string
+		digest   string
+		want     Algorithm
+	}{
+		{"pchaind.tar.gz.sha256", sha256Hex, SHA256},
+		{"pchaind.tar.gz.sha512", sha512Hex, SHA512},
+		{"pchaind.tar.gz.sha512", sha256Hex, SHA512}, // suffix wins
+		{"checksums.txt", sha256Hex, SHA256},
+		{"checksums.txt", sha512Hex, SHA512},
+		{"checksums.txt", "abc", SHA256},
+	}
+	for _, tt := range tests {
+		if got := Detect(tt.fileName, tt.digest); got != tt.want {
+			t.Errorf("Detect(%q, %d chars) = %s, want %s", tt.fileName, len(tt.digest), got, tt.want)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	data := []byte("release archive")
+	s256 := sha256.Sum256(data)
+	s512 := sha512.Sum512(data)
+	good256 := hex.EncodeToString(s256[:])
+	good512 := hex.EncodeToString(s512[:])
+
+	if err := Verify(data, "checksums.txt", good256); err != nil {
+		t.Errorf("sha256 in checksums.txt: %v", err)
+	}
+	if err := Verify(data, "checksums.txt", good512); err != nil {
+		t.Errorf("sha512 in checksums.txt: %v", err)
+	}
+	if err := Verify(data, "pchaind.tar.gz.sha512", good512); err != nil {
+		t.Errorf("sha512 file: %v", err)
+	}
+	err := Verify(data, "pchaind.tar.gz.sha512", strings.Repeat("0", 128))
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("expected mismatch for bad sha512, got %v", err)
+	}
+}
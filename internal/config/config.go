@@ -1,9 +1,13 @@
 package config
 
 import (
+	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds user/system configuration for the manager.
@@ -12,10 +16,81 @@ type Config struct {
 	ChainID        string
 	HomeDir        string
 	GenesisDomain  string
+	GenesisHash    string // expected SHA-256 of genesis.json (PUSH_GENESIS_HASH, --genesis-hash); empty skips verification
 	KeyringBackend string
 	SnapshotURL    string // Base URL for snapshot downloads
 	RPCLocal       string // e.g., http://127.0.0.1:26657
 	Denom          string // staking denom (e.g., upc)
+
+	// Node listen ports (--rpc-port/--p2p-port, PUSH_RPC_PORT/PUSH_P2P_PORT)
+	RPCPort int
+	P2PPort int
+	// EVM JSON-RPC listen ports (--json-rpc-port/--json-rpc-ws-port,
+	// PUSH_JSON_RPC_PORT/PUSH_JSON_RPC_WS_PORT)
+	JSONRPCPort   int
+	JSONRPCWSPort int
+
+	// Outbound HTTP for release downloads (see internal/httpclient)
+	HTTPProxy   string        // proxy URL from PUSH_PROXY; empty uses HTTPS_PROXY etc.
+	CACertFile  string        // extra trusted CA bundle (--ca-cert)
+	HTTPTimeout time.Duration // per-request API timeout (--http-timeout); 0 uses the default
+	// DownloadRate caps snapshot and binary downloads in bytes/sec (--download-rate); 0 is unlimited
+	DownloadRate int64
+
+	// TLS for chain RPC endpoints such as a self-hosted genesis RPC
+	RPCCACertFile  string // extra trusted CA bundle (--rpc-ca-cert, PUSH_RPC_CA_CERT)
+	RPCTLSInsecure bool   // skip certificate verification (--rpc-tls-insecure, PUSH_RPC_TLS_INSECURE)
+	RPCRetry       int    // retries for node RPC reads after a network error (--rpc-retry)
+
+	// SyncTolerance is how many blocks behind the network the node may be
+	// and still count as synced (--sync-tolerance)
+	SyncTolerance int64
+
+	// Background update checks (see internal/update)
+	UpdateCacheDir      string        // where .update-check lives, from PUSH_UPDATE_CACHE_DIR; empty uses HomeDir
+	UpdateCheckInterval time.Duration // how long a check result is reused (--update-check-interval)
+
+	// Validator, rewards and proposal queries (see internal/validator)
+	CacheTTL time.Duration // how long fetched results are reused (--cache-ttl); 0 uses the default
+
+	// How the node is supervised: UseCosmovisorAuto, Always or Never
+	// (PUSH_USE_COSMOVISOR, --cosmovisor/--no-cosmovisor)
+	UseCosmovisor string
+}
+
+// Default CometBFT listen ports.
+const (
+	DefaultRPCPort = 26657
+	DefaultP2PPort = 26656
+)
+
+// Default EVM JSON-RPC listen ports.
+const (
+	DefaultJSONRPCPort   = 8545
+	DefaultJSONRPCWSPort = 8546
+)
+
+// DefaultSyncTolerance is the default SyncTolerance in blocks.
+const DefaultSyncTolerance = 5
+
+// UseCosmovisor modes. Auto runs the node under Cosmovisor when its binary
+// is installed and pchaind directly otherwise; always requires Cosmovisor;
+// never runs pchaind directly.
+const (
+	UseCosmovisorAuto   = "auto"
+	UseCosmovisorAlways = "always"
+	UseCosmovisorNever  = "never"
+)
+
+// ParseUseCosmovisor validates a use_cosmovisor value. Empty means auto.
+func ParseUseCosmovisor(s string) (string, error) {
+	switch mode := strings.ToLower(strings.TrimSpace(s)); mode {
+	case "":
+		return UseCosmovisorAuto, nil
+	case UseCosmovisorAuto, UseCosmovisorAlways, UseCosmovisorNever:
+		return mode, nil
+	}
+	return "", fmt.Errorf("invalid use_cosmovisor %q: use auto, always or never", s)
 }
 
 // Defaults sets chain-specific defaults aligned with current scripts.
@@ -34,24 +109,160 @@ func Defaults() Config {
 		GenesisDomain:  "donut.rpc.push.org",
 		KeyringBackend: keyringBackend,
 		SnapshotURL:    "https://snapshots.donut.push.org", // Snapshot download server
-		RPCLocal:       "http://127.0.0.1:26657",
+		RPCLocal:       LocalRPCURL(DefaultRPCPort),
 		Denom:          "upc",
+		RPCPort:        DefaultRPCPort,
+		P2PPort:        DefaultP2PPort,
+		JSONRPCPort:    DefaultJSONRPCPort,
+		JSONRPCWSPort:  DefaultJSONRPCWSPort,
+		UseCosmovisor:  UseCosmovisorAuto,
+		SyncTolerance:  DefaultSyncTolerance,
 	}
 }
 
-// Load returns default config with HOME_DIR override from environment.
-// Use flags for other configuration options.
+// Load returns default config with HOME_DIR, PUSH_PROXY,
+// PUSH_UPDATE_CACHE_DIR, PUSH_GENESIS_HASH, PUSH_RPC_PORT, PUSH_P2P_PORT,
+// PUSH_JSON_RPC_PORT, PUSH_JSON_RPC_WS_PORT, PUSH_RPC_CA_CERT, PUSH_RPC_TLS_INSECURE and PUSH_USE_COSMOVISOR overrides from environment. Use flags for other configuration options.
 func Load() Config {
 	cfg := Defaults()
 	// Only support HOME_DIR env var (common pattern for XDG_* style overrides)
 	if v := os.Getenv("HOME_DIR"); v != "" {
 		cfg.HomeDir = v
 	}
+	cfg.HTTPProxy = strings.TrimSpace(os.Getenv("PUSH_PROXY"))
+	cfg.UpdateCacheDir = strings.TrimSpace(os.Getenv("PUSH_UPDATE_CACHE_DIR"))
+	cfg.GenesisHash = strings.TrimSpace(os.Getenv("PUSH_GENESIS_HASH"))
+	if n, err := strconv.Atoi(os.Getenv("PUSH_RPC_PORT")); err == nil {
+		cfg.RPCPort = n
+		cfg.RPCLocal = LocalRPCURL(n)
+	}
+	if n, err := strconv.Atoi(os.Getenv("PUSH_P2P_PORT")); err == nil {
+		cfg.P2PPort = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("PUSH_JSON_RPC_PORT")); err == nil {
+		cfg.JSONRPCPort = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("PUSH_JSON_RPC_WS_PORT")); err == nil {
+		cfg.JSONRPCWSPort = n
+	}
+	cfg.RPCCACertFile = strings.TrimSpace(os.Getenv("PUSH_RPC_CA_CERT"))
+	cfg.RPCTLSInsecure, _ = strconv.ParseBool(os.Getenv("PUSH_RPC_TLS_INSECURE"))
+	if v := strings.TrimSpace(os.Getenv("PUSH_USE_COSMOVISOR")); v != "" {
+		cfg.UseCosmovisor = v
+	}
 	return cfg
 }
 
+// LocalRPCURL returns the loopback RPC base for port.
+func LocalRPCURL(port int) string {
+	return fmt.Sprintf("http://127.0.0.1:%d", port)
+}
+
+// RPCHostPort returns host:port of RPCLocal, for TCP liveness probes.
+func (c Config) RPCHostPort() string {
+	if u, err := url.Parse(c.RPCLocal); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return fmt.Sprintf("127.0.0.1:%d", c.RPCPort)
+}
+
+// wellKnownPorts are other ports pchaind binds by default.
+var wellKnownPorts = map[int]string{
+	1317:  "REST API",
+	6060:  "pprof",
+	8545:  "EVM JSON-RPC",
+	8546:  "EVM JSON-RPC websocket",
+	9090:  "gRPC",
+	9091:  "gRPC-web",
+	26660: "Prometheus metrics",
+}
+
+// ValidatePorts rejects out-of-range or clashing listen ports and returns
+// warnings for ports that clash with another pchaind listener or need root.
+// Zero JSON-RPC ports are skipped; they keep the node's configured ones.
+func (c Config) ValidatePorts() ([]string, error) {
+	type namedPort struct {
+		name string
+		port int
+	}
+	ports := []namedPort{{"RPC", c.RPCPort}, {"P2P", c.P2PPort}}
+	for _, p := range []namedPort{{"JSON-RPC", c.JSONRPCPort}, {"JSON-RPC websocket", c.JSONRPCWSPort}} {
+		if p.port != 0 {
+			ports = append(ports, p)
+		}
+	}
+	for i, p := range ports {
+		if p.port < 1 || p.port > 65535 {
+			return nil, fmt.Errorf("invalid %s port %d: must be between 1 and 65535", p.name, p.port)
+		}
+		for _, q := range ports[:i] {
+			if q.port == p.port {
+				return nil, fmt.Errorf("%s and %s ports must differ (both %d)", q.name, p.name, p.port)
+			}
+		}
+	}
+
+	var warnings []string
+	for _, p := range []struct {
+		name string
+		port int
+	}{{"RPC", c.RPCPort}, {"P2P", c.P2PPort}} {
+		if use, ok := wellKnownPorts[p.port]; ok {
+			warnings = append(warnings, fmt.Sprintf("%s port %d is pchaind's default %s port", p.name, p.port, use))
+		}
+		if p.port < 1024 {
+			warnings = append(warnings, fmt.Sprintf("%s port %d is privileged and usually needs root", p.name, p.port))
+		}
+	}
+	if c.RPCPort == DefaultP2PPort {
+		warnings = append(warnings, fmt.Sprintf("RPC port %d is the default P2P port", c.RPCPort))
+	}
+	if c.P2PPort == DefaultRPCPort {
+		warnings = append(warnings, fmt.Sprintf("P2P port %d is the default RPC port", c.P2PPort))
+	}
+	return warnings, nil
+}
+
+// UpdateCacheLocation returns the directory holding the update check cache.
+func (c Config) UpdateCacheLocation() string {
+	if c.UpdateCacheDir != "" {
+		return c.UpdateCacheDir
+	}
+	return c.HomeDir
+}
+
 // RemoteRPCURL returns the full HTTPS RPC URL derived from GenesisDomain.
 func (c Config) RemoteRPCURL() string {
 	return "https://" + strings.TrimSuffix(c.GenesisDomain, "/") + ":443"
 }
 
+
+// HomeCandidates returns the node home locations the CLI knows about: the
+// default ~/.pchain, HOME_DIR and the cosmovisor DAEMON_HOME, deduplicated.
+func HomeCandidates() []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, dir := range []string{Defaults().HomeDir, os.Getenv("HOME_DIR"), os.Getenv("DAEMON_HOME")} {
+		if dir == "" {
+			continue
+		}
+		dir = filepath.Clean(dir)
+		if !seen[dir] {
+			seen[dir] = true
+			out = append(out, dir)
+		}
+	}
+	return out
+}
+
+// InitializedHomes returns the dirs holding an initialized node, i.e. a
+// config/genesis.json.
+func InitializedHomes(dirs []string) []string {
+	var out []string
+	for _, dir := range dirs {
+		if _, err := os.Stat(filepath.Join(dir, "config", "genesis.json")); err == nil {
+			out = append(out, dir)
+		}
+	}
+	return out
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -92,6 +93,29 @@ func TestLoad_HomeDirEnvOverride(t *testing.T) {
 	}
 }
 
+func TestLoad_ProxyEnv(t *testing.T) {
+	t.Setenv("PUSH_PROXY", " http://proxy.corp:3128 ")
+	if got := Load().HTTPProxy; got != "http://proxy.corp:3128" {
+		t.Errorf("HTTPProxy = %q, want PUSH_PROXY value", got)
+	}
+	t.Setenv("PUSH_PROXY", "")
+	if got := Load().HTTPProxy; got != "" {
+		t.Errorf("HTTPProxy = %q, want empty", got)
+	}
+}
+
+func TestUpdateCacheLocation(t *testing.T) {
+	t.Setenv("HOME_DIR", "/srv/pchain")
+	t.Setenv("PUSH_UPDATE_CACHE_DIR", "")
+	if got := Load().UpdateCacheLocation(); got != "/srv/pchain" {
+		t.Errorf("UpdateCacheLocation() = %q, want home dir", got)
+	}
+	t.Setenv("PUSH_UPDATE_CACHE_DIR", "/var/cache/push-validator")
+	if got := Load().UpdateCacheLocation(); got != "/var/cache/push-validator" {
+		t.Errorf("UpdateCacheLocation() = %q, want PUSH_UPDATE_CACHE_DIR value", got)
+	}
+}
+
 func TestRemoteRPCURL(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -126,3 +150,130 @@ func TestRemoteRPCURL(t *testing.T) {
 	}
 }
 
+
+func TestLoad_PortEnv(t *testing.T) {
+	t.Setenv("PUSH_RPC_PORT", "36657")
+	t.Setenv("PUSH_P2P_PORT", "36656")
+	cfg := Load()
+	if cfg.RPCPort != 36657 || cfg.P2PPort != 36656 {
+		t.Errorf("ports = %d/%d, want 36657/36656", cfg.RPCPort, cfg.P2PPort)
+	}
+	if cfg.RPCLocal != "http://127.0.0.1:36657" {
+		t.Errorf("RPCLocal = %q", cfg.RPCLocal)
+	}
+	if cfg.RPCHostPort() != "127.0.0.1:36657" {
+		t.Errorf("RPCHostPort() = %q", cfg.RPCHostPort())
+	}
+
+	t.Setenv("PUSH_JSON_RPC_PORT", "9545")
+	t.Setenv("PUSH_JSON_RPC_WS_PORT", "9546")
+	if cfg := Load(); cfg.JSONRPCPort != 9545 || cfg.JSONRPCWSPort != 9546 {
+		t.Errorf("JSON-RPC ports = %d/%d, want 9545/9546", cfg.JSONRPCPort, cfg.JSONRPCWSPort)
+	}
+
+	t.Setenv("PUSH_RPC_PORT", "not-a-port")
+	if cfg := Load(); cfg.RPCPort != DefaultRPCPort {
+		t.Errorf("invalid env should be ignored, got %d", cfg.RPCPort)
+	}
+}
+
+func TestLoad_RPCTLSEnv(t *testing.T) {
+	t.Setenv("PUSH_RPC_CA_CERT", " /etc/rpc-ca.pem ")
+	t.Setenv("PUSH_RPC_TLS_INSECURE", "true")
+	cfg := Load()
+	if cfg.RPCCACertFile != "/etc/rpc-ca.pem" || !cfg.RPCTLSInsecure {
+		t.Errorf("RPC TLS = %q/%v, want /etc/rpc-ca.pem/true", cfg.RPCCACertFile, cfg.RPCTLSInsecure)
+	}
+}
+
+func TestParseUseCosmovisor(t *testing.T) {
+	for in, want := range map[string]string{"": UseCosmovisorAuto, "auto": UseCosmovisorAuto, " Always ": UseCosmovisorAlways, "NEVER": UseCosmovisorNever} {
+		if got, err := ParseUseCosmovisor(in); err != nil || got != want {
+			t.Errorf("ParseUseCosmovisor(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseUseCosmovisor("sometimes"); err == nil {
+		t.Error("ParseUseCosmovisor(sometimes) should fail")
+	}
+
+	t.Setenv("PUSH_USE_COSMOVISOR", "never")
+	if cfg := Load(); cfg.UseCosmovisor != "never" {
+		t.Errorf("UseCosmovisor = %q, want never", cfg.UseCosmovisor)
+	}
+}
+
+func TestValidatePorts(t *testing.T) {
+	tests := []struct {
+		name         string
+		rpc, p2p     int
+		wantErr      bool
+		wantWarnings int
+	}{
+		{"defaults", DefaultRPCPort, DefaultP2PPort, false, 0},
+		{"second node", 36657, 36656, false, 0},
+		{"out of range", 70000, DefaultP2PPort, true, 0},
+		{"zero", 0, DefaultP2PPort, true, 0},
+		{"same port", 36657, 36657, true, 0},
+		{"grpc clash", 9090, DefaultP2PPort, false, 1},
+		{"swapped defaults", DefaultP2PPort, DefaultRPCPort, false, 2},
+		{"privileged", 80, DefaultP2PPort, false, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := Config{RPCPort: tt.rpc, P2PPort: tt.p2p}.ValidatePorts()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidatePorts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("warnings = %v, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+
+	// JSON-RPC ports must differ from each other and from RPC/P2P
+	base := Config{RPCPort: 36657, P2PPort: 36656, JSONRPCPort: 9545, JSONRPCWSPort: 9546}
+	if _, err := base.ValidatePorts(); err != nil {
+		t.Fatalf("ValidatePorts() error = %v", err)
+	}
+	for _, c := range []Config{
+		{RPCPort: 36657, P2PPort: 36656, JSONRPCPort: 9545, JSONRPCWSPort: 9545},
+		{RPCPort: 36657, P2PPort: 36656, JSONRPCPort: 36657, JSONRPCWSPort: 9546},
+		{RPCPort: 36657, P2PPort: 36656, JSONRPCPort: 9545, JSONRPCWSPort: 70000},
+	} {
+		if _, err := c.ValidatePorts(); err == nil {
+			t.Errorf("ValidatePorts(%d/%d/%d/%d) should fail", c.RPCPort, c.P2PPort, c.JSONRPCPort, c.JSONRPCWSPort)
+		}
+	}
+}
+
+func TestInitializedHomes(t *testing.T) {
+	base := t.TempDir()
+	a, b, empty := filepath.Join(base, "a"), filepath.Join(base, "b"), filepath.Join(base, "empty")
+	for _, dir := range []string{a, b} {
+		if err := os.MkdirAll(filepath.Join(dir, "config"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "config", "genesis.json"), []byte("{}"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(empty, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got := InitializedHomes([]string{a, empty, b})
+	if len(got) != 2 || got[0] != a || got[1] != b {
+		t.Errorf("InitializedHomes() = %v, want [%s %s]", got, a, b)
+	}
+}
+
+func TestHomeCandidates(t *testing.T) {
+	def := Defaults().HomeDir
+	t.Setenv("HOME_DIR", def+"/")
+	t.Setenv("DAEMON_HOME", "/srv/pchain")
+
+	got := HomeCandidates()
+	if len(got) != 2 || got[0] != def || got[1] != "/srv/pchain" {
+		t.Errorf("HomeCandidates() = %v, want [%s /srv/pchain]", got, def)
+	}
+}
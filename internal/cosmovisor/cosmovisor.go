@@ -8,6 +8,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"github.com/pushchain/push-validator-cli/internal/chain"
 )
 
 // Environment variable constants for Cosmovisor.
@@ -180,16 +182,22 @@ func (s *service) Init(ctx context.Context, opts InitOptions) error {
 		progress(fmt.Sprintf("Binary copied to: %s", destPath))
 	}
 
-	// Also copy libwasmvm.dylib if present (required on macOS)
+	// Also copy shared libraries bundled next to the binary (libwasmvm)
 	srcDir := filepath.Dir(opts.BinPath)
-	wasmLib := filepath.Join(srcDir, "libwasmvm.dylib")
-	if _, err := os.Stat(wasmLib); err == nil {
-		destLib := filepath.Join(s.GenesisDir(), "libwasmvm.dylib")
-		if err := copyFile(wasmLib, destLib); err != nil {
+	entries, _ := os.ReadDir(srcDir)
+	for _, e := range entries {
+		if e.IsDir() || !chain.IsSidecarLib(e.Name(), chain.DefaultSidecarLibs) {
+			continue
+		}
+		destLib := filepath.Join(s.GenesisDir(), e.Name())
+		if filepath.Dir(srcAbs) == filepath.Dir(destAbs) {
+			break // already in the genesis directory
+		}
+		if err := copyFile(filepath.Join(srcDir, e.Name()), destLib); err != nil {
 			// Non-fatal: log but continue
-			progress(fmt.Sprintf("Warning: failed to copy libwasmvm.dylib: %v", err))
+			progress(fmt.Sprintf("Warning: failed to copy %s: %v", e.Name(), err))
 		} else {
-			progress("Copied libwasmvm.dylib to genesis directory")
+			progress(fmt.Sprintf("Copied %s to genesis directory", e.Name()))
 		}
 	}
 
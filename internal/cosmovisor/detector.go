@@ -1,9 +1,17 @@
 package cosmovisor
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/trace"
 )
 
 // DetectionResult contains the result of Cosmovisor detection.
@@ -92,3 +100,24 @@ func IsAvailable() bool {
 func BinaryPath() string {
 	return findCosmovisor()
 }
+
+// Version runs `<binPath> version` and returns cosmovisor's own version.
+// The command also tries to report the daemon's version and fails without
+// DAEMON_HOME, so its exit status is ignored when the version line is there.
+func Version(binPath string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, binPath, "version")
+	trace.Command(cmd)
+	out, err := cmd.CombinedOutput()
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(sc.Text()), "cosmovisor version:"); ok {
+			return strings.TrimSpace(v), nil
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no version in output of %s version", binPath)
+}
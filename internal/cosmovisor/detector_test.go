@@ -355,3 +355,27 @@ func TestBinaryPath(t *testing.T) {
 		t.Logf("BinaryPath() = %q", path)
 	})
 }
+
+func TestVersion(t *testing.T) {
+	dir := t.TempDir()
+	script := func(name, body string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	// cosmovisor prints its own version, then fails without DAEMON_HOME
+	bin := script("cosmovisor", "echo 'cosmovisor version: v1.5.0'\necho 'DAEMON_HOME is not set' >&2\nexit 1\n")
+	if v, err := Version(bin); err != nil || v != "v1.5.0" {
+		t.Errorf("Version() = %q, %v; want v1.5.0", v, err)
+	}
+
+	if _, err := Version(script("other", "echo hello\n")); err == nil {
+		t.Error("expected an error when no version line is printed")
+	}
+	if _, err := Version(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected an error for a missing binary")
+	}
+}
@@ -15,15 +15,18 @@ type ChainStatus struct {
 	icons   Icons
 	etaCalc *ETACalculator
 	noEmoji bool
+	// syncTolerance is how many blocks behind the network still shows as in sync
+	syncTolerance int64
 }
 
 // NewChainStatus creates a new chain status component
-func NewChainStatus(noEmoji bool) *ChainStatus {
+func NewChainStatus(noEmoji bool, syncTolerance int64) *ChainStatus {
 	return &ChainStatus{
 		BaseComponent: BaseComponent{},
 		icons:         NewIcons(noEmoji),
 		etaCalc:       NewETACalculator(),
 		noEmoji:       noEmoji,
+		syncTolerance: syncTolerance,
 	}
 }
 
@@ -119,7 +122,7 @@ func (c *ChainStatus) renderContent(w int) string {
 		}
 	} else {
 		// Always show sync-monitor-style progress bar
-		isCatchingUp := c.data.Metrics.Chain.CatchingUp
+		isCatchingUp := !c.data.Metrics.Chain.Synced(c.syncTolerance)
 		syncLine := renderSyncProgress(localHeight, remoteHeight, c.noEmoji, isCatchingUp)
 
 		// Add ETA: calculated when syncing, "0s" when in sync
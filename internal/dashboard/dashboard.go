@@ -17,6 +17,7 @@ import (
 	"github.com/pushchain/push-validator-cli/internal/metrics"
 	"github.com/pushchain/push-validator-cli/internal/node"
 	"github.com/pushchain/push-validator-cli/internal/process"
+	"github.com/pushchain/push-validator-cli/internal/ui"
 	"github.com/pushchain/push-validator-cli/internal/update"
 	"github.com/pushchain/push-validator-cli/internal/validator"
 )
@@ -34,6 +35,9 @@ type keyMap struct {
 	Follow  key.Binding
 	Home    key.Binding
 	End     key.Binding
+	Raw     key.Binding
+	GoTo    key.Binding
+	Layout  key.Binding
 }
 
 // ShortHelp implements help.KeyMap for inline help
@@ -44,9 +48,9 @@ func (k keyMap) ShortHelp() []key.Binding {
 // FullHelp implements help.KeyMap for full help overlay
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Quit, k.Refresh, k.Help},
+		{k.Quit, k.Refresh, k.Help, k.Layout},
 		{k.Up, k.Down, k.Left, k.Right},
-		{k.Search, k.Follow, k.Home, k.End},
+		{k.Search, k.GoTo, k.Follow, k.Home, k.End, k.Raw},
 	}
 }
 
@@ -97,6 +101,18 @@ func newKeyMap() keyMap {
 			key.WithKeys("l"),
 			key.WithHelp("l", "jump to latest"),
 		),
+		Raw: key.NewBinding(
+			key.WithKeys("j"),
+			key.WithHelp("j", "toggle raw JSON logs"),
+		),
+		GoTo: key.NewBinding(
+			key.WithKeys("g"),
+			key.WithHelp("g", "jump logs to a time"),
+		),
+		Layout: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "cycle layout"),
+		),
 	}
 }
 
@@ -124,6 +140,10 @@ type Dashboard struct {
 	showHelp bool
 	loading  bool
 
+	// Layout preference, saved to the home dir on quit and when cycled
+	prefs      Prefs
+	layoutNote string // why the preferred layout isn't used, if it isn't
+
 	// Context for cancelling in-flight fetches
 	fetchCancel context.CancelFunc
 
@@ -154,7 +174,7 @@ func New(opts Options) *Dashboard {
 	registry := NewComponentRegistry()
 	registry.Register(NewHeader())
 	registry.Register(NewNodeStatus(opts.NoEmoji))
-	registry.Register(NewChainStatus(opts.NoEmoji))
+	registry.Register(NewChainStatus(opts.NoEmoji, opts.Config.SyncTolerance))
 	registry.Register(NewNetworkStatus(opts.NoEmoji))
 	registry.Register(NewValidatorsList(opts.NoEmoji, opts.Config))
 	registry.Register(NewValidatorInfo(opts.NoEmoji))
@@ -162,19 +182,32 @@ func New(opts Options) *Dashboard {
 	if opts.Supervisor != nil {
 		logPath = opts.Supervisor.LogPath()
 	}
-	registry.Register(NewLogViewer(opts.NoEmoji, logPath))
+	logViewer := NewLogViewer(opts.NoEmoji, logPath)
+	if !opts.LogSince.IsZero() {
+		logViewer.SetSince(opts.LogSince)
+	}
+	registry.Register(logViewer)
 
-	// Configure layout
-	layoutConfig := LayoutConfig{
-		Rows: []LayoutRow{
-			{Components: []string{"header"}, Weights: []int{100}, MinHeight: 4},
-			{Components: []string{"node_status", "chain_status"}, Weights: []int{50, 50}, MinHeight: 10},
-			{Components: []string{"network_status", "validator_info"}, Weights: []int{50, 50}, MinHeight: 10},
-			{Components: []string{"validators_list"}, Weights: []int{100}, MinHeight: 16},
-			{Components: []string{"log_viewer"}, Weights: []int{100}, MinHeight: 12},
-		},
+	if panelEnabled(opts.Panels, "resources") {
+		registry.Register(NewResources(opts.NoEmoji))
 	}
-	layout := NewLayout(layoutConfig, registry)
+
+	// Persistent metrics collector for continuous CPU monitoring; mempool and
+	// block queries only run when the transactions panel is shown
+	collector := metrics.New()
+	if panelEnabled(opts.Panels, "transactions") {
+		registry.Register(NewTransactions())
+		collector.CollectTransactions(transactionBlocks)
+	}
+
+	// Configure layout from the saved preference, sized for the last
+	// terminal until the first WindowSizeMsg arrives
+	prefs := LoadPrefs(opts.Config.HomeDir)
+	if opts.Layout != "" {
+		prefs.Layout = opts.Layout
+	}
+	rows, layoutNote := layoutForMode(prefs.Layout, opts.Panels, registry, prefs.Width, prefs.Height)
+	layout := NewLayout(LayoutConfig{Rows: rows}, registry)
 
 	// Initialize spinner (style will be set in Init() to avoid terminal queries before alt screen)
 	s := spinner.New()
@@ -189,10 +222,29 @@ func New(opts Options) *Dashboard {
 		spinner:   s,
 		loading:   true,
 		showHelp:  false,
-		collector: metrics.New(), // Initialize persistent collector for continuous CPU monitoring
+		collector: collector,
+		width:     prefs.Width,
+		height:    prefs.Height,
+
+		prefs:      prefs,
+		layoutNote: layoutNote,
 	}
 }
 
+// applyLayout rebuilds the layout for the preferred mode at the current size
+func (m *Dashboard) applyLayout() {
+	var rows []LayoutRow
+	rows, m.layoutNote = layoutForMode(m.prefs.Layout, m.opts.Panels, m.registry, m.width, m.height)
+	m.layout = NewLayout(LayoutConfig{Rows: rows}, m.registry)
+}
+
+// savePrefs records the layout mode and terminal size in the home dir.
+// Failing to save only costs the preference, so errors are ignored.
+func (m *Dashboard) savePrefs() {
+	m.prefs.Width, m.prefs.Height = m.width, m.height
+	_ = SavePrefs(m.opts.Config.HomeDir, m.prefs)
+}
+
 // Init initializes the dashboard (Bubble Tea lifecycle)
 func (m *Dashboard) Init() tea.Cmd {
 	// Set spinner style here (after alt screen is active) to avoid terminal queries
@@ -210,7 +262,7 @@ func (m *Dashboard) Init() tea.Cmd {
 // This bypasses the cache to ensure immediate notification of new versions.
 func (m *Dashboard) updateCheckCmd() tea.Cmd {
 	return func() tea.Msg {
-		result, err := update.ForceCheck(m.opts.Config.HomeDir, m.opts.CLIVersion)
+		result, err := update.ForceCheck(m.opts.Config.UpdateCacheLocation(), m.opts.CLIVersion, m.opts.Config.UpdateCheckInterval)
 		if err != nil {
 			return updateCheckResultMsg{result: nil}
 		}
@@ -226,6 +278,7 @@ func (m *Dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.WindowSizeMsg:
 		m.width, m.height = msg.Width, msg.Height
+		m.applyLayout()
 		return m, nil
 
 	case fetchStartedMsg:
@@ -242,7 +295,7 @@ func (m *Dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Otherwise the new fetch will cancel the previous one
 		// Adaptive refresh: faster when syncing, slower when in-sync
 		interval := m.opts.RefreshInterval
-		if !m.data.Metrics.Chain.CatchingUp && !m.lastOK.IsZero() {
+		if m.data.Metrics.Chain.Synced(m.opts.Config.SyncTolerance) && !m.lastOK.IsZero() {
 			interval = 5 * time.Second // Slower when synced
 		}
 		cmds := []tea.Cmd{tickCmd(interval)}
@@ -276,8 +329,11 @@ func (m *Dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 
 	case forceRefreshMsg:
-		// User pressed 'r' - start new fetch immediately
-		return m, m.fetchCmd()
+		// User pressed 'r' - drop the validator caches and fetch immediately
+		return m, tea.Sequence(
+			func() tea.Msg { validator.InvalidateCache(); return nil },
+			m.fetchCmd(),
+		)
 
 	case toggleHelpMsg:
 		m.showHelp = !m.showHelp
@@ -418,6 +474,9 @@ func (m *Dashboard) View() string {
 	output := lipgloss.JoinVertical(lipgloss.Left, rows...)
 
 	// Show layout warning if present
+	if m.layoutNote != "" {
+		output += fmt.Sprintf("\n⚠ %s\n", m.layoutNote)
+	}
 	if result.Warning != "" {
 		output += fmt.Sprintf("\n⚠ %s\n", result.Warning)
 	}
@@ -436,7 +495,9 @@ func (m *Dashboard) View() string {
 		keyStyle.Render("h") +
 		textStyle.Render(" for help | ") +
 		keyStyle.Render("Ctrl+C") +
-		textStyle.Render(" to exit")
+		textStyle.Render(" to exit | ") +
+		keyStyle.Render("c") +
+		textStyle.Render(" layout ("+m.prefs.Layout+")")
 
 	// Line 2: Quick CLI commands
 	commandsLine := textStyle.Render("Quick Commands: ") +
@@ -544,13 +605,26 @@ func (m *Dashboard) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// While the log viewer reads a search term or time, keys are input
+	if lv, ok := m.registry.Get("log_viewer").(*LogViewer); ok && lv.InputActive() && msg.String() != "ctrl+c" {
+		cmds := m.registry.UpdateAll(msg, m.data)
+		return m, tea.Batch(cmds...)
+	}
+
 	switch {
 	case key.Matches(msg, m.keys.Quit):
 		if m.fetchCancel != nil {
 			m.fetchCancel() // Cancel in-flight fetch
 		}
+		m.savePrefs()
 		return m, tea.Quit
 
+	case key.Matches(msg, m.keys.Layout):
+		m.prefs.Layout = nextLayoutMode(m.prefs.Layout)
+		m.applyLayout()
+		m.savePrefs()
+		return m, nil
+
 	case key.Matches(msg, m.keys.Refresh):
 		return m, func() tea.Msg { return forceRefreshMsg{} }
 
@@ -560,7 +634,8 @@ func (m *Dashboard) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, m.keys.Up), key.Matches(msg, m.keys.Down),
 		key.Matches(msg, m.keys.Left), key.Matches(msg, m.keys.Right),
 		key.Matches(msg, m.keys.Search), key.Matches(msg, m.keys.Follow),
-		key.Matches(msg, m.keys.Home), key.Matches(msg, m.keys.End):
+		key.Matches(msg, m.keys.Home), key.Matches(msg, m.keys.End),
+		key.Matches(msg, m.keys.Raw), key.Matches(msg, m.keys.GoTo):
 		// Forward to components (log viewer and validators list)
 		cmds := m.registry.UpdateAll(msg, m.data)
 		return m, tea.Batch(cmds...)
@@ -631,6 +706,19 @@ func (m *Dashboard) fetchData(ctx context.Context) (DashboardData, error) {
 		}
 	}
 
+	// Host resources, only when the resources panel is shown (best-effort)
+	if panelEnabled(m.opts.Panels, "resources") {
+		if data.NodeInfo.PID > 0 {
+			if rss, err := metrics.ProcessRSS(data.NodeInfo.PID); err == nil {
+				data.Resources.ProcessRSS = rss
+			}
+		}
+		if free, total, err := metrics.DiskFree(m.opts.Config.HomeDir); err == nil {
+			data.Resources.DiskFree = free
+			data.Resources.DiskTotal = total
+		}
+	}
+
 	// Get cached binary version (only refresh every 5 min)
 	data.NodeInfo.BinaryVer = m.getCachedVersion(ctx, data.NodeInfo.Running, data.NodeInfo.PID)
 
@@ -683,6 +771,13 @@ func (m *Dashboard) fetchData(ctx context.Context) (DashboardData, error) {
 		data.MyValidator.ValidatorExistsWithSameMoniker = myVal.ValidatorExistsWithSameMoniker
 		data.MyValidator.ConflictingMoniker = myVal.ConflictingMoniker
 
+		// Signed-blocks window progress, same math as `push-validator uptime`
+		if myVal.IsValidator {
+			if params, err := validator.GetCachedSlashingParams(ctx, m.opts.Config); err == nil {
+				data.MyValidator.SigningWindow = validator.ComputeUptime(myVal.SlashingInfo.MissedBlocks, params)
+			}
+		}
+
 		// Fetch rewards for my validator if registered (cached 30s)
 		if myVal.IsValidator && myVal.Address != "" {
 			if commRwd, outRwd, err := validator.GetCachedRewards(ctx, m.opts.Config, myVal.Address); err == nil {
@@ -698,7 +793,7 @@ func (m *Dashboard) fetchData(ctx context.Context) (DashboardData, error) {
 
 	// Check for CLI update (uses cache, no network call)
 	// Re-verify version comparison in case CLI was updated since cache was written
-	if cache, err := update.LoadCache(m.opts.Config.HomeDir); err == nil && cache.UpdateAvailable && update.IsNewerVersion(m.opts.CLIVersion, cache.LatestVersion) {
+	if cache, err := update.LoadCache(m.opts.Config.UpdateCacheLocation()); err == nil && cache.UpdateAvailable && !update.IsDevBuild(m.opts.CLIVersion) && update.IsNewerVersion(m.opts.CLIVersion, cache.LatestVersion) {
 		data.UpdateInfo.Available = true
 		data.UpdateInfo.LatestVersion = cache.LatestVersion
 	}
@@ -789,6 +884,33 @@ func (m *Dashboard) RenderStatic(data DashboardData) string {
 	b.WriteString(fmt.Sprintf("  Chain ID: %s\n", data.Metrics.Node.ChainID))
 	b.WriteString("\n")
 
+	// Resources (opt-in panel)
+	if panelEnabled(m.opts.Panels, "resources") {
+		b.WriteString("RESOURCES:\n")
+		b.WriteString(fmt.Sprintf("  CPU: %.1f%%\n", data.Metrics.System.CPUPercent))
+		if data.Resources.ProcessRSS > 0 {
+			b.WriteString(fmt.Sprintf("  pchaind RSS: %s\n", ui.FormatBytes(int64(data.Resources.ProcessRSS))))
+		}
+		if data.Resources.DiskTotal > 0 {
+			b.WriteString(fmt.Sprintf("  Disk Free: %s of %s\n", ui.FormatBytes(int64(data.Resources.DiskFree)), ui.FormatBytes(int64(data.Resources.DiskTotal))))
+		}
+		b.WriteString("\n")
+	}
+
+	// Transactions (opt-in panel)
+	if panelEnabled(m.opts.Panels, "transactions") {
+		b.WriteString("TRANSACTIONS:\n")
+		if tx := data.Metrics.Transactions; tx != nil {
+			b.WriteString(fmt.Sprintf("  Mempool: %s txs (%s)\n", HumanInt(int64(tx.MempoolTxs)), ui.FormatBytes(tx.MempoolBytes)))
+			for _, blk := range tx.RecentBlocks {
+				b.WriteString(fmt.Sprintf("  Block %s: %d txs, proposer %s\n", HumanInt(blk.Height), blk.Txs, blk.Proposer))
+			}
+		} else {
+			b.WriteString("  Mempool: —\n")
+		}
+		b.WriteString("\n")
+	}
+
 	// Validator Status
 	if data.MyValidator.IsValidator {
 		b.WriteString("VALIDATOR STATUS:\n")
@@ -801,6 +923,9 @@ func (m *Dashboard) RenderStatic(data DashboardData) string {
 			b.WriteString("\n")
 		}
 		b.WriteString(fmt.Sprintf("  Jailed: %v\n", data.MyValidator.Jailed))
+		if sw := data.MyValidator.SigningWindow; sw.Window > 0 {
+			b.WriteString(fmt.Sprintf("  Signing Window: %s (%s)\n", sw.WindowSummary(), sw.Level))
+		}
 		b.WriteString("\n")
 	}
 
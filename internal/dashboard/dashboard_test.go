@@ -2,7 +2,9 @@ package dashboard
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -796,6 +798,154 @@ func TestStyleLogLine(t *testing.T) {
 	}
 }
 
+// Test log_viewer styleLogLine with JSON and CometBFT text lines
+func TestStyleLogLine_JSON(t *testing.T) {
+	lv := NewLogViewer(true, "/tmp/test/logs/pchaind.log")
+	defer lv.Close()
+
+	jsonLine := `{"level":"info","module":"consensus","height":1234,"time":"2024-05-01T12:34:56Z","message":"finalizing commit of block"}`
+	textLine := "12:34PM INF finalizing commit of block height=1234 module=consensus"
+
+	if got := lv.styleLogLine(jsonLine, 0); got != "12:34:56 INF finalizing commit of block height=1234 module=consensus" {
+		t.Errorf("JSON line should render compact, got %q", got)
+	}
+	if got := lv.styleLogLine(textLine, 0); got != textLine {
+		t.Errorf("text line should be unchanged, got %q", got)
+	}
+
+	updated, _ := lv.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	lv = updated.(*LogViewer)
+	if !lv.showRaw {
+		t.Fatal("'j' should toggle raw display on")
+	}
+	if got := lv.styleLogLine(jsonLine, 0); got != jsonLine {
+		t.Errorf("raw mode should show the JSON as logged, got %q", got)
+	}
+	if !strings.Contains(lv.Title(), "raw") {
+		t.Errorf("title should show raw mode, got %q", lv.Title())
+	}
+}
+
+func TestLineAtOrAfter(t *testing.T) {
+	loc := time.FixedZone("test", 2*3600)
+	at := func(h, m, sec int) time.Time { return time.Date(2024, 5, 1, h, m, sec, 0, loc) }
+	lines := []string{
+		"starting node", // 0: no timestamp
+		`{"level":"info","time":"2024-05-01T10:00:00Z","message":"a"}`, // 1: 12:00:00 local
+		"I[2024-05-01|12:01:00.000] legacy line module=p2p",            // 2
+		"    at github.com/cometbft/... (continuation)",                // 3: no timestamp
+		"12:02:30 INF console line height=5",                           // 4
+		"12:03PM INF kitchen line",                                     // 5: 12:03:00
+		`{"level":"info","time":1714557840,"message":"unix seconds"}`,  // 6: 2024-05-01T10:04:00Z
+		"garbage", // 7
+		"2024-05-01T12:05:00+02:00 INF rfc3339 line", // 8
+	}
+	tests := []struct {
+		name   string
+		target time.Time
+		want   int
+	}{
+		{"before everything", at(11, 0, 0), 1},
+		{"exact match", at(12, 1, 0), 2},
+		{"between lines skips untimestamped", at(12, 1, 30), 4},
+		{"kitchen time", at(12, 2, 45), 5},
+		{"unix seconds", at(12, 4, 0), 6},
+		{"last line", at(12, 4, 1), 8},
+		{"after everything falls back to nearest", at(13, 0, 0), 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := lineAtOrAfter(lines, tt.target)
+			if !ok || got != tt.want {
+				t.Errorf("lineAtOrAfter() = %d, %v; want %d", got, ok, tt.want)
+			}
+		})
+	}
+
+	if _, ok := lineAtOrAfter([]string{"no", "timestamps", "here"}, at(12, 0, 0)); ok {
+		t.Error("lines without timestamps should report !ok")
+	}
+}
+
+func TestScrollPosForLine(t *testing.T) {
+	tests := []struct{ total, idx, rows, want int }{
+		{100, 10, 20, 70}, // line 10 at the top: lines 10-29 shown
+		{100, 90, 20, 0},  // near the end: clamp to the bottom
+		{100, 0, 20, 80},
+		{5, 2, 20, 0},
+	}
+	for _, tt := range tests {
+		if got := scrollPosForLine(tt.total, tt.idx, tt.rows); got != tt.want {
+			t.Errorf("scrollPosForLine(%d, %d, %d) = %d, want %d", tt.total, tt.idx, tt.rows, got, tt.want)
+		}
+		// The view's first line is the target when not clamped
+		if end := tt.total - tt.want; tt.want > 0 && end-tt.rows != tt.idx {
+			t.Errorf("view starts at %d, want %d", end-tt.rows, tt.idx)
+		}
+	}
+}
+
+func TestLogViewerGoToTime(t *testing.T) {
+	lv := NewLogViewer(true, "/tmp/test/logs/pchaind.log")
+	defer lv.Close()
+	day := time.Now().Format("2006-01-02")
+	for i := 0; i < 60; i++ {
+		lv.buffer.Add(fmt.Sprintf("I[%s|10:%02d:00.000] line %d", day, i, i))
+	}
+	lv.rows = 10
+
+	press := func(keys string) {
+		for _, r := range keys {
+			lv.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+	press("g")
+	if !lv.InputActive() || !strings.Contains(lv.Title(), "Go to time") {
+		t.Fatalf("'g' should open the time input, title %q", lv.Title())
+	}
+	press("10:20")
+	lv.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if lv.InputActive() || lv.followMode {
+		t.Fatal("jump should close the input and pause following")
+	}
+	if lv.scrollPos != 30 { // line 20 at the top of 10 rows out of 60
+		t.Errorf("scrollPos = %d, want 30", lv.scrollPos)
+	}
+	if content := lv.renderContent(80, 14); !strings.Contains(strings.SplitN(content, "\n", 3)[1], "line 20") {
+		t.Errorf("first visible line should be line 20:\n%s", content)
+	}
+
+	press("g")
+	press("nonsense")
+	lv.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(lv.Title(), "invalid time") || lv.scrollPos != 30 {
+		t.Errorf("invalid input should keep the position and say so, title %q", lv.Title())
+	}
+}
+
+// View records the row count a jump reads, so concurrent renders must not
+// share a read lock for it (run with -race)
+func TestLogViewerConcurrentView(t *testing.T) {
+	lv := NewLogViewer(true, "/tmp/test/logs/pchaind.log")
+	defer lv.Close()
+	for i := 0; i < 60; i++ {
+		lv.buffer.Add(fmt.Sprintf("line %d", i))
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 2; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				lv.View(80, 10+g+i%5)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
 // Test log_viewer renderFooter
 func TestRenderFooter(t *testing.T) {
 	lv := NewLogViewer(true, "/tmp/test/logs/pchaind.log")
@@ -81,7 +81,7 @@ func TestLayoutCompute(t *testing.T) {
 			registry := NewComponentRegistry()
 			registry.Register(NewHeader())
 			registry.Register(NewNodeStatus(true))
-			registry.Register(NewChainStatus(true))
+			registry.Register(NewChainStatus(true, 5))
 			registry.Register(NewNetworkStatus(true))
 
 			layout := NewLayout(tt.config, registry)
@@ -149,7 +149,7 @@ func TestLayoutComputeVerticalSlack(t *testing.T) {
 func TestComputeRowWidths(t *testing.T) {
 	registry := NewComponentRegistry()
 	registry.Register(NewNodeStatus(true)) // MinWidth: 25
-	registry.Register(NewChainStatus(true)) // MinWidth: 30
+	registry.Register(NewChainStatus(true, 5)) // MinWidth: 30
 
 	layout := NewLayout(LayoutConfig{}, registry)
 
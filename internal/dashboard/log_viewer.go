@@ -13,6 +13,8 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
+
+	"github.com/pushchain/push-validator-cli/internal/logline"
 )
 
 // LogViewer component displays and tails log file with scrolling and search
@@ -24,6 +26,12 @@ type LogViewer struct {
 	followMode bool         // Auto-scroll to latest logs
 	searchMode bool         // Search input active
 	searchTerm string       // Current search filter
+	showRaw    bool         // Show JSON log lines as logged instead of compact
+	gotoMode   bool         // Jump-to-time input active
+	gotoInput  string       // Time typed so far
+	gotoNote   string       // Result of the last jump, shown in the title
+	since      time.Time    // Jump target applied once the backlog is loaded
+	rows       int          // Log lines shown by the last render
 	noEmoji    bool
 	mu         sync.RWMutex
 
@@ -128,6 +136,16 @@ func (lv *LogViewer) Title() string {
 	if lv.searchMode {
 		return fmt.Sprintf("%s [Search: %s]", icon, lv.searchTerm)
 	}
+	if lv.gotoMode {
+		return fmt.Sprintf("%s [Go to time: %s]", icon, lv.gotoInput)
+	}
+	if lv.gotoNote != "" {
+		icon += " [" + lv.gotoNote + "]"
+	}
+
+	if lv.showRaw {
+		icon += " [raw]"
+	}
 
 	if !lv.followMode {
 		return fmt.Sprintf("%s [Paused - %d lines]", icon, lv.buffer.Count())
@@ -162,6 +180,29 @@ func (lv *LogViewer) handleKey(msg tea.KeyMsg) (Component, tea.Cmd) {
 	lv.mu.Lock()
 	defer lv.mu.Unlock()
 
+	if lv.gotoMode {
+		switch msg.String() {
+		case "esc":
+			lv.gotoMode = false
+		case "enter":
+			lv.gotoMode = false
+			if t, err := logline.ParseTime(lv.gotoInput, time.Now()); err != nil {
+				lv.gotoNote = "invalid time"
+			} else {
+				lv.jumpTo(t)
+			}
+		case "backspace":
+			if len(lv.gotoInput) > 0 {
+				lv.gotoInput = lv.gotoInput[:len(lv.gotoInput)-1]
+			}
+		default:
+			if len(msg.String()) == 1 {
+				lv.gotoInput += msg.String()
+			}
+		}
+		return lv, nil
+	}
+
 	if lv.searchMode {
 		switch msg.String() {
 		case "esc":
@@ -182,11 +223,16 @@ func (lv *LogViewer) handleKey(msg tea.KeyMsg) (Component, tea.Cmd) {
 		return lv, nil
 	}
 
+	lv.gotoNote = ""
 	switch msg.String() {
 	case "/":
 		lv.searchMode = true
 		lv.searchTerm = ""
 
+	case "g": // 'g' for 'go to' - jump to a time
+		lv.gotoMode = true
+		lv.gotoInput = ""
+
 	case "f":
 		lv.followMode = !lv.followMode
 		if lv.followMode {
@@ -217,6 +263,9 @@ func (lv *LogViewer) handleKey(msg tea.KeyMsg) (Component, tea.Cmd) {
 	case "l":  // 'l' for 'latest' - jump to newest logs
 		lv.followMode = true
 		lv.scrollPos = 0
+
+	case "j": // 'j' for 'json' - show JSON log lines raw or compact
+		lv.showRaw = !lv.showRaw
 	}
 
 	return lv, nil
@@ -231,8 +280,9 @@ func (lv *LogViewer) View(w, h int) string {
 		}
 	}()
 
-	lv.mu.RLock()
-	defer lv.mu.RUnlock()
+	// The write lock, since rendering records the row count for jumpTo
+	lv.mu.Lock()
+	defer lv.mu.Unlock()
 
 	// Style
 	style := lipgloss.NewStyle().
@@ -292,23 +342,14 @@ func (lv *LogViewer) renderContent(w, h int) string {
 	allLines := lv.buffer.GetAll()
 
 	// Filter by search term
-	var filteredLines []string
-	if lv.searchTerm != "" {
-		searchLower := strings.ToLower(lv.searchTerm)
-		for _, line := range allLines {
-			if strings.Contains(strings.ToLower(line), searchLower) {
-				filteredLines = append(filteredLines, line)
-			}
-		}
-	} else {
-		filteredLines = allLines
-	}
+	filteredLines := filterLines(allLines, lv.searchTerm)
 
 	// Dynamic line count: use allocated height minus border (2), title (1), footer (1)
 	availableLines := h - 4
 	if availableLines < 3 {
 		availableLines = 3
 	}
+	lv.rows = availableLines
 
 	// Apply scroll position
 	totalLines := len(filteredLines)
@@ -359,39 +400,116 @@ func (lv *LogViewer) renderContent(w, h int) string {
 	return fmt.Sprintf("%s\n%s\n%s", title, content, footer)
 }
 
-// styleLogLine applies color coding based on log level and truncates to maxWidth
+// filterLines returns the lines containing term, case-insensitively, or all
+// lines when term is empty.
+func filterLines(lines []string, term string) []string {
+	if term == "" {
+		return lines
+	}
+	var out []string
+	termLower := strings.ToLower(term)
+	for _, line := range lines {
+		if strings.Contains(strings.ToLower(line), termLower) {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+// lineAtOrAfter returns the index of the first line logged at or after
+// target. Lines without a parseable timestamp are skipped. When every
+// timestamped line is older than target, the last of them, the nearest, is
+// returned. ok is false when no line has a timestamp.
+func lineAtOrAfter(lines []string, target time.Time) (idx int, ok bool) {
+	idx = -1
+	for i, line := range lines {
+		t, parsed := logline.Parse(line).Timestamp(target)
+		if !parsed {
+			continue
+		}
+		if !t.Before(target) {
+			return i, true
+		}
+		idx = i
+	}
+	return max(idx, 0), idx >= 0
+}
+
+// scrollPosForLine returns the scroll position that shows line idx of total
+// at the top of a view rows lines high, or as close to it as the end of the
+// buffer allows.
+func scrollPosForLine(total, idx, rows int) int {
+	return max(total-idx-rows, 0)
+}
+
+// jumpTo pauses the viewer on the first visible line logged at or after t.
+// Callers hold lv.mu.
+func (lv *LogViewer) jumpTo(t time.Time) {
+	lines := filterLines(lv.buffer.GetAll(), lv.searchTerm)
+	idx, ok := lineAtOrAfter(lines, t)
+	if !ok {
+		lv.gotoNote = "no timestamps"
+		return
+	}
+	rows := lv.rows
+	if rows < 3 {
+		rows = 3
+	}
+	lv.followMode = false
+	lv.scrollPos = scrollPosForLine(len(lines), idx, rows)
+	lv.gotoNote = "from " + t.Format("15:04:05")
+}
+
+// SetSince positions the viewer at t once the log backlog is loaded, for the
+// dashboard's --since flag. More backlog is loaded so t is more likely to
+// be within it.
+func (lv *LogViewer) SetSince(t time.Time) {
+	lv.mu.Lock()
+	defer lv.mu.Unlock()
+	lv.since = t
+}
+
+// InputActive reports whether the viewer is reading a search term or time,
+// so the dashboard sends it every key instead of acting on shortcuts.
+func (lv *LogViewer) InputActive() bool {
+	lv.mu.RLock()
+	defer lv.mu.RUnlock()
+	return lv.searchMode || lv.gotoMode
+}
+
+// styleLogLine applies color coding based on log level and truncates to maxWidth.
+// JSON log lines are shown in compact form unless raw display is toggled on.
 func (lv *LogViewer) styleLogLine(line string, maxWidth int) string {
+	entry := logline.Parse(line)
+	text := entry.Display(lv.showRaw)
 	if lv.noEmoji {
 		if maxWidth > 0 {
-			return ansi.Truncate(line, maxWidth, "…")
+			return ansi.Truncate(text, maxWidth, "…")
 		}
-		return line
+		return text
 	}
 
 	// Detect log level and apply color
 	var style lipgloss.Style
-
-	// Pattern matching for common log levels
-	lowerLine := strings.ToLower(line)
-
-	if strings.Contains(lowerLine, "error") || strings.Contains(lowerLine, "fatal") || strings.Contains(lowerLine, "panic") || strings.Contains(lowerLine, " err ") {
+	switch entry.Level {
+	case logline.LevelError:
 		style = lipgloss.NewStyle().Foreground(lipgloss.Color("196")) // Red
-	} else if strings.Contains(lowerLine, "warn") || strings.Contains(lowerLine, "warning") || strings.Contains(lowerLine, " wrn ") {
+	case logline.LevelWarn:
 		style = lipgloss.NewStyle().Foreground(lipgloss.Color("226")) // Yellow
-	} else if strings.Contains(lowerLine, "info") || strings.Contains(lowerLine, " inf ") {
+	case logline.LevelInfo:
 		style = lipgloss.NewStyle().Foreground(lipgloss.Color("2")) // Green
-	} else if strings.Contains(lowerLine, "debug") || strings.Contains(lowerLine, "trace") || strings.Contains(lowerLine, " dbg ") {
+	case logline.LevelDebug:
 		style = lipgloss.NewStyle().Foreground(lipgloss.Color("240")) // Gray
-	} else {
+	default:
 		// Default - no color, just truncate
 		if maxWidth > 0 {
-			return ansi.Truncate(line, maxWidth, "…")
+			return ansi.Truncate(text, maxWidth, "…")
 		}
-		return line
+		return text
 	}
 
 	// Apply color then truncate (ansi.Truncate is ANSI and cell-width aware)
-	styled := style.Render(line)
+	styled := style.Render(text)
 	if maxWidth > 0 {
 		return ansi.Truncate(styled, maxWidth, "…")
 	}
@@ -400,6 +518,10 @@ func (lv *LogViewer) styleLogLine(line string, maxWidth int) string {
 
 // renderFooter shows control hints
 func (lv *LogViewer) renderFooter() string {
+	if lv.gotoMode {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("241")).
+			Render("30m, 15:04 or 2006-01-02 15:04:05 | Enter to jump | Esc to cancel")
+	}
 	if lv.searchMode {
 		return lipgloss.NewStyle().Foreground(lipgloss.Color("241")).
 			Render("Enter to apply | Esc to cancel")
@@ -407,9 +529,9 @@ func (lv *LogViewer) renderFooter() string {
 
 	var hints string
 	if lv.followMode {
-		hints = "↑/↓: scroll | f: pause | /: search | t: oldest"
+		hints = "↑/↓: scroll | f: pause | /: search | g: go to time | t: oldest | j: raw"
 	} else {
-		hints = "↑/↓: scroll | f: live | /: search | l: latest | t: oldest"
+		hints = "↑/↓: scroll | f: live | /: search | g: go to time | l: latest | t: oldest | j: raw"
 	}
 
 	return lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(hints)
@@ -431,10 +553,22 @@ func (lv *LogViewer) tailLogs(ctx context.Context) {
 		time.Sleep(1 * time.Second)
 	}
 
-	// Read initial backlog (last 100 lines)
-	if err := lv.loadBacklog(100); err != nil {
+	// Read initial backlog (last 100 lines, or a full buffer for --since)
+	lv.mu.RLock()
+	since := lv.since
+	lv.mu.RUnlock()
+	backlog := 100
+	if !since.IsZero() {
+		backlog = lv.buffer.size
+	}
+	if err := lv.loadBacklog(backlog); err != nil {
 		// Ignore error, file might not exist yet
 	}
+	if !since.IsZero() {
+		lv.mu.Lock()
+		lv.jumpTo(since)
+		lv.mu.Unlock()
+	}
 
 	// Start tailing
 	for {
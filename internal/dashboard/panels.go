@@ -0,0 +1,109 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+)
+
+// panelIDs maps the names accepted by --panels to component IDs
+var panelIDs = map[string]string{
+	"node":         "node_status",
+	"chain":        "chain_status",
+	"network":      "network_status",
+	"validator":    "validator_info",
+	"validators":   "validators_list",
+	"logs":         "log_viewer",
+	"resources":    "resources",
+	"transactions": "transactions",
+}
+
+// DefaultPanels are shown when no --panels selection is given. Opt-in panels
+// (resources, transactions) are left out.
+var DefaultPanels = []string{"node", "chain", "network", "validator", "validators", "logs"}
+
+// PanelNames lists every name accepted by ParsePanels, in layout order
+var PanelNames = []string{"node", "chain", "network", "validator", "validators", "resources", "transactions", "logs"}
+
+// ParsePanels validates a --panels selection and returns it deduplicated.
+// "default" expands to DefaultPanels, so "default,resources" adds the
+// resources panel to the usual layout. An empty selection means DefaultPanels.
+func ParsePanels(names []string) ([]string, error) {
+	var out []string
+	seen := map[string]bool{}
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			out = append(out, name)
+		}
+	}
+	for _, raw := range names {
+		name := strings.ToLower(strings.TrimSpace(raw))
+		switch {
+		case name == "":
+			continue
+		case name == "default":
+			for _, d := range DefaultPanels {
+				add(d)
+			}
+		case panelIDs[name] != "":
+			add(name)
+		default:
+			return nil, fmt.Errorf("unknown panel %q (valid: default, %s)", raw, strings.Join(PanelNames, ", "))
+		}
+	}
+	if len(out) == 0 {
+		return append([]string(nil), DefaultPanels...), nil
+	}
+	return out, nil
+}
+
+// panelEnabled reports whether the component with id is selected. The header
+// is always shown.
+func panelEnabled(panels []string, id string) bool {
+	if id == "header" {
+		return true
+	}
+	if len(panels) == 0 {
+		panels = DefaultPanels
+	}
+	for _, name := range panels {
+		if panelIDs[name] == id {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultLayoutRows is the full dashboard layout before panel selection
+func defaultLayoutRows() []LayoutRow {
+	return []LayoutRow{
+		{Components: []string{"header"}, Weights: []int{100}, MinHeight: 4},
+		{Components: []string{"node_status", "chain_status"}, Weights: []int{50, 50}, MinHeight: 10},
+		{Components: []string{"network_status", "validator_info"}, Weights: []int{50, 50}, MinHeight: 10},
+		{Components: []string{"resources"}, Weights: []int{100}, MinHeight: 6},
+		{Components: []string{"transactions"}, Weights: []int{100}, MinHeight: 10},
+		{Components: []string{"validators_list"}, Weights: []int{100}, MinHeight: 16},
+		{Components: []string{"log_viewer"}, Weights: []int{100}, MinHeight: 12},
+	}
+}
+
+// selectLayoutRows drops unselected components from rows, and rows left empty
+func selectLayoutRows(rows []LayoutRow, panels []string) []LayoutRow {
+	out := make([]LayoutRow, 0, len(rows))
+	for _, row := range rows {
+		kept := LayoutRow{MinHeight: row.MinHeight}
+		for i, id := range row.Components {
+			if !panelEnabled(panels, id) {
+				continue
+			}
+			kept.Components = append(kept.Components, id)
+			if i < len(row.Weights) {
+				kept.Weights = append(kept.Weights, row.Weights[i])
+			}
+		}
+		if len(kept.Components) > 0 {
+			out = append(out, kept)
+		}
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+package dashboard
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePanels(t *testing.T) {
+	tests := []struct {
+		in      []string
+		want    []string
+		wantErr bool
+	}{
+		{in: nil, want: DefaultPanels},
+		{in: []string{"default", "resources"}, want: append(append([]string(nil), DefaultPanels...), "resources")},
+		{in: []string{" Chain ", "node", "chain"}, want: []string{"chain", "node"}},
+		{in: []string{"resources"}, want: []string{"resources"}},
+		{in: []string{"cpu"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePanels(tt.in)
+		if tt.wantErr {
+			if err == nil || !strings.Contains(err.Error(), "unknown panel") {
+				t.Errorf("ParsePanels(%v) error = %v, want unknown panel", tt.in, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParsePanels(%v) error = %v", tt.in, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePanels(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSelectLayoutRows(t *testing.T) {
+	ids := func(rows []LayoutRow) [][]string {
+		var out [][]string
+		for _, r := range rows {
+			out = append(out, r.Components)
+			if len(r.Weights) != len(r.Components) {
+				t.Errorf("row %v has weights %v", r.Components, r.Weights)
+			}
+		}
+		return out
+	}
+
+	// Default layout has no resources row
+	got := ids(selectLayoutRows(defaultLayoutRows(), nil))
+	for _, row := range got {
+		for _, id := range row {
+			if id == "resources" {
+				t.Fatalf("resources shown by default: %v", got)
+			}
+		}
+	}
+	if len(got) != 5 {
+		t.Errorf("default rows = %v", got)
+	}
+
+	got = ids(selectLayoutRows(defaultLayoutRows(), []string{"chain", "resources"}))
+	want := [][]string{{"header"}, {"chain_status"}, {"resources"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %v, want %v", got, want)
+	}
+}
+
+func TestNewWithResourcesPanel(t *testing.T) {
+	d := New(Options{Panels: []string{"node", "resources"}, NoEmoji: true})
+	if d.registry.Get("resources") == nil {
+		t.Fatal("resources panel not registered")
+	}
+	if New(Options{NoEmoji: true}).registry.Get("resources") != nil {
+		t.Error("resources panel should be opt-in")
+	}
+
+	var data DashboardData
+	data.Resources.ProcessRSS = 512 << 20
+	data.Resources.DiskFree = 10 << 30
+	data.Resources.DiskTotal = 100 << 30
+	out := d.RenderStatic(data)
+	if !strings.Contains(out, "RESOURCES:") || !strings.Contains(out, "pchaind RSS: 512.0MB") {
+		t.Errorf("static render missing resources:\n%s", out)
+	}
+}
@@ -0,0 +1,144 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const prefsFileName = ".dashboard-prefs"
+
+// Layout modes accepted by --layout and cycled with 'c'. Auto is the
+// built-in layout; the others put that many panels side by side per row.
+const (
+	LayoutAuto   = "auto"
+	LayoutSingle = "single"
+	LayoutDouble = "double"
+	LayoutTriple = "triple"
+)
+
+// LayoutModes lists the layout modes in cycling order
+var LayoutModes = []string{LayoutAuto, LayoutSingle, LayoutDouble, LayoutTriple}
+
+// layoutColumns is the number of panels per row of each fixed layout mode
+var layoutColumns = map[string]int{LayoutSingle: 1, LayoutDouble: 2, LayoutTriple: 3}
+
+// Prefs are the dashboard preferences kept in the node home between
+// launches: the layout mode and the last terminal size.
+type Prefs struct {
+	Layout string `json:"layout"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+}
+
+// ParseLayoutMode validates a --layout value.
+func ParseLayoutMode(s string) (string, error) {
+	mode := strings.ToLower(strings.TrimSpace(s))
+	for _, m := range LayoutModes {
+		if m == mode {
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("unknown layout %q (valid: %s)", s, strings.Join(LayoutModes, ", "))
+}
+
+// nextLayoutMode returns the mode after mode in LayoutModes, wrapping around
+func nextLayoutMode(mode string) string {
+	for i, m := range LayoutModes {
+		if m == mode {
+			return LayoutModes[(i+1)%len(LayoutModes)]
+		}
+	}
+	return LayoutAuto
+}
+
+// LoadPrefs reads the preferences saved in dir. A missing or unreadable
+// file, or an unknown layout, gives the auto layout.
+func LoadPrefs(dir string) Prefs {
+	p := Prefs{Layout: LayoutAuto}
+	data, err := os.ReadFile(filepath.Join(dir, prefsFileName))
+	if err != nil || json.Unmarshal(data, &p) != nil {
+		return Prefs{Layout: LayoutAuto}
+	}
+	if mode, err := ParseLayoutMode(p.Layout); err == nil {
+		p.Layout = mode
+	} else {
+		p.Layout = LayoutAuto
+	}
+	return p
+}
+
+// SavePrefs writes p to dir.
+func SavePrefs(dir string, p Prefs) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, prefsFileName), data, 0o644)
+}
+
+// columnLayoutRows lays out the selected panels cols per row, in the order
+// of the auto layout, below the header. A row is as tall as the tallest
+// minimum of its panels' rows in the auto layout.
+func columnLayoutRows(panels []string, cols int) []LayoutRow {
+	var ids []string
+	minHeight := map[string]int{}
+	for _, row := range defaultLayoutRows() {
+		for _, id := range row.Components {
+			if id == "header" || !panelEnabled(panels, id) {
+				continue
+			}
+			ids = append(ids, id)
+			minHeight[id] = row.MinHeight
+		}
+	}
+
+	rows := []LayoutRow{{Components: []string{"header"}, Weights: []int{100}, MinHeight: 4}}
+	for start := 0; start < len(ids); start += cols {
+		end := min(start+cols, len(ids))
+		row := LayoutRow{Components: ids[start:end], Weights: equalWeights(end - start)}
+		for _, id := range row.Components {
+			row.MinHeight = max(row.MinHeight, minHeight[id])
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// layoutRowsFits reports whether every row of rows fits width with each
+// component at its MinWidth, and the rows' minimum heights fit height.
+func layoutRowsFits(rows []LayoutRow, registry *ComponentRegistry, width, height int) bool {
+	total := 0
+	for _, row := range rows {
+		w := 0
+		for _, id := range row.Components {
+			if comp := registry.Get(id); comp != nil {
+				w += comp.MinWidth()
+			} else {
+				w += 20
+			}
+		}
+		if w > width {
+			return false
+		}
+		total += row.MinHeight
+	}
+	return total <= height
+}
+
+// layoutForMode returns the layout rows for mode at the given terminal size.
+// A fixed mode that doesn't fit falls back to auto, with a note saying so.
+func layoutForMode(mode string, panels []string, registry *ComponentRegistry, width, height int) ([]LayoutRow, string) {
+	auto := selectLayoutRows(defaultLayoutRows(), panels)
+	cols, ok := layoutColumns[mode]
+	if !ok {
+		return auto, ""
+	}
+	rows := columnLayoutRows(panels, cols)
+	if width > 0 && height > 0 && !layoutRowsFits(rows, registry, width, height) {
+		return auto, fmt.Sprintf("Terminal too small for the %s layout - using auto", mode)
+	}
+	return rows, ""
+}
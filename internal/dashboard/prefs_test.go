@@ -0,0 +1,128 @@
+package dashboard
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+)
+
+func TestPrefsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	if got := LoadPrefs(dir); got != (Prefs{Layout: LayoutAuto}) {
+		t.Errorf("LoadPrefs with no file = %+v, want auto", got)
+	}
+
+	want := Prefs{Layout: LayoutDouble, Width: 160, Height: 48}
+	if err := SavePrefs(dir, want); err != nil {
+		t.Fatal(err)
+	}
+	if got := LoadPrefs(dir); got != want {
+		t.Errorf("LoadPrefs = %+v, want %+v", got, want)
+	}
+
+	for _, content := range []string{"not json", `{"layout":"quad","width":80}`} {
+		if err := os.WriteFile(filepath.Join(dir, prefsFileName), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if got := LoadPrefs(dir); got.Layout != LayoutAuto {
+			t.Errorf("LoadPrefs(%q).Layout = %q, want auto", content, got.Layout)
+		}
+	}
+}
+
+func TestParseLayoutMode(t *testing.T) {
+	if got, err := ParseLayoutMode(" Triple "); err != nil || got != LayoutTriple {
+		t.Errorf("ParseLayoutMode(Triple) = %q, %v", got, err)
+	}
+	if _, err := ParseLayoutMode("quad"); err == nil {
+		t.Error("ParseLayoutMode(quad) should fail")
+	}
+}
+
+func TestNextLayoutMode(t *testing.T) {
+	mode := LayoutAuto
+	var seen []string
+	for range LayoutModes {
+		mode = nextLayoutMode(mode)
+		seen = append(seen, mode)
+	}
+	want := []string{LayoutSingle, LayoutDouble, LayoutTriple, LayoutAuto}
+	if !reflect.DeepEqual(seen, want) {
+		t.Errorf("cycle = %v, want %v", seen, want)
+	}
+}
+
+func TestColumnLayoutRows(t *testing.T) {
+	rows := columnLayoutRows(nil, 3)
+	var got [][]string
+	for _, row := range rows {
+		got = append(got, row.Components)
+	}
+	want := [][]string{
+		{"header"},
+		{"node_status", "chain_status", "network_status"},
+		{"validator_info", "validators_list", "log_viewer"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %v, want %v", got, want)
+	}
+	if rows[1].MinHeight != 10 || rows[2].MinHeight != 16 {
+		t.Errorf("min heights = %d, %d; want 10, 16", rows[1].MinHeight, rows[2].MinHeight)
+	}
+}
+
+func TestLayoutForModeFallsBackWhenTooSmall(t *testing.T) {
+	d := New(Options{Config: config.Config{HomeDir: t.TempDir()}, NoEmoji: true})
+
+	rows, note := layoutForMode(LayoutTriple, nil, d.registry, 100, 30)
+	if note != "" || len(rows) != 3 {
+		t.Errorf("triple at 100x30: %d rows, note %q", len(rows), note)
+	}
+
+	rows, note = layoutForMode(LayoutTriple, nil, d.registry, 99, 30)
+	if !strings.Contains(note, "too small for the triple layout") {
+		t.Errorf("note = %q", note)
+	}
+	if !reflect.DeepEqual(rows, selectLayoutRows(defaultLayoutRows(), nil)) {
+		t.Errorf("fallback rows = %+v, want auto", rows)
+	}
+}
+
+func TestDashboardCycleLayoutSavesPrefs(t *testing.T) {
+	home := t.TempDir()
+	d := New(Options{
+		Config:          config.Config{HomeDir: home},
+		RefreshInterval: time.Second,
+		NoEmoji:         true,
+		Layout:          LayoutAuto,
+	})
+	d.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	// single needs more height than 40 rows, so auto is used
+	d.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if d.prefs.Layout != LayoutSingle || d.layoutNote == "" {
+		t.Errorf("after c: layout %q, note %q", d.prefs.Layout, d.layoutNote)
+	}
+	if got := LoadPrefs(home); got != (Prefs{Layout: LayoutSingle, Width: 120, Height: 40}) {
+		t.Errorf("saved prefs = %+v", got)
+	}
+
+	// Growing the window applies the preferred layout again
+	d.Update(tea.WindowSizeMsg{Width: 120, Height: 80})
+	if d.layoutNote != "" {
+		t.Errorf("note after resize = %q", d.layoutNote)
+	}
+
+	// The saved layout and size are used by the next dashboard
+	next := New(Options{Config: config.Config{HomeDir: home}, NoEmoji: true})
+	if next.prefs.Layout != LayoutSingle || next.width != 120 || next.height != 40 {
+		t.Errorf("next dashboard: layout %q, %dx%d", next.prefs.Layout, next.width, next.height)
+	}
+}
@@ -1,6 +1,7 @@
 package dashboard
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/pushchain/push-validator-cli/internal/config"
 	"github.com/pushchain/push-validator-cli/internal/metrics"
+	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
 // Helper function to create test dashboard data
@@ -64,6 +66,7 @@ func createTestData() DashboardData {
 				Tombstoned  bool
 				MissedBlocks int64
 			}
+			SigningWindow                  validator.Uptime
 			SlashingInfoError              string
 			ValidatorExistsWithSameMoniker bool
 			ConflictingMoniker            string
@@ -159,7 +162,7 @@ func TestHeaderViewWithUpdate(t *testing.T) {
 }
 
 func TestNewChainStatus(t *testing.T) {
-	comp := NewChainStatus(true)
+	comp := NewChainStatus(true, config.DefaultSyncTolerance)
 	if comp == nil {
 		t.Fatal("NewChainStatus returned nil")
 	}
@@ -178,7 +181,7 @@ func TestNewChainStatus(t *testing.T) {
 }
 
 func TestChainStatusView(t *testing.T) {
-	comp := NewChainStatus(true)
+	comp := NewChainStatus(true, config.DefaultSyncTolerance)
 	data := createTestData()
 
 	updated, _ := comp.Update(tea.Msg(nil), data)
@@ -201,6 +204,24 @@ func TestChainStatusView(t *testing.T) {
 	}
 }
 
+func TestChainStatusView_SyncTolerance(t *testing.T) {
+	for _, tt := range []struct {
+		remote int64
+		want   string
+	}{
+		{100020, "In Sync"},
+		{100021, "Syncing"},
+	} {
+		comp := NewChainStatus(true, 20)
+		data := createTestData()
+		data.Metrics.Chain = metrics.Chain{LocalHeight: 100000, RemoteHeight: tt.remote}
+		updated, _ := comp.Update(tea.Msg(nil), data)
+		if view := updated.View(80, 12); !strings.Contains(view, tt.want) {
+			t.Errorf("remote %d: view should show %q, got: %s", tt.remote, tt.want, view)
+		}
+	}
+}
+
 func TestNewNodeStatus(t *testing.T) {
 	comp := NewNodeStatus(true)
 	if comp == nil {
@@ -354,6 +375,42 @@ func TestValidatorInfoViewJailed(t *testing.T) {
 	}
 }
 
+func TestValidatorInfoViewSigningWindow(t *testing.T) {
+	params := validator.SlashingParams{SignedBlocksWindow: 100, MinSignedPerWindow: 0.5}
+	tests := []struct {
+		name   string
+		missed int64
+		bar    string
+	}{
+		{"safe", 10, "[===" + strings.Repeat(" ", 15) + "]"},
+		{"near threshold", 45, "[" + strings.Repeat("=", 16) + "  ]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp := NewValidatorInfo(true)
+			data := createTestData()
+			data.MyValidator.SigningWindow = validator.ComputeUptime(tt.missed, params)
+
+			updated, _ := comp.Update(tea.Msg(nil), data)
+			view := updated.(*ValidatorInfo).View(70, 20)
+			want := fmt.Sprintf("missed %d/100, jail at 51", tt.missed)
+			if !strings.Contains(view, want) {
+				t.Errorf("View should contain %q, got: %s", want, view)
+			}
+			if !strings.Contains(view, tt.bar) {
+				t.Errorf("View should contain bar %q, got: %s", tt.bar, view)
+			}
+		})
+	}
+
+	// Hidden until slashing params are known
+	comp := NewValidatorInfo(true)
+	updated, _ := comp.Update(tea.Msg(nil), createTestData())
+	if view := updated.(*ValidatorInfo).View(70, 20); strings.Contains(view, "jail at") {
+		t.Errorf("View should not show the window without params, got: %s", view)
+	}
+}
+
 func TestNewValidatorsList(t *testing.T) {
 	cfg := config.Config{
 		HomeDir:   "/tmp/test",
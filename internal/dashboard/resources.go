@@ -0,0 +1,165 @@
+package dashboard
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pushchain/push-validator-cli/internal/ui"
+)
+
+// resourceSamples is how many refreshes the rolling averages cover
+const resourceSamples = 12
+
+// rollingAvg keeps the mean of the last few samples
+type rollingAvg struct {
+	samples []float64
+	max     int
+}
+
+// Add records a sample, dropping the oldest once full
+func (r *rollingAvg) Add(v float64) {
+	r.samples = append(r.samples, v)
+	if len(r.samples) > r.max {
+		r.samples = r.samples[1:]
+	}
+}
+
+// Avg returns the mean of the kept samples (0 if none)
+func (r *rollingAvg) Avg() float64 {
+	if len(r.samples) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range r.samples {
+		sum += v
+	}
+	return sum / float64(len(r.samples))
+}
+
+// Resources component shows host CPU, pchaind memory and free disk
+type Resources struct {
+	BaseComponent
+	data  DashboardData
+	icons Icons
+
+	cpu      rollingAvg
+	rss      rollingAvg
+	diskFree rollingAvg
+}
+
+// NewResources creates a new resources component
+func NewResources(noEmoji bool) *Resources {
+	return &Resources{
+		BaseComponent: BaseComponent{},
+		icons:         NewIcons(noEmoji),
+		cpu:           rollingAvg{max: resourceSamples},
+		rss:           rollingAvg{max: resourceSamples},
+		diskFree:      rollingAvg{max: resourceSamples},
+	}
+}
+
+// ID returns component identifier
+func (c *Resources) ID() string {
+	return "resources"
+}
+
+// Title returns component title
+func (c *Resources) Title() string {
+	return "Resources"
+}
+
+// MinWidth returns minimum width
+func (c *Resources) MinWidth() int {
+	return 30
+}
+
+// MinHeight returns minimum height
+func (c *Resources) MinHeight() int {
+	return 6
+}
+
+// Update receives dashboard data. Samples are only taken on fresh fetches so
+// key presses don't skew the averages.
+func (c *Resources) Update(msg tea.Msg, data DashboardData) (Component, tea.Cmd) {
+	c.data = data
+	if _, ok := msg.(dataMsg); !ok {
+		return c, nil
+	}
+	c.cpu.Add(data.Metrics.System.CPUPercent)
+	if data.Resources.ProcessRSS > 0 {
+		c.rss.Add(float64(data.Resources.ProcessRSS))
+	}
+	if data.Resources.DiskTotal > 0 {
+		c.diskFree.Add(float64(data.Resources.DiskFree))
+	}
+	return c, nil
+}
+
+// View renders the component with caching
+func (c *Resources) View(w, h int) string {
+	// Render with styling
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		Padding(0, 1)
+
+	content := c.renderContent(w)
+
+	// Check cache
+	if c.CheckCacheWithSize(content, w, h) {
+		return c.GetCached()
+	}
+
+	if w < 0 {
+		w = 0
+	}
+	if h < 0 {
+		h = 0
+	}
+
+	// Account for border width (2 chars: left + right) to prevent overflow
+	borderWidth := 2
+	contentWidth := w - borderWidth
+	if contentWidth < 0 {
+		contentWidth = 0
+	}
+
+	rendered := style.Width(contentWidth).Render(content)
+	c.UpdateCache(rendered)
+	return rendered
+}
+
+// renderContent builds plain text content
+func (c *Resources) renderContent(w int) string {
+	var lines []string
+
+	// Interior width after accounting for rounded border (2 chars) and padding (2 chars).
+	inner := w - 4
+	if inner < 0 {
+		inner = 0
+	}
+
+	lines = append(lines, fmt.Sprintf("CPU: %.1f%% (avg %.1f%%)", c.data.Metrics.System.CPUPercent, c.cpu.Avg()))
+
+	if c.data.Resources.ProcessRSS > 0 {
+		lines = append(lines, fmt.Sprintf("pchaind RSS: %s (avg %s)",
+			ui.FormatBytes(int64(c.data.Resources.ProcessRSS)), ui.FormatBytes(int64(c.rss.Avg()))))
+	} else {
+		lines = append(lines, "pchaind RSS: —")
+	}
+
+	if c.data.Resources.DiskTotal > 0 {
+		freePct := float64(c.data.Resources.DiskFree) / float64(c.data.Resources.DiskTotal)
+		line := fmt.Sprintf("Disk free: %s (%.0f%%, avg %s)",
+			ui.FormatBytes(int64(c.data.Resources.DiskFree)), freePct*100, ui.FormatBytes(int64(c.diskFree.Avg())))
+		if freePct < 0.1 {
+			line = fmt.Sprintf("%s %s", c.icons.Warn, line)
+		}
+		lines = append(lines, line)
+	} else {
+		lines = append(lines, "Disk free: —")
+	}
+
+	return fmt.Sprintf("%s\n%s", FormatTitle(c.Title(), inner), joinLines(lines, "\n"))
+}
@@ -0,0 +1,76 @@
+package dashboard
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func resourcesData(cpu float64, rss, free, total uint64) DashboardData {
+	var data DashboardData
+	data.Metrics.System.CPUPercent = cpu
+	data.Resources.ProcessRSS = rss
+	data.Resources.DiskFree = free
+	data.Resources.DiskTotal = total
+	return data
+}
+
+func TestResourcesRollingAverage(t *testing.T) {
+	c := NewResources(true)
+	const gb = 1 << 30
+	for _, d := range []DashboardData{
+		resourcesData(10, 1*gb, 100*gb, 500*gb),
+		resourcesData(30, 3*gb, 98*gb, 500*gb),
+	} {
+		c.Update(dataMsg(d), d)
+	}
+
+	if got := c.cpu.Avg(); got != 20 {
+		t.Errorf("cpu avg = %v, want 20", got)
+	}
+	content := c.renderContent(80)
+	for _, want := range []string{"CPU: 30.0% (avg 20.0%)", "pchaind RSS: 3.0GB (avg 2.0GB)", "Disk free: 98.0GB (20%, avg 99.0GB)"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("content missing %q:\n%s", want, content)
+		}
+	}
+
+	// Non-data messages refresh the view but don't add samples
+	c.Update(tea.KeyMsg{Type: tea.KeyDown}, resourcesData(90, 1*gb, 1*gb, 500*gb))
+	if got := c.cpu.Avg(); got != 20 {
+		t.Errorf("cpu avg after key press = %v, want 20", got)
+	}
+	if content := c.renderContent(80); !strings.Contains(content, "[!] Disk free") {
+		t.Errorf("expected low disk warning:\n%s", content)
+	}
+}
+
+func TestResourcesWindow(t *testing.T) {
+	c := NewResources(true)
+	for i := 0; i < resourceSamples; i++ {
+		d := resourcesData(0, 0, 0, 0)
+		c.Update(dataMsg(d), d)
+	}
+	d := resourcesData(float64(resourceSamples)*100, 0, 0, 0)
+	c.Update(dataMsg(d), d)
+	if got := c.cpu.Avg(); got != 100 {
+		t.Errorf("cpu avg = %v, want 100 once the oldest sample drops out", got)
+	}
+}
+
+func TestResourcesUnknownValues(t *testing.T) {
+	c := NewResources(true)
+	d := resourcesData(5, 0, 0, 0)
+	c.Update(dataMsg(d), d)
+	content := c.renderContent(60)
+	if !strings.Contains(content, "pchaind RSS: —") || !strings.Contains(content, "Disk free: —") {
+		t.Errorf("expected placeholders:\n%s", content)
+	}
+	if c.rss.Avg() != 0 || len(c.diskFree.samples) != 0 {
+		t.Error("unknown values should not be sampled")
+	}
+	if view := c.View(60, 6); !strings.Contains(view, "RESOURCES") {
+		t.Errorf("view missing title:\n%s", view)
+	}
+}
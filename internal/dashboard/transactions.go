@@ -0,0 +1,119 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pushchain/push-validator-cli/internal/ui"
+)
+
+// transactionBlocks is how many recent blocks the transactions panel lists
+const transactionBlocks = 5
+
+// Transactions component shows mempool depth and the latest blocks' tx counts
+type Transactions struct {
+	BaseComponent
+	data DashboardData
+}
+
+// NewTransactions creates a new transactions component
+func NewTransactions() *Transactions {
+	return &Transactions{BaseComponent: BaseComponent{}}
+}
+
+// ID returns component identifier
+func (c *Transactions) ID() string {
+	return "transactions"
+}
+
+// Title returns component title
+func (c *Transactions) Title() string {
+	return "Transactions"
+}
+
+// MinWidth returns minimum width
+func (c *Transactions) MinWidth() int {
+	return 40
+}
+
+// MinHeight returns minimum height
+func (c *Transactions) MinHeight() int {
+	// title + mempool + header + blocks + border (2)
+	return transactionBlocks + 5
+}
+
+// Update receives dashboard data
+func (c *Transactions) Update(msg tea.Msg, data DashboardData) (Component, tea.Cmd) {
+	c.data = data
+	return c, nil
+}
+
+// View renders the component with caching
+func (c *Transactions) View(w, h int) string {
+	// Render with styling
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		Padding(0, 1)
+
+	content := c.renderContent(w)
+
+	// Check cache
+	if c.CheckCacheWithSize(content, w, h) {
+		return c.GetCached()
+	}
+
+	if w < 0 {
+		w = 0
+	}
+	if h < 0 {
+		h = 0
+	}
+
+	// Account for border width (2 chars: left + right) to prevent overflow
+	borderWidth := 2
+	contentWidth := w - borderWidth
+	if contentWidth < 0 {
+		contentWidth = 0
+	}
+
+	rendered := style.Width(contentWidth).Render(content)
+	c.UpdateCache(rendered)
+	return rendered
+}
+
+// renderContent builds plain text content
+func (c *Transactions) renderContent(w int) string {
+	var lines []string
+
+	// Interior width after accounting for rounded border (2 chars) and padding (2 chars).
+	inner := w - 4
+	if inner < 0 {
+		inner = 0
+	}
+
+	tx := c.data.Metrics.Transactions
+	if tx == nil {
+		lines = append(lines, "Mempool: —", "No blocks yet")
+		return fmt.Sprintf("%s\n%s", FormatTitle(c.Title(), inner), joinLines(lines, "\n"))
+	}
+
+	lines = append(lines, fmt.Sprintf("Mempool: %s txs (%s)", HumanInt(int64(tx.MempoolTxs)), ui.FormatBytes(tx.MempoolBytes)))
+	if len(tx.RecentBlocks) == 0 {
+		lines = append(lines, "No blocks yet")
+	} else {
+		lines = append(lines, fmt.Sprintf("%-10s %5s  %-12s %s", "Height", "Txs", "Proposer", "Age"))
+		for _, b := range tx.RecentBlocks {
+			age := "—"
+			if !b.Time.IsZero() {
+				age = DurationShort(time.Since(b.Time)) + " ago"
+			}
+			lines = append(lines, fmt.Sprintf("%-10s %5d  %-12s %s",
+				HumanInt(b.Height), b.Txs, truncateWithEllipsis(b.Proposer, 12), age))
+		}
+	}
+
+	return fmt.Sprintf("%s\n%s", FormatTitle(c.Title(), inner), joinLines(lines, "\n"))
+}
@@ -0,0 +1,67 @@
+package dashboard
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/metrics"
+)
+
+func transactionsData(tx *metrics.Transactions) DashboardData {
+	var data DashboardData
+	data.Metrics.Transactions = tx
+	return data
+}
+
+func TestTransactionsView(t *testing.T) {
+	c := NewTransactions()
+	c.Update(nil, transactionsData(&metrics.Transactions{
+		MempoolTxs:   1234,
+		MempoolBytes: 2 << 20,
+		RecentBlocks: []metrics.Block{
+			{Height: 1000002, Time: time.Now().Add(-5 * time.Second), Txs: 7, Proposer: "ABCDEF0123456789ABCDEF"},
+			{Height: 1000001, Txs: 0, Proposer: "0011"},
+		},
+	}))
+	out := c.View(80, 12)
+	for _, want := range []string{"Mempool: 1,234 txs (2.0MB)", "1,000,002", "ABCDEF01234…", "5s ago", "1,000,001"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestTransactionsNoData(t *testing.T) {
+	c := NewTransactions()
+	c.Update(nil, transactionsData(nil))
+	out := c.View(80, 12)
+	if !strings.Contains(out, "Mempool: —") || !strings.Contains(out, "No blocks yet") {
+		t.Errorf("expected placeholders:\n%s", out)
+	}
+
+	c.Update(nil, transactionsData(&metrics.Transactions{}))
+	out = c.View(80, 12)
+	if !strings.Contains(out, "Mempool: 0 txs") || !strings.Contains(out, "No blocks yet") {
+		t.Errorf("expected empty mempool:\n%s", out)
+	}
+}
+
+func TestNewWithTransactionsPanel(t *testing.T) {
+	d := New(Options{Panels: []string{"default", "transactions"}, NoEmoji: true})
+	if d.registry.Get("transactions") == nil {
+		t.Fatal("transactions panel not registered")
+	}
+	if New(Options{NoEmoji: true}).registry.Get("transactions") != nil {
+		t.Error("transactions panel should be opt-in")
+	}
+
+	out := d.RenderStatic(transactionsData(&metrics.Transactions{
+		MempoolTxs:   3,
+		MempoolBytes: 900,
+		RecentBlocks: []metrics.Block{{Height: 42, Txs: 3, Proposer: "AA"}},
+	}))
+	if !strings.Contains(out, "TRANSACTIONS:") || !strings.Contains(out, "Block 42: 3 txs, proposer AA") {
+		t.Errorf("static render missing transactions:\n%s", out)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pushchain/push-validator-cli/internal/metrics"
 	"github.com/pushchain/push-validator-cli/internal/process"
 	"github.com/pushchain/push-validator-cli/internal/update"
+	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
 // Message types for Bubble Tea event loop - ensures deterministic control flow
@@ -71,6 +72,7 @@ type DashboardData struct {
 			Tombstoned  bool   // Whether validator is permanently jailed (double sign)
 			MissedBlocks int64  // Number of missed blocks
 		}
+		SigningWindow                  validator.Uptime // Missed blocks against the jail threshold; Window is 0 until slashing params load
 		SlashingInfoError              string // Error message if slashing info fetch failed
 		ValidatorExistsWithSameMoniker bool   // True if a different validator uses this node's moniker
 		ConflictingMoniker            string // The moniker that conflicts
@@ -98,6 +100,13 @@ type DashboardData struct {
 		Addr string
 	}
 
+	// Host resource usage (resources panel)
+	Resources struct {
+		ProcessRSS uint64 // pchaind resident memory in bytes (0 if unknown)
+		DiskFree   uint64 // Free bytes on the home directory's filesystem
+		DiskTotal  uint64
+	}
+
 	// CLI update notification
 	UpdateInfo struct {
 		Available     bool
@@ -122,4 +131,7 @@ type Options struct {
 	CLIVersion      string             // CLI version to display in header
 	Supervisor      process.Supervisor // Process supervisor (cosmovisor-aware)
 	BinPath         string             // Path to pchaind binary (resolved via findPchaind)
+	Panels          []string           // Panels to show (see ParsePanels); empty means DefaultPanels
+	LogSince        time.Time          // If set, the log viewer opens at the first line logged at or after this time
+	Layout          string             // Layout mode (see LayoutModes); empty uses the one saved in the home dir
 }
@@ -7,6 +7,8 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/pushchain/push-validator-cli/internal/validator"
 )
 
 // ValidatorInfo component shows validator-specific information
@@ -185,6 +187,9 @@ func (c *ValidatorInfo) renderContent(w int) string {
 		leftLines = append(leftLines, fmt.Sprintf("Commission: %s", c.data.MyValidator.Commission))
 	}
 
+	// Signed-blocks window progress toward the downtime jail threshold
+	leftLines = append(leftLines, signingWindowLines(c.data.MyValidator.SigningWindow, c.noEmoji)...)
+
 	// Commission Rewards
 	if c.data.MyValidator.CommissionRewards != "" && c.data.MyValidator.CommissionRewards != "—" {
 		leftLines = append(leftLines, fmt.Sprintf("Commission Rewards: %s PC", FormatFloat(c.data.MyValidator.CommissionRewards)))
@@ -322,6 +327,27 @@ func (c *ValidatorInfo) renderContent(w int) string {
 	return fmt.Sprintf("%s\n%s", FormatTitle(c.Title(), inner), joinLines(lines, "\n"))
 }
 
+// signingWindowLines renders missed blocks against the jail threshold as a
+// summary and a bar, colored by how much of the downtime budget is used.
+// Nothing is shown until the slashing params are known.
+func signingWindowLines(u validator.Uptime, noEmoji bool) []string {
+	if u.Window <= 0 {
+		return nil
+	}
+	color := lipgloss.Color("10") // Light green
+	switch u.Level {
+	case validator.UptimeWarning:
+		color = lipgloss.Color("226") // Yellow
+	case validator.UptimeCritical:
+		color = lipgloss.Color("196") // Red
+	}
+	style := lipgloss.NewStyle().Foreground(color)
+	return []string{
+		"Window: " + style.Render(u.WindowSummary()),
+		style.Render(ProgressBar(u.BudgetUsed(), 20, noEmoji)),
+	}
+}
+
 // parseTimeExpired checks if an RFC3339 timestamp is in the past
 func parseTimeExpired(timeStr string) bool {
 	if timeStr == "" {
@@ -130,6 +130,32 @@ func (c *ValidatorsList) Update(msg tea.Msg, data DashboardData) (Component, tea
 	return c, nil
 }
 
+// validatorStatusOrder ranks statuses for display: BONDED, UNBONDING, UNBONDED, then others
+func validatorStatusOrder(status string) int {
+	switch status {
+	case "BONDED":
+		return 1
+	case "UNBONDING":
+		return 2
+	case "UNBONDED":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// ValidatorOrderLess is the validator list ordering: my validator first, then
+// by status (BONDED < UNBONDING < UNBONDED), then by voting power, highest first
+func ValidatorOrderLess(aIsMine, bIsMine bool, aStatus, bStatus string, aPower, bPower int64) bool {
+	if aIsMine != bIsMine {
+		return aIsMine
+	}
+	if ao, bo := validatorStatusOrder(aStatus), validatorStatusOrder(bStatus); ao != bo {
+		return ao < bo
+	}
+	return aPower > bPower
+}
+
 // getSortedValidators returns validators sorted by status and voting power
 func (c *ValidatorsList) getSortedValidators() []struct {
 	Moniker              string
@@ -155,36 +181,12 @@ func (c *ValidatorsList) getSortedValidators() []struct {
 	}, len(c.data.NetworkValidators.Validators))
 	copy(validators, c.data.NetworkValidators.Validators)
 
-	// Helper to get status sort order
-	statusOrder := func(status string) int {
-		switch status {
-		case "BONDED":
-			return 1
-		case "UNBONDING":
-			return 2
-		case "UNBONDED":
-			return 3
-		default:
-			return 4
-		}
-	}
-
 	sort.Slice(validators, func(i, j int) bool {
-		// My validator always comes first
-		iIsOurs := validators[i].Address == c.myValidatorAddress
-		jIsOurs := validators[j].Address == c.myValidatorAddress
-		if iIsOurs != jIsOurs {
-			return iIsOurs // True (our validator) sorts before false
-		}
-
-		// Sort by status first (BONDED < UNBONDING < UNBONDED)
-		iOrder := statusOrder(validators[i].Status)
-		jOrder := statusOrder(validators[j].Status)
-		if iOrder != jOrder {
-			return iOrder < jOrder
-		}
-		// Within same status, sort by voting power (highest first)
-		return validators[i].VotingPower > validators[j].VotingPower
+		return ValidatorOrderLess(
+			validators[i].Address == c.myValidatorAddress, validators[j].Address == c.myValidatorAddress,
+			validators[i].Status, validators[j].Status,
+			validators[i].VotingPower, validators[j].VotingPower,
+		)
 	})
 
 	return validators
@@ -0,0 +1,80 @@
+// Package diskspace checks free space on the filesystem a large write will
+// land on, so operations can fail before they change anything.
+package diskspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// MinMargin is the least headroom required on top of an operation's own size.
+const MinMargin int64 = 64 << 20
+
+// ErrInsufficient is wrapped by Check when there is not enough free space.
+var ErrInsufficient = errors.New("insufficient disk space")
+
+// Free returns the bytes available to unprivileged users on the filesystem
+// holding path. If path does not exist yet, its nearest existing parent is
+// used. It is a variable so tests can fake the filesystem.
+var Free = func(path string) (int64, error) {
+	checkPath := path
+	for {
+		if _, err := os.Stat(checkPath); err == nil {
+			break
+		}
+		parent := filepath.Dir(checkPath)
+		if parent == checkPath {
+			break
+		}
+		checkPath = parent
+	}
+
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(checkPath, &stat); err != nil {
+		return 0, fmt.Errorf("unable to check disk space: %w", err)
+	}
+	return int64(stat.Bavail) * int64(stat.Bsize), nil
+}
+
+// Required returns size plus a safety margin of 10%, at least MinMargin.
+func Required(size int64) int64 {
+	return size + max(size/10, MinMargin)
+}
+
+// Check fails with ErrInsufficient when the filesystem holding path has
+// less than Required(size) free. A non-positive size (unknown) passes.
+func Check(path string, size int64) error {
+	if size <= 0 {
+		return nil
+	}
+	free, err := Free(path)
+	if err != nil {
+		return err
+	}
+	if need := Required(size); free < need {
+		return fmt.Errorf("%w on %s: need ~%s, have %s free", ErrInsufficient, path, Human(need), Human(free))
+	}
+	return nil
+}
+
+// Human formats a byte count compactly, e.g. "2.3GB" or "512MB".
+func Human(b int64) string {
+	const (
+		kb = 1024
+		mb = kb * 1024
+		gb = mb * 1024
+	)
+	switch {
+	case b >= gb:
+		return fmt.Sprintf("%.1fGB", float64(b)/float64(gb))
+	case b >= mb:
+		return fmt.Sprintf("%.0fMB", float64(b)/float64(mb))
+	case b >= kb:
+		return fmt.Sprintf("%.0fKB", float64(b)/float64(kb))
+	default:
+		return fmt.Sprintf("%dB", b)
+	}
+}
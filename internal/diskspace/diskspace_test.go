@@ -0,0 +1,68 @@
+package diskspace
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRequired(t *testing.T) {
+	if got := Required(10 << 20); got != 10<<20+MinMargin {
+		t.Errorf("Required(10MB) = %d, want size + MinMargin", got)
+	}
+	if got := Required(10 << 30); got != 11<<30 {
+		t.Errorf("Required(10GB) = %d, want size + 10%%", got)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	orig := Free
+	t.Cleanup(func() { Free = orig })
+	Free = func(string) (int64, error) { return 1100 << 20, nil } // ~1.1GB
+
+	if err := Check("/data", 0); err != nil {
+		t.Errorf("unknown size should pass, got %v", err)
+	}
+	if err := Check("/data", 500<<20); err != nil {
+		t.Errorf("500MB should fit, got %v", err)
+	}
+	err := Check("/data", 2100<<20)
+	if !errors.Is(err, ErrInsufficient) {
+		t.Fatalf("expected ErrInsufficient, got %v", err)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "need ~2.3GB") || !strings.Contains(msg, "have 1.1GB free") {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	Free = func(string) (int64, error) { return 0, errors.New("statfs failed") }
+	if err := Check("/data", 1); err == nil || errors.Is(err, ErrInsufficient) {
+		t.Errorf("expected statfs error, got %v", err)
+	}
+}
+
+func TestFree_MissingPathUsesParent(t *testing.T) {
+	dir := t.TempDir()
+	free, err := Free(filepath.Join(dir, "not", "yet", "created"))
+	if err != nil {
+		t.Fatalf("Free() error = %v", err)
+	}
+	if free <= 0 {
+		t.Errorf("Free() = %d, want > 0", free)
+	}
+}
+
+func TestHuman(t *testing.T) {
+	tests := map[int64]string{
+		512:        "512B",
+		2048:       "2KB",
+		300 << 20:  "300MB",
+		2469606195: "2.3GB",
+		1181116006: "1.1GB",
+	}
+	for in, want := range tests {
+		if got := Human(in); got != want {
+			t.Errorf("Human(%d) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -30,6 +30,30 @@ const (
 	// ValidationError indicates validation failure
 	// (e.g., invalid config, corrupted data)
 	ValidationError = 6
+
+	// Node health codes returned by `status --strict`, most severe first
+
+	// NotRunning indicates the node process is not running
+	NotRunning = 10
+
+	// RPCUnreachable indicates the node is running but its RPC does not answer
+	RPCUnreachable = 11
+
+	// CatchingUp indicates the node is healthy but still syncing (retryable)
+	CatchingUp = 12
+
+	// NoPeers indicates the node is synced but has no connected peers
+	NoPeers = 13
+
+	// UpdateAvailable indicates a newer CLI release exists (`update --check --strict`)
+	UpdateAvailable = 20
+
+	// BroughtUp indicates `ensure-running` had to start the node or wait for
+	// it to sync; the node is healthy now
+	BroughtUp = 21
+
+	// TxFailed indicates a broadcast tx was rejected or failed on-chain
+	TxFailed = 30
 )
 
 
@@ -49,3 +73,61 @@ func CodeForError(err error) int {
 	// Default to general error - callers should use explicit error constructors
 	return GeneralError
 }
+
+// Stable, machine-readable error categories reported alongside exit codes
+const (
+	CategoryGeneral         = "general"
+	CategoryInvalidArgs     = "invalid_args"
+	CategoryPrecondition    = "precondition"
+	CategoryNetwork         = "network"
+	CategoryProcess         = "process"
+	CategoryValidation      = "validation"
+	CategorySyncStuck       = "sync_stuck"
+	CategoryNotRunning      = "not_running"
+	CategoryRPCUnreachable  = "rpc_unreachable"
+	CategoryCatchingUp      = "catching_up"
+	CategoryNoPeers         = "no_peers"
+	CategoryUpdateAvailable = "update_available"
+	CategoryBroughtUp       = "brought_up"
+	CategoryTxFailed        = "tx_failed"
+)
+
+// CategoryForCode returns the category name for an exit code.
+// Unknown and custom codes report CategoryGeneral.
+func CategoryForCode(code int) string {
+	switch code {
+	case InvalidArgs:
+		return CategoryInvalidArgs
+	case PreconditionFailed:
+		return CategoryPrecondition
+	case NetworkError:
+		return CategoryNetwork
+	case ProcessError:
+		return CategoryProcess
+	case ValidationError:
+		return CategoryValidation
+	case SyncStuck:
+		return CategorySyncStuck
+	case NotRunning:
+		return CategoryNotRunning
+	case RPCUnreachable:
+		return CategoryRPCUnreachable
+	case CatchingUp:
+		return CategoryCatchingUp
+	case NoPeers:
+		return CategoryNoPeers
+	case UpdateAvailable:
+		return CategoryUpdateAvailable
+	case BroughtUp:
+		return CategoryBroughtUp
+	case TxFailed:
+		return CategoryTxFailed
+	default:
+		return CategoryGeneral
+	}
+}
+
+// CategoryForError returns the category for err's exit code.
+func CategoryForError(err error) string {
+	return CategoryForCode(CodeForError(err))
+}
@@ -20,6 +20,17 @@ func (e *ErrorWithCode) Unwrap() error {
 	return e.Cause
 }
 
+// Sentinel errors for conditions scripts commonly branch on. Compare with
+// errors.Is; each carries its own exit code.
+var (
+	ErrNotRunning      = NewError(NotRunning, "node is not running")
+	ErrRPCUnreachable  = NewError(RPCUnreachable, "node RPC is unreachable")
+	ErrCatchingUp      = NewError(CatchingUp, "node is catching up")
+	ErrNoPeers         = NewError(NoPeers, "node has no peers")
+	ErrUpdateAvailable = NewError(UpdateAvailable, "update available")
+	ErrBroughtUp       = NewError(BroughtUp, "node brought up")
+)
+
 // NewError creates an error with an explicit exit code
 func NewError(code int, message string) *ErrorWithCode {
 	return &ErrorWithCode{Code: code, Message: message}
@@ -643,3 +643,64 @@ func TestMultipleLevelWrapping(t *testing.T) {
 		t.Errorf("CodeForError(level2) = %d, want %d", code, GeneralError)
 	}
 }
+
+func TestCategoryForCode(t *testing.T) {
+	tests := []struct {
+		code int
+		want string
+	}{
+		{GeneralError, CategoryGeneral},
+		{InvalidArgs, CategoryInvalidArgs},
+		{PreconditionFailed, CategoryPrecondition},
+		{NetworkError, CategoryNetwork},
+		{ProcessError, CategoryProcess},
+		{ValidationError, CategoryValidation},
+		{SyncStuck, CategorySyncStuck},
+		{NotRunning, CategoryNotRunning},
+		{RPCUnreachable, CategoryRPCUnreachable},
+		{CatchingUp, CategoryCatchingUp},
+		{NoPeers, CategoryNoPeers},
+		{UpdateAvailable, CategoryUpdateAvailable},
+		{BroughtUp, CategoryBroughtUp},
+		{TxFailed, CategoryTxFailed},
+		{99, CategoryGeneral},
+	}
+	for _, tt := range tests {
+		if got := CategoryForCode(tt.code); got != tt.want {
+			t.Errorf("CategoryForCode(%d) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+
+	if got := CategoryForError(NetworkErr("rpc down")); got != CategoryNetwork {
+		t.Errorf("CategoryForError(NetworkErr) = %q", got)
+	}
+	if got := CategoryForError(errors.New("plain")); got != CategoryGeneral {
+		t.Errorf("CategoryForError(plain) = %q", got)
+	}
+}
+
+func TestSentinelErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{ErrNotRunning, NotRunning},
+		{ErrRPCUnreachable, RPCUnreachable},
+		{ErrCatchingUp, CatchingUp},
+		{ErrNoPeers, NoPeers},
+		{ErrUpdateAvailable, UpdateAvailable},
+	}
+	seen := map[int]bool{}
+	for _, tt := range tests {
+		if got := CodeForError(tt.err); got != tt.code {
+			t.Errorf("CodeForError(%v) = %d, want %d", tt.err, got, tt.code)
+		}
+		if seen[tt.code] {
+			t.Errorf("exit code %d is not unique", tt.code)
+		}
+		seen[tt.code] = true
+		if !errors.Is(fmt.Errorf("wrapped: %w", tt.err), tt.err) {
+			t.Errorf("errors.Is should match wrapped %v", tt.err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Package fdlimit reads open file descriptor limits (RLIMIT_NOFILE). A
+// CometBFT node holds a descriptor for every peer connection besides its
+// database files, so a low soft limit shows up as dropped peers.
+package fdlimit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Recommended is the soft limit a validator node should run with.
+const Recommended = 65536
+
+// Unlimited is the value of a limit that is not capped.
+const Unlimited = ^uint64(0)
+
+// ErrUnsupported is returned where a limit cannot be read.
+var ErrUnsupported = errors.New("open file limits are not available on " + runtime.GOOS)
+
+// Limits is a soft and hard open file limit.
+type Limits struct {
+	Soft uint64
+	Hard uint64
+}
+
+// Low reports whether the soft limit is below Recommended.
+func (l Limits) Low() bool {
+	return l.Soft < Recommended
+}
+
+func (l Limits) String() string {
+	return fmt.Sprintf("soft %s, hard %s", format(l.Soft), format(l.Hard))
+}
+
+func format(v uint64) string {
+	if v == Unlimited {
+		return "unlimited"
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+// procDir is where per-process limits are read from; tests point it elsewhere.
+var procDir = "/proc"
+
+// Process returns the limits of the process with the given PID. It reads
+// /proc/<pid>/limits, so other platforms get ErrUnsupported.
+func Process(pid int) (Limits, error) {
+	if runtime.GOOS != "linux" {
+		return Limits{}, ErrUnsupported
+	}
+	data, err := os.ReadFile(filepath.Join(procDir, strconv.Itoa(pid), "limits"))
+	if err != nil {
+		return Limits{}, err
+	}
+	return parseProcLimits(string(data))
+}
+
+// parseProcLimits reads the "Max open files" row of a /proc/<pid>/limits
+// table.
+func parseProcLimits(data string) (Limits, error) {
+	const row = "Max open files"
+	for _, line := range strings.Split(data, "\n") {
+		rest, ok := strings.CutPrefix(line, row)
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) < 2 {
+			break
+		}
+		soft, err := parseLimit(fields[0])
+		if err != nil {
+			return Limits{}, err
+		}
+		hard, err := parseLimit(fields[1])
+		if err != nil {
+			return Limits{}, err
+		}
+		return Limits{Soft: soft, Hard: hard}, nil
+	}
+	return Limits{}, fmt.Errorf("no %q row in process limits", row)
+}
+
+func parseLimit(s string) (uint64, error) {
+	if s == "unlimited" {
+		return Unlimited, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
@@ -0,0 +1,78 @@
+package fdlimit
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+const procLimits = `Limit                     Soft Limit           Hard Limit           Units
+Max cpu time              unlimited            unlimited            seconds
+Max open files            1024                 524288               files
+Max locked memory         8388608              8388608              bytes
+`
+
+func TestParseProcLimits(t *testing.T) {
+	l, err := parseProcLimits(procLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l != (Limits{Soft: 1024, Hard: 524288}) || !l.Low() {
+		t.Errorf("limits = %+v, low %v", l, l.Low())
+	}
+	if got := l.String(); got != "soft 1024, hard 524288" {
+		t.Errorf("String() = %q", got)
+	}
+
+	l, err = parseProcLimits("Max open files            65536                unlimited            files\n")
+	if err != nil || l.Soft != 65536 || l.Hard != Unlimited || l.Low() {
+		t.Errorf("limits = %+v, %v; want 65536/unlimited, not low", l, err)
+	}
+	if got := l.String(); got != "soft 65536, hard unlimited" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if _, err := parseProcLimits("Max cpu time unlimited unlimited seconds\n"); err == nil {
+		t.Error("missing row should fail")
+	}
+}
+
+func TestProcess(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		if _, err := Process(1); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("Process() error = %v, want ErrUnsupported", err)
+		}
+		return
+	}
+	dir := t.TempDir()
+	orig := procDir
+	procDir = dir
+	defer func() { procDir = orig }()
+	if err := os.MkdirAll(filepath.Join(dir, "42"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "42", "limits"), []byte(procLimits), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if l, err := Process(42); err != nil || l.Soft != 1024 {
+		t.Errorf("Process(42) = %+v, %v", l, err)
+	}
+	if _, err := Process(43); err == nil {
+		t.Error("missing process should fail")
+	}
+}
+
+func TestChild(t *testing.T) {
+	l, err := Child()
+	if runtime.GOOS == "windows" {
+		if !errors.Is(err, ErrUnsupported) {
+			t.Errorf("Child() error = %v, want ErrUnsupported", err)
+		}
+		return
+	}
+	if err != nil || l.Soft == 0 || l.Soft > l.Hard {
+		t.Errorf("Child() = %+v, %v", l, err)
+	}
+}
@@ -0,0 +1,33 @@
+//go:build !windows
+
+package fdlimit
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Child returns the limits a process started by this CLI gets, which is
+// what a node started by push-validator inherits. The Go runtime raises its
+// own soft limit at startup and restores the original one for children, so
+// Getrlimit here would overstate it; a child shell reports the real value.
+func Child() (Limits, error) {
+	out, err := exec.Command("sh", "-c", "ulimit -Sn; ulimit -Hn").Output()
+	if err != nil {
+		return Limits{}, fmt.Errorf("read limits from sh: %w", err)
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) != 2 {
+		return Limits{}, fmt.Errorf("unexpected ulimit output %q", out)
+	}
+	soft, err := parseLimit(fields[0])
+	if err != nil {
+		return Limits{}, err
+	}
+	hard, err := parseLimit(fields[1])
+	if err != nil {
+		return Limits{}, err
+	}
+	return Limits{Soft: soft, Hard: hard}, nil
+}
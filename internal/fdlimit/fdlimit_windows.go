@@ -0,0 +1,6 @@
+package fdlimit
+
+// Child returns ErrUnsupported: Windows has no RLIMIT_NOFILE.
+func Child() (Limits, error) {
+	return Limits{}, ErrUnsupported
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -14,6 +15,8 @@ type ConfigStore interface {
 	EnableStateSync(params StateSyncParams) error
 	DisableStateSync() error
 	SetPersistentPeers(peers []string) error
+	SetListenPorts(rpcPort, p2pPort int) error
+	SetJSONRPCPorts(httpPort, wsPort int) error
 	Backup() (string, error) // returns backup path of config.toml
 }
 
@@ -46,6 +49,16 @@ func (s *store) writeConfig(content string) error {
 	return os.WriteFile(s.cfgPath(), []byte(content), 0o644)
 }
 
+func (s *store) appPath() string { return filepath.Join(s.home, "config", "app.toml") }
+
+func (s *store) readApp() (string, error) {
+	b, err := os.ReadFile(s.appPath())
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 func (s *store) Backup() (string, error) {
 	src := s.cfgPath()
 	ts := time.Now().Format("20060102-150405")
@@ -113,6 +126,151 @@ func (s *store) SetPersistentPeers(peers []string) error {
 	return s.writeConfig(content)
 }
 
+// SetListenPorts points [rpc] laddr and [p2p] laddr at the given ports,
+// keeping their configured hosts. A zero port leaves that address alone.
+func (s *store) SetListenPorts(rpcPort, p2pPort int) error {
+	content, err := s.readConfig()
+	if err != nil {
+		return err
+	}
+	for _, l := range []struct {
+		section, host string
+		port          int
+	}{
+		{"rpc", "127.0.0.1", rpcPort},
+		{"p2p", "0.0.0.0", p2pPort},
+	} {
+		if l.port == 0 {
+			continue
+		}
+		if !regexp.MustCompile(`(?m)^\[` + l.section + `\]\s*$`).MatchString(content) {
+			content += "\n[" + l.section + "]\n"
+		}
+		host := l.host
+		if h, _, ok := splitLaddr(getInSection(content, l.section, "laddr")); ok {
+			host = h
+		}
+		content = setInSection(content, l.section, map[string]string{
+			"laddr": fmt.Sprintf("\"tcp://%s:%d\"", host, l.port),
+		})
+	}
+	return s.writeConfig(content)
+}
+
+// ListenPorts returns the ports of [rpc] laddr and [p2p] laddr in home's
+// config.toml, or 0 for any that can't be read.
+func ListenPorts(home string) (rpcPort, p2pPort int) {
+	s := &store{home: home}
+	content, err := s.readConfig()
+	if err != nil {
+		return 0, 0
+	}
+	_, rpcPort, _ = splitLaddr(getInSection(content, "rpc", "laddr"))
+	_, p2pPort, _ = splitLaddr(getInSection(content, "p2p", "laddr"))
+	return rpcPort, p2pPort
+}
+
+// SetJSONRPCPorts points [json-rpc] address and ws-address in app.toml at
+// the given ports, keeping their configured hosts. A zero port leaves that
+// address alone.
+func (s *store) SetJSONRPCPorts(httpPort, wsPort int) error {
+	if httpPort == 0 && wsPort == 0 {
+		return nil
+	}
+	content, err := s.readApp()
+	if err != nil {
+		return err
+	}
+	if !regexp.MustCompile(`(?m)^\[json-rpc\]\s*$`).MatchString(content) {
+		content += "\n[json-rpc]\n"
+	}
+	for _, l := range []struct {
+		key  string
+		port int
+	}{
+		{"address", httpPort},
+		{"ws-address", wsPort},
+	} {
+		if l.port == 0 {
+			continue
+		}
+		host := "127.0.0.1"
+		if h, _, ok := splitLaddr(getInSection(content, "json-rpc", l.key)); ok {
+			host = h
+		}
+		content = setInSection(content, "json-rpc", map[string]string{
+			l.key: fmt.Sprintf("\"%s:%d\"", host, l.port),
+		})
+	}
+	return os.WriteFile(s.appPath(), []byte(content), 0o644)
+}
+
+// JSONRPCPorts returns the ports of [json-rpc] address and ws-address in
+// home's app.toml, or 0 for any that can't be read.
+func JSONRPCPorts(home string) (httpPort, wsPort int) {
+	s := &store{home: home}
+	content, err := s.readApp()
+	if err != nil {
+		return 0, 0
+	}
+	_, httpPort, _ = splitLaddr(getInSection(content, "json-rpc", "address"))
+	_, wsPort, _ = splitLaddr(getInSection(content, "json-rpc", "ws-address"))
+	return httpPort, wsPort
+}
+
+// ExternalAddress returns [p2p] external_address from config.toml with quotes
+// stripped, or "" if it is unset or the file can't be read.
+func ExternalAddress(home string) string {
+	s := &store{home: home}
+	content, err := s.readConfig()
+	if err != nil {
+		return ""
+	}
+	return strings.Trim(getInSection(content, "p2p", "external_address"), `"`)
+}
+
+// StateSyncEnabled reports whether [statesync] enable is true in home's
+// config.toml.
+func StateSyncEnabled(home string) bool {
+	s := &store{home: home}
+	content, err := s.readConfig()
+	if err != nil {
+		return false
+	}
+	return getInSection(content, "statesync", "enable") == "true"
+}
+
+// splitLaddr parses a quoted "tcp://host:port" or "host:port" listen address.
+func splitLaddr(v string) (string, int, bool) {
+	v = strings.TrimPrefix(strings.Trim(v, `"`), "tcp://")
+	i := strings.LastIndex(v, ":")
+	if i < 0 {
+		return "", 0, false
+	}
+	port, err := strconv.Atoi(v[i+1:])
+	if err != nil {
+		return "", 0, false
+	}
+	return v[:i], port, true
+}
+
+// getInSection returns the raw value of key within [section], or "".
+func getInSection(content, section, key string) string {
+	loc := regexp.MustCompile("(?m)^\\[" + regexp.QuoteMeta(section) + "\\]\\s*$").FindStringIndex(content)
+	if loc == nil {
+		return ""
+	}
+	block := content[loc[1]:]
+	if next := regexp.MustCompile(`(?m)^\[[^]]+\]\s*$`).FindStringIndex(block); next != nil {
+		block = block[:next[0]]
+	}
+	m := regexp.MustCompile("(?m)^[ \\t]*" + regexp.QuoteMeta(key) + "[ \\t]*=[ \\t]*(.*?)[ \\t]*$").FindStringSubmatch(block)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
 func setInSection(content, section string, kv map[string]string) string {
 	// Locate section bounds
 	reStart := regexp.MustCompile("(?m)^\\[" + regexp.QuoteMeta(section) + "\\]\\s*$")
@@ -133,7 +291,7 @@ func setInSection(content, section string, kv map[string]string) string {
 	after := content[end:]
 	// Apply/replace keys within block
 	for k, v := range kv {
-		re := regexp.MustCompile("(?m)^\\s*" + regexp.QuoteMeta(k) + "\\s*=\\s*.*$")
+		re := regexp.MustCompile("(?m)^[ \\t]*" + regexp.QuoteMeta(k) + "[ \\t]*=.*$")
 		line := fmt.Sprintf("%s = %s", k, v)
 		if re.MatchString(block) {
 			block = re.ReplaceAllString(block, line)
@@ -49,3 +49,111 @@ func TestConfigStore_StateSyncAndPeers(t *testing.T) {
     if _, err := os.Stat(p); err != nil { t.Fatalf("backup not created: %v", err) }
 }
 
+
+func TestConfigStore_ListenPorts(t *testing.T) {
+    dir := t.TempDir()
+    cfgDir := filepath.Join(dir, "config")
+    if err := os.MkdirAll(cfgDir, 0o755); err != nil { t.Fatal(err) }
+
+    if rpc, p2p := ListenPorts(dir); rpc != 0 || p2p != 0 {
+        t.Fatalf("missing config.toml: got %d/%d", rpc, p2p)
+    }
+
+    seed := "[rpc]\nladdr = \"tcp://0.0.0.0:26657\"\n\n[p2p]\nladdr = \"tcp://0.0.0.0:26656\"\npex = true\n"
+    cfgPath := filepath.Join(cfgDir, "config.toml")
+    if err := os.WriteFile(cfgPath, []byte(seed), 0o644); err != nil { t.Fatal(err) }
+    if rpc, p2p := ListenPorts(dir); rpc != 26657 || p2p != 26656 {
+        t.Fatalf("ListenPorts() = %d/%d, want 26657/26656", rpc, p2p)
+    }
+
+    if err := New(dir).SetListenPorts(36657, 36656); err != nil { t.Fatal(err) }
+    b, _ := os.ReadFile(cfgPath)
+    got := string(b)
+    // Hosts are kept, other keys untouched
+    if !strings.Contains(got, `laddr = "tcp://0.0.0.0:36657"`) || !strings.Contains(got, `laddr = "tcp://0.0.0.0:36656"`) || !strings.Contains(got, "pex = true") {
+        t.Fatalf("unexpected config.toml:\n%s", got)
+    }
+    if rpc, p2p := ListenPorts(dir); rpc != 36657 || p2p != 36656 {
+        t.Fatalf("ListenPorts() after set = %d/%d", rpc, p2p)
+    }
+
+    // Zero leaves a port alone; missing sections are added with default hosts
+    if err := os.WriteFile(cfgPath, []byte(""), 0o644); err != nil { t.Fatal(err) }
+    if err := New(dir).SetListenPorts(0, 36656); err != nil { t.Fatal(err) }
+    b, _ = os.ReadFile(cfgPath)
+    if strings.Contains(string(b), "[rpc]") || !strings.Contains(string(b), `laddr = "tcp://0.0.0.0:36656"`) {
+        t.Fatalf("unexpected config.toml:\n%s", b)
+    }
+}
+
+func TestJSONRPCPorts(t *testing.T) {
+    dir := t.TempDir()
+    if h, ws := JSONRPCPorts(dir); h != 0 || ws != 0 {
+        t.Fatalf("missing app.toml: got %d/%d", h, ws)
+    }
+    cfgDir := filepath.Join(dir, "config")
+    if err := os.MkdirAll(cfgDir, 0o755); err != nil { t.Fatal(err) }
+    appPath := filepath.Join(cfgDir, "app.toml")
+
+    seed := "[json-rpc]\nenable = true\naddress = \"0.0.0.0:8545\"\nws-address = \"0.0.0.0:8546\"\n\n[grpc]\naddress = \"localhost:9090\"\n"
+    if err := os.WriteFile(appPath, []byte(seed), 0o644); err != nil { t.Fatal(err) }
+    if h, ws := JSONRPCPorts(dir); h != 8545 || ws != 8546 {
+        t.Fatalf("JSONRPCPorts() = %d/%d, want 8545/8546", h, ws)
+    }
+
+    if err := New(dir).SetJSONRPCPorts(9545, 0); err != nil { t.Fatal(err) }
+    b, _ := os.ReadFile(appPath)
+    got := string(b)
+    // Hosts are kept, zero leaves ws-address alone, other tables untouched
+    if !strings.Contains(got, `address = "0.0.0.0:9545"`) || !strings.Contains(got, `ws-address = "0.0.0.0:8546"`) || !strings.Contains(got, `address = "localhost:9090"`) {
+        t.Fatalf("unexpected app.toml:\n%s", got)
+    }
+    if h, ws := JSONRPCPorts(dir); h != 9545 || ws != 8546 {
+        t.Fatalf("JSONRPCPorts() after set = %d/%d", h, ws)
+    }
+}
+
+func TestExternalAddress(t *testing.T) {
+    dir := t.TempDir()
+    if got := ExternalAddress(dir); got != "" {
+        t.Fatalf("missing config.toml: got %q", got)
+    }
+    cfgDir := filepath.Join(dir, "config")
+    if err := os.MkdirAll(cfgDir, 0o755); err != nil { t.Fatal(err) }
+    cfgPath := filepath.Join(cfgDir, "config.toml")
+
+    seed := "[rpc]\nladdr = \"tcp://0.0.0.0:26657\"\n\n[p2p]\nexternal_address = \"\"\n"
+    if err := os.WriteFile(cfgPath, []byte(seed), 0o644); err != nil { t.Fatal(err) }
+    if got := ExternalAddress(dir); got != "" {
+        t.Fatalf("empty external_address: got %q", got)
+    }
+
+    seed = "[p2p]\nexternal_address = \"203.0.113.7:26656\"\n"
+    if err := os.WriteFile(cfgPath, []byte(seed), 0o644); err != nil { t.Fatal(err) }
+    if got := ExternalAddress(dir); got != "203.0.113.7:26656" {
+        t.Fatalf("ExternalAddress() = %q", got)
+    }
+}
+
+func TestStateSyncEnabled(t *testing.T) {
+    dir := t.TempDir()
+    if StateSyncEnabled(dir) {
+        t.Fatal("missing config.toml reported as enabled")
+    }
+    cfgDir := filepath.Join(dir, "config")
+    if err := os.MkdirAll(cfgDir, 0o755); err != nil { t.Fatal(err) }
+    if err := os.WriteFile(filepath.Join(cfgDir, "config.toml"), []byte("[statesync]\nenable = false\n"), 0o644); err != nil { t.Fatal(err) }
+
+    s := New(dir)
+    if StateSyncEnabled(dir) {
+        t.Fatal("enable = false reported as enabled")
+    }
+    if err := s.EnableStateSync(StateSyncParams{TrustHeight: 1000, TrustHash: "ABC", RPCServers: []string{"a", "b"}}); err != nil { t.Fatal(err) }
+    if !StateSyncEnabled(dir) {
+        t.Fatal("expected enabled after EnableStateSync")
+    }
+    if err := s.DisableStateSync(); err != nil { t.Fatal(err) }
+    if StateSyncEnabled(dir) {
+        t.Fatal("expected disabled after DisableStateSync")
+    }
+}
@@ -0,0 +1,301 @@
+package files
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+)
+
+// NodeKey is a config.toml or app.toml setting that init flags and
+// `config node-set` are allowed to change.
+type NodeKey struct {
+	File     string // "config.toml" or "app.toml"
+	Section  string // TOML table; "" for top-level keys
+	Name     string // key within the table
+	Validate func(string) error
+}
+
+// NodeKeys are the known tunables, addressed as <table>.<key> or, for
+// top-level keys, <key>. Every value is written as a TOML string.
+var NodeKeys = map[string]NodeKey{
+	"pruning":              {File: "app.toml", Name: "pruning", Validate: oneOf("default", "nothing", "everything", "custom")},
+	"pruning-keep-recent":  {File: "app.toml", Name: "pruning-keep-recent", Validate: validUint},
+	"pruning-interval":     {File: "app.toml", Name: "pruning-interval", Validate: validUint},
+	"minimum-gas-prices":   {File: "app.toml", Name: "minimum-gas-prices", Validate: validGasPrices},
+	"log_format":           {File: "config.toml", Name: "log_format", Validate: oneOf("plain", "json")},
+	"tx_index.indexer":     {File: "config.toml", Section: "tx_index", Name: "indexer", Validate: oneOf("kv", "null", "psql")},
+	"p2p.persistent_peers": {File: "config.toml", Section: "p2p", Name: "persistent_peers", Validate: validPeers},
+	"p2p.seeds":            {File: "config.toml", Section: "p2p", Name: "seeds", Validate: validPeers},
+}
+
+// NodeKeyNames returns the known keys in sorted order.
+func NodeKeyNames() []string {
+	names := make([]string, 0, len(NodeKeys))
+	for k := range NodeKeys {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetNodeConfig validates value for key and writes it to the key's file
+// under home/config. The file is parsed with a TOML parser that keeps
+// comments, and only the value's bytes are replaced (or one line inserted
+// when the key is missing), so every other setting is left as it was.
+func SetNodeConfig(home, key, value string) error {
+	k, ok := NodeKeys[key]
+	if !ok {
+		return fmt.Errorf("unknown key %q (known: %s)", key, strings.Join(NodeKeyNames(), ", "))
+	}
+	if err := k.Validate(value); err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	path := filepath.Join(home, "config", k.File)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	line, err := toml.Marshal(map[string]string{k.Name: value})
+	if err != nil {
+		return err
+	}
+	loc, err := locateNodeKey(data, k)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", k.File, err)
+	}
+
+	var out []byte
+	switch {
+	case loc.found:
+		encoded := bytes.TrimSpace(line[bytes.IndexByte(line, '=')+1:])
+		out = splice(data, loc.valueStart, loc.valueEnd, encoded)
+	case loc.insertAt >= 0:
+		if loc.insertAt == len(data) && len(data) > 0 && data[len(data)-1] != '\n' {
+			line = append([]byte{'\n'}, line...)
+		}
+		out = splice(data, loc.insertAt, loc.insertAt, line)
+	default:
+		out = append([]byte{}, data...)
+		if len(out) > 0 && !bytes.HasSuffix(out, []byte("\n")) {
+			out = append(out, '\n')
+		}
+		out = append(out, fmt.Sprintf("\n[%s]\n", k.Section)...)
+		out = append(out, line...)
+	}
+	return os.WriteFile(path, out, 0o644)
+}
+
+// GetNodeConfig returns the current value of key, or "" when it is unset.
+func GetNodeConfig(home, key string) (string, error) {
+	k, ok := NodeKeys[key]
+	if !ok {
+		return "", fmt.Errorf("unknown key %q (known: %s)", key, strings.Join(NodeKeyNames(), ", "))
+	}
+	data, err := os.ReadFile(filepath.Join(home, "config", k.File))
+	if err != nil {
+		return "", err
+	}
+	var doc map[string]any
+	if err := toml.Unmarshal(data, &doc); err != nil {
+		return "", fmt.Errorf("parse %s: %w", k.File, err)
+	}
+	table := doc
+	if k.Section != "" {
+		t, ok := doc[k.Section].(map[string]any)
+		if !ok {
+			return "", nil
+		}
+		table = t
+	}
+	v, ok := table[k.Name]
+	if !ok {
+		return "", nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// nodeKeyLocation records where a key's value sits in a TOML document. When
+// the key is missing, insertAt is the offset to insert it at, or -1 when its
+// table is missing too.
+type nodeKeyLocation struct {
+	found                bool
+	valueStart, valueEnd int
+	insertAt             int
+}
+
+// locateNodeKey walks the document's expressions to find k's value, or the
+// place a new line for it belongs: before the first table for top-level
+// keys, or right after the table header otherwise.
+func locateNodeKey(data []byte, k NodeKey) (nodeKeyLocation, error) {
+	loc := nodeKeyLocation{insertAt: -1}
+	p := unstable.Parser{KeepComments: true}
+	p.Reset(data)
+	table := ""
+	if k.Section == "" {
+		loc.insertAt = len(data)
+	}
+	for p.NextExpression() {
+		n := p.Expression()
+		switch n.Kind {
+		case unstable.Table, unstable.ArrayTable:
+			name, keyEnd := keyName(&p, n)
+			table = name
+			if n.Kind == unstable.ArrayTable {
+				table = "[[" + name + "]]" // never matches a plain section
+			}
+			if k.Section == "" && loc.insertAt == len(data) {
+				loc.insertAt = bytes.LastIndexByte(data[:keyEnd], '\n') + 1
+			}
+			if table == k.Section && k.Section != "" {
+				if nl := bytes.IndexByte(data[keyEnd:], '\n'); nl >= 0 {
+					loc.insertAt = keyEnd + nl + 1
+				} else {
+					loc.insertAt = len(data)
+				}
+			}
+		case unstable.KeyValue:
+			name, _ := keyName(&p, n)
+			if table != "" {
+				name = table + "." + name
+			}
+			want := k.Name
+			if k.Section != "" {
+				want = k.Section + "." + k.Name
+			}
+			if name != want {
+				continue
+			}
+			v := n.Value()
+			r := v.Raw
+			if r.Length == 0 {
+				r = p.Range(v.Data) // booleans carry no raw range
+			}
+			loc.found = true
+			loc.valueStart = int(r.Offset)
+			loc.valueEnd = int(r.Offset + r.Length)
+		}
+	}
+	if err := p.Error(); err != nil {
+		return loc, err
+	}
+	return loc, nil
+}
+
+// keyName returns the dotted name of n's key and the offset just past it.
+func keyName(p *unstable.Parser, n *unstable.Node) (string, int) {
+	var parts []string
+	end := 0
+	it := n.Key()
+	for it.Next() {
+		kn := it.Node()
+		parts = append(parts, string(kn.Data))
+		r := kn.Raw
+		end = int(r.Offset + r.Length)
+	}
+	return strings.Join(parts, "."), end
+}
+
+func splice(data []byte, start, end int, repl []byte) []byte {
+	out := make([]byte, 0, len(data)-(end-start)+len(repl))
+	out = append(out, data[:start]...)
+	out = append(out, repl...)
+	return append(out, data[end:]...)
+}
+
+func oneOf(allowed ...string) func(string) error {
+	return func(v string) error {
+		for _, a := range allowed {
+			if v == a {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s", strings.Join(allowed, ", "))
+	}
+}
+
+func validUint(v string) error {
+	if _, err := strconv.ParseUint(v, 10, 64); err != nil {
+		return fmt.Errorf("must be a non-negative integer")
+	}
+	return nil
+}
+
+var gasPriceRe = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?[a-zA-Z][a-zA-Z0-9/:._-]*$`)
+
+// validGasPrices accepts "" or comma-separated DecCoins like "1000upc".
+func validGasPrices(v string) error {
+	if v == "" {
+		return nil
+	}
+	for _, c := range strings.Split(v, ",") {
+		if !gasPriceRe.MatchString(c) {
+			return fmt.Errorf("%q is not an amount with denom (e.g. 1000upc)", c)
+		}
+	}
+	return nil
+}
+
+var peerRe = regexp.MustCompile(`^[0-9a-f]{40}@[^@:\s]+:[0-9]{1,5}$`)
+
+// validPeers accepts "" or comma-separated node_id@host:port entries.
+func validPeers(v string) error {
+	if v == "" {
+		return nil
+	}
+	for _, p := range strings.Split(v, ",") {
+		if !peerRe.MatchString(p) {
+			return fmt.Errorf("%q is not node_id@host:port", p)
+		}
+	}
+	return nil
+}
+
+// ParsePeers splits a comma-separated node address list, dropping blanks,
+// and checks every entry is node_id@host:port with a valid port.
+func ParsePeers(list string) ([]string, error) {
+	var peers []string
+	for _, p := range strings.Split(list, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !peerRe.MatchString(p) {
+			return nil, fmt.Errorf("%q is not node_id@host:port (node_id is 40 hex chars)", p)
+		}
+		if port, _ := strconv.Atoi(p[strings.LastIndexByte(p, ':')+1:]); port < 1 || port > 65535 {
+			return nil, fmt.Errorf("%q has an invalid port", p)
+		}
+		peers = append(peers, p)
+	}
+	return peers, nil
+}
+
+// MergePeers appends add to existing, skipping any entry whose node ID is
+// already present so existing addresses are never replaced. It returns the
+// merged list and the entries that were new.
+func MergePeers(existing, add []string) (merged, added []string) {
+	seen := make(map[string]bool, len(existing)+len(add))
+	for _, p := range existing {
+		id, _, _ := strings.Cut(p, "@")
+		seen[id] = true
+		merged = append(merged, p)
+	}
+	for _, p := range add {
+		id, _, _ := strings.Cut(p, "@")
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		merged = append(merged, p)
+		added = append(added, p)
+	}
+	return merged, added
+}
@@ -0,0 +1,114 @@
+package files
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetNodeConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfgDir := filepath.Join(dir, "config")
+	if err := os.MkdirAll(cfgDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	app := "# This is a TOML config file.\n\n# pruning strategy\npruning = \"default\"\nminimum-gas-prices = \"\"\n\n[api]\nenable = false\n"
+	cfg := "[p2p]\n# comma separated\nseeds = \"\"\n\n[tx_index]\nindexer = \"kv\" # default\n"
+	if err := os.WriteFile(filepath.Join(cfgDir, "app.toml"), []byte(app), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(cfgDir, "config.toml"), []byte(cfg), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	peer := "6751a6539368608a65512d1a4b7ede4a9cd5004f@136.112.142.137:26656"
+	for key, value := range map[string]string{
+		"pruning":              "custom",
+		"pruning-keep-recent":  "100",
+		"minimum-gas-prices":   "1000upc",
+		"tx_index.indexer":     "null",
+		"p2p.seeds":            peer,
+		"p2p.persistent_peers": peer,
+		"log_format":           "json",
+	} {
+		if err := SetNodeConfig(dir, key, value); err != nil {
+			t.Fatalf("SetNodeConfig(%s): %v", key, err)
+		}
+		if got, err := GetNodeConfig(dir, key); err != nil || got != value {
+			t.Fatalf("GetNodeConfig(%s) = %q, %v; want %q", key, got, err, value)
+		}
+	}
+
+	b, _ := os.ReadFile(filepath.Join(cfgDir, "app.toml"))
+	s := string(b)
+	if !strings.Contains(s, "# pruning strategy\npruning = 'custom'") || !strings.Contains(s, "[api]\nenable = false") {
+		t.Fatalf("app.toml edited out of place: %s", s)
+	}
+	// pruning-keep-recent was missing and belongs before the first table
+	if i, j := strings.Index(s, "pruning-keep-recent"), strings.Index(s, "[api]"); i < 0 || i > j {
+		t.Fatalf("new top-level key not before first table: %s", s)
+	}
+	b, _ = os.ReadFile(filepath.Join(cfgDir, "config.toml"))
+	if s := string(b); !strings.Contains(s, "# comma separated\n") || !strings.Contains(s, "indexer = 'null' # default") {
+		t.Fatalf("config.toml comments not kept: %s", s)
+	} else if i, j := strings.Index(s, "log_format = 'json'"), strings.Index(s, "[p2p]"); i < 0 || i > j {
+		t.Fatalf("log_format not written as a top-level key: %s", s)
+	}
+
+	// A key whose table is missing gets the table appended
+	if err := os.WriteFile(filepath.Join(cfgDir, "config.toml"), []byte("moniker = \"n\""), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetNodeConfig(dir, "tx_index.indexer", "kv"); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := GetNodeConfig(dir, "tx_index.indexer"); got != "kv" {
+		t.Fatalf("indexer = %q after appending table, want kv", got)
+	}
+}
+
+func TestSetNodeConfig_Validation(t *testing.T) {
+	dir := t.TempDir()
+	for _, tc := range []struct{ key, value string }{
+		{"unknown", "x"},
+		{"pruning", "some"},
+		{"pruning-interval", "-1"},
+		{"minimum-gas-prices", "upc"},
+		{"tx_index.indexer", "sql"},
+		{"p2p.seeds", "abc@1.2.3.4:26656"},
+	} {
+		if err := SetNodeConfig(dir, tc.key, tc.value); err == nil {
+			t.Errorf("SetNodeConfig(%q, %q) accepted invalid input", tc.key, tc.value)
+		}
+	}
+	// Valid value but no config files yet
+	if err := SetNodeConfig(dir, "pruning", "nothing"); !os.IsNotExist(err) {
+		t.Errorf("expected not-exist error, got %v", err)
+	}
+}
+
+func TestParseAndMergePeers(t *testing.T) {
+	a := "6751a6539368608a65512d1a4b7ede4a9cd5004f@136.112.142.137:26656"
+	b := "374573900e4365bea5d946dd69c7343e56e4f375@34.72.243.200:26656"
+	bMoved := "374573900e4365bea5d946dd69c7343e56e4f375@10.0.0.1:26656"
+
+	got, err := ParsePeers(" " + a + ", ," + b)
+	if err != nil || len(got) != 2 || got[0] != a || got[1] != b {
+		t.Fatalf("ParsePeers() = %v, %v", got, err)
+	}
+	for _, bad := range []string{"abc@1.2.3.4:26656", a[:41] + "host", strings.Replace(a, "26656", "70000", 1)} {
+		if _, err := ParsePeers(bad); err == nil {
+			t.Errorf("ParsePeers(%q) accepted invalid address", bad)
+		}
+	}
+
+	merged, added := MergePeers([]string{a, b}, []string{bMoved, a})
+	if len(merged) != 2 || merged[1] != b || len(added) != 0 {
+		t.Errorf("MergePeers() = %v, %v; want existing entries kept", merged, added)
+	}
+	merged, added = MergePeers([]string{a}, []string{b, b})
+	if len(merged) != 2 || len(added) != 1 || added[0] != b {
+		t.Errorf("MergePeers() = %v, %v; want b added once", merged, added)
+	}
+}
@@ -0,0 +1,167 @@
+package files
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PrivValStateStub is the zero signing state a validator starts from.
+const PrivValStateStub = "{\n  \"height\": \"0\",\n  \"round\": 0,\n  \"step\": 0\n}\n"
+
+// ErrPrivValStateCorrupt means priv_validator_state.json exists but can't be
+// parsed. pchaind refuses to start until it is repaired.
+var ErrPrivValStateCorrupt = errors.New("priv_validator_state.json is corrupt")
+
+// PrivValStatePath returns the location of priv_validator_state.json under home.
+func PrivValStatePath(home string) string {
+	return filepath.Join(home, "data", "priv_validator_state.json")
+}
+
+// EnsurePrivValState writes the zero stub if priv_validator_state.json is
+// missing. An existing file is left alone, corrupt or not.
+func EnsurePrivValState(home string) error {
+	path := PrivValStatePath(home)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(PrivValStateStub), 0o644)
+}
+
+// ErrPrivValStateBehind means priv_validator_state.json is below a height
+// the validator is known to have signed, so its double-sign guard is gone.
+var ErrPrivValStateBehind = errors.New("priv_validator_state.json is behind the last recorded signed height")
+
+// CheckPrivValState returns an error wrapping ErrPrivValStateCorrupt if
+// priv_validator_state.json is empty, truncated or has a non-numeric height.
+// A missing file is fine; EnsurePrivValState creates it.
+func CheckPrivValState(home string) error {
+	path := PrivValStatePath(home)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = parsePrivValHeight(path, data)
+	return err
+}
+
+func parsePrivValHeight(path string, data []byte) (int64, error) {
+	var state struct {
+		Height string `json:"height"`
+		Round  int64  `json:"round"`
+		Step   int8   `json:"step"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return 0, fmt.Errorf("%w: %s: %v", ErrPrivValStateCorrupt, path, err)
+	}
+	height, err := strconv.ParseInt(state.Height, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: invalid height %q", ErrPrivValStateCorrupt, path, state.Height)
+	}
+	return height, nil
+}
+
+// PrivValStateHeight returns the last height priv_validator_state.json
+// records as signed; 0 if the file is missing.
+func PrivValStateHeight(home string) (int64, error) {
+	path := PrivValStatePath(home)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return parsePrivValHeight(path, data)
+}
+
+// SignedHeightPath returns the file holding the highest signed height seen
+// by reset and backup. It lives outside data/ so a reset keeps it.
+func SignedHeightPath(home string) string {
+	return filepath.Join(home, ".signed-height")
+}
+
+// RecordedSignedHeight returns the height in SignedHeightPath, or 0.
+func RecordedSignedHeight(home string) int64 {
+	data, err := os.ReadFile(SignedHeightPath(home))
+	if err != nil {
+		return 0
+	}
+	n, _ := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	return n
+}
+
+// RecordSignedHeight raises the recorded signed height to the one in
+// priv_validator_state.json, before a reset deletes it or a backup copies it.
+// It returns the recorded height.
+func RecordSignedHeight(home string) (int64, error) {
+	recorded := RecordedSignedHeight(home)
+	height, err := PrivValStateHeight(home)
+	if err != nil || height <= recorded {
+		return recorded, err
+	}
+	if err := os.WriteFile(SignedHeightPath(home), []byte(strconv.FormatInt(height, 10)+"\n"), 0o644); err != nil {
+		return recorded, err
+	}
+	return height, nil
+}
+
+// CheckSignedHeight returns an error wrapping ErrPrivValStateBehind if
+// priv_validator_state.json is below the recorded signed height, as it is
+// after a reset or when data is restored without its state file. Starting
+// then leaves the validator free to sign heights it already signed.
+func CheckSignedHeight(home string) error {
+	recorded := RecordedSignedHeight(home)
+	if recorded == 0 {
+		return nil
+	}
+	height, err := PrivValStateHeight(home)
+	if err != nil {
+		return err
+	}
+	if height < recorded {
+		return fmt.Errorf("%w: state height %d, signed up to %d", ErrPrivValStateBehind, height, recorded)
+	}
+	return nil
+}
+
+// ClearSignedHeight forgets the recorded signed height, once the operator
+// has accepted starting below it.
+func ClearSignedHeight(home string) error {
+	if err := os.Remove(SignedHeightPath(home)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// ResetPrivValState moves the current priv_validator_state.json aside to
+// <name>.corrupt-<timestamp> and writes the zero stub in its place. It
+// returns the path of the moved file, or "" if there was none.
+//
+// The stub clears the double-sign guard, so callers must make sure the node
+// is stopped and the key isn't signing anywhere else.
+func ResetPrivValState(home string) (string, error) {
+	path := PrivValStatePath(home)
+	moved := ""
+	if _, err := os.Stat(path); err == nil {
+		moved = fmt.Sprintf("%s.corrupt-%s", path, time.Now().Format("20060102-150405"))
+		if err := os.Rename(path, moved); err != nil {
+			return "", fmt.Errorf("move aside %s: %w", path, err)
+		}
+	}
+	if err := EnsurePrivValState(home); err != nil {
+		return moved, err
+	}
+	return moved, nil
+}
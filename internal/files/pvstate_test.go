@@ -0,0 +1,100 @@
+package files
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrivValState(t *testing.T) {
+	home := t.TempDir()
+	path := PrivValStatePath(home)
+
+	// Missing file is not an error and gets the stub
+	if err := CheckPrivValState(home); err != nil {
+		t.Fatalf("CheckPrivValState(missing) = %v", err)
+	}
+	if err := EnsurePrivValState(home); err != nil {
+		t.Fatalf("EnsurePrivValState() = %v", err)
+	}
+	if b, _ := os.ReadFile(path); string(b) != PrivValStateStub {
+		t.Fatalf("stub = %q", b)
+	}
+	if err := CheckPrivValState(home); err != nil {
+		t.Fatalf("CheckPrivValState(stub) = %v", err)
+	}
+
+	// An existing file is never overwritten by Ensure
+	signed := `{"height":"1234","round":0,"step":3,"signature":"abc"}`
+	_ = os.WriteFile(path, []byte(signed), 0o644)
+	_ = EnsurePrivValState(home)
+	if b, _ := os.ReadFile(path); string(b) != signed {
+		t.Fatalf("Ensure overwrote existing state: %q", b)
+	}
+
+	for _, tt := range []struct{ name, content string }{
+		{"empty", ""},
+		{"truncated", `{"height":"12`},
+		{"bad height", `{"height":"abc","round":0,"step":0}`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = os.WriteFile(path, []byte(tt.content), 0o644)
+			if err := CheckPrivValState(home); !errors.Is(err, ErrPrivValStateCorrupt) {
+				t.Fatalf("CheckPrivValState() = %v, want ErrPrivValStateCorrupt", err)
+			}
+		})
+	}
+
+	moved, err := ResetPrivValState(home)
+	if err != nil {
+		t.Fatalf("ResetPrivValState() = %v", err)
+	}
+	if filepath.Dir(moved) != filepath.Dir(path) {
+		t.Errorf("moved to %q", moved)
+	}
+	if b, _ := os.ReadFile(moved); string(b) != `{"height":"abc","round":0,"step":0}` {
+		t.Errorf("corrupt file not preserved: %q", b)
+	}
+	if err := CheckPrivValState(home); err != nil {
+		t.Errorf("CheckPrivValState(after reset) = %v", err)
+	}
+}
+
+func TestSignedHeight(t *testing.T) {
+	home := t.TempDir()
+	path := PrivValStatePath(home)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	_ = os.WriteFile(path, []byte(`{"height":"1234","round":0,"step":3}`), 0o644)
+
+	if err := CheckSignedHeight(home); err != nil {
+		t.Fatalf("CheckSignedHeight(nothing recorded) = %v", err)
+	}
+	if h, err := RecordSignedHeight(home); err != nil || h != 1234 {
+		t.Fatalf("RecordSignedHeight() = %d, %v; want 1234", h, err)
+	}
+
+	// A lower state never lowers the recorded height
+	_ = os.WriteFile(path, []byte(PrivValStateStub), 0o644)
+	if h, _ := RecordSignedHeight(home); h != 1234 {
+		t.Errorf("recorded height lowered to %d", h)
+	}
+	if err := CheckSignedHeight(home); !errors.Is(err, ErrPrivValStateBehind) {
+		t.Errorf("CheckSignedHeight(zeroed) = %v, want ErrPrivValStateBehind", err)
+	}
+
+	// Reset removes the state file; the recorded height survives
+	_ = os.RemoveAll(filepath.Dir(path))
+	if err := CheckSignedHeight(home); !errors.Is(err, ErrPrivValStateBehind) {
+		t.Errorf("CheckSignedHeight(missing) = %v, want ErrPrivValStateBehind", err)
+	}
+
+	if err := ClearSignedHeight(home); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckSignedHeight(home); err != nil {
+		t.Errorf("CheckSignedHeight(cleared) = %v", err)
+	}
+}
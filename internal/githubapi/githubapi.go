@@ -0,0 +1,170 @@
+// Package githubapi holds the request logic shared by everything that talks
+// to the GitHub releases API (the chain installer and the self-updater).
+package githubapi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Doer performs HTTP requests. *http.Client satisfies it.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// ErrNotFound is returned for 404 responses, which are never retried.
+var ErrNotFound = errors.New("not found")
+
+const (
+	maxAttempts = 3
+	// maxRetryAfter caps how long a Retry-After or rate-limit reset is
+	// honored; longer waits fail immediately instead of hanging the CLI.
+	maxRetryAfter = 60 * time.Second
+)
+
+// BaseDelay is the wait before the first retry. It doubles on each further
+// attempt and has up to 50% jitter added. Tests may lower it.
+var BaseDelay = time.Second
+
+// sleep and now can be overridden for testing
+var (
+	sleep = time.Sleep
+	now   = time.Now
+)
+
+// Token returns the GitHub token from PUSH_GITHUB_TOKEN, falling back to
+// GITHUB_TOKEN. Authenticated requests get 5000/hour instead of 60/hour per IP.
+func Token() string {
+	if t := strings.TrimSpace(os.Getenv("PUSH_GITHUB_TOKEN")); t != "" {
+		return t
+	}
+	return strings.TrimSpace(os.Getenv("GITHUB_TOKEN"))
+}
+
+// NewRequest builds a GET request with the headers the GitHub API expects,
+// authenticated when a token is configured. Only use it for api.github.com;
+// release asset URLs are pre-signed and must not carry the token.
+func NewRequest(url string) (*http.Request, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("User-Agent", "push-validator-cli")
+	if token := Token(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req, nil
+}
+
+// Get fetches url from the GitHub API. 429, 5xx and rate-limited 403
+// responses are retried up to 3 attempts in total with exponential backoff,
+// honoring Retry-After when present. A 404 returns ErrNotFound. On success
+// the caller must close the response body.
+func Get(client Doer, url string) (*http.Response, error) {
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		req, err := NewRequest(url)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch release: %w", err)
+		}
+		if resp.StatusCode == http.StatusOK {
+			return resp, nil
+		}
+
+		wait, retryable := retryDelay(resp, attempt)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, ErrNotFound
+		case isRateLimited(resp):
+			lastErr = fmt.Errorf("GitHub API rate limit exceeded: %s", resp.Status)
+		default:
+			lastErr = fmt.Errorf("GitHub API error: %s", resp.Status)
+		}
+		if !retryable || attempt == maxAttempts {
+			break
+		}
+		sleep(wait)
+	}
+	return nil, lastErr
+}
+
+// isRateLimited reports whether a 403/429 is GitHub's rate limiter rather
+// than a permissions problem.
+func isRateLimited(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return resp.StatusCode == http.StatusForbidden &&
+		(resp.Header.Get("Retry-After") != "" || resp.Header.Get("X-RateLimit-Remaining") == "0")
+}
+
+// retryDelay returns how long to wait before retrying resp, and whether it
+// should be retried at all.
+func retryDelay(resp *http.Response, attempt int) (time.Duration, bool) {
+	if resp.StatusCode < 500 && !isRateLimited(resp) {
+		return 0, false
+	}
+	if wait, ok := serverWait(resp); ok {
+		return wait, wait <= maxRetryAfter
+	}
+	backoff := BaseDelay << (attempt - 1)
+	if backoff > 0 {
+		backoff += rand.N(backoff/2 + 1)
+	}
+	return backoff, true
+}
+
+// serverWait reads the wait the server asked for from Retry-After (seconds
+// or HTTP date) or, for rate limits, X-RateLimit-Reset (unix seconds).
+func serverWait(resp *http.Response) (time.Duration, bool) {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(t.Sub(now()), 0), true
+		}
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return max(time.Unix(reset, 0).Sub(now()), 0), true
+		}
+	}
+	return 0, false
+}
+
+// RedirectTransport sends every request to Target's scheme and host, keeping
+// the original path and query. It lets the release clients run against a
+// local server that mimics GitHub.
+type RedirectTransport struct {
+	Target *url.URL
+	Next   http.RoundTripper // http.DefaultTransport when nil
+}
+
+func (t *RedirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL.Scheme = t.Target.Scheme
+	r.URL.Host = t.Target.Host
+	r.Host = t.Target.Host
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return next.RoundTrip(r)
+}
@@ -0,0 +1,224 @@
+package githubapi
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// scriptedDoer returns the queued responses in order and counts calls.
+type scriptedDoer struct {
+	responses []*http.Response
+	calls     int
+}
+
+func (d *scriptedDoer) Do(req *http.Request) (*http.Response, error) {
+	resp := d.responses[d.calls]
+	d.calls++
+	return resp, nil
+}
+
+func response(code int, headers map[string]string) *http.Response {
+	h := http.Header{}
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return &http.Response{
+		StatusCode: code,
+		Status:     strconv.Itoa(code) + " " + http.StatusText(code),
+		Header:     h,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}
+}
+
+// recordSleeps replaces sleep for the duration of the test.
+func recordSleeps(t *testing.T) *[]time.Duration {
+	t.Helper()
+	var slept []time.Duration
+	origSleep, origBase := sleep, BaseDelay
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+	BaseDelay = 100 * time.Millisecond
+	t.Cleanup(func() { sleep, BaseDelay = origSleep, origBase })
+	return &slept
+}
+
+func TestGet_RetriesServerErrors(t *testing.T) {
+	slept := recordSleeps(t)
+	d := &scriptedDoer{responses: []*http.Response{
+		response(http.StatusBadGateway, nil),
+		response(http.StatusServiceUnavailable, nil),
+		response(http.StatusOK, nil),
+	}}
+
+	resp, err := Get(d, "https://api.github.com/x")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if d.calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", d.calls)
+	}
+	if len(*slept) != 2 {
+		t.Fatalf("expected 2 sleeps, got %v", *slept)
+	}
+	// Exponential with up to 50% jitter
+	if s := (*slept)[0]; s < 100*time.Millisecond || s > 150*time.Millisecond {
+		t.Errorf("first backoff %v out of range", s)
+	}
+	if s := (*slept)[1]; s < 200*time.Millisecond || s > 300*time.Millisecond {
+		t.Errorf("second backoff %v out of range", s)
+	}
+}
+
+func TestGet_GivesUpAfterMaxAttempts(t *testing.T) {
+	recordSleeps(t)
+	d := &scriptedDoer{responses: []*http.Response{
+		response(http.StatusInternalServerError, nil),
+		response(http.StatusInternalServerError, nil),
+		response(http.StatusInternalServerError, nil),
+	}}
+
+	_, err := Get(d, "https://api.github.com/x")
+	if err == nil || !strings.Contains(err.Error(), "500") {
+		t.Fatalf("expected 500 error, got %v", err)
+	}
+	if d.calls != maxAttempts {
+		t.Errorf("expected %d attempts, got %d", maxAttempts, d.calls)
+	}
+}
+
+func TestGet_NotFoundIsNotRetried(t *testing.T) {
+	slept := recordSleeps(t)
+	d := &scriptedDoer{responses: []*http.Response{response(http.StatusNotFound, nil)}}
+
+	_, err := Get(d, "https://api.github.com/x")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if d.calls != 1 || len(*slept) != 0 {
+		t.Errorf("404 should not be retried (calls=%d, sleeps=%v)", d.calls, *slept)
+	}
+}
+
+func TestGet_HonorsRetryAfter(t *testing.T) {
+	slept := recordSleeps(t)
+	d := &scriptedDoer{responses: []*http.Response{
+		response(http.StatusTooManyRequests, map[string]string{"Retry-After": "7"}),
+		response(http.StatusOK, nil),
+	}}
+
+	if _, err := Get(d, "https://api.github.com/x"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*slept) != 1 || (*slept)[0] != 7*time.Second {
+		t.Errorf("expected a single 7s wait, got %v", *slept)
+	}
+}
+
+func TestGet_RateLimitedForbidden(t *testing.T) {
+	slept := recordSleeps(t)
+	origNow := now
+	now = func() time.Time { return time.Unix(1000, 0) }
+	t.Cleanup(func() { now = origNow })
+
+	d := &scriptedDoer{responses: []*http.Response{
+		response(http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1005"}),
+		response(http.StatusOK, nil),
+	}}
+	if _, err := Get(d, "https://api.github.com/x"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*slept) != 1 || (*slept)[0] != 5*time.Second {
+		t.Errorf("expected a 5s wait until reset, got %v", *slept)
+	}
+
+	// A reset far in the future is reported instead of waited out
+	d = &scriptedDoer{responses: []*http.Response{
+		response(http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "5000"}),
+	}}
+	_, err := Get(d, "https://api.github.com/x")
+	if err == nil || !strings.Contains(err.Error(), "rate limit") {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+	if d.calls != 1 {
+		t.Errorf("expected 1 attempt, got %d", d.calls)
+	}
+}
+
+func TestGet_PlainForbiddenIsNotRetried(t *testing.T) {
+	recordSleeps(t)
+	d := &scriptedDoer{responses: []*http.Response{response(http.StatusForbidden, nil)}}
+
+	if _, err := Get(d, "https://api.github.com/x"); err == nil {
+		t.Fatal("expected error")
+	}
+	if d.calls != 1 {
+		t.Errorf("expected 1 attempt, got %d", d.calls)
+	}
+}
+
+func TestNewRequest_Headers(t *testing.T) {
+	req, err := NewRequest("https://api.github.com/x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Header.Get("Accept") != "application/vnd.github.v3+json" || req.Header.Get("User-Agent") != "push-validator-cli" {
+		t.Errorf("unexpected headers: %v", req.Header)
+	}
+}
+
+func TestNewRequest_Authorization(t *testing.T) {
+	tests := []struct {
+		name       string
+		pushToken  string
+		ghToken    string
+		wantHeader string
+	}{
+		{"no token", "", "", ""},
+		{"GITHUB_TOKEN", "", "gh-abc", "Bearer gh-abc"},
+		{"PUSH_GITHUB_TOKEN wins", "push-xyz", "gh-abc", "Bearer push-xyz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PUSH_GITHUB_TOKEN", tt.pushToken)
+			t.Setenv("GITHUB_TOKEN", tt.ghToken)
+			req, err := NewRequest("https://api.github.com/x")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := req.Header.Get("Authorization"); got != tt.wantHeader {
+				t.Errorf("Authorization = %q, want %q", got, tt.wantHeader)
+			}
+		})
+	}
+}
+
+func TestRedirectTransport(t *testing.T) {
+	var gotPath, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotAuth = r.URL.RequestURI(), r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	t.Setenv("PUSH_GITHUB_TOKEN", "push-xyz")
+
+	target, _ := url.Parse(server.URL)
+	client := &http.Client{Transport: &RedirectTransport{Target: target}}
+	resp, err := Get(client, "https://api.github.com/repos/o/r/releases/latest?x=1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if gotPath != "/repos/o/r/releases/latest?x=1" {
+		t.Errorf("path = %q, want original path and query", gotPath)
+	}
+	if gotAuth != "Bearer push-xyz" {
+		t.Errorf("headers should be preserved, Authorization = %q", gotAuth)
+	}
+}
@@ -0,0 +1,217 @@
+// Package httpclient builds the HTTP client shared by the self-updater and
+// the chain installer, applying proxy, custom CA and timeout settings, and
+// throttles large downloads to the configured rate. It also holds the TLS
+// settings for chain RPC endpoints such as a self-hosted genesis RPC.
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/trace"
+)
+
+// DefaultTimeout bounds API requests when no timeout is configured.
+const DefaultTimeout = 30 * time.Second
+
+// Options configures the client.
+type Options struct {
+	// Proxy is used for all requests when set. Empty falls back to
+	// HTTPS_PROXY, HTTP_PROXY and NO_PROXY from the environment.
+	Proxy string
+	// CACertFile is a PEM bundle trusted in addition to the system roots,
+	// e.g. the certificate of a TLS-inspecting corporate proxy.
+	CACertFile string
+	// Timeout bounds each API request; 0 uses DefaultTimeout. Downloads
+	// pick their own limit with WithTimeout.
+	Timeout time.Duration
+	// DownloadRate caps large downloads read through Throttle, in bytes
+	// per second; 0 is unlimited.
+	DownloadRate int64
+	// RPCCACertFile is a PEM bundle trusted, in addition to the system
+	// roots, by RPCTransport, for RPC servers with a private certificate.
+	RPCCACertFile string
+	// RPCInsecure disables certificate verification in RPCTransport.
+	RPCInsecure bool
+}
+
+// FromConfig returns the client options held in cfg.
+func FromConfig(cfg config.Config) Options {
+	return Options{
+		Proxy:         cfg.HTTPProxy,
+		CACertFile:    cfg.CACertFile,
+		Timeout:       cfg.HTTPTimeout,
+		DownloadRate:  cfg.DownloadRate,
+		RPCCACertFile: cfg.RPCCACertFile,
+		RPCInsecure:   cfg.RPCTLSInsecure,
+	}
+}
+
+// New builds a client from opts.
+func New(opts Options) (*http.Client, error) {
+	tr, err := newTransport(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: tr, Timeout: timeoutOrDefault(opts.Timeout)}, nil
+}
+
+func newTransport(opts Options) (*http.Transport, error) {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.Proxy != "" {
+		u, err := url.Parse(opts.Proxy)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", opts.Proxy)
+		}
+		tr.Proxy = http.ProxyURL(u)
+	} else {
+		// Read the environment now rather than through http.ProxyFromEnvironment,
+		// which caches the first values it sees for the life of the process
+		proxyFunc := httpproxy.FromEnvironment().ProxyFunc()
+		tr.Proxy = func(req *http.Request) (*url.URL, error) { return proxyFunc(req.URL) }
+	}
+
+	if opts.CACertFile != "" {
+		pool, err := loadCAPool(opts.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		tr.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return tr, nil
+}
+
+// loadCAPool returns the system roots plus the certificates in file.
+func loadCAPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM certificates found in %s", file)
+	}
+	return pool, nil
+}
+
+// rpcTLSConfig returns the TLS settings for RPC endpoints, nil when opts
+// keep Go's defaults.
+func rpcTLSConfig(opts Options) (*tls.Config, error) {
+	if opts.RPCCACertFile == "" && !opts.RPCInsecure {
+		return nil, nil
+	}
+	c := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: opts.RPCInsecure}
+	if opts.RPCCACertFile != "" {
+		pool, err := loadCAPool(opts.RPCCACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("rpc CA certificate: %w", err)
+		}
+		c.RootCAs = pool
+	}
+	return c, nil
+}
+
+func timeoutOrDefault(d time.Duration) time.Duration {
+	if d <= 0 {
+		return DefaultTimeout
+	}
+	return d
+}
+
+// The process-wide settings, recorded once flags are parsed. The transport
+// is built on first use so a bad --ca-cert only fails commands that go online,
+// and wrapped so --trace logs its requests.
+var (
+	defaultMu   sync.Mutex
+	defaultOpts Options
+	defaultTr   http.RoundTripper
+	defaultErr  error
+
+	rpcBuilt bool
+	rpcTLS   *tls.Config
+	rpcTr    http.RoundTripper
+	rpcErr   error
+)
+
+// SetDefault records the settings used by Default, WithTimeout and the
+// RPC transport.
+func SetDefault(opts Options) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultOpts, defaultTr, defaultErr = opts, nil, nil
+	rpcBuilt, rpcTLS, rpcTr, rpcErr = false, nil, nil, nil
+}
+
+// Default returns a client for API requests built from the SetDefault settings.
+func Default() (*http.Client, error) {
+	return WithTimeout(0)
+}
+
+// WithTimeout returns a client sharing Default's transport with timeout d,
+// for downloads that outlast the API timeout. d <= 0 uses the configured timeout.
+func WithTimeout(d time.Duration) (*http.Client, error) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if defaultTr == nil && defaultErr == nil {
+		var tr *http.Transport
+		if tr, defaultErr = newTransport(defaultOpts); defaultErr == nil {
+			defaultTr = trace.Transport(tr)
+		}
+	}
+	if defaultErr != nil {
+		return nil, defaultErr
+	}
+	if d <= 0 {
+		d = timeoutOrDefault(defaultOpts.Timeout)
+	}
+	return &http.Client{Transport: defaultTr, Timeout: d}, nil
+}
+
+// buildRPC sets up the RPC TLS config and transport once; defaultMu is held.
+func buildRPC() {
+	if rpcBuilt {
+		return
+	}
+	rpcBuilt = true
+	rpcTLS, rpcErr = rpcTLSConfig(defaultOpts)
+	if rpcTLS == nil {
+		rpcTr = trace.Transport(nil)
+		return
+	}
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = rpcTLS
+	rpcTr = trace.Transport(tr)
+}
+
+// RPCTransport returns the transport for chain RPC requests (the genesis RPC,
+// remote height probes, node status), honouring RPCCACertFile and
+// RPCInsecure. When the CA file can't be loaded it returns the error along
+// with a strictly verifying transport, so callers that can't fail still work.
+func RPCTransport() (http.RoundTripper, error) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	buildRPC()
+	return rpcTr, rpcErr
+}
+
+// RPCTLSConfig returns the TLS settings RPCTransport uses, for websocket
+// dialers; nil means Go's defaults.
+func RPCTLSConfig() *tls.Config {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	buildRPC()
+	return rpcTLS
+}
@@ -0,0 +1,189 @@
+package httpclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+)
+
+func proxyFor(t *testing.T, c *http.Client, target string) string {
+	t.Helper()
+	req, _ := http.NewRequest("GET", target, nil)
+	u, err := c.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("Proxy() error = %v", err)
+	}
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
+
+func TestNew_Proxy(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://env-proxy:3128")
+	t.Setenv("HTTP_PROXY", "")
+	t.Setenv("NO_PROXY", "internal.example")
+
+	c, err := New(Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := proxyFor(t, c, "https://api.github.com/repos"); got != "http://env-proxy:3128" {
+		t.Errorf("HTTPS_PROXY not applied, got %q", got)
+	}
+	if got := proxyFor(t, c, "https://internal.example/x"); got != "" {
+		t.Errorf("NO_PROXY host should bypass the proxy, got %q", got)
+	}
+
+	// An explicit proxy (PUSH_PROXY) wins over the environment for every host
+	c, err = New(Options{Proxy: "http://push-proxy:8080"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, target := range []string{"https://api.github.com/repos", "https://internal.example/x"} {
+		if got := proxyFor(t, c, target); got != "http://push-proxy:8080" {
+			t.Errorf("proxy for %s = %q, want explicit proxy", target, got)
+		}
+	}
+
+	if _, err := New(Options{Proxy: "not a url"}); err == nil {
+		t.Error("expected error for invalid proxy URL")
+	}
+}
+
+func TestNew_CACert(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "")
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	// The test server's self-signed certificate is not in the system roots
+	plain, err := New(Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := plain.Get(srv.URL); err == nil {
+		t.Fatal("expected TLS verification failure without the custom CA")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, pemData, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(Options{CACertFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("request with custom CA failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	bad := filepath.Join(t.TempDir(), "bad.pem")
+	_ = os.WriteFile(bad, []byte("not a certificate"), 0o644)
+	if _, err := New(Options{CACertFile: bad}); err == nil || !strings.Contains(err.Error(), "no PEM certificates") {
+		t.Errorf("expected PEM error, got %v", err)
+	}
+	if _, err := New(Options{CACertFile: filepath.Join(t.TempDir(), "missing.pem")}); err == nil {
+		t.Error("expected error for missing CA file")
+	}
+}
+
+func TestDefault(t *testing.T) {
+	t.Cleanup(func() { SetDefault(Options{}) })
+
+	SetDefault(Options{Timeout: 5 * time.Second})
+	api, err := Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	download, err := WithTimeout(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.Timeout != 5*time.Second || download.Timeout != time.Minute {
+		t.Errorf("timeouts = %v/%v, want 5s/1m", api.Timeout, download.Timeout)
+	}
+	if api.Transport != download.Transport {
+		t.Error("API and download clients should share a transport")
+	}
+
+	SetDefault(Options{})
+	if api, _ := Default(); api.Timeout != DefaultTimeout {
+		t.Errorf("unset timeout = %v, want %v", api.Timeout, DefaultTimeout)
+	}
+
+	// A bad CA file only fails when a client is requested
+	SetDefault(Options{CACertFile: filepath.Join(t.TempDir(), "missing.pem")})
+	if _, err := Default(); err == nil {
+		t.Error("expected error from Default with a missing CA file")
+	}
+}
+
+func TestRPCTransport(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "")
+	t.Cleanup(func() { SetDefault(Options{}) })
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, pemData, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr bool
+	}{
+		{"strict by default", Options{}, true},
+		{"download CA is not trusted for RPC", Options{CACertFile: caFile}, true},
+		{"custom CA", Options{RPCCACertFile: caFile}, false},
+		{"insecure", Options{RPCInsecure: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDefault(tt.opts)
+			tr, err := RPCTransport()
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := (&http.Client{Transport: tr}).Get(srv.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("request error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		})
+	}
+
+	SetDefault(Options{RPCCACertFile: filepath.Join(t.TempDir(), "missing.pem")})
+	tr, err := RPCTransport()
+	if err == nil || tr == nil {
+		t.Errorf("RPCTransport() = %v, %v; want an error and a fallback transport", tr, err)
+	}
+	if RPCTLSConfig() != nil {
+		t.Error("a failed CA load should leave the default TLS settings")
+	}
+}
+
+func TestFromConfig(t *testing.T) {
+	cfg := config.Config{HTTPProxy: "http://p:1", CACertFile: "/ca.pem", HTTPTimeout: time.Second, RPCCACertFile: "/rpc.pem", RPCTLSInsecure: true}
+	want := Options{Proxy: "http://p:1", CACertFile: "/ca.pem", Timeout: time.Second, RPCCACertFile: "/rpc.pem", RPCInsecure: true}
+	if got := FromConfig(cfg); got != want {
+		t.Errorf("FromConfig() = %+v, want %+v", got, want)
+	}
+}
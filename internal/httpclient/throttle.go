@@ -0,0 +1,108 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateUnits maps the suffixes ParseRate accepts to their size in bytes.
+// Longer suffixes come first so "MiB" is not read as "B".
+var rateUnits = []struct {
+	suffix string
+	size   float64
+}{
+	{"kib", 1 << 10}, {"mib", 1 << 20}, {"gib", 1 << 30},
+	{"kb", 1e3}, {"mb", 1e6}, {"gb", 1e9},
+	{"k", 1e3}, {"m", 1e6}, {"g", 1e9},
+	{"b", 1},
+}
+
+// ParseRate parses a download rate such as "10MB", "512KiB" or "2.5M/s"
+// into bytes per second. Decimal units (KB, MB, GB) are powers of 1000 and
+// binary units (KiB, MiB, GiB) powers of 1024; a bare number is bytes. An
+// empty string or "0" means unlimited and returns 0.
+func ParseRate(s string) (int64, error) {
+	v := strings.ToLower(strings.TrimSpace(s))
+	v = strings.TrimSuffix(v, "/s")
+	if v == "" || v == "0" {
+		return 0, nil
+	}
+	size := 1.0
+	for _, u := range rateUnits {
+		if strings.HasSuffix(v, u.suffix) {
+			v, size = strings.TrimSpace(strings.TrimSuffix(v, u.suffix)), u.size
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid rate %q (want e.g. 10MB, 512KiB)", s)
+	}
+	rate := int64(n * size)
+	if rate == 0 && n > 0 {
+		return 0, fmt.Errorf("rate %q is below 1 byte/s", s)
+	}
+	return rate, nil
+}
+
+// Throttle wraps r so it reads at most the configured download rate
+// (Options.DownloadRate from SetDefault). It returns r unchanged when no
+// rate is set. Wrap the response body before any progress reader so the
+// reported rate and ETA reflect the limit. Canceling ctx ends a pending wait.
+func Throttle(ctx context.Context, r io.Reader) io.Reader {
+	defaultMu.Lock()
+	rate := defaultOpts.DownloadRate
+	defaultMu.Unlock()
+	return NewThrottledReader(ctx, r, rate)
+}
+
+// NewThrottledReader returns a reader that delivers at most bytesPerSec
+// bytes per second from r on average. bytesPerSec <= 0 returns r unchanged.
+// A Read waiting for the rate returns ctx.Err() once ctx is canceled.
+func NewThrottledReader(ctx context.Context, r io.Reader, bytesPerSec int64) io.Reader {
+	if bytesPerSec <= 0 {
+		return r
+	}
+	// Reads are split into chunks of about 1/10s so progress stays smooth
+	chunk := int(bytesPerSec / 10)
+	if chunk < 512 {
+		chunk = 512
+	}
+	return &throttledReader{ctx: ctx, r: r, rate: bytesPerSec, chunk: chunk}
+}
+
+type throttledReader struct {
+	ctx   context.Context
+	r     io.Reader
+	rate  int64
+	chunk int
+	start time.Time
+	read  int64
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	if t.start.IsZero() {
+		t.start = time.Now()
+	}
+	if len(p) > t.chunk {
+		p = p[:t.chunk]
+	}
+	n, err := t.r.Read(p)
+	t.read += int64(n)
+	// Sleep until the bytes read so far fit within the rate
+	due := time.Duration(float64(t.read) / float64(t.rate) * float64(time.Second))
+	if wait := due - time.Since(t.start); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-t.ctx.Done():
+			return n, t.ctx.Err()
+		}
+	}
+	return n, err
+}
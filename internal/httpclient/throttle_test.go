@@ -0,0 +1,90 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{"", 0},
+		{"0", 0},
+		{"1000", 1000},
+		{"10MB", 10_000_000},
+		{"10mb/s", 10_000_000},
+		{"512KiB", 512 * 1024},
+		{"2.5M", 2_500_000},
+		{"1 GiB", 1 << 30},
+	}
+	for _, tt := range tests {
+		got, err := ParseRate(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseRate(%q) = %d, %v; want %d", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []string{"fast", "-1MB", "10XB", "0.1B"} {
+		if _, err := ParseRate(in); err == nil {
+			t.Errorf("ParseRate(%q) should fail", in)
+		}
+	}
+}
+
+func TestThrottle_SlowsDownload(t *testing.T) {
+	body := bytes.Repeat([]byte("x"), 40*1024)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(body)
+	}))
+	defer srv.Close()
+	t.Cleanup(func() { SetDefault(Options{}) })
+
+	download := func() time.Duration {
+		t.Helper()
+		resp, err := http.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		start := time.Now()
+		data, err := io.ReadAll(Throttle(context.Background(), resp.Body))
+		if err != nil || len(data) != len(body) {
+			t.Fatalf("read %d bytes, err %v; want %d", len(data), err, len(body))
+		}
+		return time.Since(start)
+	}
+
+	SetDefault(Options{})
+	if d := download(); d > 200*time.Millisecond {
+		t.Fatalf("unthrottled download took %v", d)
+	}
+
+	// 40 KiB at 80 KiB/s needs about half a second
+	SetDefault(Options{DownloadRate: 80 * 1024})
+	if d := download(); d < 400*time.Millisecond {
+		t.Errorf("throttled download took %v, want >= 400ms", d)
+	}
+}
+
+func TestNewThrottledReader_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	// At 1 byte/s the first chunk would wait minutes
+	r := NewThrottledReader(ctx, bytes.NewReader(make([]byte, 4096)), 1)
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := r.Read(make([]byte, 1024))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Read() error = %v, want context.Canceled", err)
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("Read() returned after %v, want soon after cancel", d)
+	}
+}
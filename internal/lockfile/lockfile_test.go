@@ -0,0 +1,94 @@
+package lockfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestTryAcquire(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", UpdateName)
+
+	first, err := TryAcquire(path)
+	if err != nil {
+		t.Fatalf("TryAcquire() error = %v", err)
+	}
+	if _, err := TryAcquire(path); !errors.Is(err, ErrLocked) {
+		t.Fatalf("second TryAcquire() error = %v, want ErrLocked", err)
+	}
+	if err := first.Release(); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+
+	again, err := TryAcquire(path)
+	if err != nil {
+		t.Fatalf("TryAcquire() after release error = %v", err)
+	}
+	_ = again.Release()
+	// Releasing twice is harmless
+	if err := again.Release(); err != nil {
+		t.Errorf("second Release() error = %v", err)
+	}
+}
+
+func TestTryAcquire_Concurrent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), HomeName)
+
+	const n = 8
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		held   []*Lock
+		locked int
+	)
+	start := make(chan struct{})
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			l, err := TryAcquire(path)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				held = append(held, l)
+			case errors.Is(err, ErrLocked):
+				locked++
+			default:
+				t.Errorf("TryAcquire() error = %v", err)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if len(held) != 1 || locked != n-1 {
+		t.Fatalf("%d holders and %d ErrLocked, want 1 and %d", len(held), locked, n-1)
+	}
+	_ = held[0].Release()
+}
+
+func TestOwner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), HomeName)
+	if pid, op := Owner(path); pid != 0 || op != "" {
+		t.Errorf("Owner() of a missing file = %d, %q", pid, op)
+	}
+
+	l, err := TryAcquire(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = l.Release() }()
+	if err := l.SetOwner("push-validator reset --yes"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SetOwner("push-validator start"); err != nil {
+		t.Fatal(err)
+	}
+	if pid, op := Owner(path); pid != os.Getpid() || op != "push-validator start" {
+		t.Errorf("Owner() = %d, %q; want %d, push-validator start", pid, op, os.Getpid())
+	}
+}
@@ -0,0 +1,305 @@
+// Package logline parses node log lines. pchaind writes CometBFT-style text
+// lines by default and one JSON object per line when config.toml sets
+// log_format = "json"; Parse detects the format per line so mixed files
+// (for example across a format switch) render correctly.
+package logline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Normalized levels reported in Entry.Level.
+const (
+	LevelError = "error"
+	LevelWarn  = "warn"
+	LevelInfo  = "info"
+	LevelDebug = "debug"
+)
+
+// Field is one key/value pair of a JSON log line other than level, time and
+// message.
+type Field struct {
+	Key   string
+	Value string
+}
+
+// Entry is a parsed log line.
+type Entry struct {
+	Raw     string
+	JSON    bool   // line was a JSON object
+	Level   string // one of the Level* constants, or "" if unknown
+	Time    string // as logged; only set for JSON lines
+	Message string // only set for JSON lines
+	Fields  []Field
+}
+
+var (
+	levelKeys   = []string{"level", "lvl", "severity"}
+	timeKeys    = []string{"time", "ts", "timestamp"}
+	messageKeys = []string{"message", "msg"}
+)
+
+// Parse classifies line. JSON objects are decoded for their level, time,
+// message and remaining fields; anything else is treated as text and only
+// its level is detected.
+func Parse(line string) Entry {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "{") {
+		if e, ok := parseJSON(line, trimmed); ok {
+			return e
+		}
+	}
+	return Entry{Raw: line, Level: textLevel(line)}
+}
+
+func parseJSON(line, trimmed string) (Entry, bool) {
+	dec := json.NewDecoder(strings.NewReader(trimmed))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil || dec.More() {
+		return Entry{}, false
+	}
+	e := Entry{Raw: line, JSON: true}
+	e.Level = normLevel(takeString(obj, levelKeys))
+	e.Time = takeString(obj, timeKeys)
+	e.Message = takeString(obj, messageKeys)
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		e.Fields = append(e.Fields, Field{Key: k, Value: fieldValue(obj[k])})
+	}
+	return e, true
+}
+
+// takeString removes and returns the first of keys present in obj.
+func takeString(obj map[string]any, keys []string) string {
+	for _, k := range keys {
+		if v, ok := obj[k]; ok {
+			delete(obj, k)
+			return fieldValue(v)
+		}
+	}
+	return ""
+}
+
+func fieldValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case nil:
+		return "null"
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func normLevel(s string) string {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "error", "err", "fatal", "panic", "crit", "critical":
+		return LevelError
+	case "warn", "warning", "wrn":
+		return LevelWarn
+	case "info", "inf", "notice":
+		return LevelInfo
+	case "debug", "dbg", "trace", "trc":
+		return LevelDebug
+	}
+	return ""
+}
+
+// textLevel detects the level of a text line: the legacy CometBFT prefix
+// (E[...], W[...], I[...], D[...]) first, then the zerolog console tokens
+// and plain level words anywhere in the line.
+func textLevel(line string) string {
+	if len(line) > 1 && line[1] == '[' {
+		switch line[0] {
+		case 'E':
+			return LevelError
+		case 'W':
+			return LevelWarn
+		case 'I':
+			return LevelInfo
+		case 'D':
+			return LevelDebug
+		}
+	}
+	lower := strings.ToLower(line)
+	switch {
+	case strings.Contains(lower, "error") || strings.Contains(lower, "fatal") || strings.Contains(lower, "panic") || strings.Contains(lower, " err "):
+		return LevelError
+	case strings.Contains(lower, "warn") || strings.Contains(lower, " wrn "):
+		return LevelWarn
+	case strings.Contains(lower, "info") || strings.Contains(lower, " inf "):
+		return LevelInfo
+	case strings.Contains(lower, "debug") || strings.Contains(lower, "trace") || strings.Contains(lower, " dbg "):
+		return LevelDebug
+	}
+	return ""
+}
+
+// Compact renders a JSON entry in the node's console style,
+// "15:04:05 INF message key=value ...". Text entries are returned as-is.
+func (e Entry) Compact() string {
+	if !e.JSON {
+		return e.Raw
+	}
+	var parts []string
+	if e.Time != "" {
+		parts = append(parts, shortTime(e.Time))
+	}
+	if e.Level != "" {
+		parts = append(parts, levelTag(e.Level))
+	}
+	if e.Message != "" {
+		parts = append(parts, e.Message)
+	}
+	for _, f := range e.Fields {
+		v := f.Value
+		if v == "" || strings.ContainsAny(v, " \t") {
+			v = fmt.Sprintf("%q", v)
+		}
+		parts = append(parts, f.Key+"="+v)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Display returns the raw line when raw is set and the compact form otherwise.
+func (e Entry) Display(raw bool) string {
+	if raw {
+		return e.Raw
+	}
+	return e.Compact()
+}
+
+// Timestamp returns when the line was logged. It understands the time of
+// JSON lines (RFC 3339 or Unix seconds/milliseconds), the legacy CometBFT
+// prefix "I[2006-01-02|15:04:05.000]" and a leading RFC 3339, "15:04:05" or
+// zerolog console "3:04PM" token. The last two carry no date; they are placed
+// on ref's date in ref's location. Text timestamps without a zone are read
+// in ref's location too.
+func (e Entry) Timestamp(ref time.Time) (time.Time, bool) {
+	loc := ref.Location()
+	if e.JSON {
+		if e.Time == "" {
+			return time.Time{}, false
+		}
+		if t, ok := parseTimeToken(e.Time, ref); ok {
+			return t, true
+		}
+		if n, err := strconv.ParseFloat(e.Time, 64); err == nil && n > 0 {
+			if n >= 1e12 { // milliseconds
+				return time.UnixMilli(int64(n)).In(loc), true
+			}
+			sec, frac := math.Modf(n)
+			return time.Unix(int64(sec), int64(frac*1e9)).In(loc), true
+		}
+		return time.Time{}, false
+	}
+
+	line := strings.TrimSpace(e.Raw)
+	if len(line) > 2 && line[1] == '[' {
+		if end := strings.IndexByte(line, ']'); end > 2 {
+			for _, layout := range []string{"2006-01-02|15:04:05.000", "2006-01-02|15:04:05"} {
+				if t, err := time.ParseInLocation(layout, line[2:end], loc); err == nil {
+					return t, true
+				}
+			}
+		}
+	}
+	token := line
+	if i := strings.IndexAny(line, " \t"); i >= 0 {
+		token = line[:i]
+	}
+	return parseTimeToken(token, ref)
+}
+
+// parseTimeToken parses one timestamp token as described on Timestamp.
+func parseTimeToken(s string, ref time.Time) (time.Time, bool) {
+	loc := ref.Location()
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.In(loc), true
+		}
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, true
+		}
+	}
+	for _, layout := range []string{"15:04:05.999999999", "15:04:05", time.Kitchen, "3:04:05PM"} {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			y, m, d := ref.Date()
+			return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func shortTime(s string) string {
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format("15:04:05")
+		}
+	}
+	return s
+}
+
+func levelTag(level string) string {
+	switch level {
+	case LevelError:
+		return "ERR"
+	case LevelWarn:
+		return "WRN"
+	case LevelInfo:
+		return "INF"
+	case LevelDebug:
+		return "DBG"
+	}
+	return strings.ToUpper(level)
+}
+
+// ParseTime parses a time to show logs from, as given to --since or the
+// dashboard's go-to prompt: a duration back from now ("30m", "1h30m"), an RFC 3339 time, "2006-01-02 15:04[:05]" (a "T"
+// may replace the space) or a time of day "15:04[:05]" today. Times without
+// a zone are local.
+func ParseTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(strings.TrimPrefix(s, "-")); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.In(now.Location()), nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02 15:04", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			y, m, d := now.Date()
+			return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, now.Location()), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: use a duration like 30m, 2006-01-02 15:04:05, 15:04 or RFC 3339", s)
+}
@@ -0,0 +1,145 @@
+package logline
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		json    bool
+		level   string
+		compact string
+	}{
+		{
+			name:    "cometbft json",
+			line:    `{"level":"info","module":"consensus","height":1234,"hash":"ABCD","time":"2024-05-01T12:34:56.789Z","message":"finalizing commit of block"}`,
+			json:    true,
+			level:   LevelInfo,
+			compact: "12:34:56 INF finalizing commit of block hash=ABCD height=1234 module=consensus",
+		},
+		{
+			name:    "json error with err field",
+			line:    `{"level":"error","module":"p2p","err":"dial tcp: i/o timeout","time":"2024-05-01T12:00:00Z","message":"Stopping peer for error"}`,
+			json:    true,
+			level:   LevelError,
+			compact: `12:00:00 ERR Stopping peer for error err="dial tcp: i/o timeout" module=p2p`,
+		},
+		{
+			name:    "json msg key and nested value",
+			line:    `  {"lvl":"WARN","msg":"slow block","peer":{"id":"abc"}}`,
+			json:    true,
+			level:   LevelWarn,
+			compact: `WRN slow block peer={"id":"abc"}`,
+		},
+		{
+			name:    "json message mentioning error stays info",
+			line:    `{"level":"info","message":"no error here"}`,
+			json:    true,
+			level:   LevelInfo,
+			compact: "INF no error here",
+		},
+		{
+			name:    "cometbft console text",
+			line:    "12:34PM INF finalizing commit of block hash=ABCD height=1234 module=consensus",
+			level:   LevelInfo,
+			compact: "12:34PM INF finalizing commit of block hash=ABCD height=1234 module=consensus",
+		},
+		{
+			name:    "cometbft console error",
+			line:    "12:34PM ERR Stopping peer for error err=EOF module=p2p",
+			level:   LevelError,
+			compact: "12:34PM ERR Stopping peer for error err=EOF module=p2p",
+		},
+		{
+			name:    "legacy cometbft text",
+			line:    "I[2024-05-01|12:34:56.789] Executed block  module=state height=1234 validTxs=0 invalidTxs=0",
+			level:   LevelInfo,
+			compact: "I[2024-05-01|12:34:56.789] Executed block  module=state height=1234 validTxs=0 invalidTxs=0",
+		},
+		{
+			name:    "truncated json falls back to text",
+			line:    `{"level":"error","message":"cut`,
+			level:   LevelError,
+			compact: `{"level":"error","message":"cut`,
+		},
+		{
+			name:    "plain line",
+			line:    "starting node",
+			compact: "starting node",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Parse(tt.line)
+			if e.JSON != tt.json {
+				t.Errorf("JSON = %v, want %v", e.JSON, tt.json)
+			}
+			if e.Level != tt.level {
+				t.Errorf("Level = %q, want %q", e.Level, tt.level)
+			}
+			if got := e.Compact(); got != tt.compact {
+				t.Errorf("Compact() = %q, want %q", got, tt.compact)
+			}
+			if got := e.Display(true); got != tt.line {
+				t.Errorf("Display(true) = %q, want raw line", got)
+			}
+		})
+	}
+}
+
+func TestEntryTimestamp(t *testing.T) {
+	loc := time.FixedZone("test", -5*3600)
+	ref := time.Date(2024, 5, 1, 9, 0, 0, 0, loc)
+	tests := []struct {
+		line string
+		want time.Time
+		ok   bool
+	}{
+		{`{"level":"info","time":"2024-05-01T12:34:56.5Z","message":"x"}`, time.Date(2024, 5, 1, 12, 34, 56, 5e8, time.UTC), true},
+		{`{"level":"info","time":1714566896,"message":"x"}`, time.Date(2024, 5, 1, 12, 34, 56, 0, time.UTC), true},
+		{`{"level":"info","ts":1714566896500,"message":"x"}`, time.Date(2024, 5, 1, 12, 34, 56, 5e8, time.UTC), true},
+		{`{"level":"info","message":"no time"}`, time.Time{}, false},
+		{"I[2024-05-01|07:34:56.789] Committed state module=state", time.Date(2024, 5, 1, 7, 34, 56, 789e6, loc), true},
+		{"2024-05-01T12:34:56Z INF starting", time.Date(2024, 5, 1, 12, 34, 56, 0, time.UTC), true},
+		{"07:34:56 INF starting", time.Date(2024, 5, 1, 7, 34, 56, 0, loc), true},
+		{"7:34PM INF starting", time.Date(2024, 5, 1, 19, 34, 0, 0, loc), true},
+		{"panic: runtime error", time.Time{}, false},
+		{"", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := Parse(tt.line).Timestamp(ref)
+		if ok != tt.ok || !got.Equal(tt.want) {
+			t.Errorf("Timestamp(%q) = %v, %v; want %v, %v", tt.line, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2024, 5, 1, 15, 0, 0, 0, time.Local)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"30m", now.Add(-30 * time.Minute)},
+		{"-1h30m", now.Add(-90 * time.Minute)},
+		{"14:05", time.Date(2024, 5, 1, 14, 5, 0, 0, time.Local)},
+		{"14:05:30", time.Date(2024, 5, 1, 14, 5, 30, 0, time.Local)},
+		{"2024-04-30 23:59:59", time.Date(2024, 4, 30, 23, 59, 59, 0, time.Local)},
+		{"2024-04-30T23:59", time.Date(2024, 4, 30, 23, 59, 0, 0, time.Local)},
+		{"2024-04-30T21:00:00Z", time.Date(2024, 4, 30, 21, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := ParseTime(tt.in, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ParseTime(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "yesterday", "25:00", "0s"} {
+		if _, err := ParseTime(bad, now); err == nil {
+			t.Errorf("ParseTime(%q) should fail", bad)
+		}
+	}
+}
@@ -14,36 +14,63 @@ import (
 )
 
 type System struct {
-    CPUPercent float64
-    MemUsed    uint64
-    MemTotal   uint64
-    DiskUsed   uint64
-    DiskTotal  uint64
+    CPUPercent float64 `json:"cpu_percent" yaml:"cpu_percent"`
+    MemUsed    uint64  `json:"mem_used" yaml:"mem_used"`
+    MemTotal   uint64  `json:"mem_total" yaml:"mem_total"`
+    DiskUsed   uint64  `json:"disk_used" yaml:"disk_used"`
+    DiskTotal  uint64  `json:"disk_total" yaml:"disk_total"`
 }
 
 type Network struct {
-    Peers     int
-    LatencyMS int64
+    Peers     int   `json:"peers" yaml:"peers"`
+    LatencyMS int64 `json:"latency_ms" yaml:"latency_ms"`
 }
 
 type Chain struct {
-    LocalHeight  int64
-    RemoteHeight int64
-    CatchingUp   bool
+    LocalHeight  int64 `json:"local_height" yaml:"local_height"`
+    RemoteHeight int64 `json:"remote_height" yaml:"remote_height"`
+    CatchingUp   bool  `json:"catching_up" yaml:"catching_up"`
+}
+
+// Synced reports whether the node counts as synced: it is not catching up
+// and, when the remote height is known, no more than tolerance blocks
+// behind it. The start flow, serve-health and the dashboard all use it.
+func (c Chain) Synced(tolerance int64) bool {
+    if c.CatchingUp {
+        return false
+    }
+    return c.RemoteHeight <= 0 || c.LocalHeight >= c.RemoteHeight-tolerance
 }
 
 type Node struct {
-    ChainID      string
-    NodeID       string
-    Moniker      string
-    RPCListening bool
+    ChainID      string `json:"chain_id" yaml:"chain_id"`
+    NodeID       string `json:"node_id" yaml:"node_id"`
+    Moniker      string `json:"moniker" yaml:"moniker"`
+    RPCListening bool   `json:"rpc_listening" yaml:"rpc_listening"`
+}
+
+// Block is one recent block as shown by the dashboard transactions panel.
+type Block struct {
+    Height   int64     `json:"height" yaml:"height"`
+    Time     time.Time `json:"time" yaml:"time"`
+    Txs      int       `json:"txs" yaml:"txs"`
+    Proposer string    `json:"proposer" yaml:"proposer"`
+}
+
+// Transactions holds mempool depth and the latest blocks, newest first.
+type Transactions struct {
+    MempoolTxs   int     `json:"mempool_txs" yaml:"mempool_txs"`
+    MempoolBytes int64   `json:"mempool_bytes" yaml:"mempool_bytes"`
+    RecentBlocks []Block `json:"recent_blocks" yaml:"recent_blocks"`
 }
 
 type Snapshot struct {
-    System  System
-    Network Network
-    Chain   Chain
-    Node    Node
+    System  System  `json:"system" yaml:"system"`
+    Network Network `json:"network" yaml:"network"`
+    Chain   Chain   `json:"chain" yaml:"chain"`
+    Node    Node    `json:"node" yaml:"node"`
+    // Transactions is only collected after CollectTransactions
+    Transactions *Transactions `json:"transactions,omitempty" yaml:"transactions,omitempty"`
 }
 
 type Collector struct {
@@ -51,6 +78,10 @@ type Collector struct {
 	lastCPU    float64
 	cpuRunning bool
 	cpuDone    chan struct{} // Signal to stop CPU collection
+
+	txMu         sync.Mutex // guards recentBlocks and blocks, held across RPC calls
+	recentBlocks int        // blocks to report in Snapshot.Transactions; 0 disables
+	blocks       []Block    // fetched blocks, newest first; blocks never change so they are reused
 }
 
 // New creates a Collector with background CPU monitoring started immediately
@@ -72,6 +103,16 @@ func NewWithoutCPU() *Collector {
 	}
 }
 
+// CollectTransactions makes Collect also read the local mempool size and the
+// last n blocks (n <= 0 turns it off). Each refresh only fetches blocks it
+// has not seen yet.
+func (c *Collector) CollectTransactions(n int) {
+	c.txMu.Lock()
+	defer c.txMu.Unlock()
+	c.recentBlocks = n
+	c.blocks = nil
+}
+
 // Start begins background CPU collection (safe to call on any collector)
 func (c *Collector) Start() {
 	c.mu.Lock()
@@ -99,6 +140,22 @@ func (c *Collector) Stop() {
 	}
 }
 
+// SampleCPU measures CPU usage over one second and caches it for Collect.
+// One-shot commands use it instead of the background loop.
+func (c *Collector) SampleCPU() error {
+	percent, err := cpu.Percent(time.Second, false)
+	if err != nil {
+		return err
+	}
+	if len(percent) == 0 {
+		return fmt.Errorf("no CPU usage reported")
+	}
+	c.mu.Lock()
+	c.lastCPU = percent[0]
+	c.mu.Unlock()
+	return nil
+}
+
 // updateCPU runs in background to continuously update CPU metrics
 func (c *Collector) updateCPU() {
 	for {
@@ -143,6 +200,10 @@ func (c *Collector) Collect(ctx context.Context, localRPC, remoteRPC string) Sna
         snap.Node.Moniker = st.Moniker
         snap.Node.RPCListening = true // If we got a response, RPC is listening
     }
+    // Mempool and recent blocks (dashboard transactions panel)
+    if snap.Node.RPCListening {
+        snap.Transactions = c.collectTransactions(ctx, localRPC, snap.Chain.LocalHeight)
+    }
     // Remote status
     if st, err := remote.RemoteStatus(ctx, remoteURL); err == nil {
         snap.Chain.RemoteHeight = st.Height
@@ -178,3 +239,37 @@ func (c *Collector) Collect(ctx context.Context, localRPC, remoteRPC string) Sna
     return snap
 }
 
+// collectTransactions returns the mempool size and the last blocks up to
+// latest, or nil when transaction collection is off.
+func (c *Collector) collectTransactions(ctx context.Context, rpc string, latest int64) *Transactions {
+	c.txMu.Lock()
+	defer c.txMu.Unlock()
+	if c.recentBlocks <= 0 {
+		return nil
+	}
+	tx := &Transactions{}
+	if mp, err := node.NumUnconfirmedTxs(ctx, rpc); err == nil {
+		tx.MempoolTxs = mp.Txs
+		tx.MempoolBytes = mp.Bytes
+	}
+
+	known := make(map[int64]Block, len(c.blocks))
+	for _, b := range c.blocks {
+		known[b.Height] = b
+	}
+	var blocks []Block
+	for h := latest; h > 0 && h > latest-int64(c.recentBlocks); h-- {
+		if b, ok := known[h]; ok {
+			blocks = append(blocks, b)
+			continue
+		}
+		info, err := node.Block(ctx, rpc, h)
+		if err != nil {
+			break // keep what we have; the rest is retried next refresh
+		}
+		blocks = append(blocks, Block{Height: info.Height, Time: info.Time, Txs: info.Txs, Proposer: info.Proposer})
+	}
+	c.blocks = blocks
+	tx.RecentBlocks = append([]Block(nil), blocks...)
+	return tx
+}
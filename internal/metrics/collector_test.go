@@ -3,6 +3,7 @@ package metrics
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -398,3 +399,97 @@ func TestCollector_Collect_EmptyPeers(t *testing.T) {
 		t.Errorf("Peers = %d, want 0 when no peers", snap.Network.Peers)
 	}
 }
+
+func TestSampleCPU(t *testing.T) {
+	c := NewWithoutCPU()
+	if err := c.SampleCPU(); err != nil {
+		t.Skipf("CPU usage unavailable on this host: %v", err)
+	}
+	snap := c.Collect(context.Background(), "", "")
+	if snap.System.CPUPercent < 0 || snap.System.CPUPercent > 100 {
+		t.Errorf("CPUPercent = %v, want 0-100", snap.System.CPUPercent)
+	}
+}
+
+func TestCollector_CollectTransactions(t *testing.T) {
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	}
+
+	height := 100
+	blockCalls := map[string]int{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"result":{"node_info":{"network":"push_42101-1"},"sync_info":{"latest_block_height":"%d"}}}`, height)
+	})
+	mux.HandleFunc("/num_unconfirmed_txs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"n_txs":"7","total":"42","total_bytes":"12345","txs":null}}`))
+	})
+	mux.HandleFunc("/block", func(w http.ResponseWriter, r *http.Request) {
+		h := r.URL.Query().Get("height")
+		blockCalls[h]++
+		_, _ = fmt.Fprintf(w, `{"result":{"block":{"header":{"height":"%s","time":"2024-05-01T12:00:00Z","proposer_address":"ABCDEF"},"data":{"txs":["dHgx","dHgy"]}}}}`, h)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := NewWithoutCPU()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if snap := c.Collect(ctx, srv.URL, srv.URL); snap.Transactions != nil {
+		t.Fatal("transactions collected without CollectTransactions")
+	}
+
+	c.CollectTransactions(3)
+	snap := c.Collect(ctx, srv.URL, srv.URL)
+	tx := snap.Transactions
+	if tx == nil || tx.MempoolTxs != 42 || tx.MempoolBytes != 12345 {
+		t.Fatalf("mempool = %+v, want 42 txs / 12345 bytes", tx)
+	}
+	if len(tx.RecentBlocks) != 3 || tx.RecentBlocks[0].Height != 100 || tx.RecentBlocks[2].Height != 98 {
+		t.Fatalf("recent blocks = %+v, want 100..98 newest first", tx.RecentBlocks)
+	}
+	if b := tx.RecentBlocks[0]; b.Txs != 2 || b.Proposer != "ABCDEF" {
+		t.Errorf("block = %+v, want 2 txs from ABCDEF", b)
+	}
+
+	// The next refresh only fetches the new block
+	height = 101
+	snap = c.Collect(ctx, srv.URL, srv.URL)
+	if got := snap.Transactions.RecentBlocks; len(got) != 3 || got[0].Height != 101 || got[2].Height != 99 {
+		t.Fatalf("recent blocks after new height = %+v", got)
+	}
+	for _, h := range []string{"99", "100", "101"} {
+		if blockCalls[h] != 1 {
+			t.Errorf("block %s fetched %d times, want 1", h, blockCalls[h])
+		}
+	}
+}
+
+func TestChain_Synced(t *testing.T) {
+	tests := []struct {
+		name      string
+		chain     Chain
+		tolerance int64
+		want      bool
+	}{
+		{"at tip", Chain{LocalHeight: 100, RemoteHeight: 100}, 5, true},
+		{"one inside tolerance", Chain{LocalHeight: 96, RemoteHeight: 100}, 5, true},
+		{"at tolerance", Chain{LocalHeight: 95, RemoteHeight: 100}, 5, true},
+		{"one past tolerance", Chain{LocalHeight: 94, RemoteHeight: 100}, 5, false},
+		{"zero tolerance at tip", Chain{LocalHeight: 100, RemoteHeight: 100}, 0, true},
+		{"zero tolerance one behind", Chain{LocalHeight: 99, RemoteHeight: 100}, 0, false},
+		{"wider tolerance", Chain{LocalHeight: 80, RemoteHeight: 100}, 20, true},
+		{"ahead of remote", Chain{LocalHeight: 101, RemoteHeight: 100}, 5, true},
+		{"remote unknown", Chain{LocalHeight: 100}, 5, true},
+		{"catching up at tip", Chain{LocalHeight: 100, RemoteHeight: 100, CatchingUp: true}, 5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.chain.Synced(tt.tolerance); got != tt.want {
+				t.Errorf("%+v.Synced(%d) = %v, want %v", tt.chain, tt.tolerance, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// ProcessRSS returns the resident memory of pid in bytes.
+func ProcessRSS(pid int) (uint64, error) {
+	p, err := process.NewProcess(int32(pid))
+	if err != nil {
+		return 0, err
+	}
+	info, err := p.MemoryInfo()
+	if err != nil {
+		return 0, err
+	}
+	return info.RSS, nil
+}
+
+// DiskFree returns the free and total bytes of the filesystem holding path.
+func DiskFree(path string) (free, total uint64, err error) {
+	usage, err := disk.Usage(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	return usage.Free, usage.Total, nil
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"os"
+	"testing"
+)
+
+func TestProcessRSS(t *testing.T) {
+	rss, err := ProcessRSS(os.Getpid())
+	if err != nil {
+		t.Fatalf("ProcessRSS(self) error = %v", err)
+	}
+	if rss == 0 {
+		t.Error("expected non-zero RSS for the test process")
+	}
+	if _, err := ProcessRSS(-1); err == nil {
+		t.Error("expected error for invalid pid")
+	}
+}
+
+func TestDiskFree(t *testing.T) {
+	free, total, err := DiskFree(t.TempDir())
+	if err != nil {
+		t.Fatalf("DiskFree() error = %v", err)
+	}
+	if total == 0 || free > total {
+		t.Errorf("free=%d total=%d", free, total)
+	}
+	if _, _, err := DiskFree("/nonexistent/push-validator"); err == nil {
+		t.Error("expected error for missing path")
+	}
+}
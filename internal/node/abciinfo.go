@@ -0,0 +1,39 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// AppInfo is what a node reports about the application it runs.
+type AppInfo struct {
+	Version    string // application (pchaind) version, e.g. "0.0.2"
+	AppVersion uint64 // consensus app version (protocol)
+	Height     int64  // last committed block height
+}
+
+// ABCIInfo reads the application info from base's /abci_info.
+func ABCIInfo(ctx context.Context, base string) (AppInfo, error) {
+	body, err := Get(ctx, base, "abci_info")
+	if err != nil {
+		return AppInfo{}, err
+	}
+	var payload struct {
+		Result struct {
+			Response struct {
+				Version         string `json:"version"`
+				AppVersion      string `json:"app_version"`
+				LastBlockHeight string `json:"last_block_height"`
+			} `json:"response"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return AppInfo{}, fmt.Errorf("decode abci_info: %w", err)
+	}
+	r := payload.Result.Response
+	appVersion, _ := strconv.ParseUint(r.AppVersion, 10, 64)
+	height, _ := strconv.ParseInt(r.LastBlockHeight, 10, 64)
+	return AppInfo{Version: r.Version, AppVersion: appVersion, Height: height}, nil
+}
@@ -3,11 +3,16 @@ package node
 import (
     "context"
     "encoding/json"
+    "errors"
     "fmt"
+    "io"
     "net/http"
+    "net/url"
     "strconv"
     "strings"
     "time"
+
+    "github.com/pushchain/push-validator-cli/internal/httpclient"
 )
 
 // Client defines the RPC/WS client surface area we depend on.
@@ -16,8 +21,25 @@ type Client interface {
     RemoteStatus(ctx context.Context, baseURL string) (Status, error)
     Peers(ctx context.Context) ([]Peer, error)
     SubscribeHeaders(ctx context.Context) (<-chan Header, error)
+    // DialPeers and DialSeeds call CometBFT's unsafe dial_peers and
+    // dial_seeds routes, which the node only serves with rpc.unsafe = true.
+    // Dialing is asynchronous: a nil error means the dial was queued.
+    DialPeers(ctx context.Context, peers []string, opts DialOptions) error
+    DialSeeds(ctx context.Context, seeds []string) error
+}
+
+// DialOptions are the dial_peers flags. Persistent peers are redialed when
+// they drop, unconditional ones ignore the inbound/outbound peer limits and
+// private ones are never gossiped to other peers.
+type DialOptions struct {
+    Persistent    bool
+    Unconditional bool
+    Private       bool
 }
 
+// ErrUnsafeRPCDisabled means the node doesn't serve the unsafe RPC routes.
+var ErrUnsafeRPCDisabled = errors.New("the node's RPC does not allow unsafe routes; set unsafe = true under [rpc] in config.toml and restart the node")
+
 type Status struct {
     NodeID     string
     Moniker    string
@@ -27,8 +49,12 @@ type Status struct {
 }
 
 type Peer struct {
-    ID   string
-    Addr string // host:port
+    ID         string
+    Addr       string // host:port
+    ListenAddr string // address the peer advertises in node_info
+    Moniker    string
+    Outbound   bool          // false when the peer dialed us
+    Duration   time.Duration // how long the connection has been up; 0 if unreported
 }
 
 type Header struct {
@@ -37,19 +63,77 @@ type Header struct {
 }
 
 type httpClient struct {
-    http  *http.Client
-    base  string // e.g. http://127.0.0.1:26657
-    wsURL string // e.g. ws://127.0.0.1:26657/websocket
+    http    *http.Client
+    base    string // e.g. http://127.0.0.1:26657
+    wsURL   string // e.g. ws://127.0.0.1:26657/websocket
+    retries int
+}
+
+// DefaultRetries is how many times a client retries a failed read when
+// SetRetries hasn't been called.
+const DefaultRetries = 2
+
+// retries is what New gives each client; see SetRetries.
+var retries = DefaultRetries
+
+// retryBackoff is the wait before the first retry; it doubles each time.
+var retryBackoff = 250 * time.Millisecond
+
+// SetRetries sets how many times clients built by New retry Status,
+// RemoteStatus and Peers after a network error or an HTTP 5xx reply, and
+// redial a dropped SubscribeHeaders WebSocket (--rpc-retry). 0 disables
+// retries. Call it before building clients.
+func SetRetries(n int) {
+    if n < 0 { n = 0 }
+    retries = n
 }
 
 // New constructs a JSON-RPC client with sane timeouts. If wsURL is empty, it is derived from base.
 func New(base string) Client {
     base = strings.TrimRight(base, "/")
     ws := deriveWS(base)
+    // A bad --rpc-ca-cert is reported at startup; the transport is strict then
+    tr, _ := httpclient.RPCTransport()
     return &httpClient{
-        http: &http.Client{Timeout: 2500 * time.Millisecond},
+        http: &http.Client{Timeout: 2500 * time.Millisecond, Transport: tr},
         base: base,
         wsURL: ws,
+        retries: retries,
+    }
+}
+
+// get GETs u, retrying network errors and HTTP 5xx replies up to c.retries
+// times with backoff. Other replies, including 4xx, are returned at once,
+// as is the last attempt's reply or error.
+func (c *httpClient) get(ctx context.Context, u string) (*http.Response, error) {
+    for attempt := 0; ; attempt++ {
+        req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+        if err != nil { return nil, err }
+        resp, err := c.http.Do(req)
+        if err == nil && resp.StatusCode < 500 { return resp, nil }
+        if attempt >= c.retries || ctx.Err() != nil { return resp, err }
+        if err == nil {
+            _ = resp.Body.Close()
+            err = fmt.Errorf("remote RPC returned HTTP %d", resp.StatusCode)
+        }
+        if !sleepCtx(ctx, retryDelay(attempt)) { return nil, err }
+    }
+}
+
+// retryDelay is the wait before retry attempt+1.
+func retryDelay(attempt int) time.Duration {
+    return retryBackoff << attempt
+}
+
+// sleepCtx waits for d and reports false if ctx ended first.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+    t := time.NewTimer(d)
+    defer t.Stop()
+    select {
+    case <-t.C:
+        return true
+    case <-ctx.Done():
+        return false
     }
 }
 
@@ -72,8 +156,7 @@ func (c *httpClient) Status(ctx context.Context) (Status, error) {
 
 func (c *httpClient) RemoteStatus(ctx context.Context, baseURL string) (Status, error) {
     baseURL = strings.TrimRight(baseURL, "/")
-    req, _ := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/status", nil)
-    resp, err := c.http.Do(req)
+    resp, err := c.get(ctx, baseURL+"/status")
     if err != nil { return Status{}, err }
     defer func() { _ = resp.Body.Close() }()
     if resp.StatusCode != http.StatusOK {
@@ -104,8 +187,7 @@ func (c *httpClient) RemoteStatus(ctx context.Context, baseURL string) (Status,
 }
 
 func (c *httpClient) Peers(ctx context.Context) ([]Peer, error) {
-    req, _ := http.NewRequestWithContext(ctx, http.MethodGet, c.base+"/net_info", nil)
-    resp, err := c.http.Do(req)
+    resp, err := c.get(ctx, c.base+"/net_info")
     if err != nil { return nil, err }
     defer func() { _ = resp.Body.Close() }()
     if resp.StatusCode != http.StatusOK {
@@ -117,7 +199,12 @@ func (c *httpClient) Peers(ctx context.Context) ([]Peer, error) {
                 NodeInfo struct {
                     ID         string `json:"id"`
                     ListenAddr string `json:"listen_addr"`
+                    Moniker    string `json:"moniker"`
                 } `json:"node_info"`
+                IsOutbound       bool `json:"is_outbound"`
+                ConnectionStatus struct {
+                    Duration json.Number `json:"Duration"` // nanoseconds, usually string-encoded
+                } `json:"connection_status"`
                 RemoteIP string `json:"remote_ip"`
             } `json:"peers"`
         } `json:"result"`
@@ -126,11 +213,96 @@ func (c *httpClient) Peers(ctx context.Context) ([]Peer, error) {
     out := make([]Peer, 0, len(payload.Result.Peers))
     for _, p := range payload.Result.Peers {
         if p.NodeInfo.ID == "" || p.RemoteIP == "" { continue }
-        out = append(out, Peer{ID: p.NodeInfo.ID, Addr: fmt.Sprintf("%s:26656", p.RemoteIP)})
+        ns, _ := p.ConnectionStatus.Duration.Int64()
+        out = append(out, Peer{
+            ID:         p.NodeInfo.ID,
+            Addr:       fmt.Sprintf("%s:26656", p.RemoteIP),
+            ListenAddr: p.NodeInfo.ListenAddr,
+            Moniker:    p.NodeInfo.Moniker,
+            Outbound:   p.IsOutbound,
+            Duration:   time.Duration(ns),
+        })
     }
     return out, nil
 }
 
 func (c *httpClient) SubscribeHeaders(ctx context.Context) (<-chan Header, error) {
-    return DialAndSubscribeHeaders(ctx, c.wsURL)
+    return subscribeHeaders(ctx, c.wsURL, c.retries)
+}
+
+func (c *httpClient) DialPeers(ctx context.Context, peers []string, opts DialOptions) error {
+    q := url.Values{}
+    q.Set("peers", quoteList(peers))
+    q.Set("persistent", strconv.FormatBool(opts.Persistent))
+    q.Set("unconditional", strconv.FormatBool(opts.Unconditional))
+    q.Set("private", strconv.FormatBool(opts.Private))
+    return c.unsafeCall(ctx, "dial_peers", q)
+}
+
+func (c *httpClient) DialSeeds(ctx context.Context, seeds []string) error {
+    q := url.Values{}
+    q.Set("seeds", quoteList(seeds))
+    return c.unsafeCall(ctx, "dial_seeds", q)
+}
+
+// quoteList encodes addrs as the JSON array CometBFT's URI routes expect.
+func quoteList(addrs []string) string {
+    b, _ := json.Marshal(addrs)
+    return string(b)
+}
+
+// unsafeCall GETs an unsafe RPC route and returns the JSON-RPC error, if
+// any. A node without rpc.unsafe answers "Method not found".
+func (c *httpClient) unsafeCall(ctx context.Context, route string, q url.Values) error {
+    req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.base+"/"+route+"?"+q.Encode(), nil)
+    if err != nil { return err }
+    resp, err := c.http.Do(req)
+    if err != nil { return err }
+    defer func() { _ = resp.Body.Close() }()
+    var payload struct {
+        Error *struct {
+            Code    int    `json:"code"`
+            Message string `json:"message"`
+            Data    string `json:"data"`
+        } `json:"error"`
+    }
+    if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+        if resp.StatusCode != http.StatusOK {
+            return fmt.Errorf("%s: RPC returned HTTP %d", route, resp.StatusCode)
+        }
+        return fmt.Errorf("%s: %w", route, err)
+    }
+    if e := payload.Error; e != nil {
+        if e.Code == -32601 {
+            return fmt.Errorf("%s: %w", route, ErrUnsafeRPCDisabled)
+        }
+        msg := e.Message
+        if e.Data != "" { msg += ": " + e.Data }
+        return fmt.Errorf("%s: %s", route, msg)
+    }
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("%s: RPC returned HTTP %d", route, resp.StatusCode)
+    }
+    return nil
+}
+
+// Get issues a GET for an arbitrary RPC path (e.g. "net_info" or
+// "block?height=100") against base and returns the response body. For
+// non-200 replies the body is still returned alongside the error, since
+// CometBFT puts the JSON-RPC error there. It uses the RPC transport, so
+// --rpc-ca-cert and --rpc-tls-insecure apply; ctx bounds the request.
+func Get(ctx context.Context, base, path string) ([]byte, error) {
+    u := strings.TrimRight(base, "/") + "/" + strings.TrimLeft(path, "/")
+    req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+    if err != nil { return nil, fmt.Errorf("invalid RPC path %q: %w", path, err) }
+    tr, _ := httpclient.RPCTransport()
+    resp, err := (&http.Client{Transport: tr}).Do(req)
+    if err != nil { return nil, err }
+    defer func() { _ = resp.Body.Close() }()
+    body, err := io.ReadAll(resp.Body)
+    if err != nil { return nil, err }
+    if resp.StatusCode != http.StatusOK {
+        return body, fmt.Errorf("RPC returned HTTP %d", resp.StatusCode)
+    }
+    return body, nil
 }
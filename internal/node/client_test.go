@@ -3,11 +3,18 @@ package node
 import (
 	"context"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
 )
 
 func TestClient_Status(t *testing.T) {
@@ -448,3 +455,234 @@ func TestParseHeaderHeight(t *testing.T) {
 		})
 	}
 }
+
+func TestGet(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	} else {
+		ln.Close()
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/block", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("height") != "100" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"message":"height must be set"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":{"block":{}}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	body, err := Get(ctx, srv.URL+"/", "/block?height=100")
+	if err != nil || string(body) != `{"result":{"block":{}}}` {
+		t.Fatalf("Get() = %q, %v", body, err)
+	}
+
+	body, err = Get(ctx, srv.URL, "block")
+	if err == nil || !strings.Contains(string(body), "height must be set") {
+		t.Fatalf("expected HTTP error with body, got %q, %v", body, err)
+	}
+}
+
+func TestGet_RPCCACert(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	} else {
+		ln.Close()
+	}
+	defer httpclient.SetDefault(httpclient.Options{})
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{}}`))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	// The self-signed certificate is rejected until its CA is configured
+	httpclient.SetDefault(httpclient.Options{})
+	if _, err := Get(ctx, srv.URL, "net_info"); err == nil {
+		t.Fatal("Get() succeeded without the CA")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, pemData, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	httpclient.SetDefault(httpclient.Options{RPCCACertFile: caFile})
+	if body, err := Get(ctx, srv.URL, "net_info"); err != nil || string(body) != `{"result":{}}` {
+		t.Fatalf("Get() with the CA = %q, %v", body, err)
+	}
+}
+
+func TestClient_Peers_Metadata(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	} else {
+		ln.Close()
+	}
+
+	// Trimmed CometBFT /net_info reply
+	const netInfo = `{"jsonrpc":"2.0","id":-1,"result":{"listening":true,"n_peers":"2","peers":[
+		{"node_info":{"id":"out-peer","listen_addr":"tcp://34.72.243.200:26656","moniker":"alpha"},"is_outbound":true,
+		 "connection_status":{"Duration":"90000000000","SendMonitor":{"Active":true}},"remote_ip":"34.72.243.200"},
+		{"node_info":{"id":"in-peer","listen_addr":"tcp://0.0.0.0:26656","moniker":"beta"},"is_outbound":false,
+		 "connection_status":{"Duration":1500000000},"remote_ip":"10.0.0.5"}]}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(netInfo))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	peers, err := New(srv.URL).Peers(ctx)
+	if err != nil {
+		t.Fatalf("Peers() error: %v", err)
+	}
+	want := []Peer{
+		{ID: "out-peer", Addr: "34.72.243.200:26656", ListenAddr: "tcp://34.72.243.200:26656", Moniker: "alpha", Outbound: true, Duration: 90 * time.Second},
+		{ID: "in-peer", Addr: "10.0.0.5:26656", ListenAddr: "tcp://0.0.0.0:26656", Moniker: "beta", Duration: 1500 * time.Millisecond},
+	}
+	if len(peers) != len(want) {
+		t.Fatalf("got %d peers, want %d", len(peers), len(want))
+	}
+	for i := range want {
+		if peers[i] != want[i] {
+			t.Errorf("peer %d = %+v, want %+v", i, peers[i], want[i])
+		}
+	}
+}
+
+func TestABCIInfo(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	} else {
+		ln.Close()
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/abci_info" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":-1,"result":{"response":{"data":"pchain","version":"0.0.2","app_version":"1","last_block_height":"4242"}}}`))
+	}))
+	defer srv.Close()
+
+	info, err := ABCIInfo(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatalf("ABCIInfo() error = %v", err)
+	}
+	if info != (AppInfo{Version: "0.0.2", AppVersion: 1, Height: 4242}) {
+		t.Errorf("ABCIInfo() = %+v", info)
+	}
+}
+
+func TestClient_DialPeers(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	} else {
+		ln.Close()
+	}
+
+	var query map[string]string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dial_peers", func(w http.ResponseWriter, r *http.Request) {
+		query = map[string]string{}
+		for k := range r.URL.Query() {
+			query[k] = r.URL.Query().Get(k)
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":-1,"result":{"log":"Dialing peers in progress. See /net_info for details"}}`))
+	})
+	mux.HandleFunc("/dial_seeds", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":-1,"error":{"code":-32601,"message":"Method not found"}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := New(srv.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	peers := []string{"abc@1.2.3.4:26656", "def@5.6.7.8:26656"}
+	if err := client.DialPeers(ctx, peers, DialOptions{Persistent: true}); err != nil {
+		t.Fatalf("DialPeers() error: %v", err)
+	}
+	if query["peers"] != `["abc@1.2.3.4:26656","def@5.6.7.8:26656"]` {
+		t.Errorf("peers param = %q", query["peers"])
+	}
+	if query["persistent"] != "true" || query["unconditional"] != "false" || query["private"] != "false" {
+		t.Errorf("flags = %v", query)
+	}
+
+	if err := client.DialSeeds(ctx, peers); !errors.Is(err, ErrUnsafeRPCDisabled) {
+		t.Errorf("DialSeeds() error = %v, want ErrUnsafeRPCDisabled", err)
+	}
+}
+
+func TestClient_RetriesTransientErrors(t *testing.T) {
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	} else {
+		ln.Close()
+	}
+	origRetries, origBackoff := retries, retryBackoff
+	defer func() { retries, retryBackoff = origRetries, origBackoff }()
+	retryBackoff = time.Millisecond
+
+	var statusCalls, peersCalls, missingCalls int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		statusCalls++
+		if statusCalls == 1 {
+			// Drop the connection without a reply
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			_ = conn.Close()
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":{"node_info":{"id":"abc","network":"push_42101-1"},"sync_info":{"latest_block_height":"42"}}}`))
+	})
+	mux.HandleFunc("/net_info", func(w http.ResponseWriter, r *http.Request) {
+		peersCalls++
+		if peersCalls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":{"peers":[]}}`))
+	})
+	mux.HandleFunc("/missing/status", func(w http.ResponseWriter, r *http.Request) {
+		missingCalls++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	SetRetries(2)
+	client := New(srv.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	st, err := client.Status(ctx)
+	if err != nil || st.Height != 42 || statusCalls != 2 {
+		t.Errorf("Status() = %+v, %v after %d calls; want height 42 after 2", st, err, statusCalls)
+	}
+	if _, err := client.Peers(ctx); err != nil || peersCalls != 2 {
+		t.Errorf("Peers() error = %v after %d calls; want success after 2", err, peersCalls)
+	}
+	if _, err := client.RemoteStatus(ctx, srv.URL+"/missing"); err == nil || missingCalls != 1 {
+		t.Errorf("RemoteStatus() on 404: error = %v after %d calls; want an error and no retry", err, missingCalls)
+	}
+
+	SetRetries(0)
+	peersCalls = 0
+	if _, err := New(srv.URL).Peers(ctx); err == nil || peersCalls != 1 {
+		t.Errorf("with retries off: error = %v after %d calls; want the first failure", err, peersCalls)
+	}
+}
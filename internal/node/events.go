@@ -0,0 +1,187 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Event is one CometBFT event matching a subscription query.
+type Event struct {
+	Type   string              `json:"type"`   // e.g. tendermint/event/NewBlock
+	Events map[string][]string `json:"events"` // indexed attributes, e.g. "tm.event": ["NewBlock"]
+	Data   json.RawMessage     `json:"data"`   // the event's value as the node sent it
+}
+
+// Name returns the event name, such as NewBlock or Tx.
+func (e Event) Name() string {
+	if v := e.Events["tm.event"]; len(v) > 0 {
+		return v[0]
+	}
+	return e.Type[strings.LastIndex(e.Type, "/")+1:]
+}
+
+// ErrEventStreamClosed means the node ended an event subscription.
+var ErrEventStreamClosed = errors.New("the node closed the event stream")
+
+// subscribeAckTimeout bounds the wait for the node to accept a subscription.
+const subscribeAckTimeout = 5 * time.Second
+
+// StreamEvents subscribes to events matching query on the node at baseURL
+// (http://host:port) and calls fn with each one until ctx ends, which
+// returns nil. A rejected query or a node-side error is returned as an
+// error. When the connection drops it is redialed like SubscribeHeaders
+// (see SetRetries).
+func StreamEvents(ctx context.Context, baseURL, query string, fn func(Event)) error {
+	if err := ValidateEventQuery(query); err != nil {
+		return err
+	}
+	wsURL := deriveWS(strings.TrimRight(baseURL, "/"))
+	conn, err := subscribeEvents(ctx, wsURL, query, fn)
+	if err != nil {
+		return err
+	}
+	for {
+		// Unblock the read when ctx ends, however quiet the stream is.
+		stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+		var streamErr error
+		// Rare events can be hours apart, so a quiet stream is never a drop;
+		// pings find a dead connection instead.
+		dropped := readMessages(ctx, conn, wsPingInterval, 0, func(msg []byte) bool {
+			ev, ok, err := parseEvent(msg)
+			if err != nil {
+				streamErr = err
+				return false
+			}
+			if ok {
+				fn(ev)
+			}
+			return true
+		})
+		stop()
+		switch {
+		case streamErr != nil:
+			return streamErr
+		case ctx.Err() != nil:
+			return nil
+		case !dropped:
+			return ErrEventStreamClosed
+		}
+
+		conn = nil
+		lastErr := errors.New("connection dropped")
+		for attempt := 0; attempt < retries && conn == nil; attempt++ {
+			if !sleepCtx(ctx, retryDelay(attempt)) {
+				return nil
+			}
+			conn, lastErr = subscribeEvents(ctx, wsURL, query, fn)
+		}
+		if conn == nil {
+			return fmt.Errorf("event stream lost: %w", lastErr)
+		}
+	}
+}
+
+// subscribeEvents dials wsURL, subscribes to query and waits for the node
+// to accept it. An event that arrives first is passed to fn.
+func subscribeEvents(ctx context.Context, wsURL, query string, fn func(Event)) (*websocket.Conn, error) {
+	conn, err := dialSubscription(ctx, wsURL, query)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(subscribeAckTimeout))
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("no reply to the subscription: %w", err)
+	}
+	ev, ok, err := parseEvent(msg)
+	if err != nil {
+		closeSubscription(conn)
+		return nil, err
+	}
+	if ok {
+		fn(ev)
+	}
+	return conn, nil
+}
+
+// parseEvent decodes a subscription message. It reports ok for an event
+// and returns the node's error for an error reply; anything else, such as
+// the empty result acknowledging the subscription, is neither.
+func parseEvent(b []byte) (Event, bool, error) {
+	var payload struct {
+		Result struct {
+			Data struct {
+				Type  string          `json:"type"`
+				Value json.RawMessage `json:"value"`
+			} `json:"data"`
+			Events map[string][]string `json:"events"`
+		} `json:"result"`
+		Error *struct {
+			Message string `json:"message"`
+			Data    string `json:"data"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return Event{}, false, nil
+	}
+	if e := payload.Error; e != nil {
+		if e.Data != "" {
+			return Event{}, false, fmt.Errorf("subscription error: %s: %s", e.Message, e.Data)
+		}
+		return Event{}, false, fmt.Errorf("subscription error: %s", e.Message)
+	}
+	if payload.Result.Data.Type == "" {
+		return Event{}, false, nil
+	}
+	return Event{Type: payload.Result.Data.Type, Events: payload.Result.Events, Data: payload.Result.Data.Value}, true, nil
+}
+
+// eventCondition matches one condition of a CometBFT event query: a
+// composite key, an operator and, except for EXISTS, an operand (a quoted
+// string, a number, or a DATE or TIME literal).
+var eventCondition = regexp.MustCompile(`^[A-Za-z_][\w.\-]*\s*(?:EXISTS|(?:=|<=|>=|<|>|CONTAINS)\s*(?:'[^']*'|-?\d+(?:\.\d+)?|DATE \d{4}-\d{2}-\d{2}|TIME \S+))$`)
+
+// ValidateEventQuery checks query against the CometBFT event query grammar:
+// conditions such as tm.event='NewBlock' or tx.height>100, joined by AND.
+func ValidateEventQuery(query string) error {
+	if strings.TrimSpace(query) == "" {
+		return errors.New("event query is empty; for example tm.event='NewBlock'")
+	}
+	if strings.Count(query, "'")%2 != 0 {
+		return fmt.Errorf("invalid event query %q: unbalanced quote", query)
+	}
+	for _, cond := range splitQuery(query) {
+		if !eventCondition.MatchString(strings.TrimSpace(cond)) {
+			return fmt.Errorf("invalid event query %q: %q is not a condition like key='value', key>1 or key EXISTS", query, strings.TrimSpace(cond))
+		}
+	}
+	return nil
+}
+
+// splitQuery splits query on AND outside quoted strings.
+func splitQuery(query string) []string {
+	var (
+		parts  []string
+		quoted bool
+		start  int
+	)
+	for i := 0; i < len(query); i++ {
+		switch {
+		case query[i] == '\'':
+			quoted = !quoted
+		case !quoted && strings.HasPrefix(query[i:], " AND "):
+			parts = append(parts, query[start:i])
+			start = i + len(" AND ")
+			i += len(" AND ") - 1
+		}
+	}
+	return append(parts, query[start:])
+}
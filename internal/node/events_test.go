@@ -0,0 +1,220 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// eventServer serves a WebSocket that answers each subscription with ack,
+// then sends events and, for each connection, calls after.
+func eventServer(t *testing.T, ack string, events func(n int32) []string, after func(c *websocket.Conn, n int32)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	probe, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("skipping: cannot bind due to sandbox: %v", err)
+	}
+	probe.Close()
+
+	var conns atomic.Int32
+	up := websocket.Upgrader{Subprotocols: []string{"jsonrpc"}, CheckOrigin: func(*http.Request) bool { return true }}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := up.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		n := conns.Add(1)
+		_, _, _ = c.ReadMessage() // subscribe request
+		_ = c.WriteMessage(websocket.TextMessage, []byte(ack))
+		for _, ev := range events(n) {
+			_ = c.WriteMessage(websocket.TextMessage, []byte(ev))
+		}
+		after(c, n)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &conns
+}
+
+func newBlockEvent(height int32) string {
+	return fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"query":"tm.event='NewBlock'","data":{"type":"tendermint/event/NewBlock","value":{"block":{"header":{"height":"%d"}}}},"events":{"tm.event":["NewBlock"],"block.height":["%d"]}}}`, height, height)
+}
+
+const subscribeAck = `{"jsonrpc":"2.0","id":1,"result":{}}`
+
+func holdOpen(c *websocket.Conn, _ int32) {
+	_, _, _ = c.ReadMessage() // until the client closes
+	_ = c.Close()
+}
+
+func TestStreamEvents_DeliversUntilCancelled(t *testing.T) {
+	srv, _ := eventServer(t, subscribeAck, func(int32) []string {
+		return []string{newBlockEvent(7), newBlockEvent(8)}
+	}, holdOpen)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var got []Event
+	err := StreamEvents(ctx, srv.URL, "tm.event='NewBlock'", func(ev Event) {
+		got = append(got, ev)
+		if len(got) == 2 {
+			cancel()
+		}
+	})
+	if err != nil {
+		t.Fatalf("StreamEvents() error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d events, want 2", len(got))
+	}
+	if got[0].Type != "tendermint/event/NewBlock" || got[0].Name() != "NewBlock" {
+		t.Errorf("event = %q (%s), want tendermint/event/NewBlock", got[0].Type, got[0].Name())
+	}
+	if h := got[1].Events["block.height"]; len(h) != 1 || h[0] != "8" {
+		t.Errorf("block.height = %v, want [8]", h)
+	}
+	if !strings.Contains(string(got[0].Data), `"height":"7"`) {
+		t.Errorf("data = %s, want the block value", got[0].Data)
+	}
+}
+
+func TestStreamEvents_SubscriptionError(t *testing.T) {
+	ack := `{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"Internal error","data":"failed to subscribe: max_subscriptions_per_client reached"}}`
+	srv, _ := eventServer(t, ack, func(int32) []string { return nil }, holdOpen)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := StreamEvents(ctx, srv.URL, "tm.event='Tx'", func(Event) { t.Error("unexpected event") })
+	if err == nil || !strings.Contains(err.Error(), "max_subscriptions_per_client") {
+		t.Fatalf("StreamEvents() error = %v, want the node's subscription error", err)
+	}
+}
+
+func TestStreamEvents_RedialsAfterDrop(t *testing.T) {
+	origBackoff := retryBackoff
+	retryBackoff = time.Millisecond
+	defer func() { retryBackoff = origBackoff }()
+
+	srv, conns := eventServer(t, subscribeAck, func(n int32) []string {
+		return []string{newBlockEvent(n)}
+	}, func(c *websocket.Conn, n int32) {
+		if n == 1 {
+			// Drop the first connection without a close frame
+			_ = c.UnderlyingConn().Close()
+			return
+		}
+		holdOpen(c, n)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var heights []string
+	err := StreamEvents(ctx, srv.URL, "tm.event='NewBlock'", func(ev Event) {
+		heights = append(heights, ev.Events["block.height"]...)
+		if len(heights) == 2 {
+			cancel()
+		}
+	})
+	if err != nil {
+		t.Fatalf("StreamEvents() error: %v", err)
+	}
+	if strings.Join(heights, ",") != "1,2" {
+		t.Errorf("heights = %v, want [1 2]", heights)
+	}
+	if got := conns.Load(); got != 2 {
+		t.Errorf("connections = %d, want 2", got)
+	}
+}
+
+func TestStreamEvents_QuietStreamStaysUp(t *testing.T) {
+	origPing := wsPingInterval
+	wsPingInterval = 10 * time.Millisecond
+	defer func() { wsPingInterval = origPing }()
+
+	var pings atomic.Int32
+	srv, conns := eventServer(t, subscribeAck, func(int32) []string { return nil }, func(c *websocket.Conn, n int32) {
+		c.SetPingHandler(func(data string) error {
+			pings.Add(1)
+			return c.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+		})
+		go func() {
+			// Stay silent far longer than the ping interval, then deliver
+			time.Sleep(300 * time.Millisecond)
+			_ = c.WriteMessage(websocket.TextMessage, []byte(newBlockEvent(9)))
+		}()
+		holdOpen(c, n)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var got int
+	err := StreamEvents(ctx, srv.URL, "tm.event='NewBlock'", func(Event) {
+		got++
+		cancel()
+	})
+	if err != nil {
+		t.Fatalf("StreamEvents() error: %v", err)
+	}
+	if got != 1 || conns.Load() != 1 {
+		t.Errorf("got %d events over %d connections, want 1 over 1", got, conns.Load())
+	}
+	if pings.Load() == 0 {
+		t.Error("the quiet stream was never pinged")
+	}
+}
+
+func TestStreamEvents_ServerClose(t *testing.T) {
+	srv, _ := eventServer(t, subscribeAck, func(int32) []string { return nil }, func(c *websocket.Conn, _ int32) {
+		deadline := time.Now().Add(time.Second)
+		_ = c.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), deadline)
+		_ = c.Close()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := StreamEvents(ctx, srv.URL, "tm.event='NewBlock'", func(Event) {}); err != ErrEventStreamClosed {
+		t.Fatalf("StreamEvents() error = %v, want ErrEventStreamClosed", err)
+	}
+}
+
+func TestValidateEventQuery(t *testing.T) {
+	valid := []string{
+		"tm.event='NewBlock'",
+		"tm.event = 'Tx' AND tx.height > 5",
+		"tm.event='Tx' AND message.sender='push1abc'",
+		"transfer.amount CONTAINS 'upc' AND tx.height>=100",
+		"tx.fee EXISTS",
+		"message.note='a AND b'",
+		"block.time >= TIME 2024-01-01T00:00:00Z",
+		"block.date = DATE 2024-01-01",
+		"tx.gas < -1.5",
+	}
+	for _, q := range valid {
+		if err := ValidateEventQuery(q); err != nil {
+			t.Errorf("ValidateEventQuery(%q) = %v, want nil", q, err)
+		}
+	}
+	invalid := []string{
+		"",
+		"   ",
+		"tm.event='NewBlock",
+		"tm.event=NewBlock",
+		"tm.event='Tx' AND",
+		"tm.event='Tx' OR tm.event='NewBlock'",
+		"='Tx'",
+		"tx.height ~ 5",
+		"tx.fee EXISTS 'x'",
+	}
+	for _, q := range invalid {
+		if err := ValidateEventQuery(q); err == nil {
+			t.Errorf("ValidateEventQuery(%q) = nil, want an error", q)
+		}
+	}
+}
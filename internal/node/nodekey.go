@@ -0,0 +1,39 @@
+package node
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// IDFromKeyFile derives the node ID from a CometBFT node_key.json: the
+// lowercase hex of the first 20 bytes of SHA-256 over the ed25519 public key.
+// No network or pchaind call is needed.
+func IDFromKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	var key struct {
+		PrivKey struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"priv_key"`
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if key.PrivKey.Type != "tendermint/PrivKeyEd25519" {
+		return "", fmt.Errorf("unsupported node key type %q", key.PrivKey.Type)
+	}
+	priv, err := base64.StdEncoding.DecodeString(key.PrivKey.Value)
+	if err != nil || len(priv) != ed25519.PrivateKeySize {
+		return "", fmt.Errorf("invalid ed25519 node key in %s", path)
+	}
+	sum := sha256.Sum256(ed25519.PrivateKey(priv).Public().(ed25519.PublicKey))
+	return hex.EncodeToString(sum[:20]), nil
+}
@@ -0,0 +1,49 @@
+package node
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIDFromKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(pub)
+	want := hex.EncodeToString(sum[:20])
+
+	write := func(name, typ, value string) string {
+		path := filepath.Join(dir, name)
+		body := fmt.Sprintf(`{"priv_key":{"type":%q,"value":%q}}`, typ, value)
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	got, err := IDFromKeyFile(write("node_key.json", "tendermint/PrivKeyEd25519", base64.StdEncoding.EncodeToString(priv)))
+	if err != nil {
+		t.Fatalf("IDFromKeyFile() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("IDFromKeyFile() = %s, want %s", got, want)
+	}
+
+	for name, path := range map[string]string{
+		"missing":    filepath.Join(dir, "nope.json"),
+		"wrong type": write("secp.json", "tendermint/PrivKeySecp256k1", base64.StdEncoding.EncodeToString(priv[:32])),
+		"bad length": write("short.json", "tendermint/PrivKeyEd25519", base64.StdEncoding.EncodeToString(priv[:32])),
+	} {
+		if _, err := IDFromKeyFile(path); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
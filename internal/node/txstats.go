@@ -0,0 +1,84 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MempoolInfo is the node's unconfirmed transaction backlog.
+type MempoolInfo struct {
+	Txs   int   // transactions waiting in the mempool
+	Bytes int64 // their total size
+}
+
+// BlockInfo summarizes one committed block.
+type BlockInfo struct {
+	Height   int64
+	Time     time.Time
+	Txs      int
+	Proposer string // hex consensus address
+}
+
+// NumUnconfirmedTxs reads the mempool size from base's /num_unconfirmed_txs.
+func NumUnconfirmedTxs(ctx context.Context, base string) (MempoolInfo, error) {
+	body, err := Get(ctx, base, "num_unconfirmed_txs")
+	if err != nil {
+		return MempoolInfo{}, err
+	}
+	var payload struct {
+		Result struct {
+			Total      string `json:"total"`
+			TotalBytes string `json:"total_bytes"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return MempoolInfo{}, fmt.Errorf("decode num_unconfirmed_txs: %w", err)
+	}
+	n, _ := strconv.Atoi(payload.Result.Total)
+	b, _ := strconv.ParseInt(payload.Result.TotalBytes, 10, 64)
+	return MempoolInfo{Txs: n, Bytes: b}, nil
+}
+
+// Block reads the block at height from base's /block; height 0 means the
+// latest block.
+func Block(ctx context.Context, base string, height int64) (BlockInfo, error) {
+	path := "block"
+	if height > 0 {
+		path = fmt.Sprintf("block?height=%d", height)
+	}
+	body, err := Get(ctx, base, path)
+	if err != nil {
+		return BlockInfo{}, err
+	}
+	var payload struct {
+		Result struct {
+			Block struct {
+				Header struct {
+					Height          string    `json:"height"`
+					Time            time.Time `json:"time"`
+					ProposerAddress string    `json:"proposer_address"`
+				} `json:"header"`
+				Data struct {
+					Txs []string `json:"txs"`
+				} `json:"data"`
+			} `json:"block"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return BlockInfo{}, fmt.Errorf("decode block: %w", err)
+	}
+	h := payload.Result.Block.Header
+	n, err := strconv.ParseInt(h.Height, 10, 64)
+	if err != nil {
+		return BlockInfo{}, fmt.Errorf("block response has no height")
+	}
+	return BlockInfo{
+		Height:   n,
+		Time:     h.Time,
+		Txs:      len(payload.Result.Block.Data.Txs),
+		Proposer: h.ProposerAddress,
+	}, nil
+}
@@ -8,10 +8,61 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
 )
 
 // DialAndSubscribeHeaders uses gorilla/websocket to subscribe to NewBlockHeader events and stream heights.
 func DialAndSubscribeHeaders(ctx context.Context, wsURL string) (<-chan Header, error) {
+	return subscribeHeaders(ctx, wsURL, 0)
+}
+
+// headerQuery is the event query SubscribeHeaders uses. The cometbft.event
+// key is preferred for 0.38+; servers typically support both.
+const headerQuery = "cometbft.event='NewBlockHeader'"
+
+// subscribeHeaders streams headers like DialAndSubscribeHeaders, but when
+// the connection drops abnormally it redials up to retries times, with
+// backoff, before closing the channel. The retry count starts over after
+// each successful redial.
+func subscribeHeaders(ctx context.Context, wsURL string, retries int) (<-chan Header, error) {
+	conn, err := dialSubscription(ctx, wsURL, headerQuery)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan Header, 32)
+	// Read the settings here, not in the goroutine, which outlives the caller
+	ping, readTimeout := wsPingInterval, headerReadTimeout
+	forward := func(msg []byte) bool {
+		if h, ok := parseHeaderHeight(msg); ok {
+			out <- h
+		}
+		return true
+	}
+	go func() {
+		defer close(out)
+		for {
+			if !readMessages(ctx, conn, ping, readTimeout, forward) {
+				return
+			}
+			conn = nil
+			for attempt := 0; attempt < retries && conn == nil; attempt++ {
+				if !sleepCtx(ctx, retryDelay(attempt)) {
+					return
+				}
+				conn, _ = dialSubscription(ctx, wsURL, headerQuery)
+			}
+			if conn == nil {
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// dialSubscription opens the WebSocket and subscribes to events matching
+// query. It doesn't wait for the node to acknowledge the subscription.
+func dialSubscription(ctx context.Context, wsURL, query string) (*websocket.Conn, error) {
 	u, err := url.Parse(wsURL)
 	if err != nil {
 		return nil, err
@@ -24,6 +75,7 @@ func DialAndSubscribeHeaders(ctx context.Context, wsURL string) (<-chan Header,
 		Subprotocols:      []string{"jsonrpc"},
 		HandshakeTimeout:  5 * time.Second,
 		EnableCompression: false,
+		TLSClientConfig:   httpclient.RPCTLSConfig(),
 	}
 	// nolint:bodyclose
 	conn, _, err := d.DialContext(ctx, u.String(), map[string][]string{"Origin": {"http://localhost"}})
@@ -35,57 +87,103 @@ func DialAndSubscribeHeaders(ctx context.Context, wsURL string) (<-chan Header,
 	sub := map[string]any{
 		"jsonrpc": "2.0",
 		"method":  "subscribe",
-		// Prefer cometbft.event key for 0.38+; servers typically support both.
-		"params": map[string]string{"query": "cometbft.event='NewBlockHeader'"},
-		"id":     1,
+		"params":  map[string]string{"query": query},
+		"id":      1,
 	}
 	if err := conn.WriteJSON(sub); err != nil {
 		_ = conn.Close()
 		return nil, err
 	}
+	return conn, nil
+}
 
-	// Read deadline is a safety net for TCP connections that don't close properly.
-	// During block sync, WS silence for minutes is normal (events only at consensus tip).
-	const readTimeout = 5 * time.Minute
+// wsPingInterval is how often readMessages pings the node. The pongs show
+// the connection is alive however quiet the subscription is; tests shorten it.
+var wsPingInterval = 30 * time.Second
 
-	out := make(chan Header, 32)
+// headerReadTimeout is how long a header subscription may go without a
+// message or a pong before it counts as dropped. It is a safety net for TCP
+// connections that don't close properly, for nodes that don't answer pings.
+var headerReadTimeout = 5 * time.Minute
+
+// readMessages passes each message on conn to handle until the connection
+// ends or handle returns false, then closes it. It pings the node every ping
+// while it reads, and with readTimeout > 0 the connection is given up once neither a
+// message nor a pong has arrived for that long; with 0 only a failed ping
+// ends it. It reports whether the connection dropped abnormally (reset,
+// timeout) rather than being closed, stopped by handle or ctx ending, so it
+// is worth redialing.
+func readMessages(ctx context.Context, conn *websocket.Conn, ping, readTimeout time.Duration, handle func([]byte) bool) bool {
+	extend := func() {
+		if readTimeout > 0 {
+			_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+		} else {
+			_ = conn.SetReadDeadline(time.Time{})
+		}
+	}
+	conn.SetPongHandler(func(string) error {
+		extend()
+		return nil
+	})
+
+	defer closeSubscription(conn)
+	defer keepAlive(conn, ping)()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		default:
+		}
+		// Set read deadline before each read; reset on success
+		extend()
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			// graceful exits on normal closure or going away
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return false
+			}
+			// any other error (timeout, connection reset) may be transient
+			return ctx.Err() == nil
+		}
+		// control frames are handled inside ReadMessage
+		if !handle(msg) {
+			return false
+		}
+	}
+}
+
+// keepAlive pings conn every interval until the returned func is called. A
+// ping that can't be written closes conn, so the read blocked on it ends as
+// a drop.
+func keepAlive(conn *websocket.Conn, interval time.Duration) (stop func()) {
+	done := make(chan struct{})
 	go func() {
-		defer close(out)
-		defer func() {
-			// attempt proper close handshake
-			deadline := time.Now().Add(1500 * time.Millisecond)
-			_ = conn.SetWriteDeadline(deadline)
-			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), deadline)
-			// best-effort wait for server close
-			_ = conn.SetReadDeadline(deadline)
-			_, _, _ = conn.ReadMessage()
-			_ = conn.Close()
-		}()
+		t := time.NewTicker(interval)
+		defer t.Stop()
 		for {
 			select {
-			case <-ctx.Done():
+			case <-done:
 				return
-			default:
-			}
-			// Set read deadline before each read; reset on success
-			_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
-			_, msg, err := conn.ReadMessage()
-			if err != nil {
-				// graceful exits on normal closure or going away
-				if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			case <-t.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(10*time.Second)); err != nil {
+					_ = conn.Close()
 					return
 				}
-				// any other error (timeout, connection reset): exit
-				return
-			}
-			if h, ok := parseHeaderHeight(msg); ok {
-				out <- h
-				continue
 			}
-			// handle pong/ping implicitly via gorilla; ignore others
 		}
 	}()
-	return out, nil
+	return func() { close(done) }
+}
+
+// closeSubscription closes conn, attempting a proper close handshake.
+func closeSubscription(conn *websocket.Conn) {
+	deadline := time.Now().Add(1500 * time.Millisecond)
+	_ = conn.SetWriteDeadline(deadline)
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), deadline)
+	// best-effort wait for server close
+	_ = conn.SetReadDeadline(deadline)
+	_, _, _ = conn.ReadMessage()
+	_ = conn.Close()
 }
 
 func parseHeaderHeight(b []byte) (Header, bool) {
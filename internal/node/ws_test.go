@@ -8,9 +8,14 @@ import (
     "fmt"
     "io"
     "net"
+    "net/http"
+    "net/http/httptest"
     "strings"
+    "sync/atomic"
     "testing"
     "time"
+
+    "github.com/gorilla/websocket"
 )
 
 // minimal server-side frame writer (no masking)
@@ -142,3 +147,91 @@ func TestDialAndSubscribeHeaders_JSONRPCSubprotocol(t *testing.T) {
     }
     if e := <-srvErr; e != nil { t.Fatalf("server error: %v", e) }
 }
+
+func TestSubscribeHeaders_RedialsAfterDrop(t *testing.T) {
+    probe, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil { t.Skipf("skipping: cannot bind due to sandbox: %v", err) }
+    probe.Close()
+
+    origBackoff := retryBackoff
+    retryBackoff = time.Millisecond
+    defer func() { retryBackoff = origBackoff }()
+
+    var conns atomic.Int32
+    up := websocket.Upgrader{Subprotocols: []string{"jsonrpc"}, CheckOrigin: func(*http.Request) bool { return true }}
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        c, err := up.Upgrade(w, r, nil)
+        if err != nil { return }
+        n := conns.Add(1)
+        _, _, _ = c.ReadMessage() // subscribe request
+        ev := fmt.Sprintf(`{"result":{"data":{"value":{"header":{"height":"%d","time":"2024-01-01T00:00:00Z"}}}}}`, n)
+        _ = c.WriteMessage(websocket.TextMessage, []byte(ev))
+        if n == 1 {
+            // Drop the first connection without a close frame
+            _ = c.UnderlyingConn().Close()
+            return
+        }
+        _, _, _ = c.ReadMessage() // hold until the client closes
+        _ = c.Close()
+    }))
+    defer srv.Close()
+
+    ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+    defer cancel()
+    ch, err := subscribeHeaders(ctx, deriveWS(srv.URL), 1)
+    if err != nil { t.Fatalf("subscribeHeaders() error: %v", err) }
+    for want := int64(1); want <= 2; want++ {
+        select {
+        case h, ok := <-ch:
+            if !ok { t.Fatalf("channel closed before header %d", want) }
+            if h.Height != want { t.Errorf("height = %d, want %d", h.Height, want) }
+        case <-ctx.Done():
+            t.Fatalf("timed out waiting for header %d", want)
+        }
+    }
+    if got := conns.Load(); got != 2 {
+        t.Errorf("connections = %d, want 2", got)
+    }
+}
+
+func TestSubscribeHeaders_PongsKeepQuietStreamUp(t *testing.T) {
+    probe, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil { t.Skipf("skipping: cannot bind due to sandbox: %v", err) }
+    probe.Close()
+
+    origPing, origTimeout := wsPingInterval, headerReadTimeout
+    wsPingInterval, headerReadTimeout = 10*time.Millisecond, 50*time.Millisecond
+    defer func() { wsPingInterval, headerReadTimeout = origPing, origTimeout }()
+
+    var conns atomic.Int32
+    up := websocket.Upgrader{Subprotocols: []string{"jsonrpc"}, CheckOrigin: func(*http.Request) bool { return true }}
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        c, err := up.Upgrade(w, r, nil)
+        if err != nil { return }
+        conns.Add(1)
+        _, _, _ = c.ReadMessage() // subscribe request
+        go func() {
+            // Silent for several read timeouts; only the pongs keep it up
+            time.Sleep(300 * time.Millisecond)
+            _ = c.WriteMessage(websocket.TextMessage, []byte(`{"result":{"data":{"value":{"header":{"height":"5","time":"2024-01-01T00:00:00Z"}}}}}`))
+        }()
+        _, _, _ = c.ReadMessage() // answers pings until the client closes
+        _ = c.Close()
+    }))
+    defer srv.Close()
+
+    ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+    defer cancel()
+    ch, err := subscribeHeaders(ctx, deriveWS(srv.URL), 0)
+    if err != nil { t.Fatalf("subscribeHeaders() error: %v", err) }
+    select {
+    case h, ok := <-ch:
+        if !ok { t.Fatal("quiet stream was dropped before the header arrived") }
+        if h.Height != 5 { t.Errorf("height = %d, want 5", h.Height) }
+    case <-ctx.Done():
+        t.Fatal("timed out waiting for the header")
+    }
+    if got := conns.Load(); got != 1 {
+        t.Errorf("connections = %d, want 1", got)
+    }
+}
@@ -0,0 +1,105 @@
+// Package notify delivers validator alerts to a webhook or the local
+// desktop. Delivery is best-effort: callers log failures and keep going.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
+)
+
+// Event types reported by the validator watcher.
+const (
+	EventJailed       = "jailed"
+	EventTombstoned   = "tombstoned"
+	EventMissedBlocks = "missed_blocks"
+)
+
+// Event is one alert, sent as the webhook's JSON body.
+type Event struct {
+	Type         string    `json:"event"`
+	Moniker      string    `json:"moniker"`
+	Address      string    `json:"address"`
+	Height       int64     `json:"height,omitempty"`
+	MissedBlocks int64     `json:"missed_blocks"`
+	Time         time.Time `json:"time"`
+	Message      string    `json:"message"`
+}
+
+// Notifier delivers an event.
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, ev Event) error
+}
+
+// Webhook POSTs events as JSON to URL.
+type Webhook struct {
+	URL    string
+	Client *http.Client // nil uses httpclient.Default
+}
+
+// Name returns "webhook".
+func (w Webhook) Name() string { return "webhook" }
+
+// Notify POSTs ev and fails on any non-2xx response.
+func (w Webhook) Notify(ctx context.Context, ev Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	client := w.Client
+	if client == nil {
+		if client, err = httpclient.Default(); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Desktop shows events as local OS notifications, via osascript on macOS
+// and notify-send on Linux.
+type Desktop struct{}
+
+// Name returns "desktop".
+func (Desktop) Name() string { return "desktop" }
+
+// Notify shows ev with the platform's notifier.
+func (Desktop) Notify(ctx context.Context, ev Event) error {
+	title := "Push validator " + ev.Type
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", ev.Message, title)
+		cmd = exec.CommandContext(ctx, "osascript", "-e", script)
+	case "linux":
+		cmd = exec.CommandContext(ctx, "notify-send", "--urgency=critical", title, ev.Message)
+	default:
+		return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %w %s", cmd.Path, err, bytes.TrimSpace(out))
+	}
+	return nil
+}
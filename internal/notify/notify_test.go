@@ -0,0 +1,54 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhookNotify(t *testing.T) {
+	var got Event
+	var contentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	ev := Event{
+		Type:         EventJailed,
+		Moniker:      "val-1",
+		Address:      "pushvaloper1abc",
+		Height:       1234,
+		MissedBlocks: 501,
+		Time:         time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		Message:      "val-1 was jailed",
+	}
+	if err := (Webhook{URL: srv.URL, Client: srv.Client()}).Notify(context.Background(), ev); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if contentType != "application/json" {
+		t.Errorf("Content-Type = %q", contentType)
+	}
+	if got != ev {
+		t.Errorf("payload = %+v, want %+v", got, ev)
+	}
+}
+
+func TestWebhookNotify_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	err := (Webhook{URL: srv.URL, Client: srv.Client()}).Notify(context.Background(), Event{Type: EventJailed})
+	if err == nil {
+		t.Fatal("Notify() expected error for 502")
+	}
+}
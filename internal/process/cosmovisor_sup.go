@@ -13,7 +13,10 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/chain"
 	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/trace"
 )
 
 // CosmovisorSupervisor manages pchaind through Cosmovisor.
@@ -140,6 +143,14 @@ func (s *CosmovisorSupervisor) Stop() error {
 }
 
 func (s *CosmovisorSupervisor) Restart(opts StartOpts) (int, error) {
+	home := opts.HomeDir
+	if home == "" {
+		home = s.homeDir
+	}
+	// Refuse before stopping, so a node that can't come back keeps running
+	if err := files.CheckSignedHeight(home); err != nil {
+		return 0, err
+	}
 	if err := s.Stop(); err != nil {
 		return 0, err
 	}
@@ -208,6 +219,7 @@ func (s *CosmovisorSupervisor) Start(opts StartOpts) (int, error) {
 
 		// Run tendermint unsafe-reset-all to clear data for sync
 		cmd := exec.Command(bin, "tendermint", "unsafe-reset-all", "--home", opts.HomeDir, "--keep-addr-book")
+		cmd.Env = chain.WithLibraryPath(os.Environ(), chain.LibraryDirs(bin)...)
 		if err := cmd.Run(); err != nil {
 			// Non-fatal: continue anyway as node might work
 			_ = err
@@ -217,11 +229,16 @@ func (s *CosmovisorSupervisor) Start(opts StartOpts) (int, error) {
 		_ = os.Remove(needsInitialSyncPath)
 	}
 
-	// Always ensure priv_validator_state.json exists before starting
-	pvsPath := filepath.Join(opts.HomeDir, "data", "priv_validator_state.json")
-	if _, err := os.Stat(pvsPath); os.IsNotExist(err) {
-		_ = os.MkdirAll(filepath.Join(opts.HomeDir, "data"), 0o755)
-		_ = os.WriteFile(pvsPath, []byte(`{"height":"0","round":0,"step":0}`), 0o644)
+	// Always ensure priv_validator_state.json exists before starting, and
+	// refuse to start on a corrupt one rather than let pchaind fail opaquely
+	if err := files.CheckPrivValState(opts.HomeDir); err != nil {
+		return 0, err
+	}
+	_ = files.EnsurePrivValState(opts.HomeDir)
+	// A state file below the recorded signed height, as after a reset,
+	// lets the validator sign those heights again
+	if err := files.CheckSignedHeight(opts.HomeDir); err != nil {
+		return 0, err
 	}
 
 	// Ensure logs directory exists
@@ -229,20 +246,51 @@ func (s *CosmovisorSupervisor) Start(opts StartOpts) (int, error) {
 		return 0, err
 	}
 
+	// RPC must be pinned on the command line since it binds 0.0.0.0; fall
+	// back to the port in config.toml so restarts without opts keep it
+	rpcPort := opts.RPCPort
+	if rpcPort == 0 {
+		rpcPort, _ = files.ListenPorts(opts.HomeDir)
+	}
+	if rpcPort == 0 {
+		rpcPort = 26657
+	}
+	// JSON-RPC is pinned the same way, falling back to app.toml
+	jsonPort, wsPort := opts.JSONRPCPort, opts.JSONRPCWSPort
+	if jsonPort == 0 || wsPort == 0 {
+		tomlJSON, tomlWS := files.JSONRPCPorts(opts.HomeDir)
+		if jsonPort == 0 {
+			jsonPort = tomlJSON
+		}
+		if wsPort == 0 {
+			wsPort = tomlWS
+		}
+	}
+	if jsonPort == 0 {
+		jsonPort = 8545
+	}
+	if wsPort == 0 {
+		wsPort = 8546
+	}
+
 	// Build Cosmovisor command: cosmovisor run start [args]
 	args := []string{
 		"run", "start",
 		"--home", opts.HomeDir,
 		"--pruning=everything",
 		"--minimum-gas-prices=1000000000upc",
-		"--rpc.laddr=tcp://0.0.0.0:26657",
-		"--json-rpc.address=0.0.0.0:8545",
-		"--json-rpc.ws-address=0.0.0.0:8546",
+		fmt.Sprintf("--rpc.laddr=tcp://0.0.0.0:%d", rpcPort),
+		fmt.Sprintf("--json-rpc.address=0.0.0.0:%d", jsonPort),
+		fmt.Sprintf("--json-rpc.ws-address=0.0.0.0:%d", wsPort),
 		"--json-rpc.api=eth,txpool,personal,net,debug,web3",
 		"--chain-id=push_42101-1",
 		"--log_level", "statesync:debug,*:info",
 	}
 
+	if opts.P2PPort > 0 {
+		args = append(args, fmt.Sprintf("--p2p.laddr=tcp://0.0.0.0:%d", opts.P2PPort))
+	}
+
 	// Add extra args if provided
 	if len(opts.ExtraArgs) > 0 {
 		args = append(args, opts.ExtraArgs...)
@@ -277,8 +325,11 @@ func (s *CosmovisorSupervisor) Start(opts StartOpts) (int, error) {
 	cmd.Stderr = lf
 	cmd.Stdin = nil
 
-	// Set Cosmovisor environment variables
-	cmd.Env = os.Environ()
+	// Set Cosmovisor environment variables. The loader path lets pchaind
+	// find libwasmvm: current/bin follows upgrades, genesis/bin covers the
+	// first run before cosmovisor creates current
+	libDirs := append(chain.LibraryDirs(filepath.Join(opts.HomeDir, "cosmovisor", "current", "bin", "pchaind")), s.cosmoSvc.GenesisDir())
+	cmd.Env = chain.WithLibraryPath(os.Environ(), libDirs...)
 	for k, v := range s.cosmoSvc.EnvVars() {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
 	}
@@ -286,6 +337,7 @@ func (s *CosmovisorSupervisor) Start(opts StartOpts) (int, error) {
 	// Detach from this session/process group
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
 
+	trace.Command(cmd)
 	if err := cmd.Start(); err != nil {
 		_ = lf.Close()
 		return 0, fmt.Errorf("start cosmovisor: %w", err)
@@ -5,9 +5,12 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/cosmovisor"
 )
 
 func TestCosmovisorSupervisor_LogPath(t *testing.T) {
@@ -438,3 +441,89 @@ func createDetachedProcess(path string) *exec.Cmd {
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
 	return cmd
 }
+
+// fakeCosmovisor is a cosmovisor.Service that is always set up.
+type fakeCosmovisor struct {
+	cosmovisor.Service
+	bin, genesisDir string
+}
+
+func (f fakeCosmovisor) IsSetup() bool                { return true }
+func (f fakeCosmovisor) CosmovisorBinaryPath() string { return f.bin }
+func (f fakeCosmovisor) GenesisDir() string           { return f.genesisDir }
+func (f fakeCosmovisor) EnvVars() map[string]string {
+	return map[string]string{"DAEMON_NAME": "pchaind"}
+}
+
+func TestCosmovisorSupervisor_Start_SetsLibraryPath(t *testing.T) {
+	home := t.TempDir()
+	envOut := filepath.Join(home, "env.out")
+	bin := filepath.Join(home, "cosmovisor-bin")
+	script := "#!/bin/sh\necho \"$LD_LIBRARY_PATH\" > " + envOut + "\n"
+	for path, content := range map[string]string{
+		filepath.Join(home, "config", "genesis.json"):           "{}",
+		filepath.Join(home, "data", "blockstore.db", "CURRENT"): "",
+		bin: script,
+	} {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	genesisDir := filepath.Join(home, "cosmovisor", "genesis", "bin")
+
+	sup := &CosmovisorSupervisor{
+		homeDir:  home,
+		pidFile:  filepath.Join(home, "cosmovisor.pid"),
+		logFile:  filepath.Join(home, "logs", "cosmovisor.log"),
+		cosmoSvc: fakeCosmovisor{bin: bin, genesisDir: genesisDir},
+	}
+	if _, err := sup.Start(StartOpts{HomeDir: home}); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	got := waitForFile(t, envOut)
+	for _, dir := range []string{filepath.Join(home, "cosmovisor", "current", "bin"), genesisDir} {
+		if !strings.Contains(got, dir) {
+			t.Errorf("node LD_LIBRARY_PATH = %q, want it to include %s", got, dir)
+		}
+	}
+}
+
+func TestCosmovisorSupervisor_Start_JSONRPCPorts(t *testing.T) {
+	home := t.TempDir()
+	argsOut := filepath.Join(home, "args.out")
+	bin := filepath.Join(home, "cosmovisor-bin")
+	script := "#!/bin/sh\necho \"$@\" > " + argsOut + "\n"
+	for path, content := range map[string]string{
+		filepath.Join(home, "config", "genesis.json"):           "{}",
+		filepath.Join(home, "config", "app.toml"):               "[json-rpc]\naddress = \"127.0.0.1:8545\"\nws-address = \"127.0.0.1:9646\"\n",
+		filepath.Join(home, "data", "blockstore.db", "CURRENT"): "",
+		bin: script,
+	} {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sup := &CosmovisorSupervisor{
+		homeDir:  home,
+		pidFile:  filepath.Join(home, "cosmovisor.pid"),
+		logFile:  filepath.Join(home, "logs", "cosmovisor.log"),
+		cosmoSvc: fakeCosmovisor{bin: bin, genesisDir: filepath.Join(home, "cosmovisor", "genesis", "bin")},
+	}
+	// The HTTP port comes from opts, the websocket port from app.toml
+	if _, err := sup.Start(StartOpts{HomeDir: home, JSONRPCPort: 9545}); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	got := waitForFile(t, argsOut)
+	for _, want := range []string{"--json-rpc.address=0.0.0.0:9545", "--json-rpc.ws-address=0.0.0.0:9646"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("cosmovisor args = %q, want %s", got, want)
+		}
+	}
+}
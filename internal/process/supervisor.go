@@ -12,6 +12,10 @@ import (
 	"sync"
 	"syscall"
 	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/chain"
+	"github.com/pushchain/push-validator-cli/internal/files"
+	"github.com/pushchain/push-validator-cli/internal/trace"
 )
 
 // Supervisor controls the pchaind process: start/stop/restart and status.
@@ -32,6 +36,11 @@ type StartOpts struct {
 	Moniker   string
 	BinPath   string   // path to pchaind (defaults to "pchaind" if empty)
 	ExtraArgs []string // additional args to append after defaults
+	RPCPort   int      // CometBFT RPC listen port; 0 keeps the configured one
+	P2PPort   int      // P2P listen port; 0 keeps the configured one
+
+	JSONRPCPort   int // EVM JSON-RPC listen port; 0 keeps the configured one
+	JSONRPCWSPort int // EVM JSON-RPC websocket port; 0 keeps the configured one
 }
 
 type supervisor struct {
@@ -140,6 +149,12 @@ func (s *supervisor) Stop() error {
 }
 
 func (s *supervisor) Restart(opts StartOpts) (int, error) {
+	// Refuse before stopping, so a node that can't come back keeps running
+	if opts.HomeDir != "" {
+		if err := files.CheckSignedHeight(opts.HomeDir); err != nil {
+			return 0, err
+		}
+	}
 	if err := s.Stop(); err != nil {
 		return 0, err
 	}
@@ -184,6 +199,7 @@ func (s *supervisor) Start(opts StartOpts) (int, error) {
 
 		// Run tendermint unsafe-reset-all to clear data for sync
 		cmd := exec.Command(bin, "tendermint", "unsafe-reset-all", "--home", opts.HomeDir, "--keep-addr-book")
+		cmd.Env = chain.WithLibraryPath(os.Environ(), chain.LibraryDirs(bin)...)
 		if err := cmd.Run(); err != nil {
 			// Non-fatal: continue anyway as node might work
 			_ = err
@@ -193,11 +209,16 @@ func (s *supervisor) Start(opts StartOpts) (int, error) {
 		_ = os.Remove(needsInitialSyncPath)
 	}
 
-	// Always ensure priv_validator_state.json exists before starting
-	pvsPath := filepath.Join(opts.HomeDir, "data", "priv_validator_state.json")
-	if _, err := os.Stat(pvsPath); os.IsNotExist(err) {
-		_ = os.MkdirAll(filepath.Join(opts.HomeDir, "data"), 0o755)
-		_ = os.WriteFile(pvsPath, []byte(`{"height":"0","round":0,"step":0}`), 0o644)
+	// Always ensure priv_validator_state.json exists before starting, and
+	// refuse to start on a corrupt one rather than let pchaind fail opaquely
+	if err := files.CheckPrivValState(opts.HomeDir); err != nil {
+		return 0, err
+	}
+	_ = files.EnsurePrivValState(opts.HomeDir)
+	// A state file below the recorded signed height, as after a reset,
+	// lets the validator sign those heights again
+	if err := files.CheckSignedHeight(opts.HomeDir); err != nil {
+		return 0, err
 	}
 
 	if err := os.MkdirAll(filepath.Join(opts.HomeDir, "logs"), 0o755); err != nil {
@@ -210,7 +231,18 @@ func (s *supervisor) Start(opts StartOpts) (int, error) {
 
 	// Build args: pchaind start --home <home>
 	args := []string{"start", "--home", opts.HomeDir, "--log_level", "statesync:debug,*:info"}
-	// if RPC port env set, leave default
+	if opts.RPCPort > 0 {
+		args = append(args, fmt.Sprintf("--rpc.laddr=tcp://127.0.0.1:%d", opts.RPCPort))
+	}
+	if opts.P2PPort > 0 {
+		args = append(args, fmt.Sprintf("--p2p.laddr=tcp://0.0.0.0:%d", opts.P2PPort))
+	}
+	if opts.JSONRPCPort > 0 {
+		args = append(args, fmt.Sprintf("--json-rpc.address=0.0.0.0:%d", opts.JSONRPCPort))
+	}
+	if opts.JSONRPCWSPort > 0 {
+		args = append(args, fmt.Sprintf("--json-rpc.ws-address=0.0.0.0:%d", opts.JSONRPCWSPort))
+	}
 	if len(opts.ExtraArgs) > 0 {
 		args = append(args, opts.ExtraArgs...)
 	}
@@ -234,12 +266,15 @@ func (s *supervisor) Start(opts StartOpts) (int, error) {
 
 	cmd := exec.Command(bin, args...)
 	cmd.Dir = opts.HomeDir // Set working directory so pchaind finds .env
+	// Let pchaind find libwasmvm next to the binary or in cosmovisor
+	cmd.Env = chain.WithLibraryPath(os.Environ(), chain.LibraryDirs(bin)...)
 	cmd.Stdout = lf
 	cmd.Stderr = lf
 	cmd.Stdin = nil
 	// Detach from this session/process group
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
 
+	trace.Command(cmd)
 	if err := cmd.Start(); err != nil {
 		_ = lf.Close()
 		return 0, fmt.Errorf("start pchaind: %w", err)
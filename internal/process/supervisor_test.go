@@ -1,14 +1,18 @@
 package process
 
 import (
+    "errors"
     "fmt"
     "net"
     "os"
     "os/exec"
     "path/filepath"
     "strconv"
+    "strings"
     "testing"
     "time"
+
+    "github.com/pushchain/push-validator-cli/internal/files"
 )
 
 func TestIsRPCListening(t *testing.T) {
@@ -1088,3 +1092,89 @@ fi
         t.Error("Target .env should not be overwritten")
     }
 }
+
+func TestSupervisor_Start_BelowSignedHeight(t *testing.T) {
+    home := t.TempDir()
+    binPath := filepath.Join(home, "fake-bin")
+    if err := os.WriteFile(binPath, []byte("#!/bin/sh\nsleep 0.1\n"), 0o755); err != nil {
+        t.Fatal(err)
+    }
+    for path, content := range map[string]string{
+        filepath.Join(home, "config", "genesis.json"):            "{}",
+        filepath.Join(home, "data", "blockstore.db", "CURRENT"):  "",
+        filepath.Join(home, "data", "priv_validator_state.json"): `{"height":"0","round":0,"step":0}`,
+        filepath.Join(home, ".signed-height"):                    "500\n",
+    } {
+        if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+            t.Fatal(err)
+        }
+        if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+            t.Fatal(err)
+        }
+    }
+
+    sup := New(home)
+    if _, err := sup.Start(StartOpts{HomeDir: home, BinPath: binPath}); !errors.Is(err, files.ErrPrivValStateBehind) {
+        t.Fatalf("Start() error = %v, want ErrPrivValStateBehind", err)
+    }
+    if _, err := sup.Restart(StartOpts{HomeDir: home, BinPath: binPath}); !errors.Is(err, files.ErrPrivValStateBehind) {
+        t.Fatalf("Restart() error = %v, want ErrPrivValStateBehind", err)
+    }
+    if sup.IsRunning() {
+        t.Error("node started below the recorded signed height")
+    }
+
+    // Once the state catches up, start goes ahead
+    if err := os.WriteFile(filepath.Join(home, "data", "priv_validator_state.json"), []byte(`{"height":"500","round":0,"step":3}`), 0o644); err != nil {
+        t.Fatal(err)
+    }
+    if _, err := sup.Start(StartOpts{HomeDir: home, BinPath: binPath}); err != nil {
+        t.Fatalf("Start() error = %v", err)
+    }
+    time.Sleep(200 * time.Millisecond)
+}
+
+func TestSupervisor_Start_SetsLibraryPath(t *testing.T) {
+    home := t.TempDir()
+    binDir := filepath.Join(home, "bin")
+    binPath := filepath.Join(binDir, "pchaind")
+    envOut := filepath.Join(home, "env.out")
+    script := "#!/bin/sh\necho \"$LD_LIBRARY_PATH\" > " + envOut + "\n"
+    for path, content := range map[string]string{
+        filepath.Join(home, "config", "genesis.json"):           "{}",
+        filepath.Join(home, "data", "blockstore.db", "CURRENT"): "",
+        binPath: script,
+    } {
+        if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+            t.Fatal(err)
+        }
+        if err := os.WriteFile(path, []byte(content), 0o755); err != nil {
+            t.Fatal(err)
+        }
+    }
+    t.Setenv("LD_LIBRARY_PATH", "/opt/existing")
+
+    sup := New(home)
+    if _, err := sup.Start(StartOpts{HomeDir: home, BinPath: binPath}); err != nil {
+        t.Fatalf("Start() error = %v", err)
+    }
+    got := waitForFile(t, envOut)
+    if !strings.HasPrefix(got, binDir+":") || !strings.HasSuffix(got, ":/opt/existing") {
+        t.Errorf("node LD_LIBRARY_PATH = %q, want %s first and /opt/existing kept", got, binDir)
+    }
+}
+
+// waitForFile returns the trimmed content of path once a detached process
+// has written it.
+func waitForFile(t *testing.T, path string) string {
+    t.Helper()
+    deadline := time.Now().Add(3 * time.Second)
+    for time.Now().Before(deadline) {
+        if data, err := os.ReadFile(path); err == nil && len(data) > 0 {
+            return strings.TrimSpace(string(data))
+        }
+        time.Sleep(20 * time.Millisecond)
+    }
+    t.Fatalf("%s was not written", path)
+    return ""
+}
@@ -0,0 +1,203 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+// ExportDir is the directory under the home dir that snapshot create writes to.
+const ExportDir = "snapshot-export"
+
+// excludedFromSnapshot are data/ entries never published: the signing state
+// must stay with the validator that produced it, or a node restored from the
+// snapshot could double-sign.
+var excludedFromSnapshot = map[string]bool{
+	"priv_validator_state.json": true,
+}
+
+// CreateOptions configures snapshot creation.
+type CreateOptions struct {
+	HomeDir    string       // Node home directory (e.g., ~/.pchain)
+	OutputPath string       // Archive path (default: <HomeDir>/snapshot-export/latest.tar.lz4)
+	Progress   ProgressFunc // Optional progress callback
+}
+
+// CreateResult describes a snapshot written by Create.
+type CreateResult struct {
+	Path         string `json:"path"`
+	ChecksumPath string `json:"checksum_path"`
+	SHA256       string `json:"sha256"`
+	Size         int64  `json:"size"`
+	Files        int64  `json:"files"`
+}
+
+// DefaultExportPath returns where Create writes when no output path is set.
+// The name matches what Download fetches, so the directory can be served as
+// a snapshot URL as-is.
+func DefaultExportPath(homeDir string) string {
+	return filepath.Join(homeDir, ExportDir, CachedTarball)
+}
+
+// Create packs <HomeDir>/data into a tar.lz4 archive in the layout Extract
+// expects (a top-level data/ directory) and writes a sibling .sha256. The
+// node must be stopped so the databases are consistent on disk.
+func (s *svc) Create(ctx context.Context, opts CreateOptions) (*CreateResult, error) {
+	if opts.HomeDir == "" {
+		return nil, fmt.Errorf("HomeDir required")
+	}
+	if opts.OutputPath == "" {
+		opts.OutputPath = DefaultExportPath(opts.HomeDir)
+	}
+	if abs, err := filepath.Abs(opts.OutputPath); err == nil {
+		opts.OutputPath = abs
+	}
+	progress := opts.Progress
+	if progress == nil {
+		progress = func(ProgressPhase, int64, int64, string) {} // no-op
+	}
+
+	dataDir := filepath.Join(opts.HomeDir, "data")
+	if !hasBlockchainData(dataDir) {
+		return nil, fmt.Errorf("no blockchain data in %s", dataDir)
+	}
+	if rel, err := filepath.Rel(dataDir, opts.OutputPath); err == nil && filepath.IsLocal(rel) {
+		return nil, fmt.Errorf("output path %s must be outside %s", opts.OutputPath, dataDir)
+	}
+	outDir := filepath.Dir(opts.OutputPath)
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return nil, fmt.Errorf("create output dir: %w", err)
+	}
+	if size, err := dirSize(dataDir); err == nil {
+		// lz4 typically shrinks chain data ~4x
+		if err := checkDiskSpace(outDir, size/4); err != nil {
+			return nil, fmt.Errorf("snapshot disk space check: %w", err)
+		}
+	}
+
+	partialPath := opts.OutputPath + ".partial"
+	f, err := os.Create(partialPath)
+	if err != nil {
+		return nil, fmt.Errorf("create archive: %w", err)
+	}
+	defer os.Remove(partialPath)
+
+	counter := &countingWriter{w: f}
+	lz4Writer := lz4.NewWriter(counter)
+	tarWriter := tar.NewWriter(lz4Writer)
+
+	files, err := writeDataTar(ctx, tarWriter, dataDir, progress)
+	if err == nil {
+		err = tarWriter.Close()
+	}
+	if err == nil {
+		err = lz4Writer.Close()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("write archive: %w", err)
+	}
+
+	if err := os.Rename(partialPath, opts.OutputPath); err != nil {
+		return nil, fmt.Errorf("finalize archive: %w", err)
+	}
+
+	// Write the checksum with the same helpers Download verifies with, and
+	// read it back so the published file always parses to this hash
+	progress(PhaseVerify, 0, 1, "Computing checksum...")
+	sum, err := hashFile(opts.OutputPath)
+	if err != nil {
+		return nil, fmt.Errorf("checksum archive: %w", err)
+	}
+	checksumPath := opts.OutputPath + ".sha256"
+	if err := writeChecksumFile(checksumPath, sum, filepath.Base(opts.OutputPath)); err != nil {
+		return nil, fmt.Errorf("write checksum: %w", err)
+	}
+	if published, err := readChecksumFile(checksumPath); err != nil || published != sum {
+		return nil, fmt.Errorf("%w: %s does not read back as %s (%v)", ErrChecksumMismatch, checksumPath, sum, err)
+	}
+	progress(PhaseVerify, 1, 1, fmt.Sprintf("Wrote %s (%s)", filepath.Base(opts.OutputPath), formatBytesHuman(counter.n)))
+
+	return &CreateResult{
+		Path:         opts.OutputPath,
+		ChecksumPath: checksumPath,
+		SHA256:       sum,
+		Size:         counter.n,
+		Files:        files,
+	}, nil
+}
+
+// writeDataTar adds dataDir to tw under "data/", skipping excludedFromSnapshot
+// at its top level. It returns the number of regular files written.
+func writeDataTar(ctx context.Context, tw *tar.Writer, dataDir string, progress ProgressFunc) (int64, error) {
+	var files int64
+	err := filepath.Walk(dataDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dataDir, path)
+		if err != nil {
+			return err
+		}
+		if excludedFromSnapshot[rel] || info.Mode()&(os.ModeSocket|os.ModeNamedPipe|os.ModeDevice) != 0 {
+			return nil
+		}
+		name := filepath.ToSlash(filepath.Join("data", rel))
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = name
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		src, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		if _, err := io.Copy(tw, src); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		files++
+		progress(PhaseCreate, files, -1, name)
+		return nil
+	})
+	return files, err
+}
+
+// countingWriter counts bytes passed through to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
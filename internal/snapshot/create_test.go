@@ -0,0 +1,104 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestData creates a data/ dir with enough state for hasBlockchainData.
+func writeTestData(t *testing.T, home string) {
+	t.Helper()
+	dbDir := filepath.Join(home, "data", "application.db")
+	if err := os.MkdirAll(dbDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dbDir, "000001.ldb"), bytes.Repeat([]byte("x"), 2*1024*1024), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, "data", "priv_validator_state.json"), []byte(`{"height":"42"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	home := t.TempDir()
+	writeTestData(t, home)
+
+	res, err := New().Create(context.Background(), CreateOptions{HomeDir: home})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if res.Path != DefaultExportPath(home) {
+		t.Errorf("Path = %s, want %s", res.Path, DefaultExportPath(home))
+	}
+	if res.Files != 1 {
+		t.Errorf("Files = %d, want 1 (priv_validator_state.json excluded)", res.Files)
+	}
+	if st, err := os.Stat(res.Path); err != nil || st.Size() != res.Size {
+		t.Errorf("archive size mismatch: stat=%v err=%v, reported %d", st, err, res.Size)
+	}
+	if _, err := os.Stat(res.Path + ".partial"); !os.IsNotExist(err) {
+		t.Error("expected .partial file to be removed")
+	}
+
+	// The checksum file is in the format Download reads
+	f, err := os.Open(res.ChecksumPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	sum, err := parseChecksumFile(f)
+	if err != nil || sum != res.SHA256 {
+		t.Fatalf("checksum file hash = %q (err %v), want %s", sum, err, res.SHA256)
+	}
+	if err := verifyFile(res.Path, sum); err != nil {
+		t.Errorf("verifyFile() error = %v", err)
+	}
+
+	// The archive round-trips through the extractor
+	dest := t.TempDir()
+	if err := extractTarLz4(res.Path, dest, nil); err != nil {
+		t.Fatalf("extractTarLz4() error = %v", err)
+	}
+	if st, err := os.Stat(filepath.Join(dest, "data", "application.db", "000001.ldb")); err != nil || st.Size() != 2*1024*1024 {
+		t.Errorf("expected extracted db file, got %v (err %v)", st, err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "data", "priv_validator_state.json")); !os.IsNotExist(err) {
+		t.Error("priv_validator_state.json must not be in the snapshot")
+	}
+}
+
+func TestCreate_Errors(t *testing.T) {
+	t.Run("no data", func(t *testing.T) {
+		if _, err := New().Create(context.Background(), CreateOptions{HomeDir: t.TempDir()}); err == nil || !strings.Contains(err.Error(), "no blockchain data") {
+			t.Errorf("expected no-data error, got %v", err)
+		}
+	})
+
+	t.Run("output inside data dir", func(t *testing.T) {
+		home := t.TempDir()
+		writeTestData(t, home)
+		out := filepath.Join(home, "data", "snap.tar.lz4")
+		if _, err := New().Create(context.Background(), CreateOptions{HomeDir: home, OutputPath: out}); err == nil || !strings.Contains(err.Error(), "outside") {
+			t.Errorf("expected output-path error, got %v", err)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		home := t.TempDir()
+		writeTestData(t, home)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		out := filepath.Join(t.TempDir(), "snap.tar.lz4")
+		if _, err := New().Create(ctx, CreateOptions{HomeDir: home, OutputPath: out}); err == nil {
+			t.Error("expected error for cancelled context")
+		}
+		if _, err := os.Stat(out); !os.IsNotExist(err) {
+			t.Error("no archive should be left after a failed create")
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"hash"
 	"io"
@@ -11,8 +12,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"syscall"
 	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/diskspace"
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
+	"github.com/pushchain/push-validator-cli/internal/trace"
 )
 
 // DefaultSnapshotURL is the default base URL for snapshot downloads.
@@ -26,13 +30,14 @@ const (
 	PhaseDownload ProgressPhase = "download"
 	PhaseVerify   ProgressPhase = "verify"
 	PhaseExtract  ProgressPhase = "extract"
+	PhaseCreate   ProgressPhase = "create" // Archiving data/ for snapshot create
 )
 
 // ProgressFunc is called during download/extraction with progress updates.
 // phase: current operation (download, verify, extract)
 // current: bytes/items processed
 // total: total bytes/items (-1 if unknown)
-// message: optional status message
+// message: optional status message (during download: transfer rate and ETA)
 type ProgressFunc func(phase ProgressPhase, current, total int64, message string)
 
 // Options configures the snapshot download and extraction.
@@ -58,6 +63,8 @@ type Service interface {
 	Extract(ctx context.Context, opts ExtractOptions) error
 	// IsCacheValid checks if the cached snapshot matches the remote checksum.
 	IsCacheValid(ctx context.Context, opts Options) (bool, error)
+	// Create archives the local data directory as a publishable snapshot.
+	Create(ctx context.Context, opts CreateOptions) (*CreateResult, error)
 }
 
 // HTTPDoer interface for HTTP requests (allows mocking in tests).
@@ -74,10 +81,10 @@ func New() Service {
 	return &svc{
 		http: &http.Client{
 			Timeout: 0, // No timeout for large downloads
-			Transport: &http.Transport{
+			Transport: trace.Transport(&http.Transport{
 				ResponseHeaderTimeout: 30 * time.Second,
 				IdleConnTimeout:       90 * time.Second,
-			},
+			}),
 		},
 	}
 }
@@ -99,6 +106,19 @@ const CachedTarball = "latest.tar.lz4"
 // CachedChecksum is the filename for the cached checksum.
 const CachedChecksum = "latest.tar.lz4.sha256"
 
+// ErrChecksumNotPublished is returned by the checksum fetch when the server
+// has no checksum file for the snapshot (HTTP 404). Download treats this as
+// "unverified" rather than fatal, mirroring chain.VerifyChecksum.
+var ErrChecksumNotPublished = errors.New("no checksum published for snapshot")
+
+// ErrChecksumMismatch indicates the downloaded snapshot does not match the
+// published checksum (corrupt or truncated download).
+var ErrChecksumMismatch = errors.New("checksum verification failed")
+
+// ErrDownloadIncomplete is returned when the download still fails after all
+// retries. The .partial file is kept so a later run resumes where it stopped.
+var ErrDownloadIncomplete = errors.New("download failed")
+
 // Retry constants for download resilience.
 const (
 	maxRetries     = 3
@@ -113,27 +133,11 @@ func checkDiskSpace(path string, requiredBytes int64) error {
 		return nil
 	}
 
-	// Ensure the path exists for statfs (use parent if path doesn't exist yet)
-	checkPath := path
-	for {
-		if _, err := os.Stat(checkPath); err == nil {
-			break
-		}
-		parent := filepath.Dir(checkPath)
-		if parent == checkPath {
-			break
-		}
-		checkPath = parent
-	}
-
-	var stat syscall.Statfs_t
-	if err := syscall.Statfs(checkPath, &stat); err != nil {
-		return fmt.Errorf("unable to check disk space: %w", err)
+	available, err := diskspace.Free(path)
+	if err != nil {
+		return err
 	}
 
-	// Available space = blocks available to unprivileged users * block size
-	available := int64(stat.Bavail) * int64(stat.Bsize)
-
 	if available < requiredBytes {
 		return fmt.Errorf("insufficient disk space: need %s, have %s available",
 			formatBytesHuman(requiredBytes), formatBytesHuman(available))
@@ -142,6 +146,42 @@ func checkDiskSpace(path string, requiredBytes int64) error {
 	return nil
 }
 
+// extractFactor is the free space extraction needs, as a multiple of the
+// tarball size: ~4x lz4 expansion, held in a temp dir and the target at once.
+const extractFactor = 8
+
+// tarballURL returns the snapshot archive URL under base.
+func tarballURL(base string) string {
+	return base + "/latest.tar.lz4"
+}
+
+// RemoteSize returns the size of the snapshot archive under baseURL from a
+// HEAD request, or 0 when the server does not report it.
+func RemoteSize(ctx context.Context, h HTTPDoer, baseURL string) (int64, error) {
+	if baseURL == "" {
+		baseURL = DefaultSnapshotURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, tarballURL(baseURL), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := h.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.ContentLength < 0 {
+		return 0, nil
+	}
+	return resp.ContentLength, nil
+}
+
+// Footprint estimates the free space a fresh download and extraction of an
+// archive of size bytes needs: the cached tarball plus the extraction.
+func Footprint(size int64) int64 {
+	return size + size*extractFactor
+}
+
 // formatBytesHuman formats bytes into human-readable format (e.g., "6.5 GB").
 func formatBytesHuman(b int64) string {
 	const (
@@ -352,18 +392,22 @@ func (s *svc) Download(ctx context.Context, opts Options) error {
 
 	cacheDir := getCacheDir(opts.HomeDir)
 	cachedTarball := getCachedTarballPath(opts.HomeDir)
-	snapshotURL := opts.SnapshotURL + "/latest.tar.lz4"
-	checksumURL := opts.SnapshotURL + "/latest.tar.lz4.sha256"
+	snapshotURL := tarballURL(opts.SnapshotURL)
+	checksumURL := snapshotURL + ".sha256"
 
 	// Step 1: Fetch remote checksum first (always needed to check for updates)
 	progress(PhaseCache, 0, -1, "Fetching remote checksum...")
 	remoteChecksum, err := s.fetchChecksum(ctx, checksumURL)
-	if err != nil {
+	if errors.Is(err, ErrChecksumNotPublished) {
+		// No checksum on the server: continue, but the download cannot be verified
+		progress(PhaseVerify, 0, -1, "Warning: no checksum published, snapshot integrity will not be verified")
+		remoteChecksum = ""
+	} else if err != nil {
 		return fmt.Errorf("fetch remote checksum: %w", err)
 	}
 
 	// Step 2: Check cache validity (unless NoCache is set)
-	if !opts.NoCache && isCacheValid(opts.HomeDir, remoteChecksum) {
+	if !opts.NoCache && remoteChecksum != "" && isCacheValid(opts.HomeDir, remoteChecksum) {
 		progress(PhaseCache, 1, 1, "Snapshot cached (checksum matches remote)")
 		return nil
 	}
@@ -392,7 +436,12 @@ func (s *svc) Download(ctx context.Context, opts Options) error {
 	partialPath := cachedTarball + ".partial"
 	partialChecksumPath := partialPath + ".sha256"
 
-	if _, err := os.Stat(partialPath); err == nil {
+	if _, err := os.Stat(partialPath); err == nil && remoteChecksum == "" {
+		// Without a checksum a resumed file could never be verified, start fresh
+		progress(PhaseDownload, 0, -1, "Discarding partial download (no checksum to verify resume)...")
+		os.Remove(partialPath)
+		os.Remove(partialChecksumPath)
+	} else if err == nil {
 		// Partial file exists - check if it matches the current remote checksum
 		if savedChecksum, readErr := os.ReadFile(partialChecksumPath); readErr == nil {
 			if strings.TrimSpace(string(savedChecksum)) != remoteChecksum {
@@ -415,11 +464,14 @@ func (s *svc) Download(ctx context.Context, opts Options) error {
 	}
 
 	// Save checksum marker alongside partial for stale detection on future resume
-	os.WriteFile(partialChecksumPath, []byte(remoteChecksum), 0o644)
+	if remoteChecksum != "" {
+		os.WriteFile(partialChecksumPath, []byte(remoteChecksum), 0o644)
+	}
 
 	// Download with retry and resume support
+	rate := &rateTracker{}
 	downloadHash, err := s.downloadWithRetry(ctx, snapshotURL, cachedTarball, func(current, total int64) {
-		progress(PhaseDownload, current, total, "")
+		progress(PhaseDownload, current, total, rate.describe(time.Now(), current, total))
 	}, progress)
 	if err != nil {
 		return fmt.Errorf("download snapshot: %w", err)
@@ -428,6 +480,14 @@ func (s *svc) Download(ctx context.Context, opts Options) error {
 	// Clean up partial checksum marker (download complete, file renamed)
 	os.Remove(partialChecksumPath)
 
+	if remoteChecksum == "" {
+		// Drop any stale cached checksum so the unverified tarball is never
+		// mistaken for a verified cache hit on a later run
+		os.Remove(getCachedChecksumPath(opts.HomeDir))
+		progress(PhaseVerify, 0, -1, "Checksum unavailable, skipping verification")
+		return nil
+	}
+
 	// Verify downloaded file
 	progress(PhaseVerify, 0, 1, "Verifying checksum...")
 	if downloadHash != "" {
@@ -436,7 +496,7 @@ func (s *svc) Download(ctx context.Context, opts Options) error {
 			os.Remove(cachedTarball)
 			os.Remove(partialPath)
 			os.Remove(partialChecksumPath)
-			return fmt.Errorf("checksum verification failed: hash mismatch: expected %s, got %s", remoteChecksum, downloadHash)
+			return fmt.Errorf("%w: hash mismatch: expected %s, got %s", ErrChecksumMismatch, remoteChecksum, downloadHash)
 		}
 	} else {
 		// Resumed download — must verify by reading file
@@ -444,7 +504,7 @@ func (s *svc) Download(ctx context.Context, opts Options) error {
 			os.Remove(cachedTarball)
 			os.Remove(partialPath)
 			os.Remove(partialChecksumPath)
-			return fmt.Errorf("checksum verification failed: %w", err)
+			return fmt.Errorf("%w: %v", ErrChecksumMismatch, err)
 		}
 	}
 	progress(PhaseVerify, 1, 1, "Checksum verified")
@@ -514,7 +574,7 @@ func (s *svc) Extract(ctx context.Context, opts ExtractOptions) error {
 	if tarballInfo, err := os.Stat(cachedTarball); err == nil {
 		// lz4 typical compression ratio ~3-4x for blockchain data
 		// Need space for temp dir + final target dir simultaneously during copy
-		estimatedSize := tarballInfo.Size() * 8
+		estimatedSize := tarballInfo.Size() * extractFactor
 		if err := checkDiskSpace(opts.TargetDir, estimatedSize); err != nil {
 			return fmt.Errorf("extraction disk space check: %w", err)
 		}
@@ -686,10 +746,10 @@ func (s *svc) downloadFile(ctx context.Context, url, destPath string, progress f
 		hasher = sha256.New()
 	}
 
-	var reader io.Reader = resp.Body
+	reader := httpclient.Throttle(ctx, resp.Body)
 	if progress != nil {
 		reader = &progressReader{
-			reader:   resp.Body,
+			reader:   reader,
 			total:    totalSize,
 			current:  startOffset,
 			progress: progress,
@@ -702,11 +762,18 @@ func (s *svc) downloadFile(ctx context.Context, url, destPath string, progress f
 		writer = io.MultiWriter(out, hasher)
 	}
 
-	if _, err = io.Copy(writer, reader); err != nil {
+	written, err := io.Copy(writer, reader)
+	if err != nil {
 		// Keep partial file for resume on next attempt
 		return "", err
 	}
 
+	// Guard against servers that close the stream early without an error
+	if totalSize > 0 && startOffset+written != totalSize {
+		return "", fmt.Errorf("incomplete download: received %s of %s",
+			formatBytesHuman(startOffset+written), formatBytesHuman(totalSize))
+	}
+
 	// Close file before rename
 	out.Close()
 
@@ -759,7 +826,7 @@ func (s *svc) downloadWithRetry(ctx context.Context, url, destPath string, progr
 		phaseProgress(PhaseDownload, 0, -1, fmt.Sprintf("Download interrupted: %v", lastErr))
 	}
 
-	return "", fmt.Errorf("download failed after %d attempts: %w", maxRetries+1, lastErr)
+	return "", fmt.Errorf("%w after %d attempts: %w", ErrDownloadIncomplete, maxRetries+1, lastErr)
 }
 
 // fetchChecksum downloads and parses the checksum file.
@@ -775,6 +842,9 @@ func (s *svc) fetchChecksum(ctx context.Context, url string) (string, error) {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return "", ErrChecksumNotPublished
+	}
 	if resp.StatusCode != http.StatusOK {
 		return "", fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
 	}
@@ -826,3 +896,37 @@ func (pr *progressReader) Read(p []byte) (int, error) {
 	}
 	return n, err
 }
+
+// rateTracker turns raw byte counts into a smoothed "rate, ETA" message.
+// Samples are taken at most once per second; a count that goes backwards
+// (fresh restart) resets the tracker.
+type rateTracker struct {
+	lastTime  time.Time
+	lastBytes int64
+	speed     float64 // bytes/sec, exponentially smoothed
+}
+
+func (r *rateTracker) describe(now time.Time, current, total int64) string {
+	if r.lastTime.IsZero() || current < r.lastBytes {
+		r.lastTime, r.lastBytes, r.speed = now, current, 0
+		return ""
+	}
+	if dt := now.Sub(r.lastTime).Seconds(); dt >= 1 {
+		inst := float64(current-r.lastBytes) / dt
+		if r.speed == 0 {
+			r.speed = inst
+		} else {
+			r.speed = 0.3*inst + 0.7*r.speed
+		}
+		r.lastTime, r.lastBytes = now, current
+	}
+	if r.speed <= 0 {
+		return ""
+	}
+	msg := formatBytesHuman(int64(r.speed)) + "/s"
+	if total > 0 && current < total {
+		eta := time.Duration(float64(total-current) / r.speed * float64(time.Second))
+		msg += ", ETA " + eta.Round(time.Second).String()
+	}
+	return msg
+}
@@ -6,12 +6,17 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pierrec/lz4/v4"
 )
@@ -344,6 +349,84 @@ func TestDownload(t *testing.T) {
 		}
 	})
 
+	t.Run("Success_NoChecksumPublished", func(t *testing.T) {
+		homeDir := t.TempDir()
+		tarballContent := "mock tarball data"
+
+		// Stale checksum from an earlier verified download must not survive
+		os.MkdirAll(getCacheDir(homeDir), 0o755)
+		os.WriteFile(getCachedChecksumPath(homeDir), []byte("abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789"), 0o644)
+
+		mock := &mockHTTPDoer{
+			responses: map[string]*http.Response{
+				// No .sha256 entry: mock returns 404
+				"https://snapshots.donut.push.org/latest.tar.lz4": makeResponse(
+					http.StatusOK,
+					tarballContent,
+					nil,
+				),
+			},
+		}
+
+		var messages []string
+		svc := NewWith(mock)
+		err := svc.Download(context.Background(), Options{
+			HomeDir:     homeDir,
+			SnapshotURL: "https://snapshots.donut.push.org",
+			Progress: func(phase ProgressPhase, current, total int64, message string) {
+				if phase == PhaseVerify {
+					messages = append(messages, message)
+				}
+			},
+		})
+		if err != nil {
+			t.Fatalf("Download() error = %v", err)
+		}
+
+		data, _ := os.ReadFile(getCachedTarballPath(homeDir))
+		if string(data) != tarballContent {
+			t.Errorf("tarball content = %q, want %q", string(data), tarballContent)
+		}
+		if _, err := os.Stat(getCachedChecksumPath(homeDir)); !os.IsNotExist(err) {
+			t.Error("cached checksum should be removed for unverified download")
+		}
+		if len(messages) == 0 || !strings.Contains(messages[0], "no checksum published") {
+			t.Errorf("expected unverified warning, got %v", messages)
+		}
+	})
+
+	t.Run("Error_ChecksumMismatch", func(t *testing.T) {
+		homeDir := t.TempDir()
+		checksumContent := "abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789  latest.tar.lz4"
+
+		mock := &mockHTTPDoer{
+			responses: map[string]*http.Response{
+				"https://snapshots.donut.push.org/latest.tar.lz4.sha256": makeResponse(
+					http.StatusOK,
+					checksumContent,
+					nil,
+				),
+				"https://snapshots.donut.push.org/latest.tar.lz4": makeResponse(
+					http.StatusOK,
+					"truncated",
+					nil,
+				),
+			},
+		}
+
+		svc := NewWith(mock)
+		err := svc.Download(context.Background(), Options{
+			HomeDir:     homeDir,
+			SnapshotURL: "https://snapshots.donut.push.org",
+		})
+		if !errors.Is(err, ErrChecksumMismatch) {
+			t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+		}
+		if _, err := os.Stat(getCachedTarballPath(homeDir)); !os.IsNotExist(err) {
+			t.Error("corrupt tarball should be removed")
+		}
+	})
+
 	t.Run("NoCache_Option", func(t *testing.T) {
 		homeDir := t.TempDir()
 		tarballContent := "new tarball data"
@@ -1049,3 +1132,129 @@ func computeSHA256(data []byte) string {
 	h.Write(data)
 	return hex.EncodeToString(h.Sum(nil))
 }
+
+func TestDownload_ResumesAfterDroppedConnection(t *testing.T) {
+	// Skip if sandbox disallows binding
+	if ln, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("binding disabled in sandbox")
+	} else {
+		ln.Close()
+	}
+
+	payload := bytes.Repeat([]byte("snapshot-bytes-"), 4096)
+	checksum := computeSHA256(payload)
+
+	var requests, rangeRequests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/latest.tar.lz4.sha256", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(checksum + "  latest.tar.lz4"))
+	})
+	mux.HandleFunc("/latest.tar.lz4", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.Header().Set("Content-Length", fmt.Sprint(len(payload)))
+			return
+		}
+		requests++
+		if r.Header.Get("Range") != "" {
+			rangeRequests++
+		}
+		if requests == 1 {
+			// Promise the full body, send half, then drop the connection
+			w.Header().Set("Content-Length", fmt.Sprint(len(payload)))
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(payload[:len(payload)/2])
+			w.(http.Flusher).Flush()
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		http.ServeContent(w, r, "latest.tar.lz4", time.Time{}, bytes.NewReader(payload))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	homeDir := t.TempDir()
+	var sawInterrupt bool
+	svc := NewWith(srv.Client())
+	err := svc.Download(context.Background(), Options{
+		HomeDir:     homeDir,
+		SnapshotURL: srv.URL,
+		Progress: func(phase ProgressPhase, current, total int64, message string) {
+			if strings.HasPrefix(message, "Download interrupted") {
+				sawInterrupt = true
+			}
+		},
+	})
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	if !sawInterrupt {
+		t.Error("expected an interrupted attempt to be reported")
+	}
+	if rangeRequests == 0 {
+		t.Error("expected the retry to resume with a Range request")
+	}
+	data, _ := os.ReadFile(getCachedTarballPath(homeDir))
+	if !bytes.Equal(data, payload) {
+		t.Errorf("resumed tarball has %d bytes, want %d", len(data), len(payload))
+	}
+	if _, err := os.Stat(getCachedTarballPath(homeDir) + ".partial"); !os.IsNotExist(err) {
+		t.Error("partial file should be gone after a completed download")
+	}
+}
+
+func TestDownloadFile_ShortBody(t *testing.T) {
+	destPath := filepath.Join(t.TempDir(), "file.txt")
+	resp := makeResponse(http.StatusOK, "short", nil)
+	resp.ContentLength = 100
+
+	svc := &svc{http: &customHTTPDoer{doFunc: func(*http.Request) (*http.Response, error) { return resp, nil }}}
+	_, err := svc.downloadFile(context.Background(), "http://example.com/file.txt", destPath, nil)
+	if err == nil || !strings.Contains(err.Error(), "incomplete download") {
+		t.Fatalf("expected incomplete download error, got %v", err)
+	}
+	if _, err := os.Stat(destPath + ".partial"); err != nil {
+		t.Error("partial file should be kept for resume")
+	}
+}
+
+func TestRateTracker(t *testing.T) {
+	r := &rateTracker{}
+	start := time.Now()
+	if msg := r.describe(start, 0, 100*1024*1024); msg != "" {
+		t.Errorf("first sample should have no rate, got %q", msg)
+	}
+	msg := r.describe(start.Add(2*time.Second), 20*1024*1024, 100*1024*1024)
+	if msg != "10.0 MB/s, ETA 8s" {
+		t.Errorf("describe() = %q, want %q", msg, "10.0 MB/s, ETA 8s")
+	}
+	// A count going backwards resets the tracker
+	if msg := r.describe(start.Add(3*time.Second), 0, 100); msg != "" {
+		t.Errorf("reset should clear rate, got %q", msg)
+	}
+}
+
+func TestRemoteSize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead || r.URL.Path != "/latest.tar.lz4" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Length", "4096")
+	}))
+	defer srv.Close()
+
+	size, err := RemoteSize(context.Background(), srv.Client(), srv.URL)
+	if err != nil || size != 4096 {
+		t.Fatalf("RemoteSize() = %d, %v; want 4096", size, err)
+	}
+	size, err = RemoteSize(context.Background(), srv.Client(), srv.URL+"/missing")
+	if err != nil || size != 0 {
+		t.Fatalf("RemoteSize() on 404 = %d, %v; want 0, nil", size, err)
+	}
+	if got := Footprint(100); got != 900 {
+		t.Errorf("Footprint(100) = %d, want 900", got)
+	}
+}
@@ -42,20 +42,43 @@ func parseChecksumFile(r io.Reader) (string, error) {
 	return "", fmt.Errorf("no valid SHA256 hash found in checksum file")
 }
 
-// verifyFile computes the SHA256 hash of a file and compares it to expected.
-func verifyFile(filePath, expectedHash string) error {
+// writeChecksumFile writes hash for the file called name to path in the
+// "<hash>  <filename>" format parseChecksumFile reads.
+func writeChecksumFile(path, hash, name string) error {
+	return os.WriteFile(path, []byte(fmt.Sprintf("%s  %s\n", hash, name)), 0o644)
+}
+
+// readChecksumFile parses the checksum file at path.
+func readChecksumFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return parseChecksumFile(f)
+}
+
+// hashFile returns the hex SHA256 of a file.
+func hashFile(filePath string) (string, error) {
 	f, err := os.Open(filePath)
 	if err != nil {
-		return fmt.Errorf("open file: %w", err)
+		return "", fmt.Errorf("open file: %w", err)
 	}
 	defer f.Close()
 
 	h := sha256.New()
 	if _, err := io.Copy(h, f); err != nil {
-		return fmt.Errorf("read file: %w", err)
+		return "", fmt.Errorf("read file: %w", err)
 	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
 
-	actualHash := hex.EncodeToString(h.Sum(nil))
+// verifyFile computes the SHA256 hash of a file and compares it to expected.
+func verifyFile(filePath, expectedHash string) error {
+	actualHash, err := hashFile(filePath)
+	if err != nil {
+		return err
+	}
 	if !strings.EqualFold(actualHash, expectedHash) {
 		return fmt.Errorf("hash mismatch: expected %s, got %s", expectedHash, actualHash)
 	}
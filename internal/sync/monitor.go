@@ -15,7 +15,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
+	"github.com/pushchain/push-validator-cli/internal/metrics"
 	"github.com/pushchain/push-validator-cli/internal/node"
+	"github.com/pushchain/push-validator-cli/internal/ui"
 )
 
 type Options struct {
@@ -26,11 +29,17 @@ type Options struct {
 	Compact      bool
 	Out          io.Writer     // default os.Stdout
 	Interval     time.Duration // refresh interval for progress updates
-	Quiet        bool          // minimal, non-emoji, non-TTY style output
-	Debug        bool          // extra diagnostic prints
+	Quiet        bool          // no per-tick progress; one summary line on success
 	StuckTimeout time.Duration // timeout for detecting stalled sync
+	PathPrefix   string        // path the local RPC is served under behind a proxy (e.g. "/rpc"); empty for bare paths
+	// SyncTolerance is how many blocks behind RemoteRPC the node may be and
+	// still count as synced while it reports catching_up (config.SyncTolerance).
+	SyncTolerance int64
 }
 
+// newClient builds the node clients run uses; tests replace it.
+var newClient = node.New
+
 type pt struct {
 	h int64
 	t time.Time
@@ -45,11 +54,42 @@ func RetryableError(err error) bool {
 	return errors.Is(err, ErrSyncStuck)
 }
 
-// Run monitors block sync progress via WebSocket header subscription.
+// Run monitors block sync progress via WebSocket header subscription. When
+// the node's WebSocket is unavailable, as behind proxies that strip the
+// upgrade, or drops for good, progress is driven by polling /status every
+// Interval instead; the active mode is printed to Out. With Quiet set, progress is not printed; a single "Synced to height N in D"
+// line is written to Out once sync completes.
 func Run(ctx context.Context, opts Options) error {
 	if opts.Out == nil {
 		opts.Out = os.Stdout
 	}
+	if !opts.Quiet {
+		return run(ctx, opts)
+	}
+	out := opts.Out
+	opts.Out = io.Discard
+	start := time.Now()
+	if err := run(ctx, opts); err != nil {
+		return err
+	}
+	local := opts.LocalRPC
+	if local == "" {
+		local = "http://127.0.0.1:26657"
+	}
+	fmt.Fprintln(out, syncSummary(probeRemoteOnce(JoinPathPrefix(local, opts.PathPrefix), 0), time.Since(start)))
+	return nil
+}
+
+// syncSummary is the completion line printed in quiet mode.
+func syncSummary(height int64, elapsed time.Duration) string {
+	elapsed = elapsed.Round(time.Second)
+	if height <= 0 {
+		return fmt.Sprintf("Synced in %s", elapsed)
+	}
+	return fmt.Sprintf("Synced to height %d in %s", height, elapsed)
+}
+
+func run(ctx context.Context, opts Options) error {
 	if opts.Window <= 0 {
 		opts.Window = 30
 	}
@@ -61,7 +101,6 @@ func Run(ctx context.Context, opts Options) error {
 	const rpcFailTimeout = 60 * time.Second
 	var lastTickHeight int64
 	// Height-based sync completion: if local >= remote-tolerance for this long, consider synced
-	const heightSyncedTolerance int64 = 5
 	const heightSyncedRequired = 30 * time.Second
 	var heightSyncedSince time.Time // zero = not yet within tolerance
 
@@ -77,6 +116,7 @@ func Run(ctx context.Context, opts Options) error {
 	if local == "" {
 		local = "http://127.0.0.1:26657"
 	}
+	local = JoinPathPrefix(local, opts.PathPrefix)
 	hostport := hostPortFromURL(local)
 	// Wait for RPC up to 60s
 	if !waitTCP(hostport, 60*time.Second) {
@@ -87,15 +127,20 @@ func Run(ctx context.Context, opts Options) error {
 	if !waitRPCReady(local, 90*time.Second) {
 		return ErrSyncStuck
 	}
-	cli := node.New(local)
+	iv := opts.Interval
+	if iv <= 0 {
+		iv = 1 * time.Second
+	}
+	cli := newClient(local)
 	headers, err := cli.SubscribeHeaders(ctx)
 	if err != nil {
 		// WS not available — fall back to tick-based RPC polling.
 		// This is common during block sync when the node is still initializing.
 		headers = nil
-		if opts.Debug {
-			fmt.Fprintf(opts.Out, "  [DEBUG] WS subscribe failed (%v) — using RPC polling\n", err)
-		}
+		ui.Log().Debugf("WS subscribe failed (%v), using RPC polling", err)
+		fmt.Fprintf(opts.Out, "  → Block header stream unavailable, polling /status every %s\n", iv)
+	} else {
+		fmt.Fprintln(opts.Out, "  → Following block headers over WebSocket")
 	}
 
 	// Remote (denominator) via WebSocket headers
@@ -103,7 +148,7 @@ func Run(ctx context.Context, opts Options) error {
 	if remote == "" {
 		remote = local
 	}
-	remoteCli := node.New(remote)
+	remoteCli := newClient(remote)
 	remoteHeaders, remoteWSErr := remoteCli.SubscribeHeaders(ctx)
 
 	buf := make([]pt, 0, opts.Window)
@@ -134,9 +179,22 @@ func Run(ctx context.Context, opts Options) error {
 	if tty {
 		fmt.Fprint(opts.Out, "\r")
 	}
-	iv := opts.Interval
-	if iv <= 0 {
-		iv = 1 * time.Second
+	// Periodically refresh peers and remote latency (every ~3s)
+	refreshMetrics := func() {
+		if time.Since(lastMetricsAt) <= 3*time.Second {
+			return
+		}
+		lastMetricsAt = time.Now()
+		ctxp, cancelp := context.WithTimeout(context.Background(), 800*time.Millisecond)
+		if plist, err := cli.Peers(ctxp); err == nil {
+			lastPeers = len(plist)
+		}
+		cancelp()
+		t0 := time.Now()
+		ctxl, cancell := context.WithTimeout(context.Background(), 800*time.Millisecond)
+		_, _ = remoteCli.RemoteStatus(ctxl, remote)
+		cancell()
+		lastLatency = time.Since(t0).Milliseconds()
 	}
 	tick := time.NewTicker(iv)
 	defer tick.Stop()
@@ -212,13 +270,17 @@ func Run(ctx context.Context, opts Options) error {
 		case h, ok := <-headers:
 			if !ok {
 				// Channel closed — WS disconnected (timeout or node died).
-				if isNodeAlive(opts.LocalRPC) {
+				if isNodeAlive(local) {
 					// Node is alive but WS timed out (normal during block sync).
 					// Fall back to tick-based progress monitoring only.
 					headers = nil // nil channel blocks forever in select
+					if tty {
+						fmt.Fprint(opts.Out, "\r\033[K")
+					}
+					fmt.Fprintf(opts.Out, "  → Block header stream closed, polling /status every %s\n", iv)
 					break
 				}
-				if isSyncedQuick(opts.LocalRPC) {
+				if isSyncedQuick(local) {
 					return nil
 				}
 				return ErrSyncStuck
@@ -258,20 +320,7 @@ func Run(ctx context.Context, opts Options) error {
 			}
 			// Compute moving rate from recent headers and derive ETA string.
 			rate, eta := progressRateAndETA(buf, cur, lastRemote)
-			// Periodically refresh peers and remote latency (every ~3s)
-			if time.Since(lastMetricsAt) > 3*time.Second {
-				lastMetricsAt = time.Now()
-				ctxp, cancelp := context.WithTimeout(context.Background(), 800*time.Millisecond)
-				if plist, err := cli.Peers(ctxp); err == nil {
-					lastPeers = len(plist)
-				}
-				cancelp()
-				t0 := time.Now()
-				ctxl, cancell := context.WithTimeout(context.Background(), 800*time.Millisecond)
-				_, _ = remoteCli.RemoteStatus(ctxl, remote)
-				cancell()
-				lastLatency = time.Since(t0).Milliseconds()
-			}
+			refreshMetrics()
 			// Only render the bar once baseline exists
 			if baseH == 0 {
 				break
@@ -306,7 +355,7 @@ func Run(ctx context.Context, opts Options) error {
 		case <-tick.C:
 			if opts.StuckTimeout > 0 && lastProgress.Since() > opts.StuckTimeout {
 				// Before declaring stuck, check if node RPC is still alive
-				if !isNodeAlive(opts.LocalRPC) {
+				if !isNodeAlive(local) {
 					if tty {
 						fmt.Fprint(opts.Out, "\r\033[K")
 					}
@@ -337,6 +386,17 @@ func Run(ctx context.Context, opts Options) error {
 				lastProgress.Update()
 				lastTickHeight = st.Height
 			}
+			// Without header streams the tick also keeps peers and the
+			// remote height current
+			if headers == nil {
+				refreshMetrics()
+			}
+			if remoteHeaders == nil && lastRemote > 0 && time.Since(lastRemoteProbeAt) >= remoteProbeInterval {
+				lastRemoteProbeAt = time.Now()
+				if h := probeRemoteOnce(opts.RemoteRPC, 0); h > lastRemote {
+					lastRemote = h
+				}
+			}
 			// If we haven't printed any bar yet (e.g., already synced), render a final bar once
 			if !barPrinted {
 				cur := st.Height
@@ -505,7 +565,9 @@ func Run(ctx context.Context, opts Options) error {
 						lastRemote = remoteH
 					}
 				}
-				if cur > 0 && remoteH > 0 && cur >= remoteH-heightSyncedTolerance {
+				// catching_up is the flag being worked around, so only the heights count
+				atTip := metrics.Chain{LocalHeight: cur, RemoteHeight: remoteH}.Synced(opts.SyncTolerance)
+				if cur > 0 && remoteH > 0 && atTip {
 					if heightSyncedSince.IsZero() {
 						heightSyncedSince = time.Now()
 					} else if time.Since(heightSyncedSince) >= heightSyncedRequired {
@@ -722,6 +784,17 @@ func (a *atomicTime) Since() time.Duration {
 	return time.Since(time.Unix(0, last))
 }
 
+// JoinPathPrefix appends a path prefix such as "/rpc" to an RPC base URL, so
+// probes hit <base>/rpc/health and <base>/rpc/status. An empty prefix
+// returns base unchanged.
+func JoinPathPrefix(base, prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return base
+	}
+	return strings.TrimRight(base, "/") + "/" + prefix
+}
+
 func hostPortFromURL(s string) string {
 	u, err := url.Parse(s)
 	if err == nil && u.Host != "" {
@@ -730,13 +803,36 @@ func hostPortFromURL(s string) string {
 	return "127.0.0.1:26657"
 }
 
+// ProbeResult is a one-shot view of the local node's sync state.
+type ProbeResult struct {
+	Alive        bool // local /health answered 200
+	Synced       bool // local /status reports catching_up=false
+	LocalHeight  int64
+	RemoteHeight int64 // 0 when the remote did not answer
+}
+
+// Probe checks the local /health and /status endpoints and the remote
+// height once, without subscribing or waiting. An empty remote is skipped.
+// For an RPC behind a path prefix, pass JoinPathPrefix(local, prefix).
+func Probe(local, remote string) ProbeResult {
+	if local == "" {
+		local = "http://127.0.0.1:26657"
+	}
+	return ProbeResult{
+		Alive:        isNodeAlive(local),
+		Synced:       isSyncedQuick(local),
+		LocalHeight:  probeRemoteOnce(local, 0),
+		RemoteHeight: probeRemoteOnce(remote, 0),
+	}
+}
+
 // isSyncedQuick checks local RPC catching_up with a tiny timeout.
 func isSyncedQuick(local string) bool {
 	local = strings.TrimRight(local, "/")
 	if local == "" {
 		local = "http://127.0.0.1:26657"
 	}
-	httpc := &http.Client{Timeout: 1200 * time.Millisecond}
+	httpc := &http.Client{Timeout: 1200 * time.Millisecond, Transport: rpcTransport()}
 	ctx, cancel := context.WithTimeout(context.Background(), 800*time.Millisecond)
 	defer cancel()
 	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, local+"/status", nil)
@@ -764,7 +860,7 @@ func isNodeAlive(local string) bool {
 	if local == "" {
 		local = "http://127.0.0.1:26657"
 	}
-	httpc := &http.Client{Timeout: 3 * time.Second}
+	httpc := &http.Client{Timeout: 3 * time.Second, Transport: rpcTransport()}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, local+"/health", nil)
@@ -789,12 +885,19 @@ func waitTCP(hostport string, d time.Duration) bool {
 	return false
 }
 
+// rpcTransport returns the transport for RPC probes, honouring --rpc-ca-cert
+// and --rpc-tls-insecure.
+func rpcTransport() http.RoundTripper {
+	tr, _ := httpclient.RPCTransport()
+	return tr
+}
+
 // waitRPCReady waits for the node's /status RPC endpoint to return HTTP 200
 // with a non-zero block height. After snapshot restore the TCP port may open
 // before the node has finished loading application state.
 func waitRPCReady(base string, d time.Duration) bool {
 	base = strings.TrimRight(base, "/")
-	httpc := &http.Client{Timeout: 2 * time.Second}
+	httpc := &http.Client{Timeout: 2 * time.Second, Transport: rpcTransport()}
 	deadline := time.Now().Add(d)
 	for time.Now().Before(deadline) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
@@ -827,21 +930,33 @@ func waitRPCReady(base string, d time.Duration) bool {
 
 // probeRemoteOnce fetches a single remote height with a small timeout.
 func probeRemoteOnce(base string, fallback int64) int64 {
+	h, err := fetchHeight(base, 800*time.Millisecond)
+	if err != nil {
+		return fallback
+	}
+	return h
+}
+
+// fetchHeight reads the latest block height from base's /status within d.
+func fetchHeight(base string, d time.Duration) (int64, error) {
 	base = strings.TrimRight(base, "/")
 	if base == "" {
-		return fallback
+		return 0, errors.New("no RPC URL")
 	}
-	httpc := &http.Client{Timeout: 1200 * time.Millisecond}
-	ctx, cancel := context.WithTimeout(context.Background(), 800*time.Millisecond)
+	httpc := &http.Client{Timeout: d, Transport: rpcTransport()}
+	ctx, cancel := context.WithTimeout(context.Background(), d)
 	defer cancel()
-	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, base+"/status", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"/status", nil)
+	if err != nil {
+		return 0, err
+	}
 	resp, err := httpc.Do(req)
 	if err != nil {
-		return fallback
+		return 0, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 	if resp.StatusCode != 200 {
-		return fallback
+		return 0, fmt.Errorf("/status returned HTTP %d", resp.StatusCode)
 	}
 	var payload struct {
 		Result struct {
@@ -851,13 +966,35 @@ func probeRemoteOnce(base string, fallback int64) int64 {
 		} `json:"result"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
-		return fallback
+		return 0, fmt.Errorf("/status: %w", err)
 	}
 	h, _ := strconvParseInt(payload.Result.SyncInfo.Height)
 	if h <= 0 {
-		return fallback
+		return 0, errors.New("/status reported no block height")
+	}
+	return h, nil
+}
+
+// EndpointSample is one timed probe of an RPC endpoint.
+type EndpointSample struct {
+	Healthy bool          // /health answered 200
+	Height  int64         // latest block height from /status; 0 on failure
+	Latency time.Duration // /status round trip
+	Err     error         // why /status failed; nil on success
+}
+
+// ProbeEndpoint times one /status request against base and checks its
+// /health, for comparing candidate RPCs. Unlike the sync probes it waits up
+// to timeout, so slow endpoints get a latency rather than a failure.
+func ProbeEndpoint(base string, timeout time.Duration) EndpointSample {
+	start := time.Now()
+	h, err := fetchHeight(base, timeout)
+	return EndpointSample{
+		Healthy: isNodeAlive(base),
+		Height:  h,
+		Latency: time.Since(start),
+		Err:     err,
 	}
-	return h
 }
 
 func movingRate(buf []struct {
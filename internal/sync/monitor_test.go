@@ -3,15 +3,20 @@ package syncmon
 import (
 	"bytes"
 	"context"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
 	"github.com/pushchain/push-validator-cli/internal/node"
 )
 
@@ -150,6 +155,62 @@ func TestProbeRemoteOnce(t *testing.T) {
 	}
 }
 
+func TestProbeRemoteOnce_CustomCA(t *testing.T) {
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	}
+	t.Cleanup(func() { httpclient.SetDefault(httpclient.Options{}) })
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"sync_info":{"latest_block_height":"5000"}}}`))
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {})
+	srv := httptest.NewTLSServer(mux)
+	defer srv.Close()
+
+	// The self-signed certificate is rejected until its CA is configured
+	httpclient.SetDefault(httpclient.Options{})
+	if height := probeRemoteOnce(srv.URL, 1000); height != 1000 {
+		t.Fatalf("expected fallback height 1000 without the CA, got %d", height)
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, pemData, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	httpclient.SetDefault(httpclient.Options{RPCCACertFile: caFile})
+	if height := probeRemoteOnce(srv.URL, 1000); height != 5000 {
+		t.Fatalf("expected height 5000 with the CA, got %d", height)
+	}
+	if !isNodeAlive(srv.URL) {
+		t.Error("isNodeAlive should reach the server with the CA")
+	}
+}
+
+func TestProbeEndpoint(t *testing.T) {
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"sync_info":{"latest_block_height":"5000"}}}`))
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	s := ProbeEndpoint(srv.URL, time.Second)
+	if s.Err != nil || !s.Healthy || s.Height != 5000 || s.Latency <= 0 {
+		t.Errorf("ProbeEndpoint() = %+v", s)
+	}
+
+	srv.Close()
+	if s := ProbeEndpoint(srv.URL, time.Second); s.Err == nil || s.Healthy || s.Height != 0 {
+		t.Errorf("ProbeEndpoint() on a closed server = %+v", s)
+	}
+}
+
 func TestProbeRemoteOnce_Fallback(t *testing.T) {
 	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
 		t.Skip("skipping due to sandbox")
@@ -179,6 +240,83 @@ func TestProbeRemoteOnce_InvalidJSON(t *testing.T) {
 	}
 }
 
+func TestProbe(t *testing.T) {
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	}
+	status := func(height string, catchingUp bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"result":{"sync_info":{"latest_block_height":%q,"catching_up":%v}}}`, height, catchingUp)
+		}
+	}
+	localMux := http.NewServeMux()
+	localMux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {})
+	localMux.HandleFunc("/status", status("900", true))
+	local := httptest.NewServer(localMux)
+	defer local.Close()
+	remoteMux := http.NewServeMux()
+	remoteMux.HandleFunc("/status", status("1000", false))
+	remote := httptest.NewServer(remoteMux)
+	defer remote.Close()
+
+	got := Probe(local.URL, remote.URL)
+	want := ProbeResult{Alive: true, Synced: false, LocalHeight: 900, RemoteHeight: 1000}
+	if got != want {
+		t.Fatalf("Probe() = %+v, want %+v", got, want)
+	}
+
+	if got := Probe(local.URL, ""); got.RemoteHeight != 0 || got.LocalHeight != 900 {
+		t.Errorf("empty remote should be skipped, got %+v", got)
+	}
+}
+
+func TestProbe_PathPrefix(t *testing.T) {
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	}
+	// Reverse proxy layout: the RPC lives under /rpc and bare paths 404
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rpc/health", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/rpc/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"result":{"sync_info":{"latest_block_height":"1000","catching_up":false}}}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	if got := Probe(srv.URL, ""); got.Alive || got.Synced {
+		t.Errorf("bare paths should fail behind a prefix, got %+v", got)
+	}
+	got := Probe(JoinPathPrefix(srv.URL, "/rpc/"), JoinPathPrefix(srv.URL, "rpc"))
+	want := ProbeResult{Alive: true, Synced: true, LocalHeight: 1000, RemoteHeight: 1000}
+	if got != want {
+		t.Fatalf("Probe() = %+v, want %+v", got, want)
+	}
+}
+
+func TestJoinPathPrefix(t *testing.T) {
+	tests := []struct{ base, prefix, want string }{
+		{"http://127.0.0.1:26657", "", "http://127.0.0.1:26657"},
+		{"http://127.0.0.1:26657", "/", "http://127.0.0.1:26657"},
+		{"http://127.0.0.1:26657", "/rpc", "http://127.0.0.1:26657/rpc"},
+		{"https://node.example/", "rpc/", "https://node.example/rpc"},
+		{"https://node.example", "/a/b/", "https://node.example/a/b"},
+	}
+	for _, tt := range tests {
+		if got := JoinPathPrefix(tt.base, tt.prefix); got != tt.want {
+			t.Errorf("JoinPathPrefix(%q, %q) = %q, want %q", tt.base, tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestSyncSummary(t *testing.T) {
+	if got := syncSummary(1234567, 83*time.Second+400*time.Millisecond); got != "Synced to height 1234567 in 1m23s" {
+		t.Errorf("syncSummary() = %q", got)
+	}
+	if got := syncSummary(0, 5*time.Second); got != "Synced in 5s" {
+		t.Errorf("syncSummary() without height = %q", got)
+	}
+}
+
 func TestHostPortFromURL(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -996,6 +1134,14 @@ func (m *mockClient) SubscribeHeaders(ctx context.Context) (<-chan node.Header,
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *mockClient) DialPeers(ctx context.Context, peers []string, opts node.DialOptions) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (m *mockClient) DialSeeds(ctx context.Context, seeds []string) error {
+	return fmt.Errorf("not implemented")
+}
+
 // Test RunWithRetry with actual retry logic
 func TestRunWithRetry_WithMockServer(t *testing.T) {
 	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
@@ -1401,3 +1547,58 @@ func TestRenderProgress_FullCoverage(t *testing.T) {
 		})
 	}
 }
+
+func TestRun_PollsWhenHeaderSubscriptionFails(t *testing.T) {
+	if _, err := net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Skip("skipping due to sandbox")
+	}
+
+	// The RPC answers readiness and remote probes; the header stream is
+	// rejected, as by a proxy that strips WebSocket upgrades
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"sync_info":{"catching_up":false,"latest_block_height":"5000"}}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	var height atomic.Int64
+	height.Store(1000)
+	orig := newClient
+	defer func() { newClient = orig }()
+	newClient = func(string) node.Client {
+		return &mockClient{
+			statusFunc: func(context.Context) (node.Status, error) {
+				return node.Status{Height: height.Add(10), CatchingUp: true}, nil
+			},
+			subscribeHeadersFunc: func(context.Context) (<-chan node.Header, error) {
+				return nil, fmt.Errorf("websocket: bad handshake")
+			},
+		}
+	}
+
+	var output bytes.Buffer
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	err := Run(ctx, Options{
+		LocalRPC:  srv.URL,
+		RemoteRPC: srv.URL,
+		Window:    5,
+		Out:       &output,
+		Interval:  20 * time.Millisecond,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Run() error = %v, want the monitor to keep running until the deadline", err)
+	}
+
+	out := output.String()
+	if !strings.Contains(out, "Block header stream unavailable, polling /status every 20ms") {
+		t.Errorf("polling mode not reported:\n%s", out)
+	}
+	if n := strings.Count(out, "/5000"); n < 3 {
+		t.Errorf("got %d progress lines, want polled progress against the remote height:\n%s", n, out)
+	}
+}
@@ -0,0 +1,155 @@
+// Package trace logs every subprocess invocation and outbound HTTP request
+// when --trace is set, with secrets redacted, so a misbehaving command can be
+// followed without guessing at pchaind arguments or RPC URLs.
+package trace
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	mu  sync.Mutex
+	out io.Writer // nil while tracing is off
+)
+
+// SetOutput turns tracing on, writing to w, or off when w is nil.
+func SetOutput(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	out = w
+}
+
+// Enabled reports whether tracing is on.
+func Enabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return out != nil
+}
+
+func logf(format string, args ...any) {
+	mu.Lock()
+	defer mu.Unlock()
+	if out != nil {
+		fmt.Fprintf(out, "[trace] "+format+"\n", args...)
+	}
+}
+
+// tracedEnv lists the environment variables shown with a subprocess: the
+// library paths pchaind loads libwasmvm from and cosmovisor's settings.
+var tracedEnv = []string{"LD_LIBRARY_PATH", "DYLD_LIBRARY_PATH", "DAEMON_HOME", "DAEMON_NAME"}
+
+// Command logs cmd's binary, arguments and library path environment. Call
+// it once cmd.Env is final, before the command runs.
+func Command(cmd *exec.Cmd) {
+	if !Enabled() {
+		return
+	}
+	env := cmd.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	var parts []string
+	for _, kv := range env {
+		for _, name := range tracedEnv {
+			if strings.HasPrefix(kv, name+"=") {
+				parts = append(parts, kv)
+			}
+		}
+	}
+	parts = append(parts, cmd.Path)
+	if len(cmd.Args) > 1 {
+		parts = append(parts, RedactArgs(cmd.Args[1:])...)
+	}
+	logf("exec %s", strings.Join(parts, " "))
+}
+
+// secretWords mark flags and query parameters whose values are redacted.
+var secretWords = []string{"keyring", "pass", "token", "secret", "mnemonic", "auth", "apikey", "api-key", "api_key"}
+
+func isSecret(name string) bool {
+	name = strings.ToLower(strings.TrimLeft(name, "-"))
+	for _, w := range secretWords {
+		if strings.Contains(name, w) {
+			return true
+		}
+	}
+	return false
+}
+
+const redacted = "[REDACTED]"
+
+// RedactArgs returns args with the values of secret flags, given as
+// "--flag value" or "--flag=value", replaced.
+func RedactArgs(args []string) []string {
+	res := make([]string, len(args))
+	copy(res, args)
+	for i := 0; i < len(res); i++ {
+		a := res[i]
+		if !strings.HasPrefix(a, "-") {
+			continue
+		}
+		if name, _, ok := strings.Cut(a, "="); ok {
+			if isSecret(name) {
+				res[i] = name + "=" + redacted
+			}
+			continue
+		}
+		if isSecret(a) && i+1 < len(res) && !strings.HasPrefix(res[i+1], "-") {
+			res[i+1] = redacted
+			i++
+		}
+	}
+	return res
+}
+
+// RedactURL returns u with any password and secret query values replaced.
+func RedactURL(u *url.URL) string {
+	c := *u
+	if c.RawQuery != "" {
+		q := c.Query()
+		for k := range q {
+			if isSecret(k) {
+				q[k] = []string{redacted}
+			}
+		}
+		c.RawQuery = q.Encode()
+	}
+	return c.Redacted()
+}
+
+// Transport wraps rt (http.DefaultTransport when nil) to log each request's
+// method, URL, status and duration while tracing is on.
+func Transport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	if _, ok := rt.(*transport); ok {
+		return rt
+	}
+	return &transport{next: rt}
+}
+
+type transport struct{ next http.RoundTripper }
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !Enabled() {
+		return t.next.RoundTrip(req)
+	}
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		logf("http %s %s -> error: %v (%s)", req.Method, RedactURL(req.URL), err, elapsed)
+		return resp, err
+	}
+	logf("http %s %s -> %d (%s)", req.Method, RedactURL(req.URL), resp.StatusCode, elapsed)
+	return resp, nil
+}
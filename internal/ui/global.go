@@ -1,5 +1,7 @@
 package ui
 
+import "os"
+
 // Global UI configuration for the application (set once at startup)
 var globalConfig = Config{}
 
@@ -17,6 +19,7 @@ type Config struct {
 // InitGlobal initializes the global UI configuration (call once at startup)
 func InitGlobal(cfg Config) {
 	globalConfig = cfg
+	globalLogger = NewLogger(os.Stderr, LevelFromConfig(cfg))
 }
 
 // GetGlobal returns the global UI configuration
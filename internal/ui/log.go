@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Level is the severity of a diagnostic log line.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+// LevelFromConfig maps the global flags to the lowest level logged:
+// --debug and --verbose show everything, --quiet only warnings and errors.
+func LevelFromConfig(cfg Config) Level {
+	switch {
+	case cfg.Debug || cfg.Verbose:
+		return LevelDebug
+	case cfg.Quiet:
+		return LevelWarn
+	default:
+		return LevelInfo
+	}
+}
+
+// Logger writes leveled diagnostics, one "[LEVEL] message" line each. It
+// writes to stderr in production so stdout carries only command results,
+// which keeps --output json parseable.
+type Logger struct {
+	mu  sync.Mutex
+	w   io.Writer
+	min Level
+}
+
+// NewLogger returns a logger writing lines at min or above to w.
+func NewLogger(w io.Writer, min Level) *Logger {
+	return &Logger{w: w, min: min}
+}
+
+// WithLevel returns a logger sharing l's writer that logs from min up.
+func (l *Logger) WithLevel(min Level) *Logger {
+	return &Logger{w: l.w, min: min}
+}
+
+// Enabled reports whether lines at level are written.
+func (l *Logger) Enabled(level Level) bool { return level >= l.min }
+
+func (l *Logger) logf(level Level, format string, args ...any) {
+	if !l.Enabled(level) {
+		return
+	}
+	msg := strings.TrimRight(fmt.Sprintf(format, args...), "\n")
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.w, "[%s] %s\n", level, msg)
+}
+
+func (l *Logger) Debugf(format string, args ...any) { l.logf(LevelDebug, format, args...) }
+func (l *Logger) Infof(format string, args ...any)  { l.logf(LevelInfo, format, args...) }
+func (l *Logger) Warnf(format string, args ...any)  { l.logf(LevelWarn, format, args...) }
+func (l *Logger) Errorf(format string, args ...any) { l.logf(LevelError, format, args...) }
+
+// globalLogger is rebuilt by InitGlobal; until then it logs info and above.
+var globalLogger = NewLogger(os.Stderr, LevelInfo)
+
+// Log returns the application-wide logger configured by InitGlobal.
+func Log() *Logger { return globalLogger }
+
+// SetLogger replaces the application-wide logger (used by tests).
+func SetLogger(l *Logger) { globalLogger = l }
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLevelFromConfig(t *testing.T) {
+	tests := []struct {
+		cfg  Config
+		want Level
+	}{
+		{Config{}, LevelInfo},
+		{Config{Verbose: true}, LevelDebug},
+		{Config{Debug: true}, LevelDebug},
+		{Config{Quiet: true}, LevelWarn},
+		{Config{Quiet: true, Debug: true}, LevelDebug},
+	}
+	for _, tt := range tests {
+		if got := LevelFromConfig(tt.cfg); got != tt.want {
+			t.Errorf("LevelFromConfig(%+v) = %v, want %v", tt.cfg, got, tt.want)
+		}
+	}
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewLogger(&buf, LevelInfo)
+	l.Debugf("hidden %d", 1)
+	l.Infof("started %s", "node")
+	l.Warnf("slow peer\n")
+	l.Errorf("failed: %v", "boom")
+	want := "[INFO] started node\n[WARN] slow peer\n[ERROR] failed: boom\n"
+	if buf.String() != want {
+		t.Errorf("logged %q, want %q", buf.String(), want)
+	}
+
+	buf.Reset()
+	l.WithLevel(LevelDebug).Debugf("shown")
+	if buf.String() != "[DEBUG] shown\n" {
+		t.Errorf("WithLevel(LevelDebug) logged %q", buf.String())
+	}
+}
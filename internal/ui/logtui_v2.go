@@ -8,9 +8,12 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/term"
+
+	"github.com/pushchain/push-validator-cli/internal/logline"
 )
 
 // LogUIOptions configures the TUI log viewer
@@ -18,6 +21,7 @@ type LogUIOptions struct {
 	LogPath    string // Path to pchaind.log
 	ShowFooter bool   // Enable footer (default: true)
 	NoColor    bool   // Respect --no-color
+	Raw        bool   // Show JSON log lines as logged instead of compact
 }
 
 // RunLogUIV2 shows logs with sticky footer at bottom
@@ -46,7 +50,7 @@ func RunLogUIV2(ctx context.Context, opts LogUIOptions) error {
 	time.Sleep(10 * time.Millisecond)
 
 	// 4. Print minimal controls banner (keeps existing scrollback intact)
-	footerRaw := "Controls: Ctrl+C to exit logs"
+	footerRaw := "Controls: j to toggle raw JSON | Ctrl+C to exit logs"
 	if cols > 0 && len(footerRaw) > cols {
 		footerRaw = footerRaw[:cols]
 	}
@@ -76,9 +80,11 @@ func RunLogUIV2(ctx context.Context, opts LogUIOptions) error {
 	defer cancel()
 
 	// 9. Start log streaming
+	var raw atomic.Bool
+	raw.Store(opts.Raw)
 	logDone := make(chan error, 1)
 	go func() {
-		logDone <- streamLogsSimple(ctx, opts.LogPath, renderFooter)
+		logDone <- streamLogsSimple(ctx, opts.LogPath, &raw, renderFooter)
 	}()
 
 	// 10. Listen for keypresses
@@ -105,27 +111,32 @@ func RunLogUIV2(ctx context.Context, opts LogUIOptions) error {
 			if key == 3 { // Ctrl+C
 				return nil
 			}
+			if key == 'j' { // applies to lines printed from now on
+				raw.Store(!raw.Load())
+			}
 		}
 	}
 }
 
-// colorizeLogLine applies ANSI color based on log level
-func colorizeLogLine(line string) string {
-	lower := strings.ToLower(line)
-	switch {
-	case strings.Contains(lower, "error") || strings.Contains(lower, "fatal") || strings.Contains(lower, "panic") || strings.Contains(lower, " err "):
-		return "\033[31m" + line + "\033[0m" // Red
-	case strings.Contains(lower, "warn") || strings.Contains(lower, "warning") || strings.Contains(lower, " wrn "):
-		return "\033[33m" + line + "\033[0m" // Yellow
-	case strings.Contains(lower, "info") || strings.Contains(lower, " inf "):
-		return "\033[32m" + line + "\033[0m" // Green
-	case strings.Contains(lower, "debug") || strings.Contains(lower, "trace") || strings.Contains(lower, " dbg "):
-		return "\033[90m" + line + "\033[0m" // Gray
-	}
-	return line
+// colorizeLogLine applies ANSI color based on log level. JSON log lines are
+// rendered in compact form unless raw is set.
+func colorizeLogLine(line string, raw bool) string {
+	entry := logline.Parse(line)
+	text := entry.Display(raw)
+	switch entry.Level {
+	case logline.LevelError:
+		return "\033[31m" + text + "\033[0m" // Red
+	case logline.LevelWarn:
+		return "\033[33m" + text + "\033[0m" // Yellow
+	case logline.LevelInfo:
+		return "\033[32m" + text + "\033[0m" // Green
+	case logline.LevelDebug:
+		return "\033[90m" + text + "\033[0m" // Gray
+	}
+	return text
 }
 
-func streamLogsSimple(ctx context.Context, logPath string, onPrint func()) error {
+func streamLogsSimple(ctx context.Context, logPath string, raw *atomic.Bool, onPrint func()) error {
 	// Wait for file
 	for i := 0; i < 50; i++ {
 		if _, err := os.Stat(logPath); err == nil {
@@ -147,7 +158,7 @@ func streamLogsSimple(ctx context.Context, logPath string, onPrint func()) error
 
 	const backlogLines = 20
 	// Emit recent history so the viewer isn't blank on start
-	if err := printRecentLines(f, os.Stdout, backlogLines, onPrint); err != nil {
+	if err := printRecentLines(f, os.Stdout, backlogLines, raw.Load(), onPrint); err != nil {
 		return err
 	}
 
@@ -174,14 +185,14 @@ func streamLogsSimple(ctx context.Context, logPath string, onPrint func()) error
 		}
 
 		// Print with \r\n for raw mode
-		fmt.Fprint(os.Stdout, colorizeLogLine(strings.TrimSuffix(line, "\n"))+"\r\n")
+		fmt.Fprint(os.Stdout, colorizeLogLine(strings.TrimSuffix(line, "\n"), raw.Load())+"\r\n")
 		if onPrint != nil {
 			onPrint()
 		}
 	}
 }
 
-func printRecentLines(f *os.File, out io.Writer, maxLines int, onPrint func()) error {
+func printRecentLines(f *os.File, out io.Writer, maxLines int, raw bool, onPrint func()) error {
 	if maxLines <= 0 {
 		return nil
 	}
@@ -205,7 +216,7 @@ func printRecentLines(f *os.File, out io.Writer, maxLines int, onPrint func()) e
 		return err
 	}
 	for _, line := range buf {
-		fmt.Fprintf(out, "%s\r\n", colorizeLogLine(line))
+		fmt.Fprintf(out, "%s\r\n", colorizeLogLine(line, raw))
 		if onPrint != nil {
 			onPrint()
 		}
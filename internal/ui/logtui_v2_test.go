@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPrintRecentLines_MixedFormats(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "pchaind.log")
+	content := strings.Join([]string{
+		"12:34PM INF Executed block height=1233 module=state",
+		`{"level":"error","module":"p2p","err":"EOF","time":"2024-05-01T12:34:56Z","message":"Stopping peer for error"}`,
+		"",
+	}, "\n")
+	if err := os.WriteFile(logFile, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var out bytes.Buffer
+	if err := printRecentLines(f, &out, 10, false, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := "\033[32m12:34PM INF Executed block height=1233 module=state\033[0m\r\n" +
+		"\033[31m12:34:56 ERR Stopping peer for error err=EOF module=p2p\033[0m\r\n"
+	if out.String() != want {
+		t.Errorf("compact output = %q, want %q", out.String(), want)
+	}
+
+	out.Reset()
+	if err := printRecentLines(f, &out, 1, true, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), `"message":"Stopping peer for error"`) {
+		t.Errorf("raw output should keep the JSON, got %q", out.String())
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"golang.org/x/term"
@@ -56,6 +57,20 @@ func (s *Spinner) Tick() {
 	}
 }
 
+// rateWindow is how far back download samples are kept when computing the
+// rolling transfer rate, so the speed and ETA follow the current link speed
+// rather than the average since the start.
+const rateWindow = 5 * time.Second
+
+// nonTTYInterval is the minimum gap between progress lines when the total
+// size is unknown and output is not a terminal.
+const nonTTYInterval = 5 * time.Second
+
+type rateSample struct {
+	at    time.Time
+	bytes int64
+}
+
 // ProgressBar renders a terminal progress bar with download statistics.
 type ProgressBar struct {
 	out        io.Writer
@@ -67,18 +82,23 @@ type ProgressBar struct {
 	lastPct    float64 // for non-TTY threshold updates
 	colors     *ColorConfig
 	indent     string // indentation prefix (default "  ")
+	samples    []rateSample
+	now        func() time.Time
+	quiet      bool // no per-update output, one summary line on completion
 }
 
 // NewProgressBar creates a new progress bar for tracking download progress.
 // If total is <= 0, the progress bar will show bytes downloaded without percentage.
+// With the global --quiet setting the bar starts in quiet mode (see SetQuiet).
 func NewProgressBar(out io.Writer, total int64) *ProgressBar {
 	if out == nil {
 		out = os.Stdout
 	}
+	quiet := GetGlobal().Quiet
 
 	// Check if output is a TTY
 	isTTY := false
-	if f, ok := out.(*os.File); ok {
+	if f, ok := out.(*os.File); ok && !quiet {
 		isTTY = term.IsTerminal(int(f.Fd()))
 	}
 
@@ -103,9 +123,17 @@ func NewProgressBar(out io.Writer, total int64) *ProgressBar {
 		lastPct:    -1,
 		colors:     NewColorConfig(),
 		indent:     "  ", // default 2-space indent
+		now:        time.Now,
+		quiet:      quiet,
 	}
 }
 
+// SetQuiet switches quiet mode: updates print nothing and Finish prints a
+// single summary line such as "Downloaded 78.0 MB in 24s".
+func (p *ProgressBar) SetQuiet(quiet bool) {
+	p.quiet = quiet
+}
+
 // SetIndent sets the indentation prefix for the progress bar output.
 func (p *ProgressBar) SetIndent(indent string) {
 	p.indent = indent
@@ -114,52 +142,99 @@ func (p *ProgressBar) SetIndent(indent string) {
 // Update updates the progress bar with the current byte count.
 func (p *ProgressBar) Update(current int64) {
 	p.current = current
-
-	// Rate limit updates to avoid flicker (max 10/sec for TTY)
-	now := time.Now()
-	if p.isTTY && now.Sub(p.lastUpdate) < 100*time.Millisecond {
+	now := p.now()
+	p.addSample(now, current)
+	if p.quiet {
 		return
 	}
-	p.lastUpdate = now
 
 	if p.total <= 0 {
-		// Unknown total: just show bytes downloaded
-		fmt.Fprintf(p.out, "\r%sDownloading... %s", p.indent, FormatBytes(current))
+		// Unknown total: show bytes downloaded and speed only
+		if p.isTTY {
+			if now.Sub(p.lastUpdate) < 100*time.Millisecond {
+				return
+			}
+			p.lastUpdate = now
+			fmt.Fprintf(p.out, "\r%sDownloading... %s (%s)\033[K", p.indent, humanBytes(current), humanSpeed(p.Rate()))
+		} else if now.Sub(p.lastUpdate) >= nonTTYInterval {
+			p.lastUpdate = now
+			fmt.Fprintf(p.out, "%sDownloading... %s (%s)\n", p.indent, humanBytes(current), humanSpeed(p.Rate()))
+		}
 		return
 	}
 
 	pct := float64(current) / float64(p.total) * 100
 
 	if p.isTTY {
+		// Rate limit updates to avoid flicker (max 10/sec)
+		if now.Sub(p.lastUpdate) < 100*time.Millisecond {
+			return
+		}
+		p.lastUpdate = now
 		p.renderTTY(pct)
 	} else {
-		// Non-TTY: print at 10% intervals
+		// Non-TTY: print a full line at 10% intervals, never redraw
 		threshold := float64(int(pct/10) * 10)
 		if threshold > p.lastPct {
 			p.lastPct = threshold
-			fmt.Fprintf(p.out, "%sDownloading... %.0f%%\n", p.indent, threshold)
+			fmt.Fprintf(p.out, "%sDownloading... %.0f%%  %s\n", p.indent, threshold, p.Stats())
 		}
 	}
 }
 
-// renderTTY renders the progress bar for TTY output.
-func (p *ProgressBar) renderTTY(pct float64) {
-	// Calculate speed
-	elapsed := time.Since(p.startTime).Seconds()
-	var speed float64
-	if elapsed > 0 {
-		speed = float64(p.current) / elapsed
+// addSample records a progress point and drops samples older than rateWindow,
+// always keeping at least one earlier point to measure against.
+func (p *ProgressBar) addSample(at time.Time, bytes int64) {
+	p.samples = append(p.samples, rateSample{at: at, bytes: bytes})
+	cutoff := at.Add(-rateWindow)
+	drop := 0
+	for drop < len(p.samples)-2 && !p.samples[drop+1].at.After(cutoff) {
+		drop++
 	}
+	p.samples = p.samples[drop:]
+}
 
-	// Calculate ETA
-	eta := ""
-	if speed > 0 && p.current < p.total {
-		remaining := float64(p.total - p.current)
-		etaSeconds := remaining / speed
-		eta = formatDuration(etaSeconds)
-	} else if p.current >= p.total {
-		eta = "0s"
+// Rate returns the rolling download rate in bytes per second over the last
+// rateWindow, or 0 until two samples are available.
+func (p *ProgressBar) Rate() float64 {
+	if len(p.samples) < 2 {
+		return 0
 	}
+	first, last := p.samples[0], p.samples[len(p.samples)-1]
+	elapsed := last.at.Sub(first.at).Seconds()
+	if elapsed <= 0 || last.bytes < first.bytes {
+		return 0
+	}
+	return float64(last.bytes-first.bytes) / elapsed
+}
+
+// ETA returns the estimated time left at the current rate and whether it is
+// known. It is zero once the download is complete.
+func (p *ProgressBar) ETA() (time.Duration, bool) {
+	if p.total > 0 && p.current >= p.total {
+		return 0, true
+	}
+	rate := p.Rate()
+	if p.total <= 0 || rate <= 0 {
+		return 0, false
+	}
+	secs := float64(p.total-p.current) / rate
+	return time.Duration(secs * float64(time.Second)).Round(time.Second), true
+}
+
+// Stats formats the transfer statistics, e.g.
+// "12.3 MB / 78.0 MB (3.1 MB/s, ETA 21s)".
+func (p *ProgressBar) Stats() string {
+	eta := "--"
+	if d, ok := p.ETA(); ok {
+		eta = formatDuration(d.Seconds())
+	}
+	return fmt.Sprintf("%s / %s (%s, ETA %s)", humanBytes(p.current), humanBytes(p.total), humanSpeed(p.Rate()), eta)
+}
+
+// renderTTY renders the progress bar for TTY output.
+func (p *ProgressBar) renderTTY(pct float64) {
+	stats := p.Stats()
 
 	// Get terminal width, default to 80
 	width := 80
@@ -169,10 +244,8 @@ func (p *ProgressBar) renderTTY(pct float64) {
 		}
 	}
 
-	// Calculate bar width (leave space for stats)
-	// Format: "<indent>[████░░░░] 100.0%  999.9GB/999.9GB  999.9MB/s  ETA 99m59s"
-	// Approx: indent + 2 + bar + 2 + 7 + 2 + 17 + 2 + 10 + 2 + 10 = ~54 + indent chars for stats
-	barWidth := width - 56 - len(p.indent)
+	// Bar width is whatever is left after "<indent>[] 100.0%  <stats>"
+	barWidth := width - len(p.indent) - 10 - len(stats)
 	if barWidth < 10 {
 		barWidth = 10
 	}
@@ -188,27 +261,34 @@ func (p *ProgressBar) renderTTY(pct float64) {
 	if filled < 0 {
 		filled = 0
 	}
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
 
-	bar := ""
-	for i := 0; i < barWidth; i++ {
-		if i < filled {
-			bar += "█"
-		} else {
-			bar += "░"
-		}
-	}
+	// \033[K clears from cursor to end of line so a shorter redraw leaves no residue
+	fmt.Fprintf(p.out, "\r%s[%s] %5.1f%%  %s\033[K", p.indent, bar, pct, stats)
+}
 
-	// Format output with ANSI escape to clear rest of line (fixes /s/s bug)
-	// \033[K clears from cursor to end of line
-	fmt.Fprintf(p.out, "\r%s[%s] %5.1f%%   %s/%s   %s   ETA %s\033[K",
-		p.indent,
-		bar,
-		pct,
-		FormatBytes(p.current),
-		FormatBytes(p.total),
-		FormatSpeed(speed),
-		eta,
+// humanBytes formats a byte count with a space before the unit ("12.3 MB").
+func humanBytes(b int64) string {
+	const (
+		kb = 1024
+		mb = kb * 1024
+		gb = mb * 1024
 	)
+	switch {
+	case b >= gb:
+		return fmt.Sprintf("%.1f GB", float64(b)/gb)
+	case b >= mb:
+		return fmt.Sprintf("%.1f MB", float64(b)/mb)
+	case b >= kb:
+		return fmt.Sprintf("%.1f KB", float64(b)/kb)
+	default:
+		return fmt.Sprintf("%d B", b)
+	}
+}
+
+// humanSpeed formats a transfer rate in the same style as humanBytes.
+func humanSpeed(bytesPerSec float64) string {
+	return humanBytes(int64(bytesPerSec)) + "/s"
 }
 
 // formatDuration formats seconds into a human-readable duration string.
@@ -229,8 +309,20 @@ func formatDuration(seconds float64) string {
 	return fmt.Sprintf("%dh%dm", hours, mins)
 }
 
-// Finish completes the progress bar and moves to the next line.
+// Summary describes the finished transfer, e.g. "Downloaded 78.0 MB in 24s".
+func (p *ProgressBar) Summary() string {
+	return fmt.Sprintf("Downloaded %s in %s", humanBytes(p.current), formatDuration(p.now().Sub(p.startTime).Round(time.Second).Seconds()))
+}
+
+// Finish completes the progress bar and moves to the next line. In quiet
+// mode it prints only the summary, and nothing if the transfer stopped short.
 func (p *ProgressBar) Finish() {
+	if p.quiet {
+		if p.current > 0 && (p.total <= 0 || p.current >= p.total) {
+			fmt.Fprintf(p.out, "%s%s\n", p.indent, p.Summary())
+		}
+		return
+	}
 	if p.isTTY {
 		// Final update to show 100%
 		if p.total > 0 {
@@ -241,7 +333,7 @@ func (p *ProgressBar) Finish() {
 		flushStdin()
 	} else if p.total > 0 && p.lastPct < 100 {
 		// Ensure we print 100% for non-TTY
-		fmt.Fprintf(p.out, "%sDownloading... 100%%\n", p.indent)
+		fmt.Fprintf(p.out, "%sDownloading... 100%%  %s\n", p.indent, p.Stats())
 	}
 }
 
@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeClock returns a ProgressBar clock and a function advancing it.
+func fakeClock() (func() time.Time, func(time.Duration)) {
+	t := time.Unix(1000, 0)
+	return func() time.Time { return t }, func(d time.Duration) { t = t.Add(d) }
+}
+
+func TestProgressBar_RollingRateAndETA(t *testing.T) {
+	var out bytes.Buffer
+	bar := NewProgressBar(&out, 78*1024*1024)
+	now, advance := fakeClock()
+	bar.now = now
+
+	const mb = 1024 * 1024
+	// A fast start followed by a slow link: the rate should follow the
+	// recent 1 MB/s, not the overall average.
+	bar.Update(0)
+	advance(time.Second)
+	bar.Update(40 * mb)
+	for i := 1; i <= 10; i++ {
+		advance(time.Second)
+		bar.Update(int64(40+i) * mb)
+	}
+
+	if rate := bar.Rate(); rate < 0.99*mb || rate > 1.01*mb {
+		t.Fatalf("Rate() = %.0f, want ~1 MB/s", rate)
+	}
+	eta, ok := bar.ETA()
+	if !ok || eta != 28*time.Second {
+		t.Errorf("ETA() = %v, %v; want 28s", eta, ok)
+	}
+	if got, want := bar.Stats(), "50.0 MB / 78.0 MB (1.0 MB/s, ETA 28s)"; got != want {
+		t.Errorf("Stats() = %q, want %q", got, want)
+	}
+}
+
+func TestProgressBar_UnknownRate(t *testing.T) {
+	bar := NewProgressBar(&bytes.Buffer{}, 1000)
+	now, _ := fakeClock()
+	bar.now = now
+	bar.Update(10)
+
+	if _, ok := bar.ETA(); ok {
+		t.Error("ETA should be unknown with a single sample")
+	}
+	if got := bar.Stats(); !strings.Contains(got, "ETA --") {
+		t.Errorf("Stats() = %q, want unknown ETA", got)
+	}
+}
+
+func TestProgressBar_NonTTYNoRedraw(t *testing.T) {
+	var out bytes.Buffer
+	bar := NewProgressBar(&out, 100)
+	now, advance := fakeClock()
+	bar.now = now
+
+	for i := int64(0); i <= 100; i += 5 {
+		bar.Update(i)
+		advance(100 * time.Millisecond)
+	}
+	bar.Finish()
+
+	got := out.String()
+	if strings.Contains(got, "\r") || strings.Contains(got, "\033[") {
+		t.Errorf("non-TTY output must not contain redraw sequences: %q", got)
+	}
+	lines := strings.Split(strings.TrimSpace(got), "\n")
+	if len(lines) != 11 {
+		t.Errorf("expected a line per 10%% step, got %d: %q", len(lines), got)
+	}
+	if last := lines[len(lines)-1]; !strings.Contains(last, "100%") || !strings.Contains(last, "ETA 0s") {
+		t.Errorf("last line = %q", last)
+	}
+}
+
+func TestProgressBar_QuietSummaryOnly(t *testing.T) {
+	const mb = 1024 * 1024
+	var out bytes.Buffer
+	bar := NewProgressBar(&out, 78*mb)
+	bar.SetQuiet(true)
+	now, advance := fakeClock()
+	bar.now, bar.startTime = now, now()
+
+	for i := int64(0); i <= 78; i += 6 {
+		bar.Update(i * mb)
+		advance(2 * time.Second)
+	}
+	bar.Finish()
+	if got, want := out.String(), "  Downloaded 78.0 MB in 28s\n"; got != want {
+		t.Errorf("quiet output = %q, want %q", got, want)
+	}
+
+	// An interrupted download prints nothing; the caller reports the error
+	out.Reset()
+	bar = NewProgressBar(&out, 78*mb)
+	bar.SetQuiet(true)
+	bar.Update(10 * mb)
+	bar.Finish()
+	if out.Len() != 0 {
+		t.Errorf("incomplete quiet download should print nothing, got %q", out.String())
+	}
+}
+
+func TestNewProgressBar_GlobalQuiet(t *testing.T) {
+	orig := GetGlobal()
+	t.Cleanup(func() { InitGlobal(orig) })
+	InitGlobal(Config{Quiet: true})
+
+	var out bytes.Buffer
+	bar := NewProgressBar(&out, 100)
+	bar.Update(50)
+	if out.Len() != 0 {
+		t.Errorf("--quiet should suppress progress updates, got %q", out.String())
+	}
+}
+
+func TestHumanBytes(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want string
+	}{
+		{512, "512 B"},
+		{2048, "2.0 KB"},
+		{12897485, "12.3 MB"},
+		{3 * 1024 * 1024 * 1024, "3.0 GB"},
+	}
+	for _, tt := range tests {
+		if got := humanBytes(tt.in); got != tt.want {
+			t.Errorf("humanBytes(%d) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
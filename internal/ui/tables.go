@@ -1,8 +1,12 @@
 package ui
 
 import (
+    "os"
     "regexp"
+    "strconv"
     "strings"
+
+    "golang.org/x/term"
 )
 
 // Table renders a simple monospaced table with optional colorization using ColorConfig.
@@ -69,3 +73,78 @@ func padCell(s string, width int) string {
     if v >= width { return s }
     return s + strings.Repeat(" ", width-v)
 }
+
+// TableWidth is the line width FitTable output should fit: $COLUMNS when
+// set, so a width can be forced when piping, else the width of the terminal
+// on stdout, else 0 for no limit.
+func TableWidth() int {
+    if v, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && v > 0 {
+        return v
+    }
+    if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 {
+        return w
+    }
+    return 0
+}
+
+// FitTable renders headers and rows as aligned columns separated by two
+// spaces, with no title or separator line, for --output table. Unlike Table
+// no column is capped on its own; when maxWidth > 0 the widest columns are
+// narrowed until each line fits, down to their header's width, and cut
+// cells end in "…". Cells should be plain text; only headers are colored.
+func FitTable(c *ColorConfig, headers []string, rows [][]string, maxWidth int) string {
+    const gap = 2
+    w := make([]int, len(headers))
+    for i, h := range headers {
+        w[i] = visibleLen(h)
+    }
+    for _, r := range rows {
+        for i := range r {
+            if i < len(w) && visibleLen(r[i]) > w[i] {
+                w[i] = visibleLen(r[i])
+            }
+        }
+    }
+    if maxWidth > 0 {
+        total := gap * (len(w) - 1)
+        for _, n := range w { total += n }
+        for total > maxWidth {
+            widest := -1
+            for i := range w {
+                if w[i] > visibleLen(headers[i]) && (widest < 0 || w[i] > w[widest]) {
+                    widest = i
+                }
+            }
+            if widest < 0 { break }
+            w[widest]--
+            total--
+        }
+    }
+
+    var b strings.Builder
+    writeLine := func(cells []string, header bool) {
+        var line strings.Builder
+        for i := range w {
+            if i > 0 { line.WriteString(strings.Repeat(" ", gap)) }
+            cell := ""
+            if i < len(cells) { cell = truncateCell(cells[i], w[i]) }
+            if header { cell = c.Label(cell) }
+            line.WriteString(padCell(cell, w[i]))
+        }
+        b.WriteString(strings.TrimRight(line.String(), " "))
+        b.WriteString("\n")
+    }
+    writeLine(headers, true)
+    for _, r := range rows {
+        writeLine(r, false)
+    }
+    return b.String()
+}
+
+// truncateCell cuts s to width runes, ending in "…" when anything was cut.
+func truncateCell(s string, width int) string {
+    r := []rune(s)
+    if len(r) <= width { return s }
+    if width < 1 { return "" }
+    return string(r[:width-1]) + "…"
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFitTable(t *testing.T) {
+	c := &ColorConfig{Enabled: false, Theme: DefaultTheme()}
+	headers := []string{"NAME", "ADDRESS", "OK"}
+	rows := [][]string{
+		{"alpha", "pushvaloper1aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "yes"},
+		{"b", "short", "no"},
+	}
+
+	got := FitTable(c, headers, rows, 0)
+	pad := strings.Repeat(" ", len(rows[0][1])-len("ADDRESS"))
+	want := "NAME   ADDRESS" + pad + "  OK\n" +
+		"alpha  " + rows[0][1] + "  yes\n" +
+		"b      short" + strings.Repeat(" ", len(rows[0][1])-len("short")) + "  no\n"
+	if got != want {
+		t.Errorf("unlimited width:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The widest column gives way; headers and the short columns are kept
+	got = FitTable(c, headers, rows, 30)
+	for _, line := range strings.Split(strings.TrimRight(got, "\n"), "\n") {
+		if n := len([]rune(line)); n > 30 {
+			t.Errorf("line %q is %d wide, want <= 30", line, n)
+		}
+	}
+	if !strings.Contains(got, "alpha  pushvaloper1aaaaa…  yes") {
+		t.Errorf("expected truncated address:\n%s", got)
+	}
+
+	// Too narrow to fit: columns stop at their header's width
+	got = FitTable(c, headers, rows, 5)
+	if !strings.HasPrefix(got, "NAME  ADDRESS  OK\nalp…  pushva…  y…\n") {
+		t.Errorf("narrow table:\n%s", got)
+	}
+}
+
+func TestFitTable_ColorOnlyInHeaders(t *testing.T) {
+	c := &ColorConfig{Enabled: true, Theme: DefaultTheme()}
+	got := FitTable(c, []string{"A"}, [][]string{{"x"}}, 0)
+	lines := strings.Split(got, "\n")
+	if !strings.Contains(lines[0], "\x1b[") {
+		t.Errorf("header not colored: %q", lines[0])
+	}
+	if lines[1] != "x" {
+		t.Errorf("row = %q, want plain text", lines[1])
+	}
+}
+
+func TestTableWidth_Columns(t *testing.T) {
+	t.Setenv("COLUMNS", "123")
+	if got := TableWidth(); got != 123 {
+		t.Errorf("TableWidth() = %d, want 123", got)
+	}
+}
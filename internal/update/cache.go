@@ -9,7 +9,10 @@ import (
 
 const (
 	cacheFileName = ".update-check"
-	cacheDuration = 10 * time.Minute
+	cacheDuration = 10 * time.Minute // for entries written before Interval was recorded
+
+	// DefaultCheckInterval is how long a background check result is reused.
+	DefaultCheckInterval = 24 * time.Hour
 )
 
 // CacheEntry stores the last update check result
@@ -17,16 +20,19 @@ type CacheEntry struct {
 	CheckedAt       time.Time `json:"checked_at"`
 	LatestVersion   string    `json:"latest_version"`
 	UpdateAvailable bool      `json:"update_available"`
+	// Interval is the check interval in effect when the entry was written
+	Interval time.Duration `json:"interval,omitempty"`
 }
 
-// GetCachePath returns the path to the cache file
-func GetCachePath(homeDir string) string {
-	return filepath.Join(homeDir, cacheFileName)
+// GetCachePath returns the path to the cache file in dir (the node home dir
+// unless an update cache dir is configured)
+func GetCachePath(dir string) string {
+	return filepath.Join(dir, cacheFileName)
 }
 
 // LoadCache loads the cached update check result
-func LoadCache(homeDir string) (*CacheEntry, error) {
-	path := GetCachePath(homeDir)
+func LoadCache(dir string) (*CacheEntry, error) {
+	path := GetCachePath(dir)
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
@@ -41,8 +47,8 @@ func LoadCache(homeDir string) (*CacheEntry, error) {
 }
 
 // SaveCache saves the update check result
-func SaveCache(homeDir string, entry *CacheEntry) error {
-	path := GetCachePath(homeDir)
+func SaveCache(dir string, entry *CacheEntry) error {
+	path := GetCachePath(dir)
 	data, err := json.MarshalIndent(entry, "", "  ")
 	if err != nil {
 		return err
@@ -51,15 +57,20 @@ func SaveCache(homeDir string, entry *CacheEntry) error {
 	return os.WriteFile(path, data, 0644)
 }
 
-// IsCacheValid returns true if cache is fresh (< 10m old)
+// IsCacheValid returns true if the entry is younger than the interval stored
+// with it. Entries without an interval expire after 10 minutes.
 func IsCacheValid(entry *CacheEntry) bool {
-	return time.Since(entry.CheckedAt) < cacheDuration
+	ttl := entry.Interval
+	if ttl <= 0 {
+		ttl = cacheDuration
+	}
+	return time.Since(entry.CheckedAt) < ttl
 }
 
 // ForceCheck performs a fresh update check, ignoring cache.
 // Used by status and dashboard commands for immediate notification.
-// Updates the cache after checking.
-func ForceCheck(homeDir, currentVersion string) (*CheckResult, error) {
+// Updates the cache in cacheDir, recording interval, after checking.
+func ForceCheck(cacheDir, currentVersion string, interval time.Duration) (*CheckResult, error) {
 	updater, err := New(currentVersion)
 	if err != nil {
 		return nil, err
@@ -71,10 +82,11 @@ func ForceCheck(homeDir, currentVersion string) (*CheckResult, error) {
 	}
 
 	// Update cache with fresh result
-	_ = SaveCache(homeDir, &CacheEntry{
+	_ = SaveCache(cacheDir, &CacheEntry{
 		CheckedAt:       time.Now(),
 		LatestVersion:   result.LatestVersion,
 		UpdateAvailable: result.UpdateAvailable,
+		Interval:        interval,
 	})
 
 	return result, nil
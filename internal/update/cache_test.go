@@ -253,3 +253,39 @@ func TestSaveCache_ReadonlyDirectory(t *testing.T) {
 		t.Fatal("expected error writing to readonly directory")
 	}
 }
+
+func TestIsCacheValid_StoredInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		age      time.Duration
+		interval time.Duration
+		want     bool
+	}{
+		{"within 24h interval", 11 * time.Hour, 24 * time.Hour, true},
+		{"past 24h interval", 25 * time.Hour, 24 * time.Hour, false},
+		{"past short interval", 2 * time.Minute, time.Minute, false},
+		{"negative interval uses legacy window", 5 * time.Minute, -time.Hour, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &CacheEntry{CheckedAt: time.Now().Add(-tt.age), Interval: tt.interval}
+			if got := IsCacheValid(entry); got != tt.want {
+				t.Errorf("IsCacheValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveAndLoadCache_Interval(t *testing.T) {
+	dir := t.TempDir()
+	if err := SaveCache(dir, &CacheEntry{CheckedAt: time.Now(), LatestVersion: "1.0.0", Interval: 6 * time.Hour}); err != nil {
+		t.Fatalf("SaveCache() error = %v", err)
+	}
+	loaded, err := LoadCache(dir)
+	if err != nil {
+		t.Fatalf("LoadCache() error = %v", err)
+	}
+	if loaded.Interval != 6*time.Hour {
+		t.Errorf("Interval = %v, want 6h", loaded.Interval)
+	}
+}
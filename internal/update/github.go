@@ -2,13 +2,16 @@ package update
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
+	"regexp"
 	"runtime"
 	"strings"
 	"time"
 
 	"golang.org/x/mod/semver"
+
+	"github.com/pushchain/push-validator-cli/internal/githubapi"
 )
 
 const (
@@ -19,32 +22,20 @@ const (
 	releasesURL      = "https://api.github.com/repos/pushchain/push-validator-cli/releases"
 	releaseByTagURL  = "https://api.github.com/repos/pushchain/push-validator-cli/releases/tags/%s"
 
-	httpTimeout     = 30 * time.Second     // For API calls
-	downloadTimeout = 10 * time.Minute     // For binary downloads
+	downloadTimeout = 10 * time.Minute // For binary downloads; API calls use httpclient's timeout
 )
 
 // FetchLatestRelease gets the latest release from GitHub
 func (u *Updater) FetchLatestRelease() (*Release, error) {
-	req, err := http.NewRequest("GET", latestReleaseURL, nil)
-	if err != nil {
-		return nil, err
+	resp, err := githubapi.Get(u.http, latestReleaseURL)
+	if errors.Is(err, githubapi.ErrNotFound) {
+		return nil, fmt.Errorf("no releases found")
 	}
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	req.Header.Set("User-Agent", "push-validator-cli")
-
-	resp, err := u.http.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch release: %w", err)
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("no releases found")
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error: %s", resp.Status)
-	}
-
 	var release Release
 	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
 		return nil, fmt.Errorf("failed to parse release: %w", err)
@@ -60,28 +51,15 @@ func (u *Updater) FetchReleaseByTag(tag string) (*Release, error) {
 		tag = "v" + tag
 	}
 
-	url := fmt.Sprintf(releaseByTagURL, tag)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+	resp, err := githubapi.Get(u.http, fmt.Sprintf(releaseByTagURL, tag))
+	if errors.Is(err, githubapi.ErrNotFound) {
+		return nil, fmt.Errorf("release %s not found", tag)
 	}
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	req.Header.Set("User-Agent", "push-validator-cli")
-
-	resp, err := u.http.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch release: %w", err)
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("release %s not found", tag)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error: %s", resp.Status)
-	}
-
 	var release Release
 	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
 		return nil, fmt.Errorf("failed to parse release: %w", err)
@@ -92,9 +70,11 @@ func (u *Updater) FetchReleaseByTag(tag string) (*Release, error) {
 
 // GetAssetForPlatform finds the correct binary for current OS/arch
 func GetAssetForPlatform(release *Release) (*Asset, error) {
-	osName := runtime.GOOS
-	arch := runtime.GOARCH
+	return GetAssetForPlatformTarget(release, runtime.GOOS, runtime.GOARCH)
+}
 
+// GetAssetForPlatformTarget finds the binary for the given OS/arch
+func GetAssetForPlatformTarget(release *Release, osName, arch string) (*Asset, error) {
 	// Expected format: push-validator_1.0.0_linux_amd64.tar.gz
 	pattern := "push-validator_"
 	suffix := fmt.Sprintf("_%s_%s.tar.gz", osName, arch)
@@ -120,14 +100,40 @@ func GetChecksumAsset(release *Release) (*Asset, error) {
 	return nil, fmt.Errorf("checksums.txt not found in release")
 }
 
-// IsNewerVersion returns true if latest is newer than current
-func IsNewerVersion(current, latest string) bool {
-	// Ensure both have 'v' prefix for semver comparison
-	if !strings.HasPrefix(current, "v") {
-		current = "v" + current
+// gitDescribe matches versions stamped from `git describe --tags --dirty`:
+// a release tag, optionally followed by the commit count and hash since the
+// tag and a -dirty marker, e.g. v1.2.3-5-gabcdef0 or v1.2.3-dirty.
+var gitDescribe = regexp.MustCompile(`^(v\d+\.\d+\.\d+)(-\d+-g[0-9a-f]+)?(-dirty)?$`)
+
+// IsDevBuild reports whether version is a development build rather than a
+// release: "dev", "unknown" or any other non-semver string, or a tag with
+// commits or uncommitted changes on top of it.
+func IsDevBuild(version string) bool {
+	v := normalizeVersion(version)
+	if m := gitDescribe.FindStringSubmatch(v); m != nil {
+		return m[2] != "" || m[3] != ""
 	}
-	if !strings.HasPrefix(latest, "v") {
-		latest = "v" + latest
+	return !semver.IsValid(v)
+}
+
+// normalizeVersion returns version with surrounding space removed and a
+// lowercase 'v' prefix, the form semver expects.
+func normalizeVersion(version string) string {
+	version = strings.TrimSpace(version)
+	version = strings.TrimPrefix(strings.TrimPrefix(version, "v"), "V")
+	return "v" + version
+}
+
+// IsNewerVersion returns true if latest is newer than current by semver
+// precedence: major, minor and patch compare numerically, a prerelease
+// ranks below its release, and build metadata is ignored.
+func IsNewerVersion(current, latest string) bool {
+	current, latest = normalizeVersion(current), normalizeVersion(latest)
+
+	// A build past a tag is at least that release. semver would read the
+	// describe suffix as a prerelease and rank v1.2.3-5-gabcdef below v1.2.3.
+	if m := gitDescribe.FindStringSubmatch(current); m != nil {
+		current = m[1]
 	}
 
 	// Handle "dev" or "unknown" versions
@@ -6,11 +6,20 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"runtime"
 	"testing"
 	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/githubapi"
 )
 
+func TestMain(m *testing.M) {
+	// Retries against mock servers should not wait out the real backoff
+	githubapi.BaseDelay = 0
+	os.Exit(m.Run())
+}
+
 // mockHTTPDoer is a test helper for mocking HTTP calls.
 type mockHTTPDoer struct {
 	doFunc func(*http.Request) (*http.Response, error)
@@ -99,6 +108,48 @@ func TestIsNewerVersion(t *testing.T) {
 			latest:  "1.1.0",
 			want:    true,
 		},
+		{
+			name:    "commits past the latest tag",
+			current: "v1.2.3-5-gabcdef0",
+			latest:  "v1.2.3",
+			want:    false,
+		},
+		{
+			name:    "commits past an older tag",
+			current: "v1.2.3-5-gabcdef0",
+			latest:  "v1.2.4",
+			want:    true,
+		},
+		{
+			name:    "dirty build of the latest tag",
+			current: "v1.2.3-dirty",
+			latest:  "v1.2.3",
+			want:    false,
+		},
+		{
+			name:    "dirty build past the latest tag",
+			current: "1.2.3-5-gabcdef0-dirty",
+			latest:  "v1.2.3",
+			want:    false,
+		},
+		{
+			name:    "prerelease of the latest version",
+			current: "v1.2.3-rc.1",
+			latest:  "v1.2.3",
+			want:    true,
+		},
+		{
+			name:    "build metadata is ignored",
+			current: "v1.2.3+linux.amd64",
+			latest:  "v1.2.3",
+			want:    false,
+		},
+		{
+			name:    "latest with build metadata",
+			current: "v1.2.3",
+			latest:  "v1.2.4+build.7",
+			want:    true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -112,6 +163,84 @@ func TestIsNewerVersion(t *testing.T) {
 	}
 }
 
+func TestIsNewerVersion_Precedence(t *testing.T) {
+	tests := []struct {
+		current, latest string
+		want            bool
+	}{
+		// Numeric, not lexical, ordering
+		{"v1.9.0", "v1.10.0", true},
+		{"v1.10.0", "v1.9.0", false},
+		{"v1.0.9", "v1.0.10", true},
+		{"v9.0.0", "v10.0.0", true},
+		// Prereleases rank below the release
+		{"v1.0.0-rc1", "v1.0.0", true},
+		{"v1.0.0", "v1.0.0-rc1", false},
+		{"v1.0.0-rc.1", "v1.0.0-rc.2", true},
+		{"v1.0.0-rc.2", "v1.0.0-rc.10", true},
+		{"v1.0.0-alpha", "v1.0.0-beta", true},
+		{"v1.0.0-rc1", "v1.0.1-rc1", true},
+		// Build metadata is ignored
+		{"v1.0.0+build.1", "v1.0.0+build.2", false},
+		{"v1.0.0", "v1.0.0+build.2", false},
+		// Equal versions, however written
+		{"v1.2.3", "v1.2.3", false},
+		{"1.2.3", "v1.2.3", false},
+		{"V1.2.3", "v1.2.3", false},
+		{" v1.2.3\n", "v1.2.3", false},
+		{"v1.2.3", "V1.2.4", true},
+	}
+	for _, tt := range tests {
+		if got := IsNewerVersion(tt.current, tt.latest); got != tt.want {
+			t.Errorf("IsNewerVersion(%q, %q) = %v, want %v", tt.current, tt.latest, got, tt.want)
+		}
+	}
+}
+
+func TestIsDevBuild(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"v1.2.3", false},
+		{"1.2.3", false},
+		{"v1.2.3-rc.1", false},
+		{"v1.2.3+linux.amd64", false},
+		{"v1.2.3-5-gabcdef0", true},
+		{"v1.2.3-dirty", true},
+		{"v1.2.3-5-gabcdef0-dirty", true},
+		{"dev", true},
+		{"unknown", true},
+		{"", true},
+		{"abcdef0", true},
+	}
+	for _, tt := range tests {
+		if got := IsDevBuild(tt.version); got != tt.want {
+			t.Errorf("IsDevBuild(%q) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestGetAssetForPlatformTarget(t *testing.T) {
+	release := &Release{
+		TagName: "v1.0.0",
+		Assets: []Asset{
+			{Name: "push-validator_1.0.0_linux_amd64.tar.gz"},
+			{Name: "push-validator_1.0.0_linux_arm64.tar.gz"},
+		},
+	}
+	asset, err := GetAssetForPlatformTarget(release, "linux", "arm64")
+	if err != nil {
+		t.Fatalf("GetAssetForPlatformTarget() error = %v", err)
+	}
+	if asset.Name != "push-validator_1.0.0_linux_arm64.tar.gz" {
+		t.Errorf("asset = %s, want linux_arm64 archive", asset.Name)
+	}
+	if _, err := GetAssetForPlatformTarget(release, "windows", "amd64"); err == nil {
+		t.Error("expected error for windows/amd64")
+	}
+}
+
 func TestGetAssetForPlatform(t *testing.T) {
 	osName := runtime.GOOS
 	arch := runtime.GOARCH
@@ -670,3 +799,61 @@ func TestFetchReleaseByTag_EmptyTag(t *testing.T) {
 		t.Errorf("expected TagName 'v0.0.0', got %q", release.TagName)
 	}
 }
+
+func TestFetchLatestRelease_RetriesTransientErrors(t *testing.T) {
+	calls := 0
+	mock := &mockHTTPDoer{
+		doFunc: func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+			}
+			body, _ := json.Marshal(Release{TagName: "v1.2.0"})
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+		},
+	}
+
+	u := &Updater{CurrentVersion: "1.0.0", http: mock}
+	release, err := u.FetchLatestRelease()
+	if err != nil {
+		t.Fatalf("FetchLatestRelease() error = %v", err)
+	}
+	if release.TagName != "v1.2.0" || calls != 2 {
+		t.Errorf("TagName = %q after %d calls, want v1.2.0 after 2", release.TagName, calls)
+	}
+}
+
+func TestGitHubToken_SentOnlyToAPI(t *testing.T) {
+	for _, token := range []string{"", "secret-token"} {
+		t.Setenv("PUSH_GITHUB_TOKEN", token)
+		t.Setenv("GITHUB_TOKEN", "")
+
+		auth := map[string]string{}
+		mock := &mockHTTPDoer{
+			doFunc: func(req *http.Request) (*http.Response, error) {
+				auth[req.URL.Host] = req.Header.Get("Authorization")
+				body, _ := json.Marshal(Release{TagName: "v1.2.0"})
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+			},
+		}
+		u := &Updater{CurrentVersion: "1.0.0", http: mock}
+
+		if _, err := u.FetchLatestRelease(); err != nil {
+			t.Fatalf("FetchLatestRelease() error = %v", err)
+		}
+		if _, err := u.Download(&Asset{BrowserDownloadURL: "https://objects.githubusercontent.com/asset"}, nil); err != nil {
+			t.Fatalf("Download() error = %v", err)
+		}
+
+		want := ""
+		if token != "" {
+			want = "Bearer " + token
+		}
+		if got := auth["api.github.com"]; got != want {
+			t.Errorf("token %q: API Authorization = %q, want %q", token, got, want)
+		}
+		if got := auth["objects.githubusercontent.com"]; got != "" {
+			t.Errorf("token %q: asset download must not carry Authorization, got %q", token, got)
+		}
+	}
+}
@@ -27,6 +27,7 @@ type CheckResult struct {
 	CurrentVersion  string
 	LatestVersion   string
 	UpdateAvailable bool
+	DevBuild        bool // current is a development build, see IsDevBuild
 	Release         *Release
 }
 
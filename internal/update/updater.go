@@ -5,14 +5,22 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
-	"crypto/sha256"
-	"encoding/hex"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"time"
+
+	"github.com/pushchain/push-validator-cli/internal/checksum"
+	"github.com/pushchain/push-validator-cli/internal/httpclient"
+	"github.com/pushchain/push-validator-cli/internal/lockfile"
+	"github.com/pushchain/push-validator-cli/internal/trace"
 )
 
 // HTTPDoer matches *http.Client's Do method. Allows mocking HTTP in tests.
@@ -26,15 +34,31 @@ type Updater struct {
 	BinaryPath     string // Path to current executable
 	http           HTTPDoer // For API calls (30s timeout)
 	downloadHTTP   HTTPDoer // For binary downloads (10min timeout)
+	// LockDir is where the update lock is taken, normally the config home.
+	// Defaults to the binary's directory when empty.
+	LockDir string
 }
 
+// ErrUpdateInProgress is returned by Install when another process holds the update lock.
+var ErrUpdateInProgress = errors.New("another update is in progress")
+
+// installHook runs while Install holds the lock; tests use it to force overlap.
+var installHook func()
+
+// fsync and fsyncDir flush a file's data and a directory's entries to disk.
+// Tests replace them to observe the durability path.
+var (
+	fsync    = func(f *os.File) error { return f.Sync() }
+	fsyncDir = syncDir
+)
+
 // New creates an Updater with the default HTTP client.
 func New(currentVersion string) (*Updater, error) {
 	return NewWith(currentVersion, nil)
 }
 
 // NewWith creates an Updater with an injected HTTPDoer (for testing).
-// If h is nil, a default *http.Client with httpTimeout is used.
+// If h is nil, the shared client from httpclient.Default is used.
 func NewWith(currentVersion string, h HTTPDoer) (*Updater, error) {
 	execPath, err := os.Executable()
 	if err != nil {
@@ -48,14 +72,20 @@ func NewWith(currentVersion string, h HTTPDoer) (*Updater, error) {
 	}
 
 	if h == nil {
-		h = &http.Client{Timeout: httpTimeout}
+		if h, err = httpclient.Default(); err != nil {
+			return nil, err
+		}
+	}
+	downloadHTTP, err := httpclient.WithTimeout(downloadTimeout)
+	if err != nil {
+		return nil, err
 	}
 
 	return &Updater{
 		CurrentVersion: currentVersion,
 		BinaryPath:     realPath,
 		http:           h,
-		downloadHTTP:   &http.Client{Timeout: downloadTimeout},
+		downloadHTTP:   downloadHTTP,
 	}, nil
 }
 
@@ -73,6 +103,7 @@ func (u *Updater) Check() (*CheckResult, error) {
 		CurrentVersion:  currentVersion,
 		LatestVersion:   latestVersion,
 		UpdateAvailable: IsNewerVersion(u.CurrentVersion, release.TagName),
+		DevBuild:        IsDevBuild(u.CurrentVersion),
 		Release:         release,
 	}, nil
 }
@@ -102,10 +133,10 @@ func (u *Updater) Download(asset *Asset, progress ProgressFunc) ([]byte, error)
 		return nil, fmt.Errorf("download failed: %s", resp.Status)
 	}
 
-	var reader io.Reader = resp.Body
+	reader := httpclient.Throttle(req.Context(), resp.Body)
 	if progress != nil {
 		reader = &progressReader{
-			reader:   resp.Body,
+			reader:   reader,
 			total:    resp.ContentLength,
 			progress: progress,
 		}
@@ -138,24 +169,42 @@ func (pr *progressReader) Read(p []byte) (int, error) {
 
 // VerifyChecksum validates the downloaded archive against checksums.txt
 func (u *Updater) VerifyChecksum(data []byte, release *Release, assetName string) error {
-	checksumAsset, err := GetChecksumAsset(release)
+	expectedHash, err := u.fetchExpectedChecksum(release, assetName)
 	if err != nil {
 		return err
 	}
 
+	// checksums.txt has no algorithm suffix, so the digest length decides
+	return checksum.Verify(data, "checksums.txt", expectedHash)
+}
+
+// ChecksumAvailable confirms checksums.txt can be fetched and lists
+// assetName, without downloading the asset itself.
+func (u *Updater) ChecksumAvailable(release *Release, assetName string) error {
+	_, err := u.fetchExpectedChecksum(release, assetName)
+	return err
+}
+
+// fetchExpectedChecksum downloads checksums.txt and returns the hash for assetName
+func (u *Updater) fetchExpectedChecksum(release *Release, assetName string) (string, error) {
+	checksumAsset, err := GetChecksumAsset(release)
+	if err != nil {
+		return "", err
+	}
+
 	// Download checksums.txt
 	req, err := http.NewRequest("GET", checksumAsset.BrowserDownloadURL, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create checksum request: %w", err)
+		return "", fmt.Errorf("failed to create checksum request: %w", err)
 	}
 
 	resp, err := u.http.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to download checksums: %w", err)
+		return "", fmt.Errorf("failed to download checksums: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	// Parse checksums.txt (format: "sha256  filename")
+	// Parse checksums.txt (format: "<sha256 or sha512>  filename")
 	expectedHash := ""
 	scanner := bufio.NewScanner(resp.Body)
 	for scanner.Scan() {
@@ -168,18 +217,9 @@ func (u *Updater) VerifyChecksum(data []byte, release *Release, assetName string
 	}
 
 	if expectedHash == "" {
-		return fmt.Errorf("checksum not found for %s", assetName)
-	}
-
-	// Calculate actual hash
-	hash := sha256.Sum256(data)
-	actualHash := hex.EncodeToString(hash[:])
-
-	if actualHash != expectedHash {
-		return fmt.Errorf("checksum mismatch: expected %s, got %s", expectedHash, actualHash)
+		return "", fmt.Errorf("checksum not found for %s", assetName)
 	}
-
-	return nil
+	return expectedHash, nil
 }
 
 // ExtractBinary extracts the binary from the tar.gz archive
@@ -215,8 +255,30 @@ func (u *Updater) ExtractBinary(archiveData []byte) ([]byte, error) {
 	return nil, fmt.Errorf("binary not found in archive")
 }
 
-// Install performs atomic binary replacement
+// Install performs atomic binary replacement. It holds the update lock for
+// the duration so concurrent updates cannot interleave writes to the binary
+// or its backup.
+//
+// The backup and the new binary are fsynced before the rename, and the
+// directory after it, so after a crash or power loss BinaryPath is either
+// the old binary or the complete new one, never a truncated file.
 func (u *Updater) Install(binaryData []byte) error {
+	lockDir := u.LockDir
+	if lockDir == "" {
+		lockDir = filepath.Dir(u.BinaryPath)
+	}
+	lock, err := lockfile.TryAcquire(filepath.Join(lockDir, lockfile.UpdateName))
+	if errors.Is(err, lockfile.ErrLocked) {
+		return ErrUpdateInProgress
+	}
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.Release() }()
+	if installHook != nil {
+		installHook()
+	}
+
 	// Get current binary permissions
 	info, err := os.Stat(u.BinaryPath)
 	if err != nil {
@@ -229,9 +291,12 @@ func (u *Updater) Install(binaryData []byte) error {
 	if err := copyFile(u.BinaryPath, backupPath); err != nil {
 		return fmt.Errorf("failed to create backup: %w", err)
 	}
+	dir := filepath.Dir(u.BinaryPath)
+	if err := fsyncDir(dir); err != nil {
+		return fmt.Errorf("failed to sync backup: %w", err)
+	}
 
 	// Write to temp file in same directory (for atomic rename)
-	dir := filepath.Dir(u.BinaryPath)
 	tempFile, err := os.CreateTemp(dir, "push-validator-update-*")
 	if err != nil {
 		return fmt.Errorf("failed to create temp file: %w", err)
@@ -244,33 +309,78 @@ func (u *Updater) Install(binaryData []byte) error {
 		os.Remove(tempPath)
 		return fmt.Errorf("failed to write new binary: %w", err)
 	}
-	tempFile.Close()
 
 	// Set permissions
-	if err := os.Chmod(tempPath, mode); err != nil {
+	if err := tempFile.Chmod(mode); err != nil {
+		tempFile.Close()
 		os.Remove(tempPath)
 		return fmt.Errorf("failed to set permissions: %w", err)
 	}
 
+	// Make the data durable before the rename can expose it
+	if err := fsync(tempFile); err != nil {
+		tempFile.Close()
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to sync new binary: %w", err)
+	}
+	if err := tempFile.Close(); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+
 	// Atomic rename
 	if err := os.Rename(tempPath, u.BinaryPath); err != nil {
 		os.Remove(tempPath)
 		return fmt.Errorf("failed to install binary: %w", err)
 	}
+	if err := fsyncDir(dir); err != nil {
+		return fmt.Errorf("failed to sync install directory: %w", err)
+	}
 
 	return nil
 }
 
+// SmokeTestTimeout bounds how long a freshly installed binary may take to
+// report its version before it is considered broken.
+var SmokeTestTimeout = 10 * time.Second
+
+// SmokeTest runs the binary at path with "version", falling back to
+// "--version", and returns its trimmed output. It fails if the binary exits
+// non-zero under both or does not finish within SmokeTestTimeout.
+func SmokeTest(path string) (string, error) {
+	var lastErr error
+	for _, arg := range []string{"version", "--version"} {
+		ctx, cancel := context.WithTimeout(context.Background(), SmokeTestTimeout)
+		cmd := exec.CommandContext(ctx, path, arg)
+		trace.Command(cmd)
+		out, err := cmd.Output()
+		timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
+		cancel()
+		if timedOut {
+			return "", fmt.Errorf("%s %s did not exit within %s", filepath.Base(path), arg, SmokeTestTimeout)
+		}
+		if err == nil {
+			return strings.TrimSpace(string(out)), nil
+		}
+		lastErr = fmt.Errorf("%s %s: %w", filepath.Base(path), arg, err)
+	}
+	return "", lastErr
+}
+
 // Rollback restores the backup
 func (u *Updater) Rollback() error {
 	backupPath := u.BinaryPath + ".backup"
 	if _, err := os.Stat(backupPath); os.IsNotExist(err) {
 		return fmt.Errorf("no backup found")
 	}
-	return os.Rename(backupPath, u.BinaryPath)
+	if err := os.Rename(backupPath, u.BinaryPath); err != nil {
+		return err
+	}
+	return fsyncDir(filepath.Dir(u.BinaryPath))
 }
 
-// copyFile copies a file from src to dst
+// copyFile copies a file from src to dst, keeping src's permissions so a
+// restored backup is still executable
 func copyFile(src, dst string) error {
 	source, err := os.Open(src)
 	if err != nil {
@@ -278,12 +388,37 @@ func copyFile(src, dst string) error {
 	}
 	defer func() { _ = source.Close() }()
 
-	dest, err := os.Create(dst)
+	info, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	dest, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
 	if err != nil {
 		return err
 	}
 	defer func() { _ = dest.Close() }()
 
-	_, err = io.Copy(dest, source)
-	return err
+	if _, err := io.Copy(dest, source); err != nil {
+		return err
+	}
+	// OpenFile does not change the mode of an existing file
+	if err := dest.Chmod(info.Mode().Perm()); err != nil {
+		return err
+	}
+	return fsync(dest)
+}
+
+// syncDir fsyncs dir so a rename or new file in it survives a crash.
+// Windows can't open directories for syncing; NTFS journals renames itself.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = d.Close() }()
+	return d.Sync()
 }
@@ -5,8 +5,10 @@ import (
 	"bytes"
 	"compress/gzip"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -350,6 +352,42 @@ func TestVerifyChecksum(t *testing.T) {
 	}
 }
 
+func TestVerifyChecksum_SHA512(t *testing.T) {
+	testData := []byte("test binary content")
+	sum := sha512.Sum512(testData)
+	assetName := "push-validator_1.0.0_linux_amd64.tar.gz"
+
+	tests := []struct {
+		name    string
+		digest  string
+		wantErr bool
+	}{
+		{"sha512 matches", hex.EncodeToString(sum[:]), false},
+		{"sha512 mismatch", strings.Repeat("0", 128), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.digest + "  " + assetName + "\n"))
+			}))
+			defer server.Close()
+
+			release := &Release{TagName: "v1.0.0", Assets: []Asset{{Name: "checksums.txt", BrowserDownloadURL: server.URL}}}
+			u := &Updater{CurrentVersion: "1.0.0", http: &http.Client{}}
+			err := u.VerifyChecksum(testData, release, assetName)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+					t.Fatalf("expected checksum mismatch, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyChecksum() error = %v", err)
+			}
+		})
+	}
+}
+
 func TestVerifyChecksum_NoChecksumAsset(t *testing.T) {
 	release := &Release{
 		TagName: "v1.0.0",
@@ -367,6 +405,29 @@ func TestVerifyChecksum_NoChecksumAsset(t *testing.T) {
 	}
 }
 
+func TestChecksumAvailable(t *testing.T) {
+	checksumServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("abcd  push-validator_1.0.0_linux_amd64.tar.gz\n"))
+	}))
+	defer checksumServer.Close()
+
+	release := &Release{
+		TagName: "v1.0.0",
+		Assets:  []Asset{{Name: "checksums.txt", BrowserDownloadURL: checksumServer.URL}},
+	}
+	u := &Updater{http: &http.Client{}}
+
+	if err := u.ChecksumAvailable(release, "push-validator_1.0.0_linux_amd64.tar.gz"); err != nil {
+		t.Errorf("ChecksumAvailable() error = %v", err)
+	}
+	if err := u.ChecksumAvailable(release, "other.tar.gz"); err == nil || !strings.Contains(err.Error(), "checksum not found") {
+		t.Errorf("ChecksumAvailable() for unlisted asset error = %v", err)
+	}
+	if err := u.ChecksumAvailable(&Release{TagName: "v1.0.0"}, "x.tar.gz"); err == nil {
+		t.Error("ChecksumAvailable() expected error without checksums.txt")
+	}
+}
+
 func TestDownload(t *testing.T) {
 	testData := []byte("binary archive content")
 
@@ -960,6 +1021,116 @@ func TestInstall_SuccessfulInstall(t *testing.T) {
 	}
 }
 
+func TestInstall_SyncsBeforeRename(t *testing.T) {
+	dir := t.TempDir()
+	binPath := filepath.Join(dir, "push-validator")
+	os.WriteFile(binPath, []byte("old-binary"), 0o755)
+
+	// Record the durability calls, checking each synced file already has
+	// its final content
+	origSync, origSyncDir := fsync, fsyncDir
+	t.Cleanup(func() { fsync, fsyncDir = origSync, origSyncDir })
+	var calls []string
+	fsync = func(f *os.File) error {
+		data, err := os.ReadFile(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		calls = append(calls, "file:"+string(data))
+		return f.Sync()
+	}
+	fsyncDir = func(d string) error {
+		calls = append(calls, "dir")
+		return syncDir(d)
+	}
+
+	u := &Updater{BinaryPath: binPath}
+	if err := u.Install([]byte("new-binary-content")); err != nil {
+		t.Fatalf("Install() unexpected error: %v", err)
+	}
+	want := []string{"file:old-binary", "dir", "file:new-binary-content", "dir"}
+	if strings.Join(calls, ",") != strings.Join(want, ",") {
+		t.Errorf("sync calls = %v, want %v", calls, want)
+	}
+	if data, _ := os.ReadFile(binPath); string(data) != "new-binary-content" {
+		t.Errorf("installed binary = %q", data)
+	}
+}
+
+func TestInstall_SyncFailureKeepsOldBinary(t *testing.T) {
+	dir := t.TempDir()
+	binPath := filepath.Join(dir, "push-validator")
+	os.WriteFile(binPath, []byte("old-binary"), 0o755)
+
+	origSync := fsync
+	t.Cleanup(func() { fsync = origSync })
+	fsync = func(f *os.File) error {
+		if strings.Contains(f.Name(), "push-validator-update-") {
+			return fmt.Errorf("disk error")
+		}
+		return f.Sync()
+	}
+
+	u := &Updater{BinaryPath: binPath}
+	if err := u.Install([]byte("new-binary-content")); err == nil || !strings.Contains(err.Error(), "sync") {
+		t.Fatalf("Install() error = %v, want sync failure", err)
+	}
+	if data, _ := os.ReadFile(binPath); string(data) != "old-binary" {
+		t.Errorf("binary = %q, want the old binary untouched", data)
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dir, "push-validator-update-*")); len(matches) != 0 {
+		t.Errorf("temp file left behind: %v", matches)
+	}
+}
+
+func TestInstall_ConcurrentUpdatesAreExclusive(t *testing.T) {
+	dir := t.TempDir()
+	binPath := filepath.Join(dir, "push-validator")
+	os.WriteFile(binPath, []byte("old-binary"), 0o755)
+	lockDir := filepath.Join(dir, "home")
+
+	// Hold whichever Install gets the lock until the other has returned
+	release := make(chan struct{})
+	installHook = func() { <-release }
+	t.Cleanup(func() { installHook = nil })
+
+	results := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			u := &Updater{BinaryPath: binPath, LockDir: lockDir}
+			results <- u.Install([]byte("new-binary"))
+		}()
+	}
+
+	first := <-results
+	close(release)
+	second := <-results
+
+	var succeeded, locked int
+	for _, err := range []error{first, second} {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, ErrUpdateInProgress):
+			locked++
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if succeeded != 1 || locked != 1 {
+		t.Fatalf("expected exactly one success, got %d succeeded and %d locked out", succeeded, locked)
+	}
+	if !errors.Is(first, ErrUpdateInProgress) || first.Error() != "another update is in progress" {
+		t.Errorf("loser should fail fast, got %v", first)
+	}
+
+	// The lock is released afterwards
+	u := &Updater{BinaryPath: binPath, LockDir: lockDir}
+	if err := u.Install([]byte("newer-binary")); err != nil {
+		t.Errorf("Install() after lock release error = %v", err)
+	}
+}
+
 func TestInstall_CreateTempError(t *testing.T) {
 	if os.Getuid() == 0 {
 		t.Skip("cannot test as root")
@@ -1509,3 +1680,47 @@ func createTarGz(t *testing.T, files map[string]string) []byte {
 
 	return buf.Bytes()
 }
+
+func TestSmokeTest(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts not supported on windows")
+	}
+	dir := t.TempDir()
+	script := func(name, body string) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte("#!/bin/sh\n"+body+"\n"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	t.Run("version subcommand", func(t *testing.T) {
+		out, err := SmokeTest(script("good", `echo "push-validator v2.0.0"`))
+		if err != nil || out != "push-validator v2.0.0" {
+			t.Errorf("SmokeTest() = %q, %v", out, err)
+		}
+	})
+
+	t.Run("falls back to --version", func(t *testing.T) {
+		out, err := SmokeTest(script("flag", `[ "$1" = "--version" ] && echo v2.0.0 && exit 0; exit 1`))
+		if err != nil || out != "v2.0.0" {
+			t.Errorf("SmokeTest() = %q, %v", out, err)
+		}
+	})
+
+	t.Run("non-zero exit", func(t *testing.T) {
+		if _, err := SmokeTest(script("broken", "exit 3")); err == nil {
+			t.Error("expected error for binary that exits non-zero")
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		orig := SmokeTestTimeout
+		SmokeTestTimeout = 200 * time.Millisecond
+		defer func() { SmokeTestTimeout = orig }()
+		_, err := SmokeTest(script("hang", "exec sleep 5"))
+		if err == nil || !strings.Contains(err.Error(), "did not exit") {
+			t.Errorf("expected timeout error, got %v", err)
+		}
+	})
+}
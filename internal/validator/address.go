@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// Bech32 prefixes for Push Chain account, validator operator and consensus addresses
+const (
+	AccountPrefix = "push"
+	ValoperPrefix = "pushvaloper"
+	ValconsPrefix = "pushvalcons"
+)
+
+// DecodeBech32 decodes a bech32 address to its prefix and EVM hex form (0x...).
+func DecodeBech32(addr string) (prefix, hexAddr string, err error) {
+	hrp, data, err := bech32.Decode(addr)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid bech32 address %q: %w", addr, err)
+	}
+	// Convert 5-bit groups to 8-bit bytes
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid bech32 address %q: %w", addr, err)
+	}
+	return hrp, "0x" + strings.ToUpper(hex.EncodeToString(converted)), nil
+}
+
+// EncodeBech32 converts an EVM hex address (0x...) to a bech32 address with
+// the given prefix.
+func EncodeBech32(hexAddr, prefix string) (string, error) {
+	raw := strings.TrimPrefix(strings.TrimPrefix(hexAddr, "0x"), "0X")
+	if len(raw) != 40 {
+		return "", fmt.Errorf("invalid hex address %q: want 20 bytes (40 hex digits), got %d digits", hexAddr, len(raw))
+	}
+	b, err := hex.DecodeString(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid hex address %q: %w", hexAddr, err)
+	}
+	// Convert 8-bit bytes to 5-bit groups
+	data, err := bech32.ConvertBits(b, 8, 5, true)
+	if err != nil {
+		return "", fmt.Errorf("invalid hex address %q: %w", hexAddr, err)
+	}
+	addr, err := bech32.Encode(prefix, data)
+	if err != nil {
+		return "", fmt.Errorf("encode with prefix %q: %w", prefix, err)
+	}
+	return addr, nil
+}
+
+// Bech32ToHex converts a bech32 address (push1..., pushvaloper1...) to EVM hex format (0x...)
+// This is a pure Go implementation that doesn't require subprocess calls.
+// Invalid input gives "—", for display.
+func Bech32ToHex(addr string) string {
+	if addr == "" {
+		return "—"
+	}
+	_, h, err := DecodeBech32(addr)
+	if err != nil {
+		return "—"
+	}
+	return h
+}
+
+// HexToBech32 converts an EVM hex address (0x...) to a bech32 address with the
+// given prefix. Like Bech32ToHex it returns "—" for invalid input.
+func HexToBech32(hexAddr, prefix string) string {
+	addr, err := EncodeBech32(hexAddr, prefix)
+	if err != nil {
+		return "—"
+	}
+	return addr
+}
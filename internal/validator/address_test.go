@@ -0,0 +1,34 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecodeBech32(t *testing.T) {
+	const hexAddr = "0x0102030405060708090A0B0C0D0E0F1011121314"
+	for _, prefix := range []string{AccountPrefix, ValoperPrefix, ValconsPrefix} {
+		addr, err := EncodeBech32(strings.ToLower(hexAddr), prefix)
+		if err != nil {
+			t.Fatalf("EncodeBech32(%s) error = %v", prefix, err)
+		}
+		if !strings.HasPrefix(addr, prefix+"1") {
+			t.Errorf("EncodeBech32(%s) = %q", prefix, addr)
+		}
+		hrp, h, err := DecodeBech32(addr)
+		if err != nil || hrp != prefix || h != hexAddr {
+			t.Errorf("DecodeBech32(%q) = %q, %q, %v", addr, hrp, h, err)
+		}
+	}
+
+	for _, bad := range []string{"", "0x1234", "0xZZ02030405060708090A0B0C0D0E0F1011121314"} {
+		if _, err := EncodeBech32(bad, AccountPrefix); err == nil {
+			t.Errorf("EncodeBech32(%q) expected error", bad)
+		}
+	}
+	for _, bad := range []string{"", "push1invalid", "0x0102030405060708090A0B0C0D0E0F1011121314"} {
+		if _, _, err := DecodeBech32(bad); err == nil {
+			t.Errorf("DecodeBech32(%q) expected error", bad)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package validator
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Gas and fee settings used for every tx unless overridden.
+const (
+	DefaultGas           = "auto"
+	DefaultGasAdjustment = "1.3"
+	DefaultGasPrice      = "1000000000" // per unit of gas, in the chain denom
+)
+
+// coinRe matches an amount with an optional denom, e.g. 500 or 1000000000upc.
+var coinRe = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)([a-zA-Z][a-zA-Z0-9/]*)?$`)
+
+// TxFees overrides how pchaind sets gas and fees on broadcast txs. Empty
+// fields use the defaults. Fees and GasPrices are mutually exclusive, and
+// amounts without a denom are taken to be in the chain denom.
+type TxFees struct {
+	Gas           string // "auto" or a fixed gas limit
+	GasAdjustment string // multiplier on simulated gas; --gas auto only
+	GasPrices     string // price per unit of gas, e.g. 1000000000upc
+	Fees          string // flat fee, e.g. 200000000000000upc
+}
+
+// Validate reports settings pchaind would reject or silently ignore.
+func (f TxFees) Validate() error {
+	if f.Fees != "" && f.GasPrices != "" {
+		return fmt.Errorf("--fees and --gas-prices cannot be used together")
+	}
+	if f.Gas != "" && f.Gas != "auto" {
+		if n, err := strconv.ParseUint(f.Gas, 10, 64); err != nil || n == 0 {
+			return fmt.Errorf("invalid --gas %q: must be \"auto\" or a positive integer", f.Gas)
+		}
+		if f.GasAdjustment != "" {
+			return fmt.Errorf("--gas-adjustment only applies with --gas auto")
+		}
+	}
+	if f.GasAdjustment != "" {
+		if v, err := strconv.ParseFloat(f.GasAdjustment, 64); err != nil || v <= 0 {
+			return fmt.Errorf("invalid --gas-adjustment %q: must be a positive number", f.GasAdjustment)
+		}
+	}
+	if f.GasPrices != "" && !coinRe.MatchString(f.GasPrices) {
+		return fmt.Errorf("invalid --gas-prices %q: want an amount such as 1000000000upc", f.GasPrices)
+	}
+	if f.Fees != "" && !coinRe.MatchString(f.Fees) {
+		return fmt.Errorf("invalid --fees %q: want an amount such as 200000000000000upc", f.Fees)
+	}
+	return nil
+}
+
+// Args returns the pchaind tx flags for these settings.
+func (f TxFees) Args(denom string) []string {
+	gas := f.Gas
+	if gas == "" {
+		gas = DefaultGas
+	}
+	args := []string{"--gas=" + gas}
+	if gas == "auto" {
+		adj := f.GasAdjustment
+		if adj == "" {
+			adj = DefaultGasAdjustment
+		}
+		args = append(args, "--gas-adjustment="+adj)
+	}
+	if f.Fees != "" {
+		return append(args, "--fees="+withDenom(f.Fees, denom))
+	}
+	prices := f.GasPrices
+	if prices == "" {
+		prices = DefaultGasPrice
+	}
+	return append(args, "--gas-prices="+withDenom(prices, denom))
+}
+
+// MaxFee returns the most the tx can cost in the chain denom when that is
+// known before broadcast: a flat --fees, or a fixed --gas times the gas
+// price. With --gas auto the gas is only known after simulation, so ok is
+// false.
+func (f TxFees) MaxFee(denom string) (amount *big.Int, ok bool) {
+	if f.Fees != "" {
+		return coinAmount(withDenom(f.Fees, denom), denom)
+	}
+	if f.Gas == "" || f.Gas == "auto" {
+		return nil, false
+	}
+	gas, ok := new(big.Int).SetString(f.Gas, 10)
+	if !ok {
+		return nil, false
+	}
+	prices := f.GasPrices
+	if prices == "" {
+		prices = DefaultGasPrice
+	}
+	m := coinRe.FindStringSubmatch(withDenom(prices, denom))
+	if m == nil || m[2] != denom {
+		return nil, false
+	}
+	price, ok := new(big.Float).SetString(m[1])
+	if !ok {
+		return nil, false
+	}
+	total, _ := new(big.Float).Mul(price, new(big.Float).SetInt(gas)).Int(nil)
+	return total, true
+}
+
+// withDenom appends denom to a bare amount.
+func withDenom(amount, denom string) string {
+	if m := coinRe.FindStringSubmatch(amount); m != nil && m[2] == "" {
+		return amount + denom
+	}
+	return amount
+}
+
+// coinAmount parses an integer amount of denom, e.g. "5000upc".
+func coinAmount(coin, denom string) (*big.Int, bool) {
+	m := coinRe.FindStringSubmatch(coin)
+	if m == nil || m[2] != denom || strings.Contains(m[1], ".") {
+		return nil, false
+	}
+	return new(big.Int).SetString(m[1], 10)
+}
@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTxFees_Validate(t *testing.T) {
+	valid := []TxFees{
+		{},
+		{Gas: "auto", GasAdjustment: "1.5"},
+		{Gas: "250000", GasPrices: "2000000000upc"},
+		{Fees: "500000000000000upc"},
+		{Fees: "500000000000000"},
+	}
+	for _, f := range valid {
+		if err := f.Validate(); err != nil {
+			t.Errorf("%+v: unexpected error %v", f, err)
+		}
+	}
+	invalid := []TxFees{
+		{Fees: "1upc", GasPrices: "1upc"},
+		{Gas: "lots"},
+		{Gas: "0"},
+		{Gas: "200000", GasAdjustment: "1.5"},
+		{GasAdjustment: "-1"},
+		{GasPrices: "upc"},
+		{Fees: "1 upc"},
+	}
+	for _, f := range invalid {
+		if err := f.Validate(); err == nil {
+			t.Errorf("%+v: expected error", f)
+		}
+	}
+}
+
+func TestTxFees_Args(t *testing.T) {
+	tests := []struct {
+		fees TxFees
+		want []string
+	}{
+		{TxFees{}, []string{"--gas=auto", "--gas-adjustment=1.3", "--gas-prices=1000000000upc"}},
+		{TxFees{GasAdjustment: "2"}, []string{"--gas=auto", "--gas-adjustment=2", "--gas-prices=1000000000upc"}},
+		{TxFees{Gas: "300000", GasPrices: "5"}, []string{"--gas=300000", "--gas-prices=5upc"}},
+		{TxFees{Fees: "9000upc"}, []string{"--gas=auto", "--gas-adjustment=1.3", "--fees=9000upc"}},
+	}
+	for _, tt := range tests {
+		if got := tt.fees.Args("upc"); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%+v: Args() = %v, want %v", tt.fees, got, tt.want)
+		}
+	}
+}
+
+func TestTxFees_MaxFee(t *testing.T) {
+	if _, ok := (TxFees{}).MaxFee("upc"); ok {
+		t.Error("auto gas should have no fee before simulation")
+	}
+	if got, ok := (TxFees{Gas: "200000"}).MaxFee("upc"); !ok || got.String() != "200000000000000" {
+		t.Errorf("fixed gas at default price = %v, %v", got, ok)
+	}
+	if got, ok := (TxFees{Fees: "5000"}).MaxFee("upc"); !ok || got.String() != "5000" {
+		t.Errorf("flat fee = %v, %v", got, ok)
+	}
+	if _, ok := (TxFees{Gas: "200000", GasPrices: "1stake"}).MaxFee("upc"); ok {
+		t.Error("fee in another denom should not be summed")
+	}
+}
@@ -1,9 +1,13 @@
 package validator
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -13,69 +17,19 @@ import (
 	"sync"
 	"time"
 
-	"github.com/btcsuite/btcutil/bech32"
+	"github.com/pushchain/push-validator-cli/internal/chain"
 	"github.com/pushchain/push-validator-cli/internal/config"
+	"github.com/pushchain/push-validator-cli/internal/trace"
+	"golang.org/x/sync/singleflight"
 )
 
-// Bech32ToHex converts a bech32 address (push1..., pushvaloper1...) to EVM hex format (0x...)
-// This is a pure Go implementation that doesn't require subprocess calls.
-func Bech32ToHex(addr string) string {
-	if addr == "" {
-		return "—"
-	}
-
-	// Decode bech32 address
-	_, data, err := bech32.Decode(addr)
-	if err != nil {
-		return "—"
-	}
-
-	// Convert 5-bit groups to 8-bit bytes
-	converted, err := bech32.ConvertBits(data, 5, 8, false)
-	if err != nil {
-		return "—"
-	}
-
-	return "0x" + strings.ToUpper(hex.EncodeToString(converted))
-}
-
-// commandContext creates an exec.CommandContext with DYLD_LIBRARY_PATH set for macOS
-// to find libwasmvm.dylib in the same directory as the binary
+// commandContext creates an exec.CommandContext with LD_LIBRARY_PATH (Linux)
+// and DYLD_LIBRARY_PATH (macOS) set so pchaind finds libwasmvm next to the
+// binary or in cosmovisor, ahead of any existing search path
 func commandContext(ctx context.Context, name string, args ...string) *exec.Cmd {
 	cmd := exec.CommandContext(ctx, name, args...)
-
-	// Set DYLD_LIBRARY_PATH for macOS to find libwasmvm.dylib
-	// Check multiple potential locations for the dylib
-	dylibPaths := []string{}
-
-	// 1. Same directory as binary
-	binDir := filepath.Dir(name)
-	if binDir != "" && binDir != "." {
-		dylibPaths = append(dylibPaths, binDir)
-	}
-
-	// 2. Common cosmovisor locations
-	homeDir, err := os.UserHomeDir()
-	if err == nil {
-		cosmovisorDirs := []string{
-			filepath.Join(homeDir, ".pchain/cosmovisor/genesis/bin"),
-			filepath.Join(homeDir, ".pchain/cosmovisor/current/bin"),
-		}
-		dylibPaths = append(dylibPaths, cosmovisorDirs...)
-	}
-
-	// Build DYLD_LIBRARY_PATH
-	if len(dylibPaths) > 0 {
-		env := os.Environ()
-		existingPath := os.Getenv("DYLD_LIBRARY_PATH")
-		newPath := strings.Join(dylibPaths, ":")
-		if existingPath != "" {
-			newPath = newPath + ":" + existingPath
-		}
-		env = append(env, "DYLD_LIBRARY_PATH="+newPath)
-		cmd.Env = env
-	}
-
+	cmd.Env = chain.WithLibraryPath(os.Environ(), chain.LibraryDirs(name)...)
+	trace.Command(cmd)
 	return cmd
 }
 
@@ -99,6 +53,44 @@ func resolvePchaindBin(homeDir string) (string, error) {
 	return "", fmt.Errorf("pchaind not found in PATH or %s", filepath.Join(homeDir, "cosmovisor"))
 }
 
+// ErrRPCNotReady means a node the fetcher queries didn't answer, even after
+// retrying. Whether this node is a validator is unknown, not false.
+var ErrRPCNotReady = errors.New("node RPC not ready")
+
+// queryRetryDelays are the pauses between attempts of a retried pchaind
+// query; one more attempt is made than there are delays.
+var queryRetryDelays = []time.Duration{500 * time.Millisecond, 1500 * time.Millisecond}
+
+// withStderr appends the trimmed stderr of a failed Output call to err,
+// when there is any.
+func withStderr(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(bytes.TrimSpace(exitErr.Stderr)) > 0 {
+		return fmt.Errorf("%w: %s", err, bytes.TrimSpace(exitErr.Stderr))
+	}
+	return err
+}
+
+// outputWithRetry runs a network-touching pchaind command, retrying with
+// backoff on failure. It stops early once ctx is done. The returned error
+// carries pchaind's stderr when there is any.
+func outputWithRetry(ctx context.Context, bin string, args ...string) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		out, err := commandContext(ctx, bin, args...).Output()
+		if err == nil {
+			return out, nil
+		}
+		err = withStderr(err)
+		if attempt >= len(queryRetryDelays) {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(queryRetryDelays[attempt]):
+		}
+	}
+}
 
 
 // rewardsCacheEntry holds cached rewards data with timestamp
@@ -112,12 +104,24 @@ type rewardsCacheEntry struct {
 type Fetcher struct {
 	mu sync.Mutex
 
+	// flight coalesces overlapping cache misses: concurrent callers share
+	// one in-flight query instead of each running pchaind against the
+	// genesis RPC. mu is not held while it runs.
+	flight singleflight.Group
+
+	// gen counts Invalidate calls. A shared query stores its result only if
+	// gen hasn't moved since it started, so one begun before an
+	// invalidation can't refill the cache with what was just dropped.
+	gen uint64
+
 	// All validators cache
 	allValidators     ValidatorList
 	allValidatorsTime time.Time
 
-	// My validator cache
+	// My validator cache; myValidatorErr is the last failure, cached like a
+	// result so callers don't hammer a node that isn't answering
 	myValidator     MyValidatorInfo
+	myValidatorErr  error
 	myValidatorTime time.Time
 
 	// Rewards cache (per validator address)
@@ -128,53 +132,119 @@ type Fetcher struct {
 	proposals     ProposalList
 	proposalsTime time.Time
 
+	// Slashing params cache; these only change by governance
+	slashingParams     SlashingParams
+	slashingParamsTime time.Time
+
 	cacheTTL time.Duration
 }
 
+// DefaultCacheTTL is how long fetched validator data is reused when
+// config.Config.CacheTTL is unset.
+const DefaultCacheTTL = 30 * time.Second
+
 // NewFetcher creates a new validator fetcher with 30s cache
 func NewFetcher() *Fetcher {
 	return &Fetcher{
-		cacheTTL:     30 * time.Second,
-		rewardsTTL:   30 * time.Second,
+		cacheTTL:     DefaultCacheTTL,
+		rewardsTTL:   DefaultCacheTTL,
 		rewardsCache: make(map[string]rewardsCacheEntry),
 	}
 }
 
-// GetAllValidators fetches all validators with 30s caching
-func (f *Fetcher) GetAllValidators(ctx context.Context, cfg config.Config) (ValidatorList, error) {
+// ttl returns cfg.CacheTTL if set, else def.
+func ttl(cfg config.Config, def time.Duration) time.Duration {
+	if cfg.CacheTTL > 0 {
+		return cfg.CacheTTL
+	}
+	return def
+}
+
+// Invalidate drops every cached result (validators, my validator, rewards,
+// proposals and slashing params) so the next call of each getter queries the
+// chain, regardless of TTL. Queries already in flight still answer the
+// callers waiting on them, but their results are not cached.
+func (f *Fetcher) Invalidate() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.gen++
+	f.allValidatorsTime = time.Time{}
+	f.myValidatorTime = time.Time{}
+	f.proposalsTime = time.Time{}
+	f.slashingParamsTime = time.Time{}
+	for addr := range f.rewardsCache {
+		f.flight.Forget(rewardsFlightKey(addr))
+	}
+	f.rewardsCache = make(map[string]rewardsCacheEntry)
+	// Callers from now on start a new query rather than join one begun
+	// before the invalidation
+	f.flight.Forget(validatorsFlightKey)
+}
+
+// generation returns the current Invalidate count.
+func (f *Fetcher) generation() uint64 {
 	f.mu.Lock()
 	defer f.mu.Unlock()
+	return f.gen
+}
 
+// validatorsFlightKey is GetAllValidators' key in Fetcher.flight.
+const validatorsFlightKey = "validators"
+
+// flightTimeout bounds a shared query. It runs detached from the caller
+// that started it, so one caller giving up doesn't fail the others.
+const flightTimeout = 2 * time.Minute
+
+// flightContext returns the context a shared query started by ctx runs in.
+func flightContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), flightTimeout)
+}
+
+// GetAllValidators fetches all validators with 30s caching. Concurrent
+// callers that miss the cache share one query; each still returns early if
+// its own ctx ends first, and the query carries on for the others.
+func (f *Fetcher) GetAllValidators(ctx context.Context, cfg config.Config) (ValidatorList, error) {
+	f.mu.Lock()
 	// Force fetch on first call (cache is zero-initialized)
-	if f.allValidatorsTime.IsZero() {
-		list, err := f.fetchAllValidators(ctx, cfg)
+	fresh := !f.allValidatorsTime.IsZero() && time.Since(f.allValidatorsTime) < ttl(cfg, f.cacheTTL) && f.allValidators.Total > 0
+	cached := f.allValidators
+	f.mu.Unlock()
+	if fresh {
+		return cached, nil
+	}
+
+	ch := f.flight.DoChan(validatorsFlightKey, func() (any, error) {
+		gen := f.generation()
+		fctx, cancel := flightContext(ctx)
+		defer cancel()
+		list, err := f.fetchAllValidators(fctx, cfg)
 		if err != nil {
 			return ValidatorList{}, err
 		}
-		f.allValidators = list
-		f.allValidatorsTime = time.Now()
+		f.mu.Lock()
+		if f.gen == gen {
+			f.allValidators = list
+			f.allValidatorsTime = time.Now()
+		}
+		f.mu.Unlock()
 		return list, nil
+	})
+	var res singleflight.Result
+	select {
+	case res = <-ch:
+	case <-ctx.Done():
+		res.Err = ctx.Err()
 	}
-
-	// Return cached if still valid
-	if time.Since(f.allValidatorsTime) < f.cacheTTL && f.allValidators.Total > 0 {
-		return f.allValidators, nil
-	}
-
-	// Fetch fresh data
-	list, err := f.fetchAllValidators(ctx, cfg)
-	if err != nil {
+	if res.Err != nil {
 		// Return stale cache if available
+		f.mu.Lock()
+		defer f.mu.Unlock()
 		if f.allValidators.Total > 0 {
 			return f.allValidators, nil
 		}
-		return ValidatorList{}, err
+		return ValidatorList{}, res.Err
 	}
-
-	// Update cache
-	f.allValidators = list
-	f.allValidatorsTime = time.Now()
-	return list, nil
+	return res.Val.(ValidatorList), nil
 }
 
 // GetMyValidator fetches current node's validator status with 30s caching
@@ -187,33 +257,40 @@ func (f *Fetcher) GetMyValidator(ctx context.Context, cfg config.Config) (MyVali
 		myVal, err := f.fetchMyValidator(ctx, cfg)
 		if err != nil {
 			// IMPORTANT: Set cache time even on error to prevent infinite retry loops
+			f.myValidator = MyValidatorInfo{IsValidator: false}
+			f.myValidatorErr = err
 			f.myValidatorTime = time.Now()
-			return MyValidatorInfo{IsValidator: false}, err
+			return f.myValidator, err
 		}
 		f.myValidator = myVal
+		f.myValidatorErr = nil
 		f.myValidatorTime = time.Now()
 		return myVal, nil
 	}
 
-	// Return cached if still valid
-	if time.Since(f.myValidatorTime) < f.cacheTTL {
-		return f.myValidator, nil
+	// Return cached if still valid (including a cached error, so a node that
+	// isn't answering isn't reported as "not a validator")
+	if time.Since(f.myValidatorTime) < ttl(cfg, f.cacheTTL) {
+		return f.myValidator, f.myValidatorErr
 	}
 
 	// Fetch fresh data
 	myVal, err := f.fetchMyValidator(ctx, cfg)
 	if err != nil {
-		// Return stale cache if available
-		if f.myValidator.Address != "" || !f.myValidatorTime.IsZero() {
+		// Return stale cache if the last fetch succeeded
+		if f.myValidatorErr == nil {
 			return f.myValidator, nil
 		}
 		// Set cache time to retry on next refresh
+		f.myValidator = MyValidatorInfo{IsValidator: false}
+		f.myValidatorErr = err
 		f.myValidatorTime = time.Now()
-		return MyValidatorInfo{IsValidator: false}, err
+		return f.myValidator, err
 	}
 
 	// Update cache
 	f.myValidator = myVal
+	f.myValidatorErr = nil
 	f.myValidatorTime = time.Now()
 	return myVal, nil
 }
@@ -225,9 +302,13 @@ type validatorQueryResult struct {
 			Moniker string `json:"moniker"`
 		} `json:"description"`
 		OperatorAddress string `json:"operator_address"`
-		Status          string `json:"status"`
-		Tokens          string `json:"tokens"`
-		Commission      struct {
+		ConsensusPubkey struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"consensus_pubkey"`
+		Status     string `json:"status"`
+		Tokens     string `json:"tokens"`
+		Commission struct {
 			CommissionRates struct {
 				Rate string `json:"rate"`
 			} `json:"commission_rates"`
@@ -249,6 +330,9 @@ func (f *Fetcher) fetchAllValidators(ctx context.Context, cfg config.Config) (Va
 
 	remote := fmt.Sprintf("https://%s", cfg.GenesisDomain)
 
+	// Missed block counters are best-effort; the list is still useful without them
+	missed := fetchMissedBlocks(ctx, bin, remote)
+
 	// Fetch all validators using pagination
 	var allValidators []ValidatorInfo
 	pageKey := ""
@@ -305,6 +389,7 @@ func (f *Fetcher) fetchAllValidators(ctx context.Context, cfg config.Config) (Va
 				VotingPower:     votingPower,
 				Commission:      commission,
 				Jailed:          v.Jailed,
+				MissedBlocks:    missed[consensusAddrHex(v.ConsensusPubkey.Key+v.ConsensusPubkey.Value)],
 			})
 		}
 
@@ -321,6 +406,60 @@ func (f *Fetcher) fetchAllValidators(ctx context.Context, cfg config.Config) (Va
 	}, nil
 }
 
+// consensusAddrHex derives the hex consensus address from a base64 ed25519
+// consensus pubkey (first 20 bytes of its SHA-256). Returns "" if the key
+// cannot be decoded.
+func consensusAddrHex(pubkeyB64 string) string {
+	raw, err := base64.StdEncoding.DecodeString(pubkeyB64)
+	if err != nil || len(raw) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:20])
+}
+
+// fetchMissedBlocks returns missed block counters from all signing infos,
+// keyed by hex consensus address. Errors yield a partial or empty map.
+func fetchMissedBlocks(ctx context.Context, bin, remote string) map[string]int64 {
+	missed := make(map[string]int64)
+	pageKey := ""
+	for {
+		args := []string{"query", "slashing", "signing-infos", "--node", remote, "-o", "json", "--page-limit", "500"}
+		if pageKey != "" {
+			args = append(args, "--page-key", pageKey)
+		}
+		output, err := commandContext(ctx, bin, args...).Output()
+		if err != nil {
+			return missed
+		}
+		var result struct {
+			Info []struct {
+				Address      string `json:"address"`
+				MissedBlocks string `json:"missed_blocks_counter"`
+			} `json:"info"`
+			Pagination struct {
+				NextKey string `json:"next_key"`
+			} `json:"pagination"`
+		}
+		if err := json.Unmarshal(output, &result); err != nil {
+			return missed
+		}
+		for _, info := range result.Info {
+			n, err := strconv.ParseInt(info.MissedBlocks, 10, 64)
+			if err != nil {
+				continue
+			}
+			if h := Bech32ToHex(info.Address); strings.HasPrefix(h, "0x") {
+				missed[strings.ToLower(h[2:])] = n
+			}
+		}
+		if result.Pagination.NextKey == "" {
+			return missed
+		}
+		pageKey = result.Pagination.NextKey
+	}
+}
+
 // fetchMyValidator fetches the current node's validator info by comparing consensus pubkeys
 func (f *Fetcher) fetchMyValidator(ctx context.Context, cfg config.Config) (MyValidatorInfo, error) {
 	bin, err := resolvePchaindBin(cfg.HomeDir)
@@ -351,7 +490,8 @@ func (f *Fetcher) fetchMyValidator(ctx context.Context, cfg config.Config) (MyVa
 	// Build the full pubkey JSON string for slashing info query
 	fullPubkeyJSON := string(pubkeyBytes)
 
-	// Get local node moniker from status (for conflict detection)
+	// Get local node moniker from status (for conflict detection). Best-effort
+	// and not retried: the node may be stopped, and the lookup works without it.
 	var localMoniker string
 	statusCmd := commandContext(ctx, bin, "status", "--node", cfg.RPCLocal)
 	if statusOutput, err := statusCmd.Output(); err == nil {
@@ -392,10 +532,9 @@ func (f *Fetcher) fetchMyValidator(ctx context.Context, cfg config.Config) (MyVa
 			args = append(args, "--page-key", pageKey)
 		}
 
-		queryCmd := commandContext(ctx, bin, args...)
-		valsOutput, err := queryCmd.Output()
+		valsOutput, err := outputWithRetry(ctx, bin, args...)
 		if err != nil {
-			return MyValidatorInfo{IsValidator: false}, err
+			return MyValidatorInfo{IsValidator: false}, fmt.Errorf("%w: query validators from %s: %v", ErrRPCNotReady, remote, err)
 		}
 
 		var result struct {
@@ -426,7 +565,7 @@ func (f *Fetcher) fetchMyValidator(ctx context.Context, cfg config.Config) (MyVa
 		}
 
 		if err := json.Unmarshal(valsOutput, &result); err != nil {
-			return MyValidatorInfo{IsValidator: false}, err
+			return MyValidatorInfo{IsValidator: false}, fmt.Errorf("failed to parse validators: %w", err)
 		}
 
 		for _, v := range result.Validators {
@@ -703,7 +842,7 @@ func (f *Fetcher) GetProposals(ctx context.Context, cfg config.Config) (Proposal
 	}
 
 	// Return cached if still valid
-	if time.Since(f.proposalsTime) < f.cacheTTL && f.proposals.Total > 0 {
+	if time.Since(f.proposalsTime) < ttl(cfg, f.cacheTTL) && f.proposals.Total > 0 {
 		return f.proposals, nil
 	}
 
@@ -896,30 +1035,68 @@ func GetValidatorRewards(ctx context.Context, cfg config.Config, validatorAddr s
 	return commissionRewards, outstandingRewards, nil
 }
 
-// GetCachedValidatorRewards fetches validator rewards with 30s caching
-func (f *Fetcher) GetCachedValidatorRewards(ctx context.Context, cfg config.Config, validatorAddr string) (commission string, outstanding string, err error) {
-	f.mu.Lock()
-	defer f.mu.Unlock()
+// rewardsFlightKey is GetCachedValidatorRewards' key in Fetcher.flight.
+func rewardsFlightKey(validatorAddr string) string {
+	return "rewards/" + validatorAddr
+}
 
+// GetCachedValidatorRewards fetches validator rewards with 30s caching.
+// Concurrent callers for the same validator share one query.
+func (f *Fetcher) GetCachedValidatorRewards(ctx context.Context, cfg config.Config, validatorAddr string) (commission string, outstanding string, err error) {
 	// Check cache first
-	if cached, exists := f.rewardsCache[validatorAddr]; exists {
-		if time.Since(cached.fetchedAt) < f.rewardsTTL {
-			return cached.commission, cached.outstanding, nil
-		}
+	f.mu.Lock()
+	cached, exists := f.rewardsCache[validatorAddr]
+	f.mu.Unlock()
+	if exists && time.Since(cached.fetchedAt) < ttl(cfg, f.rewardsTTL) {
+		return cached.commission, cached.outstanding, nil
 	}
 
 	// Cache miss or expired - fetch fresh data
-	commission, outstanding, err = GetValidatorRewards(ctx, cfg, validatorAddr)
-	if err == nil {
-		// Store in cache
-		f.rewardsCache[validatorAddr] = rewardsCacheEntry{
+	ch := f.flight.DoChan(rewardsFlightKey(validatorAddr), func() (any, error) {
+		gen := f.generation()
+		fctx, cancel := flightContext(ctx)
+		defer cancel()
+		commission, outstanding, err := GetValidatorRewards(fctx, cfg, validatorAddr)
+		entry := rewardsCacheEntry{
 			commission:  commission,
 			outstanding: outstanding,
 			fetchedAt:   time.Now(),
 		}
+		f.mu.Lock()
+		if err == nil && f.gen == gen {
+			f.rewardsCache[validatorAddr] = entry
+		}
+		f.mu.Unlock()
+		return entry, err
+	})
+	select {
+	case res := <-ch:
+		entry := res.Val.(rewardsCacheEntry)
+		return entry.commission, entry.outstanding, res.Err
+	case <-ctx.Done():
+		return "—", "—", ctx.Err()
 	}
+}
+
+// slashingParamsTTL is how long slashing params are reused. They change only
+// through governance, so they are cached well past the usual TTL.
+const slashingParamsTTL = 10 * time.Minute
+
+// GetSlashingParams fetches the chain's slashing params with 10m caching
+func (f *Fetcher) GetSlashingParams(ctx context.Context, cfg config.Config) (SlashingParams, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 
-	return commission, outstanding, err
+	if !f.slashingParamsTime.IsZero() && time.Since(f.slashingParamsTime) < slashingParamsTTL {
+		return f.slashingParams, nil
+	}
+	params, err := GetSlashingParams(ctx, cfg)
+	if err != nil {
+		return SlashingParams{}, err
+	}
+	f.slashingParams = params
+	f.slashingParamsTime = time.Now()
+	return params, nil
 }
 
 // Global fetcher instance
@@ -935,6 +1112,29 @@ func GetCachedMyValidator(ctx context.Context, cfg config.Config) (MyValidatorIn
 	return globalFetcher.GetMyValidator(ctx, cfg)
 }
 
+// GetCachedSlashingParams returns cached slashing params
+func GetCachedSlashingParams(ctx context.Context, cfg config.Config) (SlashingParams, error) {
+	return globalFetcher.GetSlashingParams(ctx, cfg)
+}
+
+// InvalidateMyValidator drops the cached my-validator info so the next
+// GetMyValidator call queries the chain. Used when polling for a change.
+func (f *Fetcher) InvalidateMyValidator() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.myValidatorTime = time.Time{}
+}
+
+// InvalidateCachedMyValidator drops the global my-validator cache entry
+func InvalidateCachedMyValidator() {
+	globalFetcher.InvalidateMyValidator()
+}
+
+// InvalidateCache drops everything the global fetcher has cached
+func InvalidateCache() {
+	globalFetcher.Invalidate()
+}
+
 // GetCachedRewards returns validator rewards with 30s caching
 func GetCachedRewards(ctx context.Context, cfg config.Config, validatorAddr string) (commission string, outstanding string, err error) {
 	return globalFetcher.GetCachedValidatorRewards(ctx, cfg, validatorAddr)
@@ -1045,10 +1245,8 @@ func getKeyringAddresses(bin string, cfg config.Config) []string {
 	}
 
 	// Parse the JSON output to extract addresses
-	var keys []struct {
-		Address string `json:"address"`
-	}
-	if err := json.Unmarshal(output, &keys); err != nil {
+	keys, err := parseKeyList(output)
+	if err != nil {
 		return addresses
 	}
 
@@ -1,7 +1,12 @@
 package validator
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -10,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcutil/bech32"
 	"github.com/pushchain/push-validator-cli/internal/config"
 )
 
@@ -49,6 +55,9 @@ while [[ $# -gt 0 ]]; do
 				if [ "$1" = "signing-info" ]; then
 					echo '{"val_signing_info":{"address":"pushvalcons1test","start_height":"1","jailed_until":"1970-01-01T00:00:00Z","tombstoned":false,"missed_blocks_counter":"5"}}'
 					exit 0
+				elif [ "$1" = "params" ]; then
+					echo '{"params":{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s"}}'
+					exit 0
 				fi
 			fi
 			;;
@@ -96,6 +105,32 @@ exit 1
 	return binPath
 }
 
+func TestCommandContext_LibraryPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("LD_LIBRARY_PATH", "/usr/local/lib")
+	t.Setenv("DYLD_LIBRARY_PATH", "")
+
+	cmd := commandContext(context.Background(), "/opt/push/bin/pchaind", "version")
+	env := map[string]string{}
+	for _, kv := range cmd.Env {
+		k, v, _ := strings.Cut(kv, "=")
+		env[k] = v
+	}
+
+	dirs := strings.Join([]string{
+		"/opt/push/bin",
+		filepath.Join(home, ".pchain/cosmovisor/genesis/bin"),
+		filepath.Join(home, ".pchain/cosmovisor/current/bin"),
+	}, string(os.PathListSeparator))
+	if want := dirs + string(os.PathListSeparator) + "/usr/local/lib"; env["LD_LIBRARY_PATH"] != want {
+		t.Errorf("LD_LIBRARY_PATH = %q, want %q", env["LD_LIBRARY_PATH"], want)
+	}
+	if env["DYLD_LIBRARY_PATH"] != dirs {
+		t.Errorf("DYLD_LIBRARY_PATH = %q, want %q", env["DYLD_LIBRARY_PATH"], dirs)
+	}
+}
+
 func TestFetcher_GetAllValidators(t *testing.T) {
 	createMockPchaind(t, nil)
 
@@ -284,6 +319,64 @@ func TestFetcher_GetMyValidator_Cache(t *testing.T) {
 	}
 }
 
+func TestFetcher_InvalidateMyValidator(t *testing.T) {
+	f := NewFetcher()
+	f.myValidator = MyValidatorInfo{IsValidator: true, Address: "pushvaloper1cached"}
+	f.myValidatorTime = time.Now()
+
+	f.InvalidateMyValidator()
+	if !f.myValidatorTime.IsZero() {
+		t.Error("expected cache time to be cleared so the next call refetches")
+	}
+}
+
+func TestFetcher_Invalidate(t *testing.T) {
+	f := NewFetcher()
+	now := time.Now()
+	f.allValidatorsTime, f.myValidatorTime, f.proposalsTime = now, now, now
+	f.rewardsCache["pushvaloper1cached"] = rewardsCacheEntry{commission: "1.00", fetchedAt: now}
+
+	f.Invalidate()
+	if !f.allValidatorsTime.IsZero() || !f.myValidatorTime.IsZero() || !f.proposalsTime.IsZero() {
+		t.Error("expected all cache times to be cleared")
+	}
+	if len(f.rewardsCache) != 0 {
+		t.Error("expected rewards cache to be emptied")
+	}
+}
+
+func TestFetcher_CacheTTLFromConfig(t *testing.T) {
+	createMockPchaind(t, nil)
+	cached := ValidatorList{Total: 1, Validators: []ValidatorInfo{{OperatorAddress: "pushvaloper1cached"}}}
+	cfg := config.Config{GenesisDomain: "donut.rpc.push.org", HomeDir: t.TempDir()}
+
+	tests := []struct {
+		name     string
+		cacheTTL time.Duration
+		want     string
+	}{
+		{"default TTL keeps 10s-old data", 0, "pushvaloper1cached"},
+		{"longer TTL keeps 10s-old data", time.Minute, "pushvaloper1cached"},
+		{"shorter TTL refetches", 5 * time.Second, "pushvaloper1test"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFetcher()
+			f.allValidators = cached
+			f.allValidatorsTime = time.Now().Add(-10 * time.Second)
+			cfg.CacheTTL = tt.cacheTTL
+
+			list, err := f.GetAllValidators(context.Background(), cfg)
+			if err != nil {
+				t.Fatalf("GetAllValidators error: %v", err)
+			}
+			if got := list.Validators[0].OperatorAddress; got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestFetcher_GetMyValidator_ErrorCaching(t *testing.T) {
 	// Test that errors are cached with timestamp to avoid infinite retry loops
 	if runtime.GOOS == "windows" {
@@ -350,6 +443,91 @@ exit 1
 	}
 }
 
+// writeFlakyPchaind installs a mock pchaind whose staking validators query
+// fails the first failures times, counting attempts in a file.
+func writeFlakyPchaind(t *testing.T, failures int) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("windows not supported in this test")
+	}
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "attempts")
+	script := fmt.Sprintf(`#!/usr/bin/env bash
+case "$*" in
+*"show-validator"*)
+	echo '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"TESTPUBKEY123"}'
+	;;
+*"staking validators"*)
+	n=$(( $(cat %[1]q 2>/dev/null || echo 0) + 1 ))
+	echo "$n" > %[1]q
+	if [ "$n" -le %[2]d ]; then
+		echo "post failed: connection refused" >&2
+		exit 1
+	fi
+	echo '{"validators":[{"operator_address":"pushvaloper1test","description":{"moniker":"test-validator"},"consensus_pubkey":{"value":"TESTPUBKEY123"},"status":"BOND_STATUS_BONDED","tokens":"1000000000000000000000"}]}'
+	;;
+*"keys list"*)
+	echo '[]'
+	;;
+*)
+	exit 1
+	;;
+esac
+`, counter, failures)
+	if err := os.WriteFile(filepath.Join(dir, "pchaind"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	oldPath := os.Getenv("PATH")
+	t.Cleanup(func() { os.Setenv("PATH", oldPath) })
+	os.Setenv("PATH", dir+":"+oldPath)
+
+	origDelays := queryRetryDelays
+	t.Cleanup(func() { queryRetryDelays = origDelays })
+	queryRetryDelays = []time.Duration{time.Millisecond, time.Millisecond}
+	return counter
+}
+
+func TestFetcher_GetMyValidator_TransientThenSuccess(t *testing.T) {
+	counter := writeFlakyPchaind(t, 2)
+	cfg := config.Config{GenesisDomain: "donut.rpc.push.org", HomeDir: t.TempDir()}
+
+	myVal, err := NewFetcher().GetMyValidator(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("GetMyValidator error: %v", err)
+	}
+	if !myVal.IsValidator || myVal.Address != "pushvaloper1test" {
+		t.Errorf("expected validator pushvaloper1test, got %+v", myVal)
+	}
+	if data, _ := os.ReadFile(counter); strings.TrimSpace(string(data)) != "3" {
+		t.Errorf("expected 3 query attempts, got %q", strings.TrimSpace(string(data)))
+	}
+}
+
+func TestFetcher_GetMyValidator_RPCNotReady(t *testing.T) {
+	counter := writeFlakyPchaind(t, 100)
+	cfg := config.Config{GenesisDomain: "donut.rpc.push.org", HomeDir: t.TempDir()}
+	f := NewFetcher()
+
+	_, err := f.GetMyValidator(context.Background(), cfg)
+	if !errors.Is(err, ErrRPCNotReady) {
+		t.Fatalf("expected ErrRPCNotReady, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("expected pchaind stderr in error, got %v", err)
+	}
+	if data, _ := os.ReadFile(counter); strings.TrimSpace(string(data)) != "3" {
+		t.Errorf("expected retries to stop after 3 attempts, got %q", strings.TrimSpace(string(data)))
+	}
+
+	// Within the TTL the cached error is returned without querying again
+	if _, err := f.GetMyValidator(context.Background(), cfg); !errors.Is(err, ErrRPCNotReady) {
+		t.Errorf("expected cached ErrRPCNotReady, got %v", err)
+	}
+	if data, _ := os.ReadFile(counter); strings.TrimSpace(string(data)) != "3" {
+		t.Errorf("expected no new attempts while cached, got %q", strings.TrimSpace(string(data)))
+	}
+}
+
 func TestParseStatus(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -976,6 +1154,112 @@ exit 1
 	_ = callCount
 }
 
+func TestFetcher_GetAllValidators_CoalescesConcurrentCalls(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows not supported in this test")
+	}
+
+	dir := t.TempDir()
+	calls := filepath.Join(t.TempDir(), "calls")
+	script := `#!/usr/bin/env bash
+if [ "$1" = "query" ] && [ "$2" = "staking" ]; then
+	echo x >> ` + calls + `
+	sleep 0.3
+	echo '{"validators":[{"operator_address":"pushvaloper1test","description":{"moniker":"test"},"consensus_pubkey":{"value":"KEY"},"status":"BOND_STATUS_BONDED","tokens":"1000000000000000000000","commission":{"commission_rates":{"rate":"0.10"}},"jailed":false}]}'
+	exit 0
+fi
+exit 1
+`
+	if err := os.WriteFile(filepath.Join(dir, "pchaind"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+":"+os.Getenv("PATH"))
+
+	f := NewFetcher()
+	cfg := config.Config{GenesisDomain: "donut.rpc.push.org", HomeDir: t.TempDir()}
+
+	const n = 8
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			list, err := f.GetAllValidators(context.Background(), cfg)
+			if err == nil && list.Total != 1 {
+				err = fmt.Errorf("got %d validators, want 1", list.Total)
+			}
+			errs <- err
+		}()
+	}
+
+	// A caller whose context ends stops waiting without disturbing the query
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := f.GetAllValidators(ctx, cfg); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("cancelled caller error = %v, want deadline exceeded", err)
+	}
+
+	for i := 0; i < n; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("caller %d: %v", i, err)
+		}
+	}
+	data, _ := os.ReadFile(calls)
+	if got := strings.Count(string(data), "x"); got != 1 {
+		t.Errorf("%d concurrent callers ran %d validator queries, want 1", n+1, got)
+	}
+}
+
+func TestFetcher_Invalidate_DuringQuery(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows not supported in this test")
+	}
+
+	dir := t.TempDir()
+	calls := filepath.Join(t.TempDir(), "calls")
+	script := `#!/usr/bin/env bash
+if [ "$1" = "query" ] && [ "$2" = "staking" ]; then
+	echo x >> ` + calls + `
+	sleep 0.3
+	echo '{"validators":[{"operator_address":"pushvaloper1test","description":{"moniker":"test"},"consensus_pubkey":{"value":"KEY"},"status":"BOND_STATUS_BONDED","tokens":"1000000000000000000000","commission":{"commission_rates":{"rate":"0.10"}},"jailed":false}]}'
+	exit 0
+fi
+exit 1
+`
+	if err := os.WriteFile(filepath.Join(dir, "pchaind"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+":"+os.Getenv("PATH"))
+
+	f := NewFetcher()
+	cfg := config.Config{GenesisDomain: "donut.rpc.push.org", HomeDir: t.TempDir()}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := f.GetAllValidators(context.Background(), cfg)
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	f.Invalidate()
+
+	// The caller that started the query still gets its answer...
+	if err := <-done; err != nil {
+		t.Fatalf("GetAllValidators() error = %v", err)
+	}
+	// ...but the result predates the invalidation, so it isn't cached
+	f.mu.Lock()
+	stored := !f.allValidatorsTime.IsZero()
+	f.mu.Unlock()
+	if stored {
+		t.Error("query started before Invalidate was cached")
+	}
+	if _, err := f.GetAllValidators(context.Background(), cfg); err != nil {
+		t.Fatalf("GetAllValidators() after Invalidate error = %v", err)
+	}
+	data, _ := os.ReadFile(calls)
+	if got := strings.Count(string(data), "x"); got != 2 {
+		t.Errorf("ran %d validator queries, want 2 (one before and one after Invalidate)", got)
+	}
+}
+
 func TestNewFetcher(t *testing.T) {
 	f := NewFetcher()
 
@@ -1201,3 +1485,71 @@ func TestBech32ToHex(t *testing.T) {
 		})
 	}
 }
+
+func TestHexToBech32(t *testing.T) {
+	const hexAddr = "0x0102030405060708090A0B0C0D0E0F1011121314"
+	valoper := HexToBech32(hexAddr, ValoperPrefix)
+	if valoper != "pushvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5v4yt0n" {
+		t.Errorf("HexToBech32(valoper) = %q", valoper)
+	}
+	// Round trip, case-insensitive hex in
+	if got := Bech32ToHex(HexToBech32(strings.ToLower(hexAddr), AccountPrefix)); got != hexAddr {
+		t.Errorf("round trip = %q, want %q", got, hexAddr)
+	}
+
+	for _, bad := range []string{"", "0x1234", "0xZZ02030405060708090A0B0C0D0E0F1011121314", "pushvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5v4yt0n"} {
+		if got := HexToBech32(bad, AccountPrefix); got != "—" {
+			t.Errorf("HexToBech32(%q) = %q, want '—'", bad, got)
+		}
+	}
+}
+
+func TestFetcher_GetAllValidators_MissedBlocks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows not supported in this test")
+	}
+
+	pubkey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, 32))
+	sum := sha256.Sum256(bytes.Repeat([]byte{7}, 32))
+	conv, err := bech32.ConvertBits(sum[:20], 8, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valcons, err := bech32.Encode("pushvalcons", conv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	script := fmt.Sprintf(`#!/usr/bin/env bash
+if [ "$2" = "staking" ]; then
+	echo '{"validators":[{"operator_address":"pushvaloper1a","description":{"moniker":"a"},"consensus_pubkey":{"key":"%s"},"status":"BOND_STATUS_BONDED","tokens":"1","jailed":false},{"operator_address":"pushvaloper1b","description":{"moniker":"b"},"consensus_pubkey":{"key":"OTHER"},"status":"BOND_STATUS_BONDED","tokens":"1","jailed":false}]}'
+	exit 0
+fi
+if [ "$2" = "slashing" ] && [ "$3" = "signing-infos" ]; then
+	echo '{"info":[{"address":"%s","missed_blocks_counter":"42"}],"pagination":{"next_key":null}}'
+	exit 0
+fi
+exit 1
+`, pubkey, valcons)
+	if err := os.WriteFile(filepath.Join(dir, "pchaind"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	oldPath := os.Getenv("PATH")
+	t.Cleanup(func() { os.Setenv("PATH", oldPath) })
+	os.Setenv("PATH", dir+":"+oldPath)
+
+	list, err := NewFetcher().GetAllValidators(context.Background(), config.Config{GenesisDomain: "donut.rpc.push.org", HomeDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("GetAllValidators error: %v", err)
+	}
+	if len(list.Validators) != 2 {
+		t.Fatalf("expected 2 validators, got %d", len(list.Validators))
+	}
+	if list.Validators[0].MissedBlocks != 42 {
+		t.Errorf("expected 42 missed blocks for a, got %d", list.Validators[0].MissedBlocks)
+	}
+	if list.Validators[1].MissedBlocks != 0 {
+		t.Errorf("expected 0 missed blocks for b, got %d", list.Validators[1].MissedBlocks)
+	}
+}
@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+)
+
+// Rewards are a validator's claimable distribution rewards in the base denom
+// (upc), truncated to whole units.
+type Rewards struct {
+	Commission  string `json:"commission"`
+	Outstanding string `json:"outstanding"`
+}
+
+// GetRawValidatorRewards queries the commission and outstanding rewards of
+// validatorAddr from the remote node. Unlike GetValidatorRewards, amounts are
+// returned unrounded in the base denom and query failures are errors.
+func GetRawValidatorRewards(ctx context.Context, cfg config.Config, validatorAddr string) (Rewards, error) {
+	if validatorAddr == "" {
+		return Rewards{}, fmt.Errorf("validator address required")
+	}
+	bin, err := resolvePchaindBin(cfg.HomeDir)
+	if err != nil {
+		return Rewards{}, fmt.Errorf("pchaind not found: %w", err)
+	}
+	remote := fmt.Sprintf("https://%s", cfg.GenesisDomain)
+
+	commission, err := queryRewardAmount(ctx, bin, remote, "commission", validatorAddr)
+	if err != nil {
+		return Rewards{}, err
+	}
+	outstanding, err := queryRewardAmount(ctx, bin, remote, "validator-outstanding-rewards", validatorAddr)
+	if err != nil {
+		return Rewards{}, err
+	}
+	return Rewards{Commission: orZero(commission), Outstanding: orZero(outstanding)}, nil
+}
+
+// queryRewardAmount runs `query distribution <query> <addr>` and returns the
+// first coin's whole-unit amount, or "" if there is none.
+func queryRewardAmount(ctx context.Context, bin, remote, query, validatorAddr string) (string, error) {
+	out, err := commandContext(ctx, bin, "query", "distribution", query, validatorAddr, "--node", remote, "-o", "json").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to query %s: %w", query, err)
+	}
+	return parseRewardAmount(out)
+}
+
+// parseRewardAmount reads both the commission ({"commission":{"commission":[...]}})
+// and outstanding ({"rewards":{"rewards":[...]}}) output formats. Amounts are
+// DecCoins like "1234.5upc"; the fraction and denom are dropped.
+func parseRewardAmount(out []byte) (string, error) {
+	var res struct {
+		Commission struct {
+			Commission []string `json:"commission"`
+		} `json:"commission"`
+		Rewards struct {
+			Rewards []string `json:"rewards"`
+		} `json:"rewards"`
+	}
+	if err := json.Unmarshal(out, &res); err != nil {
+		return "", fmt.Errorf("failed to parse rewards: %w", err)
+	}
+	coins := append(res.Commission.Commission, res.Rewards.Rewards...)
+	if len(coins) == 0 {
+		return "", nil
+	}
+	amount := strings.TrimRight(coins[0], "abcdefghijklmnopqrstuvwxyz/")
+	amount, _, _ = strings.Cut(amount, ".")
+	if amount == "" {
+		return "", fmt.Errorf("invalid reward amount %q", coins[0])
+	}
+	return amount, nil
+}
+
+func orZero(amount string) string {
+	if amount == "" {
+		return "0"
+	}
+	return amount
+}
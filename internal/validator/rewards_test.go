@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+)
+
+func TestParseRewardAmount(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"commission", `{"commission":{"commission":["1234.567upc"]}}`, "1234"},
+		{"outstanding", `{"rewards":{"rewards":["200000000000000000000upc"]}}`, "200000000000000000000"},
+		{"empty", `{"commission":{}}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRewardAmount([]byte(tt.in))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if _, err := parseRewardAmount([]byte("not json")); err == nil {
+		t.Error("expected parse error")
+	}
+}
+
+func TestGetRawValidatorRewards(t *testing.T) {
+	createMockPchaind(t, nil)
+	cfg := config.Config{GenesisDomain: "donut.rpc.push.org", HomeDir: t.TempDir()}
+
+	r, err := GetRawValidatorRewards(context.Background(), cfg, "pushvaloper1test")
+	if err != nil {
+		t.Fatalf("GetRawValidatorRewards error: %v", err)
+	}
+	if r.Commission != "100000000000000000000" || r.Outstanding != "200000000000000000000" {
+		t.Errorf("unexpected rewards: %+v", r)
+	}
+	if _, err := GetRawValidatorRewards(context.Background(), cfg, ""); err == nil {
+		t.Error("expected error for empty address")
+	}
+}
@@ -15,16 +15,20 @@ type KeyInfo struct {
 type Service interface {
     EnsureKey(ctx context.Context, name string) (KeyInfo, error)                  // returns key info
     ImportKey(ctx context.Context, name string, mnemonic string) (KeyInfo, error) // imports key from mnemonic
+    ListKeys(ctx context.Context) ([]KeyInfo, error)                               // lists keyring entries (no secrets)
+    ShowKey(ctx context.Context, name string) (KeyInfo, error)                     // shows a single key (no secrets)
     GetEVMAddress(ctx context.Context, addr string) (string, error)               // returns hex/EVM address
     IsValidator(ctx context.Context, addr string) (bool, error)
     IsAddressValidator(ctx context.Context, cosmosAddr string) (bool, error) // checks if address controls a validator
     Balance(ctx context.Context, addr string) (string, error) // denom string for now
+    Balances(ctx context.Context, addr string) ([]Coin, error) // all denoms held by addr
     Register(ctx context.Context, args RegisterArgs) (string, error) // returns tx hash
     Unjail(ctx context.Context, keyName string) (string, error) // returns tx hash
     EditValidator(ctx context.Context, args EditValidatorArgs) (string, error) // returns tx hash
     WithdrawRewards(ctx context.Context, validatorAddr string, keyName string, includeCommission bool) (string, error) // returns tx hash
     Delegate(ctx context.Context, args DelegateArgs) (string, error) // returns tx hash
     Vote(ctx context.Context, args VoteArgs) (string, error) // returns tx hash
+    QueryTx(ctx context.Context, hash string) (TxResult, error) // ErrTxNotFound until included
 }
 
 type RegisterArgs struct {
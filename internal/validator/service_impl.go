@@ -17,12 +17,24 @@ type Options struct {
 	Keyring       string
 	GenesisDomain string // e.g., donut.rpc.push.org
 	Denom         string // e.g., upc
+	Fees          TxFees // gas and fee overrides for txs
+	BroadcastMode string // sync, async or block; empty leaves pchaind's default
 }
 
 func NewWith(opts Options) Service { return &svc{opts: opts} }
 
 type svc struct{ opts Options }
 
+// txArgs appends the gas/fee and broadcast-mode flags and --yes to a pchaind
+// tx command line.
+func (s *svc) txArgs(args ...string) []string {
+	args = append(args, s.opts.Fees.Args(s.opts.Denom)...)
+	if mode := pchaindBroadcastMode(s.opts.BroadcastMode); mode != "" {
+		args = append(args, "--broadcast-mode="+mode)
+	}
+	return append(args, "--yes")
+}
+
 func (s *svc) EnsureKey(ctx context.Context, name string) (KeyInfo, error) {
 	if name == "" {
 		return KeyInfo{}, errors.New("key name required")
@@ -96,6 +108,62 @@ func (s *svc) getKeyInfo(ctx context.Context, name, addr, mnemonic string) (KeyI
 	}, nil
 }
 
+// ListKeys returns all keys in the configured keyring. Mnemonics are never included.
+func (s *svc) ListKeys(ctx context.Context) ([]KeyInfo, error) {
+	if s.opts.BinPath == "" {
+		s.opts.BinPath = "pchaind"
+	}
+	out, err := commandContext(ctx, s.opts.BinPath, "keys", "list", "--keyring-backend", s.opts.Keyring, "--home", s.opts.HomeDir, "--output", "json").Output()
+	if err != nil {
+		return nil, fmt.Errorf("keys list: %w", err)
+	}
+	return parseKeyList(out)
+}
+
+// ShowKey returns details for a single key. Mnemonics are never included.
+func (s *svc) ShowKey(ctx context.Context, name string) (KeyInfo, error) {
+	if name == "" {
+		return KeyInfo{}, errors.New("key name required")
+	}
+	if s.opts.BinPath == "" {
+		s.opts.BinPath = "pchaind"
+	}
+	out, err := commandContext(ctx, s.opts.BinPath, "keys", "show", name, "--keyring-backend", s.opts.Keyring, "--home", s.opts.HomeDir, "--output", "json").Output()
+	if err != nil {
+		return KeyInfo{}, fmt.Errorf("keys show %s: %w", name, withStderr(err))
+	}
+	keys, err := parseKeyList(append(append([]byte("["), out...), ']'))
+	if err != nil || len(keys) == 0 {
+		return KeyInfo{}, fmt.Errorf("failed to parse key '%s'", name)
+	}
+	return keys[0], nil
+}
+
+// parseKeyList parses `keys list --output json` output. The pubkey field is
+// either a JSON object or (newer SDKs) a JSON-encoded string; both are kept as
+// the raw JSON object text.
+func parseKeyList(output []byte) ([]KeyInfo, error) {
+	var raw []struct {
+		Name    string          `json:"name"`
+		Type    string          `json:"type"`
+		Address string          `json:"address"`
+		Pubkey  json.RawMessage `json:"pubkey"`
+	}
+	if err := json.Unmarshal(output, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse keys: %w", err)
+	}
+	keys := make([]KeyInfo, 0, len(raw))
+	for _, k := range raw {
+		pubkey := string(k.Pubkey)
+		var asString string
+		if json.Unmarshal(k.Pubkey, &asString) == nil {
+			pubkey = asString
+		}
+		keys = append(keys, KeyInfo{Name: k.Name, Type: k.Type, Address: k.Address, Pubkey: pubkey})
+	}
+	return keys, nil
+}
+
 // extractMnemonic extracts the mnemonic phrase from keys add output
 func extractMnemonic(output string) string {
 	lines := strings.Split(output, "\n")
@@ -395,6 +463,19 @@ func (s *svc) IsAddressValidator(ctx context.Context, cosmosAddr string) (bool,
 }
 
 func (s *svc) Balance(ctx context.Context, addr string) (string, error) {
+	coins, err := s.Balances(ctx, addr)
+	if err != nil {
+		return "0", err
+	}
+	for _, c := range coins {
+		if c.Denom == s.opts.Denom {
+			return c.Amount, nil
+		}
+	}
+	return "0", nil
+}
+
+func (s *svc) Balances(ctx context.Context, addr string) ([]Coin, error) {
 	if s.opts.BinPath == "" {
 		s.opts.BinPath = "pchaind"
 	}
@@ -403,20 +484,15 @@ func (s *svc) Balance(ctx context.Context, addr string) (string, error) {
 	q := commandContext(ctx, s.opts.BinPath, "query", "bank", "balances", addr, "--node", remote, "-o", "json")
 	out, err := q.Output()
 	if err != nil {
-		return "0", fmt.Errorf("query balance: %w", err)
+		return nil, fmt.Errorf("query balance: %w", err)
 	}
 	var payload struct {
-		Balances []struct{ Denom, Amount string } `json:"balances"`
+		Balances []Coin `json:"balances"`
 	}
 	if err := json.Unmarshal(out, &payload); err != nil {
-		return "0", err
-	}
-	for _, c := range payload.Balances {
-		if c.Denom == s.opts.Denom {
-			return c.Amount, nil
-		}
+		return nil, err
 	}
-	return "0", nil
+	return payload.Balances, nil
 }
 
 func (s *svc) Register(ctx context.Context, args RegisterArgs) (string, error) {
@@ -459,15 +535,13 @@ func (s *svc) Register(ctx context.Context, args RegisterArgs) (string, error) {
 	remote := fmt.Sprintf("https://%s", s.opts.GenesisDomain)
 	ctxTimeout, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
-	cmd := commandContext(ctxTimeout, s.opts.BinPath, "tx", "staking", "create-validator", tmp.Name(),
+	cmd := commandContext(ctxTimeout, s.opts.BinPath, s.txArgs("tx", "staking", "create-validator", tmp.Name(),
 		"--from", args.KeyName,
 		"--chain-id", s.opts.ChainID,
 		"--keyring-backend", s.opts.Keyring,
 		"--home", s.opts.HomeDir,
 		"--node", remote,
-		"--gas=auto", "--gas-adjustment=1.3", fmt.Sprintf("--gas-prices=1000000000%s", s.opts.Denom),
-		"--yes",
-	)
+	)...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		// Try to extract a clean reason
@@ -491,6 +565,9 @@ func (s *svc) Register(ctx context.Context, args RegisterArgs) (string, error) {
 		}
 		return "", errors.New(msg)
 	}
+	if err := checkTxCode(out); err != nil {
+		return "", err
+	}
 	// Find txhash:
 	lines := strings.Split(string(out), "\n")
 	for _, ln := range lines {
@@ -540,15 +617,13 @@ func (s *svc) Unjail(ctx context.Context, keyName string) (string, error) {
 	ctxTimeout, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
 
-	cmd := commandContext(ctxTimeout, s.opts.BinPath, "tx", "slashing", "unjail",
+	cmd := commandContext(ctxTimeout, s.opts.BinPath, s.txArgs("tx", "slashing", "unjail",
 		"--from", keyName,
 		"--chain-id", s.opts.ChainID,
 		"--keyring-backend", s.opts.Keyring,
 		"--home", s.opts.HomeDir,
 		"--node", remote,
-		"--gas=auto", "--gas-adjustment=1.3", fmt.Sprintf("--gas-prices=1000000000%s", s.opts.Denom),
-		"--yes",
-	)
+	)...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		// Try to extract a clean reason
@@ -559,6 +634,9 @@ func (s *svc) Unjail(ctx context.Context, keyName string) (string, error) {
 		return "", errors.New(msg)
 	}
 
+	if err := checkTxCode(out); err != nil {
+		return "", err
+	}
 	// Find txhash
 	lines := strings.Split(string(out), "\n")
 	for _, ln := range lines {
@@ -590,8 +668,6 @@ func (s *svc) EditValidator(ctx context.Context, args EditValidatorArgs) (string
 		"--keyring-backend", s.opts.Keyring,
 		"--home", s.opts.HomeDir,
 		"--node", remote,
-		"--gas=auto", "--gas-adjustment=1.3", fmt.Sprintf("--gas-prices=1000000000%s", s.opts.Denom),
-		"--yes",
 	}
 
 	// Only include flags for non-empty fields
@@ -614,7 +690,7 @@ func (s *svc) EditValidator(ctx context.Context, args EditValidatorArgs) (string
 	ctxTimeout, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
 
-	cmd := commandContext(ctxTimeout, s.opts.BinPath, cmdArgs...)
+	cmd := commandContext(ctxTimeout, s.opts.BinPath, s.txArgs(cmdArgs...)...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		msg := extractErrorLine(string(out))
@@ -624,6 +700,9 @@ func (s *svc) EditValidator(ctx context.Context, args EditValidatorArgs) (string
 		return "", errors.New(msg)
 	}
 
+	if err := checkTxCode(out); err != nil {
+		return "", err
+	}
 	// Find txhash
 	lines := strings.Split(string(out), "\n")
 	for _, ln := range lines {
@@ -659,8 +738,6 @@ func (s *svc) WithdrawRewards(ctx context.Context, validatorAddr string, keyName
 		"--keyring-backend", s.opts.Keyring,
 		"--home", s.opts.HomeDir,
 		"--node", remote,
-		"--gas=auto", "--gas-adjustment=1.3", fmt.Sprintf("--gas-prices=1000000000%s", s.opts.Denom),
-		"--yes",
 	}
 
 	// Add commission flag if requested
@@ -672,7 +749,7 @@ func (s *svc) WithdrawRewards(ctx context.Context, validatorAddr string, keyName
 	ctxTimeout, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
 
-	cmd := commandContext(ctxTimeout, s.opts.BinPath, args...)
+	cmd := commandContext(ctxTimeout, s.opts.BinPath, s.txArgs(args...)...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		// Extract and enhance error message
@@ -686,6 +763,9 @@ func (s *svc) WithdrawRewards(ctx context.Context, validatorAddr string, keyName
 		return "", errors.New(msg)
 	}
 
+	if err := checkTxCode(out); err != nil {
+		return "", err
+	}
 	// Find txhash
 	lines := strings.Split(string(out), "\n")
 	for _, ln := range lines {
@@ -736,7 +816,7 @@ func (s *svc) Delegate(ctx context.Context, args DelegateArgs) (string, error) {
 	ctxTimeout, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
 
-	cmd := commandContext(ctxTimeout, s.opts.BinPath, "tx", "staking", "delegate",
+	cmd := commandContext(ctxTimeout, s.opts.BinPath, s.txArgs("tx", "staking", "delegate",
 		args.ValidatorAddress,
 		fmt.Sprintf("%s%s", args.Amount, s.opts.Denom),
 		"--from", args.KeyName,
@@ -744,9 +824,7 @@ func (s *svc) Delegate(ctx context.Context, args DelegateArgs) (string, error) {
 		"--keyring-backend", s.opts.Keyring,
 		"--home", s.opts.HomeDir,
 		"--node", remote,
-		"--gas=auto", "--gas-adjustment=1.3", fmt.Sprintf("--gas-prices=1000000000%s", s.opts.Denom),
-		"--yes",
-	)
+	)...)
 
 	out, err := cmd.CombinedOutput()
 	if err != nil {
@@ -758,6 +836,9 @@ func (s *svc) Delegate(ctx context.Context, args DelegateArgs) (string, error) {
 		return "", errors.New(msg)
 	}
 
+	if err := checkTxCode(out); err != nil {
+		return "", err
+	}
 	// Extract tx hash from output
 	lines := strings.Split(string(out), "\n")
 	for _, line := range lines {
@@ -804,7 +885,7 @@ func (s *svc) Vote(ctx context.Context, args VoteArgs) (string, error) {
 	ctxTimeout, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
 
-	cmd := commandContext(ctxTimeout, s.opts.BinPath, "tx", "gov", "vote",
+	cmd := commandContext(ctxTimeout, s.opts.BinPath, s.txArgs("tx", "gov", "vote",
 		args.ProposalID,
 		option,
 		"--from", args.KeyName,
@@ -812,9 +893,7 @@ func (s *svc) Vote(ctx context.Context, args VoteArgs) (string, error) {
 		"--keyring-backend", s.opts.Keyring,
 		"--home", s.opts.HomeDir,
 		"--node", remote,
-		"--gas=auto", "--gas-adjustment=1.3", fmt.Sprintf("--gas-prices=1000000000%s", s.opts.Denom),
-		"--yes",
-	)
+	)...)
 
 	out, err := cmd.CombinedOutput()
 	if err != nil {
@@ -828,6 +907,9 @@ func (s *svc) Vote(ctx context.Context, args VoteArgs) (string, error) {
 		return "", errors.New(msg)
 	}
 
+	if err := checkTxCode(out); err != nil {
+		return "", err
+	}
 	// Extract tx hash from output
 	lines := strings.Split(string(out), "\n")
 	for _, line := range lines {
@@ -918,6 +918,28 @@ exit 1
 	}
 }
 
+func TestValidator_ShowKey_ReportsPchaindError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows not supported in this test")
+	}
+
+	dir := t.TempDir()
+	binPath := filepath.Join(dir, "pchaind")
+	script := "#!/usr/bin/env sh\necho 'Error: failed to open keyring: permission denied' >&2\nexit 1\n"
+	if err := os.WriteFile(binPath, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewWith(Options{BinPath: binPath, HomeDir: t.TempDir(), Keyring: "file"})
+	_, err := s.ShowKey(context.Background(), "test-key")
+	if err == nil {
+		t.Fatal("expected error when pchaind fails")
+	}
+	if !strings.Contains(err.Error(), "permission denied") || strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected pchaind's stderr in the error, got: %v", err)
+	}
+}
+
 func TestValidator_ImportKey_ShowAddressFailure(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("windows not supported in this test")
@@ -1282,3 +1304,97 @@ func TestImproveVoteErrorMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestParseKeyList(t *testing.T) {
+	// Newer SDKs encode pubkey as a JSON string; older ones as an object
+	out := []byte(`[
+		{"name":"a","type":"local","address":"push1a","pubkey":"{\"@type\":\"/eth.PubKey\",\"key\":\"AAA\"}"},
+		{"name":"b","type":"ledger","address":"push1b","pubkey":{"@type":"/eth.PubKey","key":"BBB"}}
+	]`)
+	keys, err := parseKeyList(out)
+	if err != nil {
+		t.Fatalf("parseKeyList error: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("got %d keys, want 2", len(keys))
+	}
+	if keys[0].Name != "a" || keys[0].Address != "push1a" || !strings.Contains(keys[0].Pubkey, "AAA") {
+		t.Errorf("unexpected first key: %+v", keys[0])
+	}
+	if keys[1].Type != "ledger" || !strings.Contains(keys[1].Pubkey, "BBB") {
+		t.Errorf("unexpected second key: %+v", keys[1])
+	}
+	if keys[0].Mnemonic != "" || keys[1].Mnemonic != "" {
+		t.Error("mnemonic must never be populated from keys list")
+	}
+
+	if _, err := parseKeyList([]byte("not json")); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestValidator_TxFeeFlagsForwarded(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows not supported in this test")
+	}
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	bin := filepath.Join(dir, "pchaind")
+	script := "#!/usr/bin/env sh\n" +
+		"echo \"$@\" >> " + argsFile + "\n" +
+		"echo 'txhash: 0xFEE'\n"
+	if err := os.WriteFile(bin, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		fees TxFees
+		want []string
+		not  []string
+	}{
+		{"defaults", TxFees{}, []string{"--gas=auto", "--gas-adjustment=1.3", "--gas-prices=1000000000upc", "--yes"}, []string{"--fees"}},
+		{"fixed gas and flat fee", TxFees{Gas: "400000", Fees: "800000000000000upc"}, []string{"--gas=400000", "--fees=800000000000000upc", "--yes"}, []string{"--gas-adjustment", "--gas-prices"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = os.Remove(argsFile)
+			s := NewWith(Options{BinPath: bin, HomeDir: dir, ChainID: "push_42101-1", Keyring: "test", GenesisDomain: "donut.rpc.push.org", Denom: "upc", Fees: tt.fees})
+			ctx := context.Background()
+			calls := []func() (string, error){
+				func() (string, error) { return s.Unjail(ctx, "k") },
+				func() (string, error) { return s.WithdrawRewards(ctx, "pushvaloper1x", "k", true) },
+				func() (string, error) {
+					return s.Delegate(ctx, DelegateArgs{ValidatorAddress: "pushvaloper1x", Amount: "1", KeyName: "k"})
+				},
+				func() (string, error) { return s.EditValidator(ctx, EditValidatorArgs{KeyName: "k", Moniker: "m"}) },
+				func() (string, error) { return s.Vote(ctx, VoteArgs{ProposalID: "1", Option: "yes", KeyName: "k"}) },
+			}
+			for _, call := range calls {
+				if _, err := call(); err != nil {
+					t.Fatalf("tx error: %v", err)
+				}
+			}
+			data, err := os.ReadFile(argsFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			if len(lines) != len(calls) {
+				t.Fatalf("got %d invocations, want %d", len(lines), len(calls))
+			}
+			for _, line := range lines {
+				for _, w := range tt.want {
+					if !strings.Contains(line, w) {
+						t.Errorf("%q missing %s", line, w)
+					}
+				}
+				for _, n := range tt.not {
+					if strings.Contains(line, n) {
+						t.Errorf("%q should not contain %s", line, n)
+					}
+				}
+			}
+		})
+	}
+}
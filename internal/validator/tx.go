@@ -0,0 +1,142 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Broadcast modes accepted by --broadcast-mode
+const (
+	BroadcastSync  = "sync"  // return after CheckTx
+	BroadcastAsync = "async" // return immediately, before CheckTx
+	BroadcastBlock = "block" // return once the tx is in a block
+)
+
+// ErrTxNotFound means the node doesn't know the tx (yet).
+var ErrTxNotFound = errors.New("tx not found")
+
+// ErrTxFailed means the tx was rejected in CheckTx or failed on-chain.
+var ErrTxFailed = errors.New("tx failed")
+
+// TxResult is a tx's outcome as reported by `query tx`.
+type TxResult struct {
+	Hash    string `json:"txhash"`
+	Height  int64  `json:"height"`
+	Code    uint32 `json:"code"`
+	RawLog  string `json:"raw_log"`
+	GasUsed int64  `json:"gas_used"`
+}
+
+// ValidBroadcastMode reports whether mode is one of the broadcast modes.
+func ValidBroadcastMode(mode string) bool {
+	switch mode {
+	case BroadcastSync, BroadcastAsync, BroadcastBlock:
+		return true
+	}
+	return false
+}
+
+// pchaindBroadcastMode maps a broadcast mode to pchaind's flag value. Cosmos
+// SDK v0.50 dropped block mode, so block is broadcast as sync and the caller
+// waits for inclusion with WaitForTx.
+func pchaindBroadcastMode(mode string) string {
+	if mode == BroadcastBlock {
+		return BroadcastSync
+	}
+	return mode
+}
+
+// QueryTx looks up a tx by hash on the remote node. It returns ErrTxNotFound
+// until the tx is in a block.
+func (s *svc) QueryTx(ctx context.Context, hash string) (TxResult, error) {
+	remote := fmt.Sprintf("https://%s", s.opts.GenesisDomain)
+	ctxTimeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	out, err := commandContext(ctxTimeout, s.opts.BinPath, "query", "tx", hash, "--node", remote, "-o", "json").CombinedOutput()
+	if err != nil {
+		if strings.Contains(strings.ToLower(string(out)), "not found") {
+			return TxResult{}, ErrTxNotFound
+		}
+		msg := extractErrorLine(string(out))
+		if msg == "" {
+			msg = err.Error()
+		}
+		return TxResult{}, errors.New(msg)
+	}
+	return parseTxResult(out)
+}
+
+// parseTxResult parses `query tx -o json` output. The SDK encodes 64-bit
+// numbers as strings.
+func parseTxResult(out []byte) (TxResult, error) {
+	var raw struct {
+		TxHash  string `json:"txhash"`
+		Height  string `json:"height"`
+		Code    uint32 `json:"code"`
+		RawLog  string `json:"raw_log"`
+		GasUsed string `json:"gas_used"`
+	}
+	if err := json.Unmarshal(out, &raw); err != nil {
+		return TxResult{}, fmt.Errorf("failed to parse tx: %w", err)
+	}
+	height, _ := strconv.ParseInt(raw.Height, 10, 64)
+	gasUsed, _ := strconv.ParseInt(raw.GasUsed, 10, 64)
+	return TxResult{Hash: raw.TxHash, Height: height, Code: raw.Code, RawLog: raw.RawLog, GasUsed: gasUsed}, nil
+}
+
+// TxError returns an error wrapping ErrTxFailed if r has a non-zero code.
+func (r TxResult) TxError() error {
+	if r.Code == 0 {
+		return nil
+	}
+	if r.RawLog == "" {
+		return fmt.Errorf("%w with code %d", ErrTxFailed, r.Code)
+	}
+	return fmt.Errorf("%w with code %d: %s", ErrTxFailed, r.Code, r.RawLog)
+}
+
+// checkTxCode reports a tx that pchaind broadcast but CheckTx rejected. pchaind
+// exits 0 in that case and prints a response with a non-zero code.
+func checkTxCode(out []byte) error {
+	var r TxResult
+	for _, ln := range strings.Split(string(out), "\n") {
+		ln = strings.TrimSpace(ln)
+		switch {
+		case strings.HasPrefix(ln, "code:"):
+			code, err := strconv.ParseUint(strings.TrimSpace(strings.TrimPrefix(ln, "code:")), 10, 32)
+			if err == nil {
+				r.Code = uint32(code)
+			}
+		case strings.HasPrefix(ln, "raw_log:"):
+			r.RawLog = strings.Trim(strings.TrimSpace(strings.TrimPrefix(ln, "raw_log:")), `'"`)
+		}
+	}
+	return r.TxError()
+}
+
+// WaitForTx polls query every interval until the tx is in a block or ctx is
+// done. Not-found and transient query errors are retried. A tx included with
+// a non-zero code is returned along with an error wrapping ErrTxFailed.
+func WaitForTx(ctx context.Context, query func(context.Context, string) (TxResult, error), hash string, interval time.Duration) (TxResult, error) {
+	var lastErr error
+	for {
+		r, err := query(ctx, hash)
+		if err == nil {
+			return r, r.TxError()
+		}
+		lastErr = err
+		select {
+		case <-ctx.Done():
+			if errors.Is(lastErr, ErrTxNotFound) {
+				return TxResult{Hash: hash}, fmt.Errorf("tx %s not included in a block before timeout", hash)
+			}
+			return TxResult{Hash: hash}, fmt.Errorf("tx %s not confirmed before timeout: %w", hash, lastErr)
+		case <-time.After(interval):
+		}
+	}
+}
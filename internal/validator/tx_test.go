@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckTxCode(t *testing.T) {
+	ok := "code: 0\ncodespace: \"\"\nraw_log: \"\"\ntxhash: ABC\n"
+	if err := checkTxCode([]byte(ok)); err != nil {
+		t.Errorf("code 0: unexpected error %v", err)
+	}
+	if err := checkTxCode([]byte("txhash: ABC\n")); err != nil {
+		t.Errorf("no code line: unexpected error %v", err)
+	}
+	bad := "code: 13\ncodespace: sdk\nraw_log: 'insufficient fee: got 1upc required 2upc'\ntxhash: ABC\n"
+	err := checkTxCode([]byte(bad))
+	if !errors.Is(err, ErrTxFailed) || !strings.Contains(err.Error(), "code 13: insufficient fee") {
+		t.Errorf("code 13: error = %v", err)
+	}
+}
+
+func TestWaitForTx(t *testing.T) {
+	t.Run("included after not found", func(t *testing.T) {
+		calls := 0
+		query := func(context.Context, string) (TxResult, error) {
+			calls++
+			if calls < 3 {
+				return TxResult{}, ErrTxNotFound
+			}
+			return TxResult{Hash: "ABC", Height: 42}, nil
+		}
+		r, err := WaitForTx(context.Background(), query, "ABC", time.Millisecond)
+		if err != nil || r.Height != 42 || calls != 3 {
+			t.Errorf("got %+v, %v after %d calls", r, err, calls)
+		}
+	})
+
+	t.Run("failed on-chain", func(t *testing.T) {
+		query := func(context.Context, string) (TxResult, error) {
+			return TxResult{Hash: "ABC", Height: 7, Code: 5, RawLog: "out of gas"}, nil
+		}
+		r, err := WaitForTx(context.Background(), query, "ABC", time.Millisecond)
+		if !errors.Is(err, ErrTxFailed) || r.Code != 5 || !strings.Contains(err.Error(), "out of gas") {
+			t.Errorf("got %+v, %v", r, err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		query := func(context.Context, string) (TxResult, error) { return TxResult{}, ErrTxNotFound }
+		_, err := WaitForTx(ctx, query, "ABC", time.Millisecond)
+		if err == nil || errors.Is(err, ErrTxFailed) || !strings.Contains(err.Error(), "not included") {
+			t.Errorf("error = %v", err)
+		}
+	})
+}
+
+func TestValidator_QueryTx(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows not supported in this test")
+	}
+	bin := filepath.Join(t.TempDir(), "pchaind")
+	script := `#!/usr/bin/env sh
+case "$3" in
+FOUND) echo '{"height":"120","txhash":"FOUND","code":0,"raw_log":"","gas_used":"81234"}' ;;
+FAILED) echo '{"height":"121","txhash":"FAILED","code":11,"raw_log":"out of gas in location: Delegate","gas_used":"200000"}' ;;
+PENDING) echo "Error: tx (PENDING) not found" >&2; exit 1 ;;
+*) echo "Error: post failed: connection refused" >&2; exit 1 ;;
+esac
+`
+	if err := os.WriteFile(bin, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	s := NewWith(Options{BinPath: bin, GenesisDomain: "donut.rpc.push.org"})
+	ctx := context.Background()
+
+	r, err := s.QueryTx(ctx, "FOUND")
+	if err != nil || r.Height != 120 || r.Code != 0 || r.GasUsed != 81234 {
+		t.Errorf("FOUND: %+v, %v", r, err)
+	}
+	r, err = s.QueryTx(ctx, "FAILED")
+	if err != nil || r.Code != 11 || r.TxError() == nil {
+		t.Errorf("FAILED: %+v, %v", r, err)
+	}
+	if _, err := s.QueryTx(ctx, "PENDING"); !errors.Is(err, ErrTxNotFound) {
+		t.Errorf("PENDING: error = %v, want ErrTxNotFound", err)
+	}
+	if _, err := s.QueryTx(ctx, "OTHER"); err == nil || errors.Is(err, ErrTxNotFound) {
+		t.Errorf("OTHER: error = %v", err)
+	}
+}
+
+func TestValidator_BroadcastModeForwarded(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows not supported in this test")
+	}
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	bin := filepath.Join(dir, "pchaind")
+	script := "#!/usr/bin/env sh\necho \"$@\" > " + argsFile + "\necho 'txhash: ABC'\n"
+	if err := os.WriteFile(bin, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for mode, want := range map[string]string{"": "", BroadcastAsync: "--broadcast-mode=async", BroadcastBlock: "--broadcast-mode=sync"} {
+		s := NewWith(Options{BinPath: bin, HomeDir: dir, Denom: "upc", BroadcastMode: mode})
+		if _, err := s.Unjail(context.Background(), "k"); err != nil {
+			t.Fatal(err)
+		}
+		data, _ := os.ReadFile(argsFile)
+		if got := string(data); (want == "" && strings.Contains(got, "--broadcast-mode")) || !strings.Contains(got, want) {
+			t.Errorf("mode %q: args %q, want %q", mode, got, want)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package validator
 
+// Coin is a single bank balance entry in base units
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
 // ValidatorInfo contains information about a single validator
 type ValidatorInfo struct {
 	OperatorAddress string
@@ -9,6 +15,7 @@ type ValidatorInfo struct {
 	VotingPower     int64  // Tokens converted to power
 	Commission      string // Commission rate as percentage
 	Jailed          bool
+	MissedBlocks    int64 // Missed blocks in the current signing window (0 if unknown)
 }
 
 // ValidatorList contains a list of validators
@@ -0,0 +1,168 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+)
+
+// SlashingParams holds the chain's downtime-jailing parameters
+type SlashingParams struct {
+	SignedBlocksWindow   int64
+	MinSignedPerWindow   float64 // fraction in [0,1]
+	DowntimeJailDuration string
+}
+
+// Uptime levels, by how much of the allowed downtime has been used
+const (
+	UptimeOK       = "ok"
+	UptimeWarning  = "warning"
+	UptimeCritical = "critical"
+)
+
+// Uptime is a validator's signing record within the current slashing window
+type Uptime struct {
+	Window       int64   `json:"signed_blocks_window"`
+	MinSigned    float64 `json:"min_signed_per_window"`
+	Missed       int64   `json:"missed_blocks"`
+	MaxMissed    int64   `json:"max_missed_blocks"`  // misses allowed before jailing
+	MissesLeft   int64   `json:"misses_until_jail"`  // further misses tolerated; the next one jails
+	UptimePct    float64 `json:"uptime_pct"`         // signed share of the window, 0-100
+	ThresholdPct float64 `json:"jail_threshold_pct"` // uptime below this jails, 0-100
+	Level        string  `json:"level"`              // ok, warning or critical
+	Tombstoned   bool    `json:"tombstoned"`
+}
+
+// ComputeUptime derives the uptime readout from the missed-blocks counter and
+// the slashing params. The chain jails once missed exceeds
+// window - round(window * min_signed_per_window).
+func ComputeUptime(missed int64, params SlashingParams) Uptime {
+	u := Uptime{
+		Window:    params.SignedBlocksWindow,
+		MinSigned: params.MinSignedPerWindow,
+		Missed:    missed,
+	}
+	if u.Window <= 0 {
+		u.Level = UptimeOK
+		return u
+	}
+	minSigned := int64(math.Round(float64(u.Window) * params.MinSignedPerWindow))
+	u.MaxMissed = u.Window - minSigned
+	u.MissesLeft = max(u.MaxMissed-missed, 0)
+	u.UptimePct = float64(u.Window-missed) / float64(u.Window) * 100
+	u.ThresholdPct = params.MinSignedPerWindow * 100
+
+	used := u.BudgetUsed()
+	switch {
+	case used >= 0.8:
+		u.Level = UptimeCritical
+	case used >= 0.5:
+		u.Level = UptimeWarning
+	default:
+		u.Level = UptimeOK
+	}
+	return u
+}
+
+// JailAt is the missed-blocks count at which the chain jails the validator
+func (u Uptime) JailAt() int64 {
+	return u.MaxMissed + 1
+}
+
+// BudgetUsed is the share of the downtime budget already spent, clamped to
+// [0,1]. A zero budget counts as fully used.
+func (u Uptime) BudgetUsed() float64 {
+	if u.MaxMissed <= 0 {
+		return 1
+	}
+	return min(max(float64(u.Missed)/float64(u.MaxMissed), 0), 1)
+}
+
+// WindowSummary is the short form of the readout, e.g.
+// "missed 23/100, jail at 51".
+func (u Uptime) WindowSummary() string {
+	return fmt.Sprintf("missed %d/%d, jail at %d", u.Missed, u.Window, u.JailAt())
+}
+
+// GetSlashingParams queries the chain's slashing params from the remote node
+func GetSlashingParams(ctx context.Context, cfg config.Config) (SlashingParams, error) {
+	bin, err := resolvePchaindBin(cfg.HomeDir)
+	if err != nil {
+		return SlashingParams{}, fmt.Errorf("pchaind not found: %w", err)
+	}
+	remote := fmt.Sprintf("https://%s", cfg.GenesisDomain)
+	output, err := commandContext(ctx, bin, "query", "slashing", "params", "--node", remote, "-o", "json").Output()
+	if err != nil {
+		return SlashingParams{}, fmt.Errorf("failed to query slashing params: %w", err)
+	}
+	return parseSlashingParams(output)
+}
+
+// parseSlashingParams accepts both the wrapped ({"params":{...}}) and bare
+// output formats of `query slashing params`.
+func parseSlashingParams(output []byte) (SlashingParams, error) {
+	type raw struct {
+		SignedBlocksWindow   string `json:"signed_blocks_window"`
+		MinSignedPerWindow   string `json:"min_signed_per_window"`
+		DowntimeJailDuration string `json:"downtime_jail_duration"`
+	}
+	var wrapped struct {
+		Params raw `json:"params"`
+		raw
+	}
+	if err := json.Unmarshal(output, &wrapped); err != nil {
+		return SlashingParams{}, fmt.Errorf("failed to parse slashing params: %w", err)
+	}
+	r := wrapped.Params
+	if r.SignedBlocksWindow == "" {
+		r = wrapped.raw
+	}
+
+	window, err := strconv.ParseInt(r.SignedBlocksWindow, 10, 64)
+	if err != nil {
+		return SlashingParams{}, fmt.Errorf("invalid signed_blocks_window %q", r.SignedBlocksWindow)
+	}
+	minSigned, err := strconv.ParseFloat(r.MinSignedPerWindow, 64)
+	if err != nil {
+		return SlashingParams{}, fmt.Errorf("invalid min_signed_per_window %q", r.MinSignedPerWindow)
+	}
+	return SlashingParams{
+		SignedBlocksWindow:   window,
+		MinSignedPerWindow:   minSigned,
+		DowntimeJailDuration: r.DowntimeJailDuration,
+	}, nil
+}
+
+// GetMyUptime reads this node's consensus pubkey, fetches its signing info and
+// the slashing params, and computes the uptime within the current window.
+func GetMyUptime(ctx context.Context, cfg config.Config) (Uptime, error) {
+	bin, err := resolvePchaindBin(cfg.HomeDir)
+	if err != nil {
+		return Uptime{}, fmt.Errorf("pchaind not found: %w", err)
+	}
+	pubkey, err := commandContext(ctx, bin, "tendermint", "show-validator", "--home", cfg.HomeDir).Output()
+	if err != nil {
+		return Uptime{}, fmt.Errorf("failed to read consensus pubkey: %w", err)
+	}
+
+	info, err := GetSlashingInfo(ctx, cfg, strings.TrimSpace(string(pubkey)))
+	if err != nil {
+		return Uptime{}, fmt.Errorf("%w (is this node a registered validator?)", err)
+	}
+	params, err := GetSlashingParams(ctx, cfg)
+	if err != nil {
+		return Uptime{}, err
+	}
+
+	u := ComputeUptime(info.MissedBlocks, params)
+	if info.Tombstoned {
+		u.Tombstoned = true
+		u.Level = UptimeCritical
+	}
+	return u, nil
+}
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pushchain/push-validator-cli/internal/config"
+)
+
+func TestComputeUptime(t *testing.T) {
+	params := SlashingParams{SignedBlocksWindow: 10000, MinSignedPerWindow: 0.05}
+	tests := []struct {
+		name       string
+		missed     int64
+		wantLeft   int64
+		wantUptime float64
+		wantLevel  string
+	}{
+		{"healthy", 100, 9400, 99, UptimeOK},
+		{"half budget used", 4750, 4750, 52.5, UptimeWarning},
+		{"close to jail", 9000, 500, 10, UptimeCritical},
+		{"past threshold", 9600, 0, 4, UptimeCritical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := ComputeUptime(tt.missed, params)
+			if u.MaxMissed != 9500 {
+				t.Errorf("MaxMissed = %d, want 9500", u.MaxMissed)
+			}
+			if u.MissesLeft != tt.wantLeft {
+				t.Errorf("MissesLeft = %d, want %d", u.MissesLeft, tt.wantLeft)
+			}
+			if u.UptimePct != tt.wantUptime {
+				t.Errorf("UptimePct = %v, want %v", u.UptimePct, tt.wantUptime)
+			}
+			if u.Level != tt.wantLevel {
+				t.Errorf("Level = %s, want %s", u.Level, tt.wantLevel)
+			}
+		})
+	}
+
+	if u := ComputeUptime(3, SlashingParams{}); u.Level != UptimeOK || u.MaxMissed != 0 {
+		t.Errorf("zero window should not divide by zero: %+v", u)
+	}
+}
+
+func TestUptime_WindowSummary(t *testing.T) {
+	params := SlashingParams{SignedBlocksWindow: 100, MinSignedPerWindow: 0.5}
+	tests := []struct {
+		missed      int64
+		wantSummary string
+		wantUsed    float64
+		wantLevel   string
+	}{
+		{0, "missed 0/100, jail at 51", 0, UptimeOK},
+		{23, "missed 23/100, jail at 51", 0.46, UptimeOK},
+		{45, "missed 45/100, jail at 51", 0.9, UptimeCritical},
+		{60, "missed 60/100, jail at 51", 1, UptimeCritical},
+	}
+	for _, tt := range tests {
+		u := ComputeUptime(tt.missed, params)
+		if got := u.WindowSummary(); got != tt.wantSummary {
+			t.Errorf("WindowSummary() = %q, want %q", got, tt.wantSummary)
+		}
+		if got := u.BudgetUsed(); got != tt.wantUsed {
+			t.Errorf("missed %d: BudgetUsed() = %v, want %v", tt.missed, got, tt.wantUsed)
+		}
+		if u.Level != tt.wantLevel {
+			t.Errorf("missed %d: Level = %s, want %s", tt.missed, u.Level, tt.wantLevel)
+		}
+	}
+}
+
+func TestParseSlashingParams(t *testing.T) {
+	for _, in := range []string{
+		`{"params":{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s"}}`,
+		`{"signed_blocks_window":"100","min_signed_per_window":"0.5","downtime_jail_duration":"600s"}`,
+	} {
+		p, err := parseSlashingParams([]byte(in))
+		if err != nil {
+			t.Fatalf("parseSlashingParams(%s) error = %v", in, err)
+		}
+		if p.SignedBlocksWindow != 100 || p.MinSignedPerWindow != 0.5 || p.DowntimeJailDuration != "600s" {
+			t.Errorf("unexpected params: %+v", p)
+		}
+	}
+	if _, err := parseSlashingParams([]byte(`{"params":{}}`)); err == nil {
+		t.Error("expected error for missing window")
+	}
+}
+
+func TestGetMyUptime(t *testing.T) {
+	createMockPchaind(t, nil)
+	cfg := config.Config{GenesisDomain: "donut.rpc.push.org", HomeDir: t.TempDir()}
+
+	u, err := GetMyUptime(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("GetMyUptime error: %v", err)
+	}
+	// Mock reports 5 missed in a 100-block window with 50% min signed
+	if u.Missed != 5 || u.Window != 100 || u.MissesLeft != 45 || u.UptimePct != 95 || u.Level != UptimeOK {
+		t.Errorf("unexpected uptime: %+v", u)
+	}
+}